@@ -82,6 +82,10 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 
 		// check whether the sender address is EOA
 		fromAddr := common.BytesToAddress(from)
+		if avd.evmKeeper.IsAccountFrozen(ctx, fromAddr) {
+			return ctx, errorsmod.Wrapf(evmtypes.ErrAccountFrozen, "sender %s", fromAddr)
+		}
+
 		acct := avd.evmKeeper.GetAccount(ctx, fromAddr)
 
 		if acct == nil {
@@ -204,6 +208,16 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 		priority := evmtypes.GetTxPriority(txData, baseFee)
 
+		// The mempool reaps transactions by priority, so a transaction can't have a higher
+		// priority than the pending transactions of the same sender with lower nonces, otherwise
+		// it could be included before them and fail with an invalid nonce.
+		if ctx.IsCheckTx() {
+			sender := common.HexToAddress(msgEthTx.From)
+			if pending, found := egcd.evmKeeper.GetSenderPriorityTransient(ctx, sender, txData.GetNonce()); found && pending < priority {
+				priority = pending
+			}
+		}
+
 		if priority < minPriority {
 			minPriority = priority
 		}
@@ -227,6 +241,20 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		)
 	}
 
+	// return a retryable error if the tx doesn't fit in the gas left in the block. The block gas
+	// meter is only set in DeliverTx, the tx can be included in a later block.
+	if !ctx.IsCheckTx() && !simulate && ctx.BlockGasMeter() != nil && ctx.BlockGasMeter().Limit() > 0 {
+		if remaining := ctx.BlockGasMeter().GasRemaining(); gasWanted > remaining {
+			egcd.evmKeeper.AddPostponedTx()
+			return ctx, errorsmod.Wrapf(
+				evmtypes.ErrBlockGasExceeded,
+				"eth: tx gas (%d) exceeds remaining block gas (%d)",
+				gasWanted,
+				remaining,
+			)
+		}
+	}
+
 	// Set tx GasMeter with a limit of GasWanted (i.e gas limit from the Ethereum tx).
 	// The gas consumed will be then reset to the gas used by the state transition
 	// in the EVM.
@@ -259,7 +287,7 @@ func NewCanTransferDecorator(evmKeeper EVMKeeper) CanTransferDecorator {
 func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	params := ctd.evmKeeper.GetParams(ctx)
 	ethCfg := params.ChainConfig.EthereumConfig(ctd.evmKeeper.ChainID())
-	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()))
+	signer := ctd.evmKeeper.SenderCachingSigner(ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight())))
 
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgHandleTx)
@@ -315,22 +343,40 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// TxReplacementPriceBump is the minimum percentage by which the effective gas price of a
+// transaction has to exceed the one of the pending transaction with the same nonce to replace it.
+const TxReplacementPriceBump = 10
+
 // EthIncrementSenderSequenceDecorator increments the sequence of the signers.
 type EthIncrementSenderSequenceDecorator struct {
-	ak evmtypes.AccountKeeper
+	ak        evmtypes.AccountKeeper
+	evmKeeper EVMKeeper
 }
 
 // NewEthIncrementSenderSequenceDecorator creates a new EthIncrementSenderSequenceDecorator.
-func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper) EthIncrementSenderSequenceDecorator {
+func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper) EthIncrementSenderSequenceDecorator {
 	return EthIncrementSenderSequenceDecorator{
-		ak: ak,
+		ak:        ak,
+		evmKeeper: ek,
 	}
 }
 
 // AnteHandle handles incrementing the sequence of the signer (i.e sender). If the transaction is a
 // contract creation, the nonce will be incremented during the transaction execution and not within
 // this AnteHandler decorator.
+//
+// During CheckTx, a transaction with the nonce of a pending transaction of the sender replaces it
+// if its effective gas price is at least TxReplacementPriceBump percent higher. The sequence isn't
+// incremented again in that case. The replaced transaction can't be removed from the mempool, it
+// fails with an invalid nonce once the replacement is included in a block.
 func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var baseFee *big.Int
+	if ctx.IsCheckTx() {
+		evmParams := issd.evmKeeper.GetParams(ctx)
+		ethCfg := evmParams.GetChainConfig().EthereumConfig(issd.evmKeeper.ChainID())
+		baseFee = issd.evmKeeper.GetBaseFee(ctx, ethCfg)
+	}
+
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgHandleTx)
 		if !ok {
@@ -350,7 +396,15 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx s
 			)
 		}
 		nonce := acc.GetSequence()
-		
+		sender := common.BytesToAddress(msgEthTx.GetFrom().Bytes())
+
+		if ctx.IsCheckTx() && txData.GetNonce() < nonce {
+			if err := issd.replacePendingTx(ctx, sender, txData, nonce, baseFee); err != nil {
+				return ctx, err
+			}
+			continue
+		}
+
 		// we merged the nonce verification to nonce increment, so when tx includes multiple messages
 		// with same sender, they'll be accepted.
 		if txData.GetNonce() != nonce {
@@ -359,14 +413,47 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx s
 				"invalid nonce; got %d, expected %d", txData.GetNonce(), nonce,
 			)
 		}
-		
+
 		// increase sequence of sender
 		if err := acc.SetSequence(nonce + 1); err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to set sequence to %d", acc.GetSequence()+1)
 		}
 
 		issd.ak.SetAccount(ctx, acc)
+
+		if ctx.IsCheckTx() {
+			issd.evmKeeper.SetPendingTxTransient(ctx, sender, nonce, ctx.Priority(), txData.EffectiveGasPrice(baseFee))
+		}
 	}
 
 	return next(ctx, tx, simulate)
 }
+
+// replacePendingTx replaces the pending transaction of the sender with the nonce of the given
+// transaction, if the effective gas price of the replacement is high enough.
+func (issd EthIncrementSenderSequenceDecorator) replacePendingTx(
+	ctx sdk.Context, sender common.Address, txData evmtypes.TxData, nonce uint64, baseFee *big.Int,
+) error {
+	_, pendingPrice, found := issd.evmKeeper.GetPendingTxTransient(ctx, sender, txData.GetNonce())
+	if !found {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected %d", txData.GetNonce(), nonce,
+		)
+	}
+
+	// minPrice = pendingPrice * (100 + TxReplacementPriceBump) / 100
+	minPrice := new(big.Int).Mul(pendingPrice, big.NewInt(100+TxReplacementPriceBump))
+	minPrice.Div(minPrice, big.NewInt(100))
+
+	gasPrice := txData.EffectiveGasPrice(baseFee)
+	if gasPrice.Cmp(minPrice) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"replacement transaction underpriced; got effective gas price %s, expected at least %s", gasPrice, minPrice,
+		)
+	}
+
+	issd.evmKeeper.SetPendingTxTransient(ctx, sender, txData.GetNonce(), ctx.Priority(), gasPrice)
+	return nil
+}
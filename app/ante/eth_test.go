@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/SigmaGmbH/evm-module/app/ante"
 	"github.com/SigmaGmbH/evm-module/server/config"
@@ -13,6 +14,7 @@ import (
 	// "github.com/SigmaGmbH/evm-module/x/evm/statedb"
 	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -91,6 +93,24 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 			true,
 			true,
 		},
+		{
+			"sender frozen",
+			tx,
+			func() {
+				suite.app.EvmKeeper.SetAccountFrozen(suite.ctx, addr)
+			},
+			true,
+			false,
+		},
+		{
+			"success unfrozen account",
+			tx,
+			func() {
+				suite.app.EvmKeeper.DeleteAccountFrozen(suite.ctx, addr)
+			},
+			true,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -112,7 +132,7 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 
 func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 	suite.SetupTest()
-	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, suite.app.EvmKeeper)
 
 	addr := tests.GenerateAddress()
 
@@ -332,6 +352,149 @@ func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	}
 }
 
+func (suite *AnteTestSuite) TestEthGasConsumeDecoratorBlockGasExceeded() {
+	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, config.DefaultMaxTxGasWanted)
+
+	addr := tests.GenerateAddress()
+
+	ethCfg := suite.app.EvmKeeper.GetParams(suite.ctx).
+		ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
+	gasPrice := new(big.Int).Add(baseFee, evmtypes.DefaultPriorityReduction.BigInt())
+
+	txGasLimit := uint64(1000000)
+	tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), txGasLimit, gasPrice, nil, nil, nil, &ethtypes.AccessList{{Address: addr, StorageKeys: nil}})
+	tx.From = addr.Hex()
+
+	testCases := []struct {
+		name         string
+		consumed     uint64
+		checkTx      bool
+		expErr       error
+		expPostponed uint64
+	}{
+		{"fits in the remaining block gas", 0, false, nil, 0},
+		{"exceeds the remaining block gas", 10000000 - txGasLimit + 1, false, evmtypes.ErrBlockGasExceeded, 1},
+		{"block gas isn't checked in CheckTx", 10000000 - txGasLimit + 1, true, nil, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt(1001000000000000))
+
+			blockGasMeter := sdk.NewGasMeter(10000000)
+			blockGasMeter.ConsumeGas(tc.consumed, "block")
+
+			ctx := suite.ctx.
+				WithIsCheckTx(tc.checkTx).
+				WithBlockGasMeter(blockGasMeter).
+				WithGasMeter(sdk.NewInfiniteGasMeter())
+
+			before := suite.app.EvmKeeper.GetPostponedTxs()
+			_, err := dec.AnteHandle(ctx, tx, false, NextFn)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(before+tc.expPostponed, suite.app.EvmKeeper.GetPostponedTxs())
+		})
+	}
+}
+
+// senderPriorityTestSetup returns the gas consume and sequence decorators chained, a CheckTx context
+// with two funded accounts and a function to build their txs with a tip of the given priority.
+func (suite *AnteTestSuite) senderPriorityTestSetup() (sdk.AnteHandler, sdk.Context, common.Address, common.Address, func(common.Address, uint64, int64) *evmtypes.MsgHandleTx) {
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, config.DefaultMaxTxGasWanted),
+		ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, suite.app.EvmKeeper),
+	)
+
+	addr := tests.GenerateAddress()
+	otherAddr := tests.GenerateAddress()
+
+	ethCfg := suite.app.EvmKeeper.GetParams(suite.ctx).
+		ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
+
+	newTx := func(from common.Address, nonce uint64, tipPriority int64) *evmtypes.MsgHandleTx {
+		gasPrice := new(big.Int).Add(baseFee, big.NewInt(evmtypes.DefaultPriorityReduction.Int64()*tipPriority))
+		tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), nonce, big.NewInt(10), 1000000, gasPrice, nil, nil, nil, &ethtypes.AccessList{{Address: from, StorageKeys: nil}})
+		tx.From = from.Hex()
+		return tx
+	}
+
+	checkCtx, _ := suite.ctx.WithIsCheckTx(true).WithBlockGasMeter(sdk.NewGasMeter(10000000000000000000)).CacheContext()
+	for _, a := range []common.Address{addr, otherAddr} {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(checkCtx, a.Bytes())
+		suite.app.AccountKeeper.SetAccount(checkCtx, acc)
+		suite.Require().NoError(suite.app.EvmKeeper.SetBalance(checkCtx, a, big.NewInt(1000000000000000000)))
+	}
+
+	return anteHandler, checkCtx, addr, otherAddr, newTx
+}
+
+func (suite *AnteTestSuite) TestEthGasConsumeDecoratorSenderPriority() {
+	anteHandler, checkCtx, addr, otherAddr, newTx := suite.senderPriorityTestSetup()
+
+	testCases := []struct {
+		name        string
+		tx          *evmtypes.MsgHandleTx
+		checkTx     bool
+		expPriority int64
+	}{
+		{"first pending tx of the sender", newTx(addr, 0, 3), true, 3},
+		{"higher tip is capped by the pending tx of the sender", newTx(addr, 1, 5), true, 3},
+		{"lower tip lowers the priority of the sender", newTx(addr, 2, 2), true, 2},
+		{"higher tip is capped by the lowest pending tx of the sender", newTx(addr, 3, 3), true, 2},
+		{"other sender isn't capped", newTx(otherAddr, 0, 5), true, 5},
+		{"priority isn't capped in DeliverTx", newTx(addr, 4, 5), false, 5},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, err := anteHandler(checkCtx.WithIsCheckTx(tc.checkTx).WithGasMeter(sdk.NewInfiniteGasMeter()), tc.tx, false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPriority, ctx.Priority())
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestEthIncrementSenderSequenceDecoratorReplacement() {
+	anteHandler, checkCtx, addr, otherAddr, newTx := suite.senderPriorityTestSetup()
+
+	testCases := []struct {
+		name        string
+		tx          *evmtypes.MsgHandleTx
+		checkTx     bool
+		expErr      error
+		expPriority int64
+		expNonce    uint64
+	}{
+		{"first pending tx of the sender", newTx(addr, 0, 3), true, nil, 3, 1},
+		{"second pending tx of the sender", newTx(addr, 1, 5), true, nil, 3, 2},
+		{"replacement below the price bump", newTx(addr, 1, 10), true, errortypes.ErrInsufficientFee, 0, 2},
+		{"replacement with the price bump", newTx(addr, 1, 200), true, nil, 3, 2},
+		{"replacement of the replacement below the price bump", newTx(addr, 1, 210), true, errortypes.ErrInsufficientFee, 0, 2},
+		{"replacement of the first tx raises its priority", newTx(addr, 0, 200), true, nil, 200, 2},
+		{"first pending tx of other sender", newTx(otherAddr, 0, 3), true, nil, 3, 1},
+		{"replacement isn't allowed in DeliverTx", newTx(addr, 1, 400), false, errortypes.ErrInvalidSequence, 0, 2},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, err := anteHandler(checkCtx.WithIsCheckTx(tc.checkTx).WithGasMeter(sdk.NewInfiniteGasMeter()), tc.tx, false)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPriority, ctx.Priority())
+			}
+			suite.Require().Equal(tc.expNonce, suite.app.EvmKeeper.GetNonce(checkCtx, common.HexToAddress(tc.tx.From)))
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestCanTransferDecorator() {
 	dec := ante.NewCanTransferDecorator(suite.app.EvmKeeper)
 
@@ -418,7 +581,7 @@ func (suite *AnteTestSuite) TestCanTransferDecorator() {
 }
 
 func (suite *AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
-	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, suite.app.EvmKeeper)
 	addr, privKey := tests.NewAddrKey()
 
 	contract := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 0, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
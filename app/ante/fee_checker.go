@@ -86,6 +86,12 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) authante.TxFeeChecker {
 		// calculate the effective gas price using the EIP-1559 logic.
 		effectivePrice := sdkmath.NewIntFromBigInt(types.EffectiveGasPrice(baseFeeInt.BigInt(), feeCap.BigInt(), maxPriorityPrice.BigInt()))
 
+		// the effective gas price can't be lower than the global min gas price, which the
+		// MinGasPriceDecorator already checked against the fee cap
+		if minGasPrice := k.GetMinGasPrice(ctx).Ceil().TruncateInt(); effectivePrice.LT(minGasPrice) {
+			effectivePrice = sdkmath.MinInt(minGasPrice, feeCap)
+		}
+
 		// NOTE: create a new coins slice without having to validate the denom
 		effectiveFee := sdk.Coins{
 			{
@@ -24,6 +24,7 @@ var _ DynamicFeeEVMKeeper = MockEVMKeeper{}
 type MockEVMKeeper struct {
 	BaseFee        *big.Int
 	EnableLondonHF bool
+	MinGasPrice    sdk.Dec
 }
 
 func (m MockEVMKeeper) GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int {
@@ -37,6 +38,13 @@ func (m MockEVMKeeper) GetParams(ctx sdk.Context) evmtypes.Params {
 	return evmtypes.DefaultParams()
 }
 
+func (m MockEVMKeeper) GetMinGasPrice(ctx sdk.Context) sdk.Dec {
+	if m.MinGasPrice.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return m.MinGasPrice
+}
+
 func (m MockEVMKeeper) ChainID() *big.Int {
 	return big.NewInt(9000)
 }
@@ -203,6 +211,46 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			5,
 			true,
 		},
+		{
+			"success, dynamic fee charges at least the global min gas price",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10), MinGasPrice: sdk.NewDec(15),
+			},
+			func() sdk.Tx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uswtr", sdk.NewInt(20))))
+
+				option, err := codectypes.NewAnyWithValue(&ethermint.ExtensionOptionDynamicFeeTx{})
+				require.NoError(t, err)
+				txBuilder.SetExtensionOptions(option)
+				return txBuilder.GetTx()
+			},
+			"15uswtr",
+			0,
+			true,
+		},
+		{
+			"success, dynamic fee global min gas price capped by the fee cap",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10), MinGasPrice: sdk.NewDec(15),
+			},
+			func() sdk.Tx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uswtr", sdk.NewInt(12))))
+
+				option, err := codectypes.NewAnyWithValue(&ethermint.ExtensionOptionDynamicFeeTx{})
+				require.NoError(t, err)
+				txBuilder.SetExtensionOptions(option)
+				return txBuilder.GetTx()
+			},
+			"12uswtr",
+			0,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
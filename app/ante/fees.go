@@ -131,6 +131,9 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 // minimum global fee, which is defined by the  MinGasPrice (parameter) * GasLimit (tx argument).
 func (empd EthMinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	minGasPrice := empd.feesKeeper.GetParams(ctx).MinGasPrice
+	if minGasPrice.IsNil() {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidType, "FeeMarketKeeper was not properly initialized, minGasPrice is nil")
+	}
 
 	// short-circuit if min gas price is 0
 	if minGasPrice.IsZero() {
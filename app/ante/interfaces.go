@@ -22,6 +22,7 @@ import (
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 
 	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
@@ -33,6 +34,7 @@ type DynamicFeeEVMKeeper interface {
 	ChainID() *big.Int
 	GetParams(ctx sdk.Context) evmtypes.Params
 	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
+	GetMinGasPrice(ctx sdk.Context) sdk.Dec
 }
 
 // EVMKeeper defines the expected keeper interface used on the Eth AnteHandler
@@ -48,6 +50,12 @@ type EVMKeeper interface {
 	SetAccountCode(ctx sdk.Context, addr common.Address, code []byte) error
 	SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error
 	GetAccount(ctx sdk.Context, addr common.Address) *evmtypes.Account
+	AddPostponedTx()
+	IsAccountFrozen(ctx sdk.Context, addr common.Address) bool
+	GetSenderPriorityTransient(ctx sdk.Context, sender common.Address, nonce uint64) (int64, bool)
+	GetPendingTxTransient(ctx sdk.Context, sender common.Address, nonce uint64) (int64, *big.Int, bool)
+	SetPendingTxTransient(ctx sdk.Context, sender common.Address, nonce uint64, priority int64, gasPrice *big.Int)
+	SenderCachingSigner(signer ethtypes.Signer) ethtypes.Signer
 }
 
 type protoTxProvider interface {
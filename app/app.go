@@ -131,12 +131,24 @@ import (
 	"github.com/SigmaGmbH/evm-module/ethereum/eip712"
 	srvflags "github.com/SigmaGmbH/evm-module/server/flags"
 	ethermint "github.com/SigmaGmbH/evm-module/types"
+	"github.com/SigmaGmbH/evm-module/x/attestation"
+	attestationkeeper "github.com/SigmaGmbH/evm-module/x/attestation/keeper"
+	attestationtypes "github.com/SigmaGmbH/evm-module/x/attestation/types"
 	"github.com/SigmaGmbH/evm-module/x/evm"
 	evmkeeper "github.com/SigmaGmbH/evm-module/x/evm/keeper"
 	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
 	"github.com/SigmaGmbH/evm-module/x/feemarket"
 	feemarketkeeper "github.com/SigmaGmbH/evm-module/x/feemarket/keeper"
 	feemarkettypes "github.com/SigmaGmbH/evm-module/x/feemarket/types"
+	"github.com/SigmaGmbH/evm-module/x/oracle"
+	oraclekeeper "github.com/SigmaGmbH/evm-module/x/oracle/keeper"
+	oracletypes "github.com/SigmaGmbH/evm-module/x/oracle/types"
+	"github.com/SigmaGmbH/evm-module/x/scheduler"
+	schedulerkeeper "github.com/SigmaGmbH/evm-module/x/scheduler/keeper"
+	schedulertypes "github.com/SigmaGmbH/evm-module/x/scheduler/types"
+	"github.com/SigmaGmbH/evm-module/x/tokenfactory"
+	tokenfactorykeeper "github.com/SigmaGmbH/evm-module/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/SigmaGmbH/evm-module/x/tokenfactory/types"
 
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
@@ -187,6 +199,10 @@ var (
 		// Ethermint modules
 		evm.AppModuleBasic{},
 		feemarket.AppModuleBasic{},
+		tokenfactory.AppModuleBasic{},
+		scheduler.AppModuleBasic{},
+		oracle.AppModuleBasic{},
+		attestation.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -200,6 +216,7 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:            nil,
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		tokenfactorytypes.ModuleName:   nil,                                  // deploys and owns the issued token contracts
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -252,8 +269,12 @@ type EthermintApp struct {
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 
 	// Ethermint keepers
-	EvmKeeper       *evmkeeper.Keeper
-	FeeMarketKeeper feemarketkeeper.Keeper
+	EvmKeeper          *evmkeeper.Keeper
+	FeeMarketKeeper    feemarketkeeper.Keeper
+	TokenFactoryKeeper tokenfactorykeeper.Keeper
+	SchedulerKeeper    schedulerkeeper.Keeper
+	OracleKeeper       oraclekeeper.Keeper
+	AttestationKeeper  attestationkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -305,7 +326,8 @@ func NewEthermintApp(
 		// ica keys
 		icahosttypes.StoreKey,
 		// ethermint keys
-		evmtypes.StoreKey, feemarkettypes.StoreKey,
+		evmtypes.StoreKey, feemarkettypes.StoreKey, tokenfactorytypes.StoreKey, schedulertypes.StoreKey,
+		oracletypes.StoreKey, attestationtypes.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -436,6 +458,35 @@ func NewEthermintApp(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper, evmSs,
 	)
+	app.EvmKeeper.SetQueryBudget(evmtypes.QueryBudget{
+		MaxHostCalls: cast.ToUint64(appOpts.Get(srvflags.EVMQueryMaxHostCalls)),
+		Timeout:      cast.ToDuration(appOpts.Get(srvflags.EVMQueryTimeout)),
+	})
+	app.EvmKeeper.SetCodeCacheSize(cast.ToUint64(appOpts.Get(srvflags.EVMCodeCacheSize)) << 20)
+	app.EvmKeeper.SetStateHistory(
+		storeHistory{cms: app.CommitMultiStore(), key: keys[evmtypes.StoreKey]},
+		cast.ToBool(appOpts.Get(srvflags.EVMArchive)),
+	)
+
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName), keys[tokenfactorytypes.StoreKey],
+		app.AccountKeeper, app.EvmKeeper,
+	)
+
+	app.SchedulerKeeper = schedulerkeeper.NewKeeper(
+		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName), keys[schedulertypes.StoreKey],
+		app.EvmKeeper, app.FeeMarketKeeper,
+	)
+
+	app.OracleKeeper = oraclekeeper.NewKeeper(
+		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName), keys[oracletypes.StoreKey],
+		app.EvmKeeper,
+	)
+
+	app.AttestationKeeper = attestationkeeper.NewKeeper(
+		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName), keys[attestationtypes.StoreKey],
+		app.EvmKeeper,
+	)
 
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -538,6 +589,10 @@ func NewEthermintApp(
 		// Ethermint app modules
 		feemarket.NewAppModule(app.FeeMarketKeeper, feeMarketSs),
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
+		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper),
+		scheduler.NewAppModule(app.SchedulerKeeper),
+		oracle.NewAppModule(app.OracleKeeper),
+		attestation.NewAppModule(app.AttestationKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -551,6 +606,8 @@ func NewEthermintApp(
 		capabilitytypes.ModuleName,
 		feemarkettypes.ModuleName,
 		evmtypes.ModuleName,
+		// scheduled calls use the chain id and block hash set by the evm module
+		schedulertypes.ModuleName,
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -569,6 +626,9 @@ func NewEthermintApp(
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
+		tokenfactorytypes.ModuleName,
+		oracletypes.ModuleName,
+		attestationtypes.ModuleName,
 	)
 
 	// NOTE: fee market module must go last in order to retrieve the block gas used.
@@ -576,6 +636,10 @@ func NewEthermintApp(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		// prices are pushed before the evm module commits the block bloom
+		oracletypes.ModuleName,
+		// attestations expire with the validity set by governance in this block
+		attestationtypes.ModuleName,
 		evmtypes.ModuleName,
 		feemarkettypes.ModuleName,
 		// no-op modules
@@ -595,6 +659,8 @@ func NewEthermintApp(
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
+		tokenfactorytypes.ModuleName,
+		schedulertypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -619,6 +685,11 @@ func NewEthermintApp(
 		// NOTE: feemarket need to be initialized before genutil module:
 		// gentx transactions use MinGasPriceDecorator.AnteHandle
 		feemarkettypes.ModuleName,
+		// token factory deploys contracts through the evm module
+		tokenfactorytypes.ModuleName,
+		schedulertypes.ModuleName,
+		oracletypes.ModuleName,
+		attestationtypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
@@ -642,7 +713,7 @@ func NewEthermintApp(
 
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	// Make sure it's called after `app.mm` and `app.configurator` are set.
-	app.RegisterUpgradeHandlers()
+	app.RegisterUpgradeHandlers(homePath)
 
 	// add test gRPC service for testing gRPC queries in isolation
 	// testdata.RegisterTestServiceServer(app.GRPCQueryRouter(), testdata.TestServiceImpl{})
@@ -672,6 +743,14 @@ func NewEthermintApp(
 	// upgrade.
 	app.setPostHandler()
 
+	// the EVM snapshot extension must be registered before the stores are loaded, as state sync can
+	// restore a snapshot right after
+	if manager := app.SnapshotManager(); manager != nil {
+		if err := manager.RegisterExtensions(evmkeeper.NewSnapshotter(app.CommitMultiStore(), app.EvmKeeper)); err != nil {
+			panic(fmt.Errorf("failed to register the EVM snapshot extension: %s", err))
+		}
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -890,6 +969,11 @@ func (app *EthermintApp) GetTxConfig() client.TxConfig {
 	return cfg.TxConfig
 }
 
+// ModuleVersionMap returns the consensus versions of the modules registered in the app.
+func (app *EthermintApp) ModuleVersionMap() module.VersionMap {
+	return app.mm.GetVersionMap()
+}
+
 // RegisterSwaggerAPI registers swagger route with API Server
 func RegisterSwaggerAPI(_ client.Context, rtr *mux.Router) {
 	statikFS, err := fs.New()
@@ -0,0 +1,24 @@
+package app
+
+import (
+	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// storeHistory reports the heights whose state is stored in an IAVL store of the multistore. All
+// stores are committed and pruned together, so the heights of one store are those of the app.
+type storeHistory struct {
+	cms storetypes.CommitMultiStore
+	key storetypes.StoreKey
+}
+
+// LatestVersion returns the latest committed height
+func (h storeHistory) LatestVersion() int64 {
+	return h.cms.LatestVersion()
+}
+
+// VersionExists returns true if the state of the height wasn't pruned
+func (h storeHistory) VersionExists(version int64) bool {
+	store, ok := h.cms.GetCommitKVStore(h.key).(*iavlstore.Store)
+	return ok && store.VersionExists(version)
+}
@@ -16,14 +16,82 @@
 package app
 
 import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	attestationtypes "github.com/SigmaGmbH/evm-module/x/attestation/types"
+	oracletypes "github.com/SigmaGmbH/evm-module/x/oracle/types"
+	schedulertypes "github.com/SigmaGmbH/evm-module/x/scheduler/types"
+	tokenfactorytypes "github.com/SigmaGmbH/evm-module/x/tokenfactory/types"
 )
 
-func (app *EthermintApp) RegisterUpgradeHandlers() {
-	planName := "integration-test-upgrade"
-	app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
-	})
+// Upgrade defines a software upgrade of the chain: the name of its governance plan, the handler run
+// at the upgrade height and the stores added, renamed or deleted by the new binary.
+type Upgrade struct {
+	Name string
+	// CreateHandler returns the upgrade handler, which runs the in-place store migrations of the
+	// modules by default
+	CreateHandler func(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// Upgrades are the software upgrades handled by this binary. A new upgrade is added here with the
+// name of its plan, and the consensus versions of the migrated modules are bumped.
+var Upgrades = []Upgrade{
+	{
+		Name:          "integration-test-upgrade",
+		CreateHandler: CreateMigrationsUpgradeHandler,
+	},
+	{
+		// adds the stores of the modules introduced after the first release
+		Name:          "v1.1.0",
+		CreateHandler: CreateMigrationsUpgradeHandler,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added: []string{
+				attestationtypes.StoreKey,
+				tokenfactorytypes.StoreKey,
+				schedulertypes.StoreKey,
+				oracletypes.StoreKey,
+			},
+		},
+	},
+}
+
+// CreateMigrationsUpgradeHandler returns an upgrade handler running the in-place store migrations of
+// all modules whose consensus version was bumped
+func CreateMigrationsUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
+
+// RegisterUpgradeHandlers registers the handlers of the upgrades and, when the node restarts at the
+// height of a pending upgrade, sets the store loader applying its store upgrades. The upgrade info is
+// read from the home of the node, so the store loader isn't set for apps without a home.
+func (app *EthermintApp) RegisterUpgradeHandlers(homePath string) {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(upgrade.Name, upgrade.CreateHandler(app.mm, app.configurator))
+	}
+	if homePath == "" {
+		return
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Errorf("failed to read upgrade info from disk: %w", err))
+	}
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for i := range Upgrades {
+		if Upgrades[i].Name == upgradeInfo.Name {
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &Upgrades[i].StoreUpgrades))
+			return
+		}
+	}
 }
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/SigmaGmbH/evm-module/encoding"
+)
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	var upgrade Upgrade
+	for _, u := range Upgrades {
+		if u.Name == "v1.1.0" {
+			upgrade = u
+		}
+	}
+	require.NotEmpty(t, upgrade.StoreUpgrades.Added)
+
+	// commit the stores of the binary before the upgrade
+	db := dbm.NewMemDB()
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	for name, key := range Setup(false, nil).keys {
+		if !upgrade.StoreUpgrades.IsAdded(name) {
+			cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		}
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.Commit()
+
+	// the node restarts at the upgrade height with the plan written by the upgrade module
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+	bz, err := json.Marshal(upgradetypes.Plan{Name: upgrade.Name, Height: 2})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data", upgradetypes.UpgradeInfoFilename), bz, 0o600))
+
+	// loading the added stores fails with a version mismatch unless the store loader adds them
+	app := NewEthermintApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, encoding.MakeConfig(ModuleBasics), simapp.EmptyAppOptions{})
+	require.Equal(t, int64(1), app.LastBlockHeight())
+	require.True(t, app.UpgradeKeeper.HasHandler(upgrade.Name))
+	for _, name := range upgrade.StoreUpgrades.Added {
+		require.NotNil(t, app.CommitMultiStore().GetCommitKVStore(app.keys[name]))
+	}
+}
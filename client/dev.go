@@ -0,0 +1,147 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/SigmaGmbH/evm-module/server/config"
+	srvflags "github.com/SigmaGmbH/evm-module/server/flags"
+	"github.com/SigmaGmbH/evm-module/testutil/network"
+	evmmoduletypes "github.com/SigmaGmbH/evm-module/types"
+)
+
+const (
+	flagBlockTime = "block-time"
+
+	// devChainID is the chain ID of the dev node, so wallets keep their network config between runs
+	devChainID = "swisstronik_1337-1"
+	// devMnemonic is the mnemonic of the accounts of Ethereum development tools, so their accounts
+	// are pre-funded on the dev node as well
+	devMnemonic = "test test test test test test test test test test test junk"
+)
+
+// NewDevCmd returns a command which runs a single node chain for dApp development
+func NewDevCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dev",
+		Short: "Run a single node chain for dApp development",
+		Long: `Run a single validator chain in-process for dApp development. By default, a block is sealed as soon as
+a transaction is received. With --block-time, blocks are produced at that interval instead.
+
+The genesis pre-funds --accounts EVM accounts derived from the mnemonic along the Ethereum HD path. The default
+mnemonic is the one of Ethereum development tools, so the accounts and the chain ID are the same on every run.
+The accounts are printed with their private keys at startup.
+
+Transactions are encrypted like on any other node, with the master key of the enclave of this machine. It is
+created if missing, so the dev node works with SW mode enclaves. The chain state is removed on exit.
+
+Example:
+	swisstronikd dev --block-time 1s
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			blockTime, _ := cmd.Flags().GetDuration(flagBlockTime)
+			numAccounts, _ := cmd.Flags().GetInt(flagNumAccounts)
+			mnemonic, _ := cmd.Flags().GetString(flagMnemonic)
+			initKey, _ := cmd.Flags().GetBool(flagInitMasterKey)
+
+			networkConfig := network.DefaultConfig()
+			networkConfig.NumValidators = 1
+			networkConfig.ChainID, _ = cmd.Flags().GetString(flags.FlagChainID)
+			networkConfig.RPCAddress, _ = cmd.Flags().GetString(flagRPCAddress)
+			networkConfig.JSONRPCAddress, _ = cmd.Flags().GetString(srvflags.JSONRPCAddress)
+			networkConfig.EnableTMLogging, _ = cmd.Flags().GetBool(flagEnableLogging)
+			if blockTime < 0 {
+				return fmt.Errorf("negative block time %s", blockTime)
+			}
+			networkConfig.TimeoutCommit = blockTime
+			networkConfig.SkipEmptyBlocks = blockTime == 0
+
+			accounts, err := newDevAccounts(mnemonic, numAccounts)
+			if err != nil {
+				return err
+			}
+			if err := addDevAccountsToGenesis(networkConfig, accounts); err != nil {
+				return err
+			}
+
+			if initKey {
+				if err := initMasterKey(cmd); err != nil {
+					return err
+				}
+			}
+
+			baseDir, err := os.MkdirTemp("", "swisstronik-dev-")
+			if err != nil {
+				return err
+			}
+
+			node, err := network.New(network.NewCLILogger(cmd), baseDir, networkConfig)
+			if err != nil {
+				return err
+			}
+			defer node.Cleanup()
+
+			if _, err := node.WaitForHeight(1); err != nil {
+				return err
+			}
+
+			printDevNode(cmd, networkConfig, accounts)
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			<-ctx.Done()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Duration(flagBlockTime, 0, "Interval between blocks (0 seals a block as soon as a transaction is received)")
+	cmd.Flags().Int(flagNumAccounts, 10, "Number of EVM accounts pre-funded in the genesis, derived from the mnemonic")
+	cmd.Flags().String(flagMnemonic, devMnemonic, "Mnemonic the pre-funded EVM accounts are derived from")
+	cmd.Flags().Bool(flagInitMasterKey, true, "Create a master key if the enclave of this machine doesn't hold one")
+	cmd.Flags().String(flags.FlagChainID, devChainID, "Chain ID of the dev chain")
+	cmd.Flags().String(flagRPCAddress, "tcp://127.0.0.1:26657", "the RPC address to listen on")
+	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
+	cmd.Flags().Bool(flagEnableLogging, false, "Enable INFO logging of the tendermint node")
+	return cmd
+}
+
+// printDevNode prints the endpoints and the pre-funded accounts of the dev node
+func printDevNode(cmd *cobra.Command, cfg network.Config, accounts devAccounts) {
+	balance := cfg.AccountTokens.Quo(evmmoduletypes.PowerReduction)
+
+	cmd.Println()
+	cmd.Println("Available Accounts")
+	cmd.Println("==================")
+	for i, account := range accounts.Accounts {
+		cmd.Printf("(%d) %s (%s SWTR)\n", i, account.Address, balance)
+	}
+
+	cmd.Println()
+	cmd.Println("Private Keys")
+	cmd.Println("==================")
+	for i, account := range accounts.Accounts {
+		cmd.Printf("(%d) %s\n", i, account.PrivateKey)
+	}
+
+	cmd.Println()
+	cmd.Printf("Mnemonic:   %s\n", accounts.Mnemonic)
+	cmd.Printf("Chain ID:   %s\n", cfg.ChainID)
+	cmd.Printf("JSON-RPC:   http://%s\n", cfg.JSONRPCAddress)
+	cmd.Printf("Tendermint: %s\n", cfg.RPCAddress)
+	if cfg.SkipEmptyBlocks {
+		cmd.Println("Blocks:     sealed on transaction receipt")
+	} else {
+		cmd.Printf("Blocks:     every %s\n", cfg.TimeoutCommit.Round(time.Millisecond))
+	}
+	cmd.Println()
+	cmd.Println("press Ctrl+C to stop the node")
+}
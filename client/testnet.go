@@ -25,6 +25,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/SigmaGmbH/librustgo"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/spf13/cobra"
@@ -75,6 +76,9 @@ var (
 	flagRPCAddress        = "rpc.address"
 	flagAPIAddress        = "api.address"
 	flagPrintMnemonic     = "print-mnemonic"
+	flagNumAccounts       = "accounts"
+	flagMnemonic          = "mnemonic"
+	flagInitMasterKey     = "init-master-key"
 )
 
 type initArgs struct {
@@ -87,6 +91,9 @@ type initArgs struct {
 	numValidators     int
 	outputDir         string
 	startingIPAddress string
+	numAccounts       int
+	mnemonic          string
+	initMasterKey     bool
 }
 
 type startArgs struct {
@@ -101,6 +108,9 @@ type startArgs struct {
 	numValidators  int
 	enableLogging  bool
 	printMnemonic  bool
+	numAccounts    int
+	mnemonic       string
+	initMasterKey  bool
 }
 
 func addTestnetFlagsToCmd(cmd *cobra.Command) {
@@ -112,6 +122,9 @@ func addTestnetFlagsToCmd(cmd *cobra.Command) {
 			evmmoduletypes.SwtrDenom),
 		"Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01photino,0.001stake)")
 	cmd.Flags().String(flags.FlagKeyAlgorithm, string(hd.EthSecp256k1Type), "Key signing algorithm to generate keys for")
+	cmd.Flags().Int(flagNumAccounts, 10, "Number of EVM accounts pre-funded in the genesis, derived from the mnemonic")
+	cmd.Flags().String(flagMnemonic, "", "Mnemonic the pre-funded EVM accounts are derived from, if left blank a new one is generated")
+	cmd.Flags().Bool(flagInitMasterKey, true, "Create a master key if the enclave of this machine doesn't hold one")
 }
 
 // NewTestnetCmd creates a root testnet command with subcommands to run an in-process testnet or initialize
@@ -144,6 +157,14 @@ or a similar setup where each node has a manually configurable IP address.
 
 Note, strict routability for addresses is turned off in the config file.
 
+The genesis pre-funds --accounts EVM accounts derived from the mnemonic along the Ethereum HD path, so the
+mnemonic can be imported into Ethereum wallets. The mnemonic and the accounts with their private keys are
+written to accounts.json in the output directory.
+
+The enclaves of the nodes must hold the same master key. Nodes on this machine share the master key of its
+enclave, which is created if missing. SW mode enclaves can't pass remote attestation, so nodes on other
+machines must copy the sealed master key instead of requesting it with the attested seed exchange.
+
 Example:
 	swisstronikd testnet init-files --v 4 --output-dir ./.testnets --starting-ip-address 192.168.10.2
 	`,
@@ -165,6 +186,9 @@ Example:
 			args.startingIPAddress, _ = cmd.Flags().GetString(flagStartingIPAddress)
 			args.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
 			args.algo, _ = cmd.Flags().GetString(flags.FlagKeyAlgorithm)
+			args.numAccounts, _ = cmd.Flags().GetInt(flagNumAccounts)
+			args.mnemonic, _ = cmd.Flags().GetString(flagMnemonic)
+			args.initMasterKey, _ = cmd.Flags().GetBool(flagInitMasterKey)
 
 			return initTestnetFiles(clientCtx, cmd, serverCtx.Config, mbm, genBalIterator, args)
 		},
@@ -190,6 +214,10 @@ func testnetStartCmd() *cobra.Command {
 and generate "v" directories, populated with necessary validator configuration files
 (private validator, genesis, config, etc.).
 
+The genesis pre-funds --accounts EVM accounts derived from the mnemonic along the Ethereum HD path. They are
+written with their private keys to accounts.json in the testnet directory. The nodes share the master key of
+the enclave of this machine, which is created if missing.
+
 Example:
 	swisstronikd testnet --v 4 --output-dir ./.testnets
 	`,
@@ -206,6 +234,9 @@ Example:
 			args.grpcAddress, _ = cmd.Flags().GetString(srvflags.GRPCAddress)
 			args.jsonrpcAddress, _ = cmd.Flags().GetString(srvflags.JSONRPCAddress)
 			args.printMnemonic, _ = cmd.Flags().GetBool(flagPrintMnemonic)
+			args.numAccounts, _ = cmd.Flags().GetInt(flagNumAccounts)
+			args.mnemonic, _ = cmd.Flags().GetString(flagMnemonic)
+			args.initMasterKey, _ = cmd.Flags().GetBool(flagInitMasterKey)
 
 			return startTestnet(cmd, args)
 		},
@@ -233,7 +264,15 @@ func initTestnetFiles(
 	args initArgs,
 ) error {
 	if args.chainID == "" {
-		args.chainID = fmt.Sprintf("swisstronikd_%d-1", tmrand.Int63n(9999999999999)+1)
+		args.chainID = fmt.Sprintf("swisstronik_%d-1", tmrand.Int63n(9999999999999)+1)
+	}
+	if !evmmoduletypes.IsValidChainID(args.chainID) {
+		return fmt.Errorf("invalid chain-id %s, expected {identifier}_{EIP155 chain ID}-{version}, e.g. swisstronik_1291-1", args.chainID)
+	}
+
+	accounts, err := newDevAccounts(args.mnemonic, args.numAccounts)
+	if err != nil {
+		return err
 	}
 
 	nodeIDs := make([]string, args.numValidators)
@@ -376,11 +415,17 @@ func initTestnetFiles(
 		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config/app.toml"), appConfig)
 	}
 
+	devGenAccounts, devGenBalances := accounts.genesis(sdk.NewCoins(
+		sdk.NewCoin(evmmoduletypes.SwtrDenom, sdk.TokensFromConsensusPower(10000, evmmoduletypes.PowerReduction)),
+	))
+	genAccounts = append(genAccounts, devGenAccounts...)
+	genBalances = append(genBalances, devGenBalances...)
+
 	if err := initGenFiles(clientCtx, mbm, args.chainID, evmmoduletypes.SwtrDenom, genAccounts, genBalances, genFiles, args.numValidators); err != nil {
 		return err
 	}
 
-	err := collectGenFiles(
+	err = collectGenFiles(
 		clientCtx, nodeConfig, args.chainID, nodeIDs, valPubKeys, args.numValidators,
 		args.outputDir, args.nodeDirPrefix, args.nodeDaemonHome, genBalIterator,
 	)
@@ -388,7 +433,37 @@ func initTestnetFiles(
 		return err
 	}
 
+	accountsFile, err := accounts.write(args.outputDir)
+	if err != nil {
+		return err
+	}
+
+	if args.initMasterKey {
+		if err := initMasterKey(cmd); err != nil {
+			return err
+		}
+	}
+
 	cmd.PrintErrf("Successfully initialized %d node directories\n", args.numValidators)
+	cmd.PrintErrf("Pre-funded %d EVM accounts, written to %s\n", args.numAccounts, accountsFile)
+	return nil
+}
+
+// initMasterKey creates a master key if the enclave of this machine doesn't hold one. The enclave keeps
+// the sealed master key outside of the node homes, so all local nodes share it.
+func initMasterKey(cmd *cobra.Command) error {
+	initialized, err := librustgo.IsNodeInitialized()
+	if err != nil {
+		return err
+	}
+	if initialized {
+		return nil
+	}
+
+	if err := librustgo.InitializeMasterKey(false); err != nil {
+		return fmt.Errorf("failed to create the master key: %w", err)
+	}
+	cmd.PrintErrln("Created a new master key in the enclave of this machine")
 	return nil
 }
 
@@ -561,6 +636,20 @@ func startTestnet(cmd *cobra.Command, args startArgs) error {
 	networkConfig.PrintMnemonic = args.printMnemonic
 	networkLogger := network.NewCLILogger(cmd)
 
+	accounts, err := newDevAccounts(args.mnemonic, args.numAccounts)
+	if err != nil {
+		return err
+	}
+	if err := addDevAccountsToGenesis(networkConfig, accounts); err != nil {
+		return err
+	}
+
+	if args.initMasterKey {
+		if err := initMasterKey(cmd); err != nil {
+			return err
+		}
+	}
+
 	baseDir := fmt.Sprintf("%s/%s", args.outputDir, networkConfig.ChainID)
 	if _, err := os.Stat(baseDir); !os.IsNotExist(err) {
 		return fmt.Errorf(
@@ -573,6 +662,12 @@ func startTestnet(cmd *cobra.Command, args startArgs) error {
 		return err
 	}
 
+	accountsFile, err := accounts.write(baseDir)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("pre-funded %d EVM accounts, written to %s\n", args.numAccounts, accountsFile)
+
 	_, err = testnet.WaitForHeight(1)
 	if err != nil {
 		return err
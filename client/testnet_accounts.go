@@ -0,0 +1,125 @@
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/SigmaGmbH/evm-module/crypto/hd"
+	"github.com/SigmaGmbH/evm-module/testutil/network"
+	evmmoduletypes "github.com/SigmaGmbH/evm-module/types"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// devAccountsFile is the file in the testnet directory the pre-funded accounts are written to
+const devAccountsFile = "accounts.json"
+
+// devAccount is an EVM account pre-funded in the genesis of a local testnet
+type devAccount struct {
+	Address       string `json:"address"`
+	Bech32Address string `json:"bech32_address"`
+	PrivateKey    string `json:"private_key"`
+}
+
+// devAccounts are the pre-funded accounts of a local testnet with the mnemonic they are derived from
+type devAccounts struct {
+	Mnemonic string       `json:"mnemonic"`
+	Accounts []devAccount `json:"accounts"`
+}
+
+// newDevAccounts derives n accounts from the mnemonic along the Ethereum HD path m/44'/60'/0'/0/i, so
+// wallets importing the mnemonic find the same accounts. A new mnemonic is generated if it's empty.
+func newDevAccounts(mnemonic string, n int) (devAccounts, error) {
+	if mnemonic == "" {
+		entropy, err := bip39.NewEntropy(256)
+		if err != nil {
+			return devAccounts{}, err
+		}
+		if mnemonic, err = bip39.NewMnemonic(entropy); err != nil {
+			return devAccounts{}, err
+		}
+	}
+
+	hdPathIter, err := evmmoduletypes.NewHDPathIterator(evmmoduletypes.BIP44HDPath, false)
+	if err != nil {
+		return devAccounts{}, err
+	}
+
+	accounts := devAccounts{Mnemonic: mnemonic, Accounts: make([]devAccount, n)}
+	for i := range accounts.Accounts {
+		bz, err := hd.EthSecp256k1.Derive()(mnemonic, "", hdPathIter().String())
+		if err != nil {
+			return devAccounts{}, err
+		}
+		privKey := hd.EthSecp256k1.Generate()(bz)
+		addr := privKey.PubKey().Address()
+
+		accounts.Accounts[i] = devAccount{
+			Address:       common.BytesToAddress(addr).Hex(),
+			Bech32Address: sdk.AccAddress(addr).String(),
+			PrivateKey:    hexutil.Encode(privKey.Bytes()),
+		}
+	}
+
+	return accounts, nil
+}
+
+// genesis returns the genesis accounts and balances funding every account with the coins
+func (d devAccounts) genesis(coins sdk.Coins) ([]authtypes.GenesisAccount, []banktypes.Balance) {
+	genAccounts := make([]authtypes.GenesisAccount, len(d.Accounts))
+	genBalances := make([]banktypes.Balance, len(d.Accounts))
+	for i, account := range d.Accounts {
+		addr := sdk.AccAddress(common.HexToAddress(account.Address).Bytes())
+		genAccounts[i] = &evmmoduletypes.EthAccount{
+			BaseAccount: authtypes.NewBaseAccount(addr, nil, 0, 0),
+			CodeHash:    common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+		}
+		genBalances[i] = banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
+	}
+
+	return genAccounts, genBalances
+}
+
+// write writes the accounts with their private keys to the testnet directory, readable by the owner only
+func (d devAccounts) write(dir string) (string, error) {
+	bz, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	file := filepath.Join(dir, devAccountsFile)
+	if err := os.MkdirAll(dir, nodeDirPerm); err != nil {
+		return "", err
+	}
+	return file, os.WriteFile(file, bz, 0o600)
+}
+
+// addDevAccountsToGenesis adds the accounts to the genesis state of the in-process testnet, funded with the
+// account tokens of its validators
+func addDevAccountsToGenesis(cfg network.Config, d devAccounts) error {
+	genAccounts, genBalances := d.genesis(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, cfg.AccountTokens)))
+
+	var authGenState authtypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[authtypes.ModuleName], &authGenState)
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return err
+	}
+	authGenState.Accounts = append(authGenState.Accounts, accounts...)
+	cfg.GenesisState[authtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&authGenState)
+
+	var bankGenState banktypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankGenState)
+	bankGenState.Balances = append(bankGenState.Balances, genBalances...)
+	cfg.GenesisState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenState)
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"testing"
+
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDevAccounts(t *testing.T) {
+	// the accounts of the mnemonic are well known from Ethereum development tools
+	mnemonic := "test test test test test test test test test test test junk"
+
+	accounts, err := newDevAccounts(mnemonic, 2)
+	require.NoError(t, err)
+	require.Equal(t, mnemonic, accounts.Mnemonic)
+	require.Len(t, accounts.Accounts, 2)
+	require.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", accounts.Accounts[0].Address)
+	require.Equal(t, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", accounts.Accounts[0].PrivateKey)
+	require.Equal(t, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", accounts.Accounts[1].Address)
+
+	accounts, err = newDevAccounts("", 1)
+	require.NoError(t, err)
+	require.True(t, bip39.IsMnemonicValid(accounts.Mnemonic))
+	require.Len(t, accounts.Accounts, 1)
+}
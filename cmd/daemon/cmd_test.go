@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
@@ -26,3 +27,14 @@ func TestInitCmd(t *testing.T) {
 	err := svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
 	require.NoError(t, err)
 }
+
+func TestEnclaveStatusCmdWithoutSeed(t *testing.T) {
+	rootCmd, _ := daemon.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"enclave",
+		"status",
+	})
+
+	err := svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
+	require.Equal(t, server.ErrorCode{Code: 2}, err)
+}
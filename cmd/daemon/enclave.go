@@ -0,0 +1,192 @@
+package root
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/SigmaGmbH/librustgo"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+
+	attestationtypes "github.com/SigmaGmbH/evm-module/x/attestation/types"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// Exit codes of the enclave status command, in the order the checks are run
+const (
+	exitSeedUnavailable = 2
+	exitNotAttested     = 3
+	exitEpochKeyInvalid = 4
+)
+
+// enclaveStatus is the output of the enclave status command
+type enclaveStatus struct {
+	// SeedAvailable is true if the enclave holds the sealed master seed
+	SeedAvailable bool `json:"seed_available"`
+	// NodePublicKey is the public key the enclave is registered with
+	NodePublicKey string `json:"node_public_key,omitempty"`
+	// Attestation is the registration of the enclave in the attestation module
+	Attestation *enclaveAttestation `json:"attestation,omitempty"`
+	// CurrentEpoch is the state encryption key epoch of the latest block
+	CurrentEpoch uint64 `json:"current_epoch"`
+	// EpochPublicKey is the public key the enclave derives for the current epoch
+	EpochPublicKey string `json:"epoch_public_key,omitempty"`
+	// Error describes the failed check, if any
+	Error string `json:"error,omitempty"`
+}
+
+// enclaveAttestation contains the measurements and the freshness of the registered attestation
+type enclaveAttestation struct {
+	MrEnclave        string     `json:"mr_enclave"`
+	MrSigner         string     `json:"mr_signer"`
+	IsvSvn           uint32     `json:"isv_svn"`
+	RegisteredHeight int64      `json:"registered_height"`
+	RegisteredTime   time.Time  `json:"registered_time"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	Expired          bool       `json:"expired"`
+}
+
+// EnclaveCmd returns the parent command for the commands managing the SGX enclave of the node
+func EnclaveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "enclave",
+		Short:                      "Commands for managing the SGX enclave of the node",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		EnclaveStatusCmd(),
+	)
+	return cmd
+}
+
+// EnclaveStatusCmd returns a command which checks that the enclave of the node can serve the chain
+func EnclaveStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Check that the enclave of the node holds the master seed and is attested",
+		Long: `Check the SGX enclave of the node and print its status. The enclave must hold the sealed master seed,
+its public key must be registered in the attestation module without an expired attestation, and it must
+derive the public key of the current state encryption key epoch. The registration and the key epochs are
+queried from the node given by --node.
+
+The command exits with a non-zero code if a check fails, so it can be used by monitoring:
+  1  the command failed, e.g. the node couldn't be queried
+  2  the enclave doesn't hold the master seed
+  3  the enclave isn't registered or its attestation expired
+  4  the enclave doesn't derive the public key of the current key epoch
+`,
+		Args:          cobra.NoArgs,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				cmd.PrintErrln("Error:", err)
+				return err
+			}
+
+			status, code, err := checkEnclave(cmd, clientCtx)
+			if err != nil {
+				cmd.PrintErrln("Error:", err)
+				return err
+			}
+
+			bz, err := json.MarshalIndent(status, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.PrintBytes(bz); err != nil {
+				return err
+			}
+			if code != 0 {
+				return sdkserver.ErrorCode{Code: code}
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// checkEnclave runs the checks of the enclave status command. It returns the exit code of the
+// first failed check, the remaining checks are skipped.
+func checkEnclave(cmd *cobra.Command, clientCtx client.Context) (enclaveStatus, int, error) {
+	var status enclaveStatus
+
+	seedAvailable, err := librustgo.IsNodeInitialized()
+	if err != nil {
+		return status, 0, fmt.Errorf("failed to access the enclave: %w", err)
+	}
+	status.SeedAvailable = seedAvailable
+	if !seedAvailable {
+		status.Error = "sealed master key was not found"
+		return status, exitSeedUnavailable, nil
+	}
+
+	nodeKey, err := librustgo.GetNodePublicKey()
+	if err != nil {
+		return status, 0, fmt.Errorf("failed to get the node public key: %w", err)
+	}
+	status.NodePublicKey = hex.EncodeToString(nodeKey.PublicKey)
+
+	attestationClient := attestationtypes.NewQueryClient(clientCtx)
+	paramsRes, err := attestationClient.Params(cmd.Context(), &attestationtypes.QueryParamsRequest{})
+	if err != nil {
+		return status, 0, err
+	}
+	nodeRes, err := attestationClient.Node(cmd.Context(), &attestationtypes.QueryNodeRequest{PublicKey: status.NodePublicKey})
+	if grpcstatus.Code(err) == codes.NotFound {
+		status.Error = "enclave isn't registered in the attestation module"
+		return status, exitNotAttested, nil
+	} else if err != nil {
+		return status, 0, err
+	}
+	node := nodeRes.Node
+	status.Attestation = &enclaveAttestation{
+		MrEnclave:        hex.EncodeToString(node.MrEnclave),
+		MrSigner:         hex.EncodeToString(node.MrSigner),
+		IsvSvn:           node.IsvSvn,
+		RegisteredHeight: node.RegisteredHeight,
+		RegisteredTime:   node.RegisteredTime,
+		Expired:          node.Expired,
+	}
+	if validity := paramsRes.Params.AttestationValidity; validity > 0 {
+		expiresAt := node.RegisteredTime.Add(validity)
+		status.Attestation.ExpiresAt = &expiresAt
+	}
+	if node.Expired {
+		status.Error = "attestation of the enclave expired, register the node with a fresh quote"
+		return status, exitNotAttested, nil
+	}
+
+	evmClient := evmtypes.NewQueryClient(clientCtx)
+	epochRes, err := evmClient.EpochKeys(cmd.Context(), &evmtypes.QueryEpochKeysRequest{})
+	if err != nil {
+		return status, 0, err
+	}
+	status.CurrentEpoch = epochRes.CurrentEpoch
+
+	// the enclave derives a single key pair from the master seed, which is used in every epoch
+	publicKey := common.BytesToHash(nodeKey.PublicKey)
+	status.EpochPublicKey = publicKey.Hex()
+	for _, key := range epochRes.EpochKeys {
+		if key.Epoch == epochRes.CurrentEpoch && common.HexToHash(key.PublicKey) != publicKey {
+			status.Error = fmt.Sprintf("chain uses public key %s, the enclave doesn't hold the master seed of the chain", key.PublicKey)
+			return status, exitEpochKeyInvalid, nil
+		}
+	}
+
+	return status, 0, nil
+}
@@ -0,0 +1,109 @@
+package root
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+
+	"github.com/SigmaGmbH/evm-module/app"
+	evmkeeper "github.com/SigmaGmbH/evm-module/x/evm/keeper"
+)
+
+const (
+	flagDumpStart   = "start"
+	flagDumpLimit   = "limit"
+	flagDumpStorage = "storage"
+)
+
+// ExportEVMStateCmd returns a command which streams the EVM state in the go-ethereum dump format
+func ExportEVMStateCmd(appCreator appCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-evm-state",
+		Short: "Stream the EVM state in the go-ethereum dump format",
+		Long: `Stream the EVM accounts with their code and optionally their storage as JSON lines, one account per
+line in the format of the go-ethereum state dump, ordered by address. The storage of confidential contracts
+is written encrypted, as stored.
+
+With --limit, the last line contains the address of the next account, {"next": "0x..."}, which resumes the
+export when passed to --start. The node must be stopped, since its databases are locked while running.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+
+			height, err := cmd.Flags().GetInt64(sdkserver.FlagHeight)
+			if err != nil {
+				return err
+			}
+			start, err := cmd.Flags().GetString(flagDumpStart)
+			if err != nil {
+				return err
+			}
+			if start != "" && !common.IsHexAddress(start) {
+				return fmt.Errorf("invalid start address %s", start)
+			}
+			opts := evmkeeper.DumpOptions{Start: common.HexToAddress(start)}
+			if opts.Limit, err = cmd.Flags().GetInt(flagDumpLimit); err != nil {
+				return err
+			}
+			if opts.Storage, err = cmd.Flags().GetBool(flagDumpStorage); err != nil {
+				return err
+			}
+			if opts.Limit < 0 {
+				return fmt.Errorf("negative limit %d", opts.Limit)
+			}
+
+			dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+			db, err := dbm.NewDB("application", sdkserver.GetAppDBBackend(serverCtx.Viper), dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			ethermintApp := app.NewEthermintApp(
+				serverCtx.Logger, db, nil, height == -1, map[int64]bool{}, "", uint(1), appCreator.encCfg, serverCtx.Viper,
+			)
+			if height != -1 {
+				if err := ethermintApp.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+
+			ctx := ethermintApp.BaseApp.NewUncachedContext(false, tmproto.Header{Height: ethermintApp.LastBlockHeight()})
+
+			out := bufio.NewWriter(cmd.OutOrStdout())
+			encoder := json.NewEncoder(out)
+			next, err := ethermintApp.EvmKeeper.DumpAccounts(ctx, opts, func(account state.DumpAccount) error {
+				return encoder.Encode(account)
+			})
+			if err != nil {
+				return err
+			}
+			if next != nil {
+				if err := encoder.Encode(struct {
+					Next common.Address `json:"next"`
+				}{*next}); err != nil {
+					return err
+				}
+			}
+
+			return out.Flush()
+		},
+	}
+
+	cmd.Flags().Int64(sdkserver.FlagHeight, -1, "Export the state at a particular height (-1 means latest height)")
+	cmd.Flags().String(flagDumpStart, "", "Address of the first exported account, the next cursor of the previous export")
+	cmd.Flags().Int(flagDumpLimit, 0, "Max number of exported accounts (0 exports all accounts)")
+	cmd.Flags().Bool(flagDumpStorage, false, "Export the storage of the accounts")
+
+	return cmd
+}
@@ -0,0 +1,224 @@
+package root
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	tmnode "github.com/tendermint/tendermint/node"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/SigmaGmbH/evm-module/app"
+)
+
+// UpgradeCheckCmd returns a command which dry-runs the upgrade plan against a copy of the node state
+func UpgradeCheckCmd(appCreator appCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-check [plan-file]",
+		Short: "Dry-run upgrade plan against a copy of the node state",
+		Long: `Validate compatibility of this binary with the node state before the real upgrade height.
+The command copies the application state, checks that the SGX enclave is available and runs the upgrade
+handler of the plan with its store migrations on a branch of the latest state of the copy. The branch is
+discarded, so neither the copy nor the original state is modified. Past blocks aren't re-executed, since
+the node only keeps the latest state to execute them against. The node must be stopped, since its
+databases are locked while running.
+
+The plan file contains upgrade plan in JSON format, e.g. {"name": "v2", "height": "1000"}.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var plan upgradetypes.Plan
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &plan); err != nil {
+				return fmt.Errorf("failed to parse upgrade plan: %w", err)
+			}
+			if err := plan.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cfg := serverCtx.Config
+			dbBackend := sdkserver.GetAppDBBackend(serverCtx.Viper)
+
+			tmpDir, err := os.MkdirTemp("", "upgrade-check-")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmpDir)
+
+			appDBDir := "application.db"
+			if err := copyDir(filepath.Join(cfg.RootDir, "data", appDBDir), filepath.Join(tmpDir, appDBDir)); err != nil {
+				return fmt.Errorf("failed to copy application state: %w", err)
+			}
+
+			db, err := dbm.NewDB("application", dbBackend, tmpDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// open local tendermint db to load the header of the latest block
+			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := tmstore.NewBlockStore(tmdb)
+			defer blockStore.Close()
+
+			ethermintApp, ok := appCreator.newApp(serverCtx.Logger, db, nil, serverCtx.Viper).(*app.EthermintApp)
+			if !ok {
+				return fmt.Errorf("unexpected application type")
+			}
+
+			checker := upgradeChecker{
+				out:        cmd.OutOrStdout(),
+				app:        ethermintApp,
+				blockStore: blockStore,
+			}
+
+			if incompatibilities := checker.Run(plan); incompatibilities > 0 {
+				return fmt.Errorf("found %d incompatibilities", incompatibilities)
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "no incompatibilities found")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// upgradeChecker runs the checks of the upgrade-check command against a copy of the state
+type upgradeChecker struct {
+	out        io.Writer
+	app        *app.EthermintApp
+	blockStore *tmstore.BlockStore
+
+	incompatibilities int
+}
+
+func (c *upgradeChecker) reportf(format string, args ...interface{}) {
+	c.incompatibilities++
+	fmt.Fprintf(c.out, "INCOMPATIBLE: "+format+"\n", args...)
+}
+
+// Run runs all checks and returns the number of found incompatibilities
+func (c *upgradeChecker) Run(plan upgradetypes.Plan) int {
+	height := c.app.LastBlockHeight()
+	block := c.blockStore.LoadBlock(height)
+	if block == nil {
+		c.reportf("block %d of the application state is missing in the block store", height)
+		return c.incompatibilities
+	}
+
+	fmt.Fprintf(c.out, "checking upgrade %q against state at height %d\n", plan.Name, height)
+
+	// the migrations write to a branch of the latest state, which is never written back
+	ctx, _ := c.app.BaseApp.NewUncachedContext(false, *block.Header.ToProto()).CacheContext()
+	c.app.EvmKeeper.WithChainID(ctx)
+
+	c.checkEnclave()
+	c.checkMigrations(ctx, plan)
+
+	return c.incompatibilities
+}
+
+// checkEnclave checks that the SGX enclave used by this binary is available
+func (c *upgradeChecker) checkEnclave() {
+	nodePublicKey, err := c.app.EvmKeeper.GetNodePublicKey()
+	if err != nil {
+		c.reportf("enclave is not available: %s", err)
+		return
+	}
+
+	fmt.Fprintf(c.out, "enclave node public key: %s\n", nodePublicKey.Hex())
+}
+
+// checkMigrations reports module version changes and runs the upgrade handler
+func (c *upgradeChecker) checkMigrations(ctx sdk.Context, plan upgradetypes.Plan) {
+	fromVM := c.app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	toVM := c.app.ModuleVersionMap()
+
+	modules := make([]string, 0, len(toVM))
+	for name := range toVM {
+		modules = append(modules, name)
+	}
+	sort.Strings(modules)
+
+	for _, name := range modules {
+		from, found := fromVM[name]
+		switch {
+		case !found:
+			fmt.Fprintf(c.out, "module %s: new, version %d\n", name, toVM[name])
+		case from > toVM[name]:
+			c.reportf("module %s: stored version %d is newer than binary version %d", name, from, toVM[name])
+		case from != toVM[name]:
+			fmt.Fprintf(c.out, "module %s: migrate from version %d to %d\n", name, from, toVM[name])
+		}
+	}
+
+	if !c.app.UpgradeKeeper.HasHandler(plan.Name) {
+		c.reportf("upgrade handler %q is not registered in this binary", plan.Name)
+		return
+	}
+
+	// ApplyUpgrade panics if migrations fail
+	defer func() {
+		if r := recover(); r != nil {
+			c.reportf("store migrations failed: %v", r)
+		}
+	}()
+
+	c.app.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+	fmt.Fprintln(c.out, "store migrations applied")
+}
+
+// copyDir recursively copies directory content from src to dst
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode())
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		_, err = io.Copy(out, in)
+		return err
+	})
+}
@@ -0,0 +1,68 @@
+package root
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// ValidateGenesisCmd returns the validate-genesis command of the SDK, which also checks the EVM
+// genesis accounts against the accounts of the auth genesis. The modules validate their genesis
+// separately, so a code hash not matching the code of a contract is otherwise only found by
+// InitGenesis when the chain starts.
+func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := genutilcli.ValidateGenesisCmd(mbm)
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		cdc := client.GetClientContextFromCmd(cmd).Codec
+
+		genesis := serverCtx.Config.GenesisFile()
+		if len(args) > 0 {
+			genesis = args[0]
+		}
+
+		// a malformed genesis file or module genesis is reported by the validation of the SDK
+		genDoc, err := tmtypes.GenesisDocFromFile(genesis)
+		if err != nil {
+			return nil
+		}
+		var appState map[string]json.RawMessage
+		if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+			return nil
+		}
+		var authGenesis authtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[authtypes.ModuleName], &authGenesis); err != nil {
+			return nil
+		}
+		var evmGenesis evmtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenesis); err != nil {
+			return nil
+		}
+
+		genAccounts, err := authtypes.UnpackAccounts(authGenesis.Accounts)
+		if err != nil {
+			return nil
+		}
+		accounts := make([]authtypes.AccountI, len(genAccounts))
+		for i, account := range genAccounts {
+			accounts[i] = account
+		}
+
+		if err := evmGenesis.ValidateAccounts(accounts); err != nil {
+			return fmt.Errorf("error validating genesis file %s: %s", genesis, err)
+		}
+		return nil
+	}
+
+	return cmd
+}
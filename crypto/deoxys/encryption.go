@@ -1,11 +1,11 @@
 package deoxys
 
 import (
+	"crypto/cipher"
 	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
 	"fmt"
-	"github.com/oasisprotocol/deoxysii"
 	"golang.org/x/crypto/curve25519"
 )
 
@@ -14,63 +14,20 @@ import (
 //
 // As an output, this function returns vector which contains 15 bytes nonce and ciphertext.
 func EncryptState(masterKey, contractAddress, value []byte) ([]byte, error) {
-	// Derive tx encryption key
-	txKey := DeriveEncryptionKey(masterKey, []byte("StateEncryptionKeyV1"))
-	// Derive contract encryption key
-	contractKey := DeriveEncryptionKey(txKey, contractAddress)
-	// Encrypt storage value
-	return encryptDeoxys(contractKey, value)
+	return NewDeoxysProvider().EncryptState(masterKey, contractAddress, value)
 }
 
 func DecryptState(masterKey, contractAddress, value []byte) ([]byte, error) {
-	// Derive tx encryption key
-	txKey := DeriveEncryptionKey(masterKey, []byte("StateEncryptionKeyV1"))
-	// Derive contract encryption key
-	contractKey := DeriveEncryptionKey(txKey, contractAddress)
-	// Encrypt storage value
-	return decryptDeoxys(contractKey, value)
+	return NewDeoxysProvider().DecryptState(masterKey, contractAddress, value)
 }
 
 // EncryptECDH encrypts provided value using encryption key, derived from user private key and node public key.
 func EncryptECDH(privateKey, nodePublicKey, data []byte) ([]byte, error) {
-	// Check length of node public key
-	if len(nodePublicKey) != 32 {
-		return nil, fmt.Errorf("wrong public key size. Expected 32, got %d", len(nodePublicKey))
-	}
-	// Derive shared secret
-	sharedSecret, err := diffieHellman(privateKey, nodePublicKey)
-	if err != nil {
-		return nil, err
-	}
-	// Derive encryption key
-	encryptionKey := DeriveEncryptionKey(sharedSecret[:], []byte("IOEncryptionKeyV1"))
-	// Encrypt data
-	encryptedData, err := encryptDeoxys(encryptionKey, data)
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepend encrypted data with user public key
-	var sizedPrivateKey [32]byte
-	copy(sizedPrivateKey[:], privateKey[:32])
-	userPublicKey := GetCurve25519PublicKey(sizedPrivateKey)
-	return append(userPublicKey[:], encryptedData...), nil
+	return NewDeoxysProvider().EncryptECDH(privateKey, nodePublicKey, data)
 }
 
 func DecryptECDH(privateKey, nodePublicKey, encryptedData []byte) ([]byte, error) {
-	// Check length of node public key
-	if len(nodePublicKey) != 32 {
-		return nil, fmt.Errorf("wrong public key size. Expected 32, got %d", len(nodePublicKey))
-	}
-	// Derive shared secret
-	sharedSecret, err := diffieHellman(privateKey, nodePublicKey)
-	if err != nil {
-		return nil, err
-	}
-	// Derive encryption key
-	encryptionKey := DeriveEncryptionKey(sharedSecret[:], []byte("IOEncryptionKeyV1"))
-	// Decrypt data
-	return decryptDeoxys(encryptionKey, encryptedData)
+	return NewDeoxysProvider().DecryptECDH(privateKey, nodePublicKey, encryptedData)
 }
 
 func diffieHellman(privateKey, publicKey []byte) ([]byte, error) {
@@ -83,47 +40,49 @@ func GetCurve25519PublicKey(privateKey [32]byte) [32]byte {
 	return publicKey
 }
 
-func encryptDeoxys(encryptionKey, plaintext []byte) ([]byte, error) {
-	// Generate additional data
-	ad := make([]byte, deoxysii.TagSize)
-	// Generate random nonce
-	var nonce [deoxysii.NonceSize]byte
-	if _, err := rand.Read(nonce[:]); err != nil {
-		return nil, fmt.Errorf("failed to generate random nonce: %w", err)
-	}
+// seal encrypts the plaintext with the AEAD cipher constructed by newCipher. The output
+// contains the random nonce, the additional data and the ciphertext.
+func seal(newCipher func(key []byte) (cipher.AEAD, error), encryptionKey, plaintext []byte) ([]byte, error) {
 	// Construct cipher
-	cipher, err := deoxysii.New(encryptionKey)
+	aead, err := newCipher(encryptionKey)
 	if err != nil {
 		return nil, err
 	}
+	// Generate additional data
+	ad := make([]byte, aead.Overhead())
+	// Generate random nonce
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate random nonce: %w", err)
+	}
 	// Encrypt value
-	ciphertext := cipher.Seal(nil, nonce[:], plaintext, ad[:])
+	ciphertext := aead.Seal(nil, nonce, plaintext, ad)
 
-	var result = append(nonce[:], ad...)
+	var result = append(nonce, ad...)
 	result = append(result, ciphertext...)
 
 	return result, nil
 }
 
-func decryptDeoxys(encryptionKey, encryptedData []byte) ([]byte, error) {
-	// Split encrypted data into nonce, ad, ciphertext
-	nonce := encryptedData[:deoxysii.NonceSize]
-	ad := encryptedData[deoxysii.NonceSize : deoxysii.NonceSize+deoxysii.TagSize]
-	ciphertext := encryptedData[deoxysii.NonceSize+deoxysii.TagSize:]
-
+// open decrypts data produced by seal with the AEAD cipher constructed by newCipher
+func open(newCipher func(key []byte) (cipher.AEAD, error), encryptionKey, encryptedData []byte) ([]byte, error) {
 	// Construct cipher
-	cipher, err := deoxysii.New(encryptionKey)
+	aead, err := newCipher(encryptionKey)
 	if err != nil {
 		return nil, err
 	}
 
-	// Decrypt value
-	plaintext, err := cipher.Open(nil, nonce, ciphertext, ad)
-	if err != nil {
-		return nil, err
+	// Split encrypted data into nonce, ad, ciphertext
+	nonceSize, adSize := aead.NonceSize(), aead.Overhead()
+	if len(encryptedData) < nonceSize+adSize+aead.Overhead() {
+		return nil, fmt.Errorf("encrypted data is too short: %d bytes", len(encryptedData))
 	}
+	nonce := encryptedData[:nonceSize]
+	ad := encryptedData[nonceSize : nonceSize+adSize]
+	ciphertext := encryptedData[nonceSize+adSize:]
 
-	return plaintext, nil
+	// Decrypt value
+	return aead.Open(nil, nonce, ciphertext, ad)
 }
 
 // DeriveEncryptionKey derives encryption key using master key and salt
@@ -0,0 +1,55 @@
+package deoxys
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// Key derivation versions
+const (
+	// KeyDerivationV1 derives keys as HMAC-SHA256 of the master key with the salt as HMAC key.
+	// The domain is not part of the derivation, which is kept for backward compatibility.
+	KeyDerivationV1 byte = 1
+	// KeyDerivationV2 derives keys with HKDF-SHA256 using the domain label as info, so keys
+	// derived for different encrypted surfaces are independent even for equal salts.
+	KeyDerivationV2 byte = 2
+)
+
+// KeyDomain labels the protocol an encryption key is derived for
+type KeyDomain string
+
+// Key derivation domains
+const (
+	KeyDomainState       KeyDomain = "swisstronik/v2/state"
+	KeyDomainTxEnvelope  KeyDomain = "swisstronik/v2/tx-envelope"
+	KeyDomainRPCResponse KeyDomain = "swisstronik/v2/rpc-response"
+	KeyDomainSeedShare   KeyDomain = "swisstronik/v2/seed-share"
+)
+
+// DeriveEncryptionKeyWithVersion derives an encryption key from the master key and salt with
+// the derivation selected by the version byte. The domain is only used by KeyDerivationV2.
+func DeriveEncryptionKeyWithVersion(version byte, masterKey, salt []byte, domain KeyDomain) ([]byte, error) {
+	switch version {
+	case KeyDerivationV1:
+		return DeriveEncryptionKey(masterKey, salt), nil
+	case KeyDerivationV2:
+		if domain == "" {
+			return nil, fmt.Errorf("key domain cannot be empty")
+		}
+		return deriveHKDF(masterKey, salt, []byte(domain))
+	default:
+		return nil, fmt.Errorf("unsupported key derivation version %d", version)
+	}
+}
+
+// deriveHKDF derives a 32 bytes key with HKDF-SHA256
+func deriveHKDF(masterKey, salt, info []byte) ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, masterKey, salt, info), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,49 @@
+package deoxys
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestDeriveEncryptionKeyWithVersion(t *testing.T) {
+	masterKey := make([]byte, 32)
+	salt := []byte("test")
+
+	// version 1 matches the legacy derivation and ignores the domain
+	v1, err := DeriveEncryptionKeyWithVersion(KeyDerivationV1, masterKey, salt, KeyDomainState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(v1) != "19c3288df186addcbf1a9fbab4e4be48aaa7d8468a955eea3326f5a63807142a" {
+		t.Fatal("version 1 must match the legacy derivation")
+	}
+
+	state, err := DeriveEncryptionKeyWithVersion(KeyDerivationV2, masterKey, salt, KeyDomainState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	envelope, err := DeriveEncryptionKeyWithVersion(KeyDerivationV2, masterKey, salt, KeyDomainTxEnvelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := DeriveEncryptionKeyWithVersion(KeyDerivationV2, masterKey, salt, KeyDomainRPCResponse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state) != 32 || bytes.Equal(state, v1) || bytes.Equal(state, envelope) || bytes.Equal(envelope, response) {
+		t.Fatal("keys of different domains must be independent")
+	}
+
+	again, _ := DeriveEncryptionKeyWithVersion(KeyDerivationV2, masterKey, salt, KeyDomainState)
+	if !bytes.Equal(state, again) {
+		t.Fatal("derivation must be deterministic")
+	}
+
+	if _, err := DeriveEncryptionKeyWithVersion(KeyDerivationV2, masterKey, salt, ""); err == nil {
+		t.Fatal("expected error for empty domain")
+	}
+	if _, err := DeriveEncryptionKeyWithVersion(3, masterKey, salt, KeyDomainState); err == nil {
+		t.Fatal("expected error for unsupported version")
+	}
+}
@@ -0,0 +1,134 @@
+package deoxys
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"os"
+
+	"github.com/oasisprotocol/deoxysii"
+)
+
+const (
+	// SGXModeEnv is the environment variable selecting the SGX mode of the node
+	SGXModeEnv = "SGX_MODE"
+	// SGXModeSoftware runs the node without enclave hardware
+	SGXModeSoftware = "SW"
+)
+
+// EncryptionProvider encrypts contract state and transaction data. In hardware mode the
+// encryption happens inside the enclave, the software providers implement the same scheme
+// in Go, so the confidential flow can be exercised on machines without SGX.
+type EncryptionProvider interface {
+	// EncryptState encrypts a storage value with the key derived for the contract
+	EncryptState(masterKey, contractAddress, value []byte) ([]byte, error)
+	// DecryptState decrypts a storage value encrypted with EncryptState
+	DecryptState(masterKey, contractAddress, value []byte) ([]byte, error)
+	// EncryptECDH encrypts data with the key shared between the user and the node.
+	// The output is prepended with the user public key.
+	EncryptECDH(privateKey, nodePublicKey, data []byte) ([]byte, error)
+	// DecryptECDH decrypts data encrypted with the shared key, without the public key prefix
+	DecryptECDH(privateKey, nodePublicKey, encryptedData []byte) ([]byte, error)
+}
+
+var (
+	_ EncryptionProvider = SoftwareProvider{}
+)
+
+// SoftwareProvider implements the encryption scheme of the enclave in Go with the
+// configured AEAD cipher.
+type SoftwareProvider struct {
+	newCipher func(key []byte) (cipher.AEAD, error)
+}
+
+// NewDeoxysProvider returns a software provider using Deoxys-II, whose output is
+// compatible with the enclave.
+func NewDeoxysProvider() SoftwareProvider {
+	return SoftwareProvider{newCipher: deoxysii.New}
+}
+
+// NewAESGCMProvider returns a software provider using AES-256-GCM. Its output can't be
+// decrypted by the enclave, so it's only suited for networks without enclave nodes.
+func NewAESGCMProvider() SoftwareProvider {
+	return SoftwareProvider{newCipher: newAESGCM}
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// IsSoftwareMode returns true if the node runs without enclave hardware
+func IsSoftwareMode() bool {
+	return os.Getenv(SGXModeEnv) == SGXModeSoftware
+}
+
+// ProviderFromEnv returns the Deoxys-II software provider if SGX_MODE=SW and the given
+// hardware provider otherwise.
+func ProviderFromEnv(hardware EncryptionProvider) EncryptionProvider {
+	if IsSoftwareMode() || hardware == nil {
+		return NewDeoxysProvider()
+	}
+	return hardware
+}
+
+// EncryptState implements EncryptionProvider
+func (p SoftwareProvider) EncryptState(masterKey, contractAddress, value []byte) ([]byte, error) {
+	return seal(p.newCipher, stateKey(masterKey, contractAddress), value)
+}
+
+// DecryptState implements EncryptionProvider
+func (p SoftwareProvider) DecryptState(masterKey, contractAddress, value []byte) ([]byte, error) {
+	return open(p.newCipher, stateKey(masterKey, contractAddress), value)
+}
+
+// EncryptECDH implements EncryptionProvider
+func (p SoftwareProvider) EncryptECDH(privateKey, nodePublicKey, data []byte) ([]byte, error) {
+	encryptionKey, err := ioKey(privateKey, nodePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	encryptedData, err := seal(p.newCipher, encryptionKey, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepend encrypted data with user public key
+	var sizedPrivateKey [32]byte
+	copy(sizedPrivateKey[:], privateKey)
+	userPublicKey := GetCurve25519PublicKey(sizedPrivateKey)
+	return append(userPublicKey[:], encryptedData...), nil
+}
+
+// DecryptECDH implements EncryptionProvider
+func (p SoftwareProvider) DecryptECDH(privateKey, nodePublicKey, encryptedData []byte) ([]byte, error) {
+	encryptionKey, err := ioKey(privateKey, nodePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(p.newCipher, encryptionKey, encryptedData)
+}
+
+// stateKey derives the state encryption key of the contract
+func stateKey(masterKey, contractAddress []byte) []byte {
+	txKey := DeriveEncryptionKey(masterKey, []byte("StateEncryptionKeyV1"))
+	return DeriveEncryptionKey(txKey, contractAddress)
+}
+
+// ioKey derives the transaction data encryption key shared between the user and the node
+func ioKey(privateKey, nodePublicKey []byte) ([]byte, error) {
+	if len(privateKey) != 32 {
+		return nil, fmt.Errorf("wrong private key size. Expected 32, got %d", len(privateKey))
+	}
+	if len(nodePublicKey) != 32 {
+		return nil, fmt.Errorf("wrong public key size. Expected 32, got %d", len(nodePublicKey))
+	}
+	sharedSecret, err := diffieHellman(privateKey, nodePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return DeriveEncryptionKey(sharedSecret, []byte("IOEncryptionKeyV1")), nil
+}
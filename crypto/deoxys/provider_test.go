@@ -0,0 +1,100 @@
+package deoxys
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestSoftwareProviders(t *testing.T) {
+	providers := map[string]SoftwareProvider{
+		"deoxys":  NewDeoxysProvider(),
+		"aes-gcm": NewAESGCMProvider(),
+	}
+
+	masterKey := make([]byte, 32)
+	contractAddress := make([]byte, 20)
+	value := []byte("confidential")
+
+	var userPrivateKey, nodePrivateKey [32]byte
+	rand.Read(userPrivateKey[:])
+	rand.Read(nodePrivateKey[:])
+	nodePublicKey := GetCurve25519PublicKey(nodePrivateKey)
+
+	for name, provider := range providers {
+		encryptedState, err := provider.EncryptState(masterKey, contractAddress, value)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		decryptedState, err := provider.DecryptState(masterKey, contractAddress, encryptedState)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if !bytes.Equal(value, decryptedState) {
+			t.Fatalf("%s: state decryption failed", name)
+		}
+		if _, err := provider.DecryptState(masterKey, []byte("other contract"), encryptedState); err == nil {
+			t.Fatalf("%s: state of another contract must not be decryptable", name)
+		}
+		if _, err := provider.DecryptState(masterKey, contractAddress, encryptedState[:10]); err == nil {
+			t.Fatalf("%s: truncated state must not be decryptable", name)
+		}
+
+		encryptedData, err := provider.EncryptECDH(userPrivateKey[:], nodePublicKey[:], value)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		decryptedData, err := provider.DecryptECDH(nodePrivateKey[:], encryptedData[:32], encryptedData[32:])
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if !bytes.Equal(value, decryptedData) {
+			t.Fatalf("%s: ECDH decryption failed", name)
+		}
+	}
+}
+
+func TestDeoxysProviderCompatibility(t *testing.T) {
+	masterKey := make([]byte, 32)
+	contractAddress := make([]byte, 20)
+	value := make([]byte, 32)
+
+	// the software provider must produce the format of the enclave
+	encryptedState, err := NewDeoxysProvider().EncryptState(masterKey, contractAddress, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewAESGCMProvider().DecryptState(masterKey, contractAddress, encryptedState); err == nil {
+		t.Fatal("deoxys ciphertext must not be decryptable with aes-gcm")
+	}
+	if len(encryptedState) != 15+16+len(value)+16 {
+		t.Fatalf("unexpected ciphertext length %d", len(encryptedState))
+	}
+}
+
+// enclaveProvider stands in for the enclave backed provider
+type enclaveProvider struct {
+	SoftwareProvider
+}
+
+func TestProviderFromEnv(t *testing.T) {
+	hardware := enclaveProvider{NewAESGCMProvider()}
+
+	t.Setenv(SGXModeEnv, "HW")
+	if _, ok := ProviderFromEnv(hardware).(enclaveProvider); !ok || IsSoftwareMode() {
+		t.Fatal("expected hardware provider")
+	}
+
+	t.Setenv(SGXModeEnv, SGXModeSoftware)
+	if !IsSoftwareMode() {
+		t.Fatal("expected software mode")
+	}
+	provider := ProviderFromEnv(hardware)
+	encryptedState, err := provider.EncryptState(make([]byte, 32), nil, []byte{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecryptState(make([]byte, 32), nil, encryptedState); err != nil {
+		t.Fatal("expected deoxys software provider in SW mode")
+	}
+}
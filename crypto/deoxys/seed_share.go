@@ -0,0 +1,67 @@
+package deoxys
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/oasisprotocol/deoxysii"
+	"golang.org/x/crypto/curve25519"
+)
+
+// EncryptSeedShare encrypts a master seed backup share to the x25519 public key of an operator.
+// A fresh ephemeral key is used for every share, its public key is prepended to the output.
+func EncryptSeedShare(share, operatorPublicKey []byte) ([]byte, error) {
+	if len(operatorPublicKey) != curve25519.PointSize {
+		return nil, fmt.Errorf("invalid operator public key length: %d", len(operatorPublicKey))
+	}
+
+	ephemeralPrivateKey := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(ephemeralPrivateKey); err != nil {
+		return nil, fmt.Errorf("failed to generate ephemeral key: %w", err)
+	}
+	ephemeralPublicKey, err := curve25519.X25519(ephemeralPrivateKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptionKey, err := seedShareKey(ephemeralPrivateKey, operatorPublicKey, ephemeralPublicKey, operatorPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := seal(deoxysii.New, encryptionKey, share)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(ephemeralPublicKey, ciphertext...), nil
+}
+
+// DecryptSeedShare decrypts a share encrypted with EncryptSeedShare using the operator private key
+func DecryptSeedShare(encryptedShare, operatorPrivateKey []byte) ([]byte, error) {
+	if len(encryptedShare) < curve25519.PointSize {
+		return nil, fmt.Errorf("encrypted share is too short: %d bytes", len(encryptedShare))
+	}
+	ephemeralPublicKey := encryptedShare[:curve25519.PointSize]
+
+	operatorPublicKey, err := curve25519.X25519(operatorPrivateKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	encryptionKey, err := seedShareKey(operatorPrivateKey, ephemeralPublicKey, ephemeralPublicKey, operatorPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(deoxysii.New, encryptionKey, encryptedShare[curve25519.PointSize:])
+}
+
+// seedShareKey derives the share encryption key from the shared secret. Both public keys are
+// used as salt, so the key is bound to the sender and the recipient of the share.
+func seedShareKey(privateKey, publicKey, ephemeralPublicKey, operatorPublicKey []byte) ([]byte, error) {
+	sharedSecret, err := diffieHellman(privateKey, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	salt := append(append([]byte{}, ephemeralPublicKey...), operatorPublicKey...)
+	return DeriveEncryptionKeyWithVersion(KeyDerivationV2, sharedSecret, salt, KeyDomainSeedShare)
+}
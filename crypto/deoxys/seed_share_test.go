@@ -0,0 +1,44 @@
+package deoxys
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestSeedShareEncryption(t *testing.T) {
+	var operatorPrivateKey [32]byte
+	if _, err := rand.Read(operatorPrivateKey[:]); err != nil {
+		t.Fatal(err)
+	}
+	operatorPublicKey := GetCurve25519PublicKey(operatorPrivateKey)
+	share := []byte("master seed share")
+
+	encryptedShare, err := EncryptSeedShare(share, operatorPublicKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decryptedShare, err := DecryptSeedShare(encryptedShare, operatorPrivateKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(share, decryptedShare) {
+		t.Fatal("original and decrypted shares are not the same")
+	}
+
+	var otherPrivateKey [32]byte
+	if _, err := rand.Read(otherPrivateKey[:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecryptSeedShare(encryptedShare, otherPrivateKey[:]); err == nil {
+		t.Fatal("share decrypted with a foreign operator key")
+	}
+
+	if _, err := EncryptSeedShare(share, operatorPublicKey[:31]); err == nil {
+		t.Fatal("share encrypted to an invalid public key")
+	}
+	if _, err := DecryptSeedShare(encryptedShare[:20], operatorPrivateKey[:]); err == nil {
+		t.Fatal("truncated share decrypted")
+	}
+}
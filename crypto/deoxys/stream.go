@@ -0,0 +1,214 @@
+package deoxys
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/oasisprotocol/deoxysii"
+)
+
+// Large payloads, e.g. encrypted genesis exports or state snapshots, are encrypted with the
+// STREAM construction: the payload is split into chunks, each chunk is sealed with a nonce
+// made of a random prefix, the chunk counter and a flag marking the last chunk. This keeps
+// memory usage constant and prevents reordering, dropping or truncating chunks.
+//
+// The output contains the nonce prefix followed by the sealed chunks. All chunks but the
+// last one contain exactly StreamChunkSize bytes of plaintext.
+
+const (
+	// StreamChunkSize is the size of the plaintext chunks
+	StreamChunkSize = 64 * 1024
+
+	streamCounterSize = 4
+	streamPrefixSize  = deoxysii.NonceSize - streamCounterSize - 1
+)
+
+// streamNonce returns the nonce of the chunk with the given counter
+func streamNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, deoxysii.NonceSize)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[streamPrefixSize:], counter)
+	if last {
+		nonce[deoxysii.NonceSize-1] = 1
+	}
+	return nonce
+}
+
+type streamWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	closed  bool
+}
+
+// NewStreamEncrypter returns a writer encrypting the written data to w with the given key.
+// Close must be called to seal the last chunk, it doesn't close w.
+func NewStreamEncrypter(w io.Writer, key []byte) (io.WriteCloser, error) {
+	aead, err := deoxysii.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, streamPrefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, fmt.Errorf("failed to generate random nonce prefix: %w", err)
+	}
+	if _, err := w.Write(prefix); err != nil {
+		return nil, err
+	}
+
+	return &streamWriter{
+		w:      w,
+		aead:   aead,
+		prefix: prefix,
+		buf:    make([]byte, 0, StreamChunkSize+1),
+	}, nil
+}
+
+// Write implements io.Writer. A chunk is only sealed once more data follows it, so the
+// last chunk can be sealed with the last flag on Close.
+func (s *streamWriter) Write(p []byte) (int, error) {
+	if s.closed {
+		return 0, errors.New("write to closed stream")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		n := copy(s.buf[len(s.buf):cap(s.buf)], p)
+		s.buf = s.buf[:len(s.buf)+n]
+		p = p[n:]
+		written += n
+
+		if len(s.buf) > StreamChunkSize {
+			if err := s.seal(s.buf[:StreamChunkSize], false); err != nil {
+				return written, err
+			}
+			s.buf = append(s.buf[:0], s.buf[StreamChunkSize:]...)
+		}
+	}
+
+	return written, nil
+}
+
+// Close seals the last chunk
+func (s *streamWriter) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.seal(s.buf, true)
+}
+
+func (s *streamWriter) seal(chunk []byte, last bool) error {
+	if s.counter == math.MaxUint32 {
+		return errors.New("stream exceeds the maximum number of chunks")
+	}
+
+	sealed := s.aead.Seal(nil, streamNonce(s.prefix, s.counter, last), chunk, nil)
+	s.counter++
+	_, err := s.w.Write(sealed)
+	return err
+}
+
+type streamReader struct {
+	r       *bufio.Reader
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	chunk   []byte
+	done    bool
+}
+
+// NewStreamDecrypter returns a reader decrypting the data encrypted by a stream encrypter
+// from r. Reading returns an error if any chunk was modified, reordered or the stream was
+// truncated.
+func NewStreamDecrypter(r io.Reader, key []byte) (io.Reader, error) {
+	aead, err := deoxysii.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, streamPrefixSize)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, fmt.Errorf("failed to read nonce prefix: %w", err)
+	}
+
+	return &streamReader{
+		r:      bufio.NewReaderSize(r, StreamChunkSize+aead.Overhead()+1),
+		aead:   aead,
+		prefix: prefix,
+		chunk:  make([]byte, StreamChunkSize+aead.Overhead()),
+	}, nil
+}
+
+// Read implements io.Reader
+func (s *streamReader) Read(p []byte) (int, error) {
+	for len(s.buf) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		if err := s.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, s.buf)
+	s.buf = s.buf[n:]
+	return n, nil
+}
+
+// open reads and decrypts the next chunk. The chunk is the last one if no data follows it.
+func (s *streamReader) open() error {
+	n, err := io.ReadFull(s.r, s.chunk)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	last := n < len(s.chunk)
+	if !last {
+		if _, err := s.r.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		}
+	}
+
+	plaintext, err := s.aead.Open(nil, streamNonce(s.prefix, s.counter, last), s.chunk[:n], nil)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt chunk %d: %w", s.counter, err)
+	}
+
+	s.counter++
+	s.buf = plaintext
+	s.done = last
+	return nil
+}
+
+// EncryptStream encrypts all data from src to dst with the given key
+func EncryptStream(key []byte, dst io.Writer, src io.Reader) error {
+	w, err := NewStreamEncrypter(dst, key)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, src); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// DecryptStream decrypts all data encrypted with EncryptStream from src to dst
+func DecryptStream(key []byte, dst io.Writer, src io.Reader) error {
+	r, err := NewStreamDecrypter(src, key)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, r)
+	return err
+}
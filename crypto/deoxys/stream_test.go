@@ -0,0 +1,119 @@
+package deoxys
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/oasisprotocol/deoxysii"
+)
+
+func TestStreamEncryption(t *testing.T) {
+	key := make([]byte, 32)
+	rand.Read(key)
+
+	sizes := []int{0, 1, StreamChunkSize - 1, StreamChunkSize, StreamChunkSize + 1, 3*StreamChunkSize + 5}
+	for _, size := range sizes {
+		plaintext := make([]byte, size)
+		rand.Read(plaintext)
+
+		var encrypted bytes.Buffer
+		if err := EncryptStream(key, &encrypted, bytes.NewReader(plaintext)); err != nil {
+			t.Fatal(size, err)
+		}
+
+		// the last chunk may be full, empty payloads still have a sealed last chunk
+		chunks := (size + StreamChunkSize - 1) / StreamChunkSize
+		if chunks == 0 {
+			chunks = 1
+		}
+		if expLen := streamPrefixSize + size + chunks*deoxysii.TagSize; encrypted.Len() != expLen {
+			t.Fatalf("size %d: expected %d encrypted bytes, got %d", size, expLen, encrypted.Len())
+		}
+
+		var decrypted bytes.Buffer
+		if err := DecryptStream(key, &decrypted, bytes.NewReader(encrypted.Bytes())); err != nil {
+			t.Fatal(size, err)
+		}
+		if !bytes.Equal(plaintext, decrypted.Bytes()) {
+			t.Fatalf("size %d: decrypted data differs", size)
+		}
+	}
+}
+
+func TestStreamSmallWrites(t *testing.T) {
+	key := make([]byte, 32)
+	plaintext := make([]byte, 2*StreamChunkSize+100)
+	rand.Read(plaintext)
+
+	var encrypted bytes.Buffer
+	w, err := NewStreamEncrypter(&encrypted, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < len(plaintext); i += 1000 {
+		end := i + 1000
+		if end > len(plaintext) {
+			end = len(plaintext)
+		}
+		if _, err := w.Write(plaintext[i:end]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var decrypted bytes.Buffer
+	if err := DecryptStream(key, &decrypted, &encrypted); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(plaintext, decrypted.Bytes()) {
+		t.Fatal("decrypted data differs")
+	}
+}
+
+func TestStreamTampering(t *testing.T) {
+	key := make([]byte, 32)
+	plaintext := make([]byte, 2*StreamChunkSize+100)
+	rand.Read(plaintext)
+
+	var buf bytes.Buffer
+	if err := EncryptStream(key, &buf, bytes.NewReader(plaintext)); err != nil {
+		t.Fatal(err)
+	}
+	encrypted := buf.Bytes()
+	sealedChunkSize := StreamChunkSize + deoxysii.TagSize
+
+	modified := append([]byte{}, encrypted...)
+	modified[len(modified)-1] ^= 1
+
+	// swap the first two chunks
+	reordered := append([]byte{}, encrypted[:streamPrefixSize]...)
+	reordered = append(reordered, encrypted[streamPrefixSize+sealedChunkSize:streamPrefixSize+2*sealedChunkSize]...)
+	reordered = append(reordered, encrypted[streamPrefixSize:streamPrefixSize+sealedChunkSize]...)
+	reordered = append(reordered, encrypted[streamPrefixSize+2*sealedChunkSize:]...)
+
+	wrongKey := make([]byte, 32)
+	wrongKey[0] = 1
+
+	testCases := []struct {
+		name string
+		key  []byte
+		data []byte
+	}{
+		{"modified chunk", key, modified},
+		{"reordered chunks", key, reordered},
+		{"truncated at chunk boundary", key, encrypted[:streamPrefixSize+2*sealedChunkSize]},
+		{"truncated in chunk", key, encrypted[:len(encrypted)-10]},
+		{"missing prefix", key, encrypted[:5]},
+		{"wrong key", wrongKey, encrypted},
+	}
+
+	for _, tc := range testCases {
+		var decrypted bytes.Buffer
+		if err := DecryptStream(tc.key, &decrypted, bytes.NewReader(tc.data)); err == nil {
+			t.Fatalf("%s: expected decryption error", tc.name)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Package shamir implements Shamir's secret sharing over GF(2^8). It is used to back up the
+// enclave master seed as shares held by several operators, so the seed can be recovered from
+// any threshold of them while fewer shares reveal nothing about it.
+package shamir
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// ShareOverhead is the number of bytes a share is longer than the secret. The last byte of
+// every share is its x coordinate.
+const ShareOverhead = 1
+
+// Split divides the secret into parts shares, any threshold of which reconstruct it.
+func Split(secret []byte, parts, threshold int) ([][]byte, error) {
+	switch {
+	case len(secret) == 0:
+		return nil, errors.New("cannot split an empty secret")
+	case threshold < 2:
+		return nil, fmt.Errorf("threshold must be at least 2, got %d", threshold)
+	case parts < threshold:
+		return nil, fmt.Errorf("parts %d cannot be less than threshold %d", parts, threshold)
+	case parts > 255:
+		return nil, fmt.Errorf("parts cannot exceed 255, got %d", parts)
+	}
+
+	xCoordinates, err := randomXCoordinates(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([][]byte, parts)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+ShareOverhead)
+		shares[i][len(secret)] = xCoordinates[i]
+	}
+
+	// every secret byte is the constant term of its own random polynomial
+	coefficients := make([]byte, threshold)
+	for idx, b := range secret {
+		coefficients[0] = b
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, fmt.Errorf("failed to generate polynomial: %w", err)
+		}
+		for i := range shares {
+			shares[i][idx] = evaluate(coefficients, xCoordinates[i])
+		}
+	}
+
+	return shares, nil
+}
+
+// Combine reconstructs the secret from at least threshold shares. Combining fewer shares
+// than the threshold returns an unrelated value, it can't be detected here.
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, errors.New("at least 2 shares are required")
+	}
+
+	size := len(shares[0])
+	if size <= ShareOverhead {
+		return nil, errors.New("shares are too short")
+	}
+
+	xCoordinates := make([]byte, len(shares))
+	seen := make(map[byte]bool, len(shares))
+	for i, share := range shares {
+		if len(share) != size {
+			return nil, errors.New("shares must have the same length")
+		}
+		x := share[size-1]
+		if x == 0 || seen[x] {
+			return nil, fmt.Errorf("invalid or duplicated share %d", x)
+		}
+		seen[x] = true
+		xCoordinates[i] = x
+	}
+
+	secret := make([]byte, size-ShareOverhead)
+	yCoordinates := make([]byte, len(shares))
+	for idx := range secret {
+		for i, share := range shares {
+			yCoordinates[i] = share[idx]
+		}
+		secret[idx] = interpolateAtZero(xCoordinates, yCoordinates)
+	}
+
+	return secret, nil
+}
+
+// randomXCoordinates returns distinct non-zero x coordinates in random order
+func randomXCoordinates(n int) ([]byte, error) {
+	values := make([]byte, 255)
+	for i := range values {
+		values[i] = byte(i + 1)
+	}
+
+	// Fisher-Yates shuffle with rejection sampling to avoid modulo bias
+	var buf [1]byte
+	for i := len(values) - 1; i > 0; i-- {
+		limit := byte(256 - 256%(i+1))
+		for {
+			if _, err := rand.Read(buf[:]); err != nil {
+				return nil, fmt.Errorf("failed to generate share coordinates: %w", err)
+			}
+			if limit == 0 || buf[0] < limit {
+				break
+			}
+		}
+		j := int(buf[0]) % (i + 1)
+		values[i], values[j] = values[j], values[i]
+	}
+
+	return values[:n], nil
+}
+
+// evaluate returns the value of the polynomial at x using Horner's method
+func evaluate(coefficients []byte, x byte) byte {
+	var result byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result = add(mul(result, x), coefficients[i])
+	}
+	return result
+}
+
+// interpolateAtZero returns the value at 0 of the Lagrange polynomial through the points
+func interpolateAtZero(xs, ys []byte) byte {
+	var result byte
+	for i := range xs {
+		basis := byte(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			// basis *= x_j / (x_j - x_i), subtraction is xor in GF(2^8)
+			basis = mul(basis, div(xs[j], add(xs[j], xs[i])))
+		}
+		result = add(result, mul(ys[i], basis))
+	}
+	return result
+}
+
+func add(a, b byte) byte {
+	return a ^ b
+}
+
+// mul multiplies in GF(2^8) modulo the AES polynomial x^8 + x^4 + x^3 + x + 1, without
+// data dependent branches
+func mul(a, b byte) byte {
+	var result byte
+	for i := 0; i < 8; i++ {
+		result ^= a & -(b & 1)
+		carry := -(a >> 7)
+		a = (a << 1) ^ (0x1b & carry)
+		b >>= 1
+	}
+	return result
+}
+
+// inverse returns a^254, which is the multiplicative inverse of a non-zero a
+func inverse(a byte) byte {
+	result := a
+	for i := 0; i < 6; i++ {
+		result = mul(mul(result, result), a)
+	}
+	return mul(result, result)
+}
+
+func div(a, b byte) byte {
+	return mul(a, inverse(b))
+}
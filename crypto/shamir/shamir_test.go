@@ -0,0 +1,89 @@
+package shamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("swisstronik master seed of 32 b.")
+
+	shares, err := Split(secret, 5, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shares) != 5 {
+		t.Fatalf("expected 5 shares, got %d", len(shares))
+	}
+
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		parts := make([][]byte, 0, len(subset))
+		for _, i := range subset {
+			parts = append(parts, shares[i])
+		}
+		recovered, err := Combine(parts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(secret, recovered) {
+			t.Fatalf("subset %v recovered a wrong secret", subset)
+		}
+	}
+
+	recovered, err := Combine(shares[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(secret, recovered) {
+		t.Fatal("secret recovered below the threshold")
+	}
+}
+
+func TestSplitInvalid(t *testing.T) {
+	testCases := []struct {
+		name      string
+		secret    []byte
+		parts     int
+		threshold int
+	}{
+		{"empty secret", nil, 3, 2},
+		{"threshold too low", []byte{1}, 3, 1},
+		{"parts below threshold", []byte{1}, 2, 3},
+		{"too many parts", []byte{1}, 256, 2},
+	}
+
+	for _, tc := range testCases {
+		if _, err := Split(tc.secret, tc.parts, tc.threshold); err == nil {
+			t.Fatalf("%s: expected error", tc.name)
+		}
+	}
+}
+
+func TestCombineInvalid(t *testing.T) {
+	shares, err := Split([]byte("secret"), 3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Combine(shares[:1]); err == nil {
+		t.Fatal("combined a single share")
+	}
+	if _, err := Combine([][]byte{shares[0], shares[0]}); err == nil {
+		t.Fatal("combined duplicated shares")
+	}
+	if _, err := Combine([][]byte{shares[0], shares[1][1:]}); err == nil {
+		t.Fatal("combined shares of different length")
+	}
+}
+
+func TestFieldArithmetic(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		if mul(byte(a), inverse(byte(a))) != 1 {
+			t.Fatalf("wrong inverse of %d", a)
+		}
+	}
+	// 0x53 * 0xca = 0x01 in the AES field
+	if mul(0x53, 0xca) != 0x01 {
+		t.Fatal("wrong multiplication")
+	}
+}
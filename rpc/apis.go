@@ -32,6 +32,7 @@ import (
 	"github.com/SigmaGmbH/evm-module/rpc/namespaces/ethereum/personal"
 	"github.com/SigmaGmbH/evm-module/rpc/namespaces/ethereum/txpool"
 	"github.com/SigmaGmbH/evm-module/rpc/namespaces/ethereum/web3"
+	"github.com/SigmaGmbH/evm-module/rpc/namespaces/swisstronik"
 	"github.com/SigmaGmbH/evm-module/rpc/namespaces/utils"
 	ethermint "github.com/SigmaGmbH/evm-module/types"
 
@@ -55,6 +56,10 @@ const (
 	MinerNamespace    = "miner"
 	UtilsNamespace    = "utils"
 
+	// Swisstronik namespaces
+
+	SwisstronikNamespace = "swisstronik"
+
 	apiVersion = "1.0"
 )
 
@@ -94,12 +99,12 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, ethermint.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
 					Version:   apiVersion,
-					Service:   web3.NewPublicAPI(),
+					Service:   web3.NewPublicAPI(clientCtx),
 					Public:    true,
 				},
 			}
@@ -172,6 +177,22 @@ func init() {
 				},
 			}
 		},
+		SwisstronikNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: SwisstronikNamespace,
+					Version:   apiVersion,
+					Service:   swisstronik.NewPublicAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 		UtilsNamespace: func(_ *server.Context, 
 			_ client.Context, 
 			_ *rpcclient.WSClient, 
@@ -190,25 +211,25 @@ func init() {
 	}
 }
 
-// GetRPCAPIs returns the list of all APIs
+// GetRPCAPIs returns the APIs of the selected namespaces. It fails if a namespace isn't registered.
 func GetRPCAPIs(ctx *server.Context,
 	clientCtx client.Context,
 	tmWSClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer ethermint.EVMTxIndexer,
 	selectedAPIs []string,
-) []rpc.API {
+) ([]rpc.API, error) {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
-		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
-		} else {
-			ctx.Logger.Error("invalid namespace value", "namespace", ns)
+		creator, ok := apiCreators[ns]
+		if !ok {
+			return nil, fmt.Errorf("invalid JSON-RPC API namespace %s", ns)
 		}
+		apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
 	}
 
-	return apis
+	return apis, nil
 }
 
 // RegisterAPINamespace registers a new API namespace with the API creator.
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/SigmaGmbH/evm-module/rpc/ratelimit"
+)
+
+const (
+	// ProxyTokenHeader is the header of requests forwarded by the websocket server, which were already
+	// authenticated on the websocket connection
+	ProxyTokenHeader = "X-Auth-Proxy-Token"
+
+	// jwtIssuedAtTolerance is the max difference between the issued at time of a JWT and the local time,
+	// as in go-ethereum
+	jwtIssuedAtTolerance = 60 * time.Second
+
+	// maxRequestContentLength is the max size of an HTTP request body, as enforced by the go-ethereum rpc server
+	maxRequestContentLength = 1024 * 1024 * 5
+)
+
+var (
+	// ErrMissingToken is returned if a request calling a protected namespace has no bearer token
+	ErrMissingToken = errors.New("missing authorization bearer token")
+	// ErrInvalidToken is returned if the bearer token is neither a valid API key nor a valid JWT
+	ErrInvalidToken = errors.New("invalid authorization bearer token")
+)
+
+// Config defines the protected namespaces and the accepted credentials. Multiple API keys and JWT
+// secrets can be valid at the same time, so they can be rotated without downtime.
+type Config struct {
+	// Namespaces defines the JSON-RPC namespaces that require authentication
+	Namespaces []string
+	// APIKeys defines the accepted API keys
+	APIKeys []string
+	// JWTSecrets defines the secrets of the accepted HS256 JWTs
+	JWTSecrets [][]byte
+}
+
+// Authenticator restricts the calls of the protected namespaces to requests with a valid API key or
+// JWT bearer token
+type Authenticator struct {
+	namespaces map[string]bool
+	apiKeys    [][]byte
+	jwtSecrets [][]byte
+	proxyToken string
+	now        func() time.Time
+}
+
+// New creates an authenticator with the given config
+func New(cfg Config) *Authenticator {
+	namespaces := make(map[string]bool, len(cfg.Namespaces))
+	for _, namespace := range cfg.Namespaces {
+		namespaces[namespace] = true
+	}
+
+	apiKeys := make([][]byte, len(cfg.APIKeys))
+	for i, key := range cfg.APIKeys {
+		apiKeys[i] = []byte(key)
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		panic(err)
+	}
+
+	return &Authenticator{
+		namespaces: namespaces,
+		apiKeys:    apiKeys,
+		jwtSecrets: cfg.JWTSecrets,
+		proxyToken: hex.EncodeToString(token),
+		now:        time.Now,
+	}
+}
+
+// Protected returns true if any of the methods belongs to a protected namespace
+func (a *Authenticator) Protected(methods []string) bool {
+	for _, method := range methods {
+		namespace, _, _ := strings.Cut(method, "_")
+		if a.namespaces[namespace] {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticate returns an error if the request doesn't have a valid API key or JWT bearer token
+func (a *Authenticator) Authenticate(r *http.Request) error {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") {
+		return ErrMissingToken
+	}
+
+	for _, key := range a.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(token), key) == 1 {
+			return nil
+		}
+	}
+
+	return a.verifyJWT(token)
+}
+
+// verifyJWT returns an error if the token isn't an HS256 JWT signed with one of the secrets, issued
+// within the tolerance of the local time and not expired
+func (a *Authenticator) verifyJWT(token string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ErrInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	valid := false
+	for _, secret := range a.jwtSecrets {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(parts[0] + "." + parts[1]))
+		if hmac.Equal(signature, mac.Sum(nil)) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return ErrInvalidToken
+	}
+
+	var claims struct {
+		IssuedAt  *int64 `json:"iat"`
+		ExpiresAt *int64 `json:"exp"`
+	}
+	if err := decodeSegment(parts[1], &claims); err != nil || claims.IssuedAt == nil {
+		return ErrInvalidToken
+	}
+
+	now := a.now()
+	issuedAt := time.Unix(*claims.IssuedAt, 0)
+	if issuedAt.Before(now.Add(-jwtIssuedAtTolerance)) || issuedAt.After(now.Add(jwtIssuedAtTolerance)) {
+		return errors.New("stale authorization bearer token")
+	}
+	if claims.ExpiresAt != nil && !now.Before(time.Unix(*claims.ExpiresAt, 0)) {
+		return errors.New("expired authorization bearer token")
+	}
+
+	return nil
+}
+
+// decodeSegment decodes a base64url encoded JSON segment of a JWT
+func decodeSegment(segment string, v interface{}) error {
+	bz, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
+
+// SetProxyToken marks the request as forwarded by the websocket server, so it isn't authenticated twice
+func (a *Authenticator) SetProxyToken(r *http.Request) {
+	r.Header.Set(ProxyTokenHeader, a.proxyToken)
+}
+
+// Handler returns an HTTP handler rejecting JSON-RPC requests calling a protected namespace without
+// valid credentials with status 401, before passing them to the next handler.
+func (a *Authenticator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(ProxyTokenHeader) == a.proxyToken {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+		if a.Protected(ratelimit.RequestMethods(body)) {
+			if err := a.Authenticate(r); err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	secret    = []byte("0123456789abcdef0123456789abcdef")
+	oldSecret = []byte("fedcba9876543210fedcba9876543210")
+)
+
+func signJWT(t *testing.T, alg string, claims map[string]interface{}, secret []byte) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func newTestAuthenticator(now time.Time) *Authenticator {
+	a := New(Config{
+		Namespaces: []string{"debug", "personal"},
+		APIKeys:    []string{"key-1", "key-2"},
+		JWTSecrets: [][]byte{secret, oldSecret},
+	})
+	a.now = func() time.Time { return now }
+	return a
+}
+
+func TestProtected(t *testing.T) {
+	a := newTestAuthenticator(time.Now())
+	require.True(t, a.Protected([]string{"debug_traceTransaction"}))
+	require.True(t, a.Protected([]string{"eth_chainId", "personal_sign"}))
+	require.False(t, a.Protected([]string{"eth_chainId", "txpool_content"}))
+	require.False(t, a.Protected([]string{""}))
+}
+
+func TestAuthenticate(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	a := newTestAuthenticator(now)
+
+	testCases := []struct {
+		name          string
+		authorization string
+		expErr        bool
+	}{
+		{"missing", "", true},
+		{"not a bearer token", "Basic key-1", true},
+		{"api key", "Bearer key-1", false},
+		{"rotated api key", "Bearer key-2", false},
+		{"invalid api key", "Bearer key-3", true},
+		{"jwt", "Bearer " + signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix()}, secret), false},
+		{"jwt of rotated secret", "Bearer " + signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix() - 30}, oldSecret), false},
+		{"jwt of unknown secret", "Bearer " + signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix()}, []byte("unknown")), true},
+		{"jwt with other alg", "Bearer " + signJWT(t, "HS512", map[string]interface{}{"iat": now.Unix()}, secret), true},
+		{"jwt without iat", "Bearer " + signJWT(t, "HS256", map[string]interface{}{}, secret), true},
+		{"stale jwt", "Bearer " + signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix() - 61}, secret), true},
+		{"future jwt", "Bearer " + signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix() + 61}, secret), true},
+		{"expired jwt", "Bearer " + signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix(), "exp": now.Unix()}, secret), true},
+		{"malformed jwt", "Bearer a.b.c", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+
+			err := a.Authenticate(req)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	a := newTestAuthenticator(time.Now())
+
+	var served int
+	handler := a.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	}))
+
+	serve := func(body, authorization string, proxied bool) int {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		if proxied {
+			a.SetProxyToken(req)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve(`{"method":"eth_chainId"}`, "", false))
+	require.Equal(t, http.StatusUnauthorized, serve(`[{"method":"eth_chainId"},{"method":"debug_traceTransaction"}]`, "", false))
+	require.Equal(t, http.StatusUnauthorized, serve(`{"method":"debug_traceTransaction"}`, "Bearer invalid", false))
+	require.Equal(t, http.StatusOK, serve(`{"method":"debug_traceTransaction"}`, "Bearer key-1", false))
+	require.Equal(t, 2, served)
+
+	// requests forwarded by the websocket server were already authenticated
+	require.Equal(t, http.StatusOK, serve(`{"method":"debug_traceTransaction"}`, "", true))
+	require.Equal(t, 3, served)
+}
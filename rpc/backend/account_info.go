@@ -46,7 +46,7 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 
 	res, err := b.queryClient.Code(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
-		return nil, err
+		return nil, b.stateError(blockNum.Int64(), err)
 	}
 
 	return res.Code, nil
@@ -107,7 +107,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	res, err := b.queryClient.Account(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, b.stateError(height, err)
 	}
 
 	// query account proofs
@@ -147,7 +147,7 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 
 	res, err := b.queryClient.Storage(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
-		return nil, err
+		return nil, b.stateError(blockNum.Int64(), err)
 	}
 
 	value := common.HexToHash(res.Value)
@@ -172,7 +172,7 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 
 	res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
-		return nil, err
+		return nil, b.stateError(blockNum.Int64(), err)
 	}
 
 	val, ok := sdkmath.NewIntFromString(res.Balance)
@@ -219,7 +219,7 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 	includePending := blockNum == rpctypes.EthPendingBlockNumber
 	nonce, err := b.getAccountNonce(address, includePending, blockNum.Int64(), b.logger)
 	if err != nil {
-		return nil, err
+		return nil, b.stateError(blockNum.Int64(), err)
 	}
 
 	n = hexutil.Uint64(nonce)
@@ -241,3 +241,38 @@ func (b *Backend) GetNodePublicKey(blockNrOrHash rpctypes.BlockNumberOrHash) (st
 
 	return res.NodePublicKey, nil
 }
+
+// GetEpochKeys returns the enclave public keys of the state encryption key epochs known at the
+// given block. It includes the latest epoch, which may start after the block.
+func (b *Backend) GetEpochKeys(blockNrOrHash rpctypes.BlockNumberOrHash) ([]rpctypes.EpochKeyResult, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryEpochKeysRequest{}
+	res, err := b.queryClient.EpochKeys(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return nil, err
+	}
+
+	epochKeys := make([]rpctypes.EpochKeyResult, len(res.EpochKeys))
+	for i, epochKey := range res.EpochKeys {
+		publicKey, err := hexutil.Decode(epochKey.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		epochKeys[i] = rpctypes.EpochKeyResult{
+			Epoch:      hexutil.Uint64(epochKey.Epoch),
+			StartBlock: hexutil.Uint64(epochKey.StartHeight),
+			PublicKey:  publicKey,
+		}
+		if epochKey.EndHeight != 0 {
+			endBlock := hexutil.Uint64(epochKey.EndHeight)
+			epochKeys[i].EndBlock = &endBlock
+		}
+	}
+
+	return epochKeys, nil
+}
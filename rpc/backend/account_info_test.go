@@ -76,6 +76,68 @@ func (suite *BackendTestSuite) TestGetCode() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetEpochKeys() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	publicKey := common.HexToHash("0x01")
+	endBlock := hexutil.Uint64(9)
+
+	testCases := []struct {
+		name          string
+		blockNrOrHash rpctypes.BlockNumberOrHash
+		registerMock  func()
+		expPass       bool
+		expEpochKeys  []rpctypes.EpochKeyResult
+	}{
+		{
+			"fail - BlockHash and BlockNumber are both nil",
+			rpctypes.BlockNumberOrHash{},
+			func() {},
+			false,
+			nil,
+		},
+		{
+			"fail - query client errors on getting epoch keys",
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterEpochKeysError(queryClient)
+			},
+			false,
+			nil,
+		},
+		{
+			"pass",
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterEpochKeys(queryClient, []evmtypes.EpochKey{
+					{Epoch: 0, StartHeight: 0, EndHeight: 9, PublicKey: publicKey.Hex()},
+					{Epoch: 1, StartHeight: 10, PublicKey: publicKey.Hex()},
+				})
+			},
+			true,
+			[]rpctypes.EpochKeyResult{
+				{Epoch: 0, StartBlock: 0, EndBlock: &endBlock, PublicKey: publicKey.Bytes()},
+				{Epoch: 1, StartBlock: 10, PublicKey: publicKey.Bytes()},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			epochKeys, err := suite.backend.GetEpochKeys(tc.blockNrOrHash)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expEpochKeys, epochKeys)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetProof() {
 	blockNrInvalid := rpctypes.NewBlockNumber(big.NewInt(1))
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(4))
@@ -67,7 +67,7 @@ type EVMBackend interface {
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
 	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
-	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
+	RPCEVMTimeout() time.Duration // global timeout for eth_call, eth_estimateGas and tracing over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() int64
 
@@ -75,11 +75,14 @@ type EVMBackend interface {
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
+	UnlockAccount(address common.Address, duration *uint64) (bool, error)
+	LockAccount(address common.Address) bool
 
 	// Blocks Info
 	BlockNumber() (hexutil.Uint64, error)
 	GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
 	GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error)
+	GetBlockFull(blockNum rpctypes.BlockNumber, withTraces bool) (map[string]interface{}, error)
 	GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint
 	GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) *hexutil.Uint
 	TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error)
@@ -102,6 +105,7 @@ type EVMBackend interface {
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
 	GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
 	GetNodePublicKey(blockNrOrHash rpctypes.BlockNumberOrHash) (string, error)
+	GetEpochKeys(blockNrOrHash rpctypes.BlockNumberOrHash) ([]rpctypes.EpochKeyResult, error)
 
 	// Chain Info
 	ChainID() (*hexutil.Big, error)
@@ -120,6 +124,7 @@ type EVMBackend interface {
 	GetTxByTxIndex(height int64, txIndex uint) (*ethermint.TxResult, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	EthReceiptsFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Receipts, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
@@ -134,6 +139,8 @@ type EVMBackend interface {
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetBlockBlooms(from, to int64) (map[int64]ethtypes.Bloom, error)
+	GetStoredLogsByHeight(height int64) ([][]*ethtypes.Log, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
@@ -143,8 +150,6 @@ type EVMBackend interface {
 
 var _ BackendI = (*Backend)(nil)
 
-var bAttributeKeyEthereumBloom = []byte(evmtypes.AttributeKeyEthereumBloom)
-
 // Backend implements the BackendI interface
 type Backend struct {
 	ctx                 context.Context
@@ -152,9 +157,13 @@ type Backend struct {
 	queryClient         *rpctypes.QueryClient // gRPC query client
 	logger              log.Logger
 	chainID             *big.Int
+	eip155Active        uint32 // set once the chain is past the EIP-155 fork block, accessed atomically
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             ethermint.EVMTxIndexer
+	traceCache          *traceCache
+	gasPriceOracle      *gasPriceOracle
+	keyringSigner       *keyringSigner
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -184,5 +193,8 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		traceCache:          newTraceCache(appConf.JSONRPC.TraceCacheSize, appConf.JSONRPC.TraceCacheTTL),
+		gasPriceOracle:      newGasPriceOracle(appConf.JSONRPC.GasPriceOracleBlocks, appConf.JSONRPC.GasPriceOraclePercentile),
+		keyringSigner:       newKeyringSigner(appConf.JSONRPC.EnableKeyringSigning, appConf.JSONRPC.KeyringSigningAccounts),
 	}
 }
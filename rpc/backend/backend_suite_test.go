@@ -82,7 +82,9 @@ func (suite *BackendTestSuite) SetupTest() {
 	suite.backend = NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer)
 	suite.backend.queryClient.QueryClient = mocks.NewEVMQueryClient(suite.T())
 	suite.backend.clientCtx.Client = mocks.NewClient(suite.T())
-	suite.backend.queryClient.FeeMarket = mocks.NewFeeMarketQueryClient(suite.T())
+	feeMarketClient := mocks.NewFeeMarketQueryClient(suite.T())
+	RegisterBlockBaseFeeNotFound(feeMarketClient)
+	suite.backend.queryClient.FeeMarket = feeMarketClient
 	suite.backend.ctx = rpctypes.ContextWithHeight(1)
 
 	// Add codec
@@ -156,6 +158,7 @@ func (suite *BackendTestSuite) buildFormattedBlock(
 
 	return rpctypes.FormatBlock(
 		header,
+		blockRes.TxsResults,
 		resBlock.Block.Size(),
 		gasLimit,
 		gasUsed,
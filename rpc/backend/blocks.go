@@ -16,7 +16,6 @@
 package backend
 
 import (
-	"bytes"
 	"fmt"
 	"math"
 	"math/big"
@@ -29,7 +28,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/trie"
 	"github.com/pkg/errors"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 	"google.golang.org/grpc"
@@ -94,6 +92,102 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 	return res, nil
 }
 
+// GetBlockFull returns the JSON-RPC compatible Ethereum block identified by number with its
+// full transaction objects, the receipts of the transactions and optionally their traces, so
+// block explorers can backfill a block with a single call.
+func (b *Backend) GetBlockFull(blockNum rpctypes.BlockNumber, withTraces bool) (map[string]interface{}, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, nil
+	}
+
+	// return if requested block height is greater than the current one
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to fetch block result from Tendermint", "height", blockNum, "error", err.Error())
+		return nil, nil
+	}
+
+	block, err := b.RPCBlockFromTendermintBlock(resBlock, blockRes, true)
+	if err != nil {
+		b.logger.Debug("GetEthBlockFromTendermint failed", "height", blockNum, "error", err.Error())
+		return nil, err
+	}
+
+	receipts := []map[string]interface{}{}
+	var hashes []common.Hash
+	for i, txBz := range resBlock.Block.Txs {
+		txResult := blockRes.TxsResults[i]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResult) {
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", resBlock.Block.Height, "error", err.Error())
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgHandleTx)
+			if !ok {
+				continue
+			}
+
+			hash := common.HexToHash(ethMsg.Hash)
+			resTx := &tmrpctypes.ResultTx{Height: resBlock.Block.Height, Index: uint32(i), TxResult: *txResult}
+			res, err := rpctypes.ParseTxIndexerResult(resTx, tx, func(txs *rpctypes.ParsedTxs) *rpctypes.ParsedTx {
+				return txs.GetTxByHash(hash)
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			receipt, err := b.RPCReceiptFromTxResult(hash, res, resBlock, blockRes)
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+			hashes = append(hashes, hash)
+		}
+	}
+	block["receipts"] = receipts
+
+	if !withTraces {
+		return block, nil
+	}
+
+	traces := []map[string]interface{}{}
+	if resBlock.Block.Height > 0 && len(hashes) > 0 {
+		results, err := b.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), nil, resBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, result := range results {
+			if i >= len(hashes) || result == nil {
+				break
+			}
+			trace := map[string]interface{}{
+				"transactionHash":     hashes[i],
+				"transactionPosition": hexutil.Uint64(i),
+				"result":              result.Result,
+			}
+			if result.Error != "" {
+				trace["error"] = result.Error
+			}
+			traces = append(traces, trace)
+		}
+	}
+	block["traces"] = traces
+
+	return block, nil
+}
+
 // GetBlockByHash returns the JSON-RPC compatible Ethereum block identified by
 // hash.
 func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
@@ -315,7 +409,7 @@ func (b *Backend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Heade
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", resBlock.Block.Height, "error", err)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, blockRes.TxsResults, bloom, baseFee)
 	return ethHeader, nil
 }
 
@@ -345,24 +439,17 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", resBlock.Block.Height, "error", err)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, blockRes.TxsResults, bloom, baseFee)
 	return ethHeader, nil
 }
 
 // BlockBloom query block bloom filter from block results
 func (b *Backend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
-	for _, event := range blockRes.EndBlockEvents {
-		if event.Type != evmtypes.EventTypeBlockBloom {
-			continue
-		}
-
-		for _, attr := range event.Attributes {
-			if bytes.Equal(attr.Key, bAttributeKeyEthereumBloom) {
-				return ethtypes.BytesToBloom(attr.Value), nil
-			}
-		}
+	bloom, found := rpctypes.BloomFromEvents(blockRes.EndBlockEvents)
+	if !found {
+		return ethtypes.Bloom{}, errors.New("block bloom event is not found")
 	}
-	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
+	return bloom, nil
 }
 
 // RPCBlockFromTendermintBlock returns a JSON-RPC compatible Ethereum block from a
@@ -453,7 +540,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 	}
 
 	formattedBlock := rpctypes.FormatBlock(
-		block.Header, block.Size(),
+		block.Header, blockRes.TxsResults, block.Size(),
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
@@ -498,7 +585,7 @@ func (b *Backend) EthBlockFromTendermintBlock(
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", height, "error", err)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
+	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, blockRes.TxsResults, bloom, baseFee)
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
 
 	txs := make([]*ethtypes.Transaction, len(msgs))
@@ -506,7 +593,7 @@ func (b *Backend) EthBlockFromTendermintBlock(
 		txs[i] = ethMsg.AsTransaction()
 	}
 
-	// TODO: add tx receipts
-	ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, nil, trie.NewStackTrie(nil))
+	// keep the roots of the header, which are mapped from the Tendermint block
+	ethBlock := ethtypes.NewBlockWithHeader(ethHeader).WithBody(txs, nil)
 	return ethBlock, nil
 }
@@ -8,7 +8,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/trie"
 	"github.com/tendermint/tendermint/abci/types"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -221,6 +220,92 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockFull() {
+	testCases := []struct {
+		name         string
+		blockNumber  ethrpc.BlockNumber
+		withTraces   bool
+		registerMock func(ethrpc.BlockNumber)
+		expNoop      bool
+	}{
+		{
+			"pass - tendermint block not found",
+			ethrpc.BlockNumber(1),
+			true,
+			func(blockNum ethrpc.BlockNumber) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, blockNum.Int64())
+			},
+			true,
+		},
+		{
+			"pass - block results error",
+			ethrpc.BlockNumber(1),
+			true,
+			func(blockNum ethrpc.BlockNumber) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, blockNum.Int64(), nil)
+				RegisterBlockResultsError(client, blockNum.Int64())
+			},
+			true,
+		},
+		{
+			"pass - without tx and traces",
+			ethrpc.BlockNumber(1),
+			false,
+			func(blockNum ethrpc.BlockNumber) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, blockNum.Int64(), nil)
+				RegisterBlockResults(client, blockNum.Int64())
+				RegisterConsensusParams(client, blockNum.Int64())
+
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				RegisterValidatorAccount(queryClient, sdk.AccAddress(tests.GenerateAddress().Bytes()))
+			},
+			false,
+		},
+		{
+			"pass - without tx, with traces",
+			ethrpc.BlockNumber(1),
+			true,
+			func(blockNum ethrpc.BlockNumber) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, blockNum.Int64(), nil)
+				RegisterBlockResults(client, blockNum.Int64())
+				RegisterConsensusParams(client, blockNum.Int64())
+
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				RegisterValidatorAccount(queryClient, sdk.AccAddress(tests.GenerateAddress().Bytes()))
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock(tc.blockNumber)
+
+			block, err := suite.backend.GetBlockFull(tc.blockNumber, tc.withTraces)
+			suite.Require().NoError(err)
+
+			if tc.expNoop {
+				suite.Require().Nil(block)
+				return
+			}
+
+			suite.Require().Equal([]interface{}{}, block["transactions"])
+			suite.Require().Equal([]map[string]interface{}{}, block["receipts"])
+			if tc.withTraces {
+				suite.Require().Equal([]map[string]interface{}{}, block["traces"])
+			} else {
+				suite.Require().NotContains(block, "traces")
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetBlockByHash() {
 	var (
 		blockRes *tmrpctypes.ResultBlockResults
@@ -861,7 +946,7 @@ func (suite *BackendTestSuite) TestBlockBloom() {
 					{
 						Type: evmtypes.EventTypeBlockBloom,
 						Attributes: []types.EventAttribute{
-							{Key: []byte(bAttributeKeyEthereumBloom)},
+							{Key: []byte(evmtypes.AttributeKeyEthereumBloom)},
 						},
 					},
 				},
@@ -1110,6 +1195,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 
 			expBlock = ethrpc.FormatBlock(
 				header,
+				tc.blockRes.TxsResults,
 				tc.resBlock.Block.Size(),
 				gasLimit,
 				gasUsed,
@@ -1195,6 +1281,7 @@ func (suite *BackendTestSuite) TestEthMsgsFromTendermintBlock() {
 
 func (suite *BackendTestSuite) TestHeaderByNumber() {
 	var expResultBlock *tmrpctypes.ResultBlock
+	var expBlockRes *tmrpctypes.ResultBlockResults
 
 	_, bz := suite.buildEthereumTx()
 
@@ -1249,7 +1336,8 @@ func (suite *BackendTestSuite) TestHeaderByNumber() {
 				height := blockNum.Int64()
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				expResultBlock, _ = RegisterBlock(client, height, nil)
-				_, err := RegisterBlockResults(client, height)
+				var err error
+				expBlockRes, err = RegisterBlockResults(client, height)
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(queryClient)
@@ -1264,7 +1352,8 @@ func (suite *BackendTestSuite) TestHeaderByNumber() {
 				height := blockNum.Int64()
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				expResultBlock, _ = RegisterBlock(client, height, nil)
-				_, err := RegisterBlockResults(client, height)
+				var err error
+				expBlockRes, err = RegisterBlockResults(client, height)
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
@@ -1279,7 +1368,8 @@ func (suite *BackendTestSuite) TestHeaderByNumber() {
 				height := blockNum.Int64()
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				expResultBlock, _ = RegisterBlock(client, height, bz)
-				_, err := RegisterBlockResults(client, height)
+				var err error
+				expBlockRes, err = RegisterBlockResults(client, height)
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
@@ -1295,7 +1385,7 @@ func (suite *BackendTestSuite) TestHeaderByNumber() {
 			header, err := suite.backend.HeaderByNumber(tc.blockNumber)
 
 			if tc.expPass {
-				expHeader := ethrpc.EthHeaderFromTendermint(expResultBlock.Block.Header, ethtypes.Bloom{}, tc.baseFee)
+				expHeader := ethrpc.EthHeaderFromTendermint(expResultBlock.Block.Header, expBlockRes.TxsResults, ethtypes.Bloom{}, tc.baseFee)
 				suite.Require().NoError(err)
 				suite.Require().Equal(expHeader, header)
 			} else {
@@ -1307,6 +1397,7 @@ func (suite *BackendTestSuite) TestHeaderByNumber() {
 
 func (suite *BackendTestSuite) TestHeaderByHash() {
 	var expResultBlock *tmrpctypes.ResultBlock
+	var expBlockRes *tmrpctypes.ResultBlockResults
 
 	_, bz := suite.buildEthereumTx()
 	block := tmtypes.MakeBlock(1, []tmtypes.Tx{bz}, nil, nil)
@@ -1360,7 +1451,8 @@ func (suite *BackendTestSuite) TestHeaderByHash() {
 				height := int64(1)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				expResultBlock, _ = RegisterBlockByHash(client, hash, bz)
-				_, err := RegisterBlockResults(client, height)
+				var err error
+				expBlockRes, err = RegisterBlockResults(client, height)
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(queryClient)
@@ -1375,7 +1467,8 @@ func (suite *BackendTestSuite) TestHeaderByHash() {
 				height := int64(1)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				expResultBlock, _ = RegisterBlockByHash(client, hash, nil)
-				_, err := RegisterBlockResults(client, height)
+				var err error
+				expBlockRes, err = RegisterBlockResults(client, height)
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
@@ -1390,7 +1483,8 @@ func (suite *BackendTestSuite) TestHeaderByHash() {
 				height := int64(1)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				expResultBlock, _ = RegisterBlockByHash(client, hash, bz)
-				_, err := RegisterBlockResults(client, height)
+				var err error
+				expBlockRes, err = RegisterBlockResults(client, height)
 				suite.Require().NoError(err)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
@@ -1406,7 +1500,7 @@ func (suite *BackendTestSuite) TestHeaderByHash() {
 			header, err := suite.backend.HeaderByHash(tc.hash)
 
 			if tc.expPass {
-				expHeader := ethrpc.EthHeaderFromTendermint(expResultBlock.Block.Header, ethtypes.Bloom{}, tc.baseFee)
+				expHeader := ethrpc.EthHeaderFromTendermint(expResultBlock.Block.Header, expBlockRes.TxsResults, ethtypes.Bloom{}, tc.baseFee)
 				suite.Require().NoError(err)
 				suite.Require().Equal(expHeader, header)
 			} else {
@@ -1419,6 +1513,8 @@ func (suite *BackendTestSuite) TestHeaderByHash() {
 func (suite *BackendTestSuite) TestEthBlockByNumber() {
 	msgHandleTx, bz := suite.buildEthereumTx()
 	emptyBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)
+	txBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{bz}, nil, nil)
+	txResults := []*types.ResponseDeliverTx{{Code: 0, GasUsed: 0}}
 
 	testCases := []struct {
 		name         string
@@ -1465,17 +1561,14 @@ func (suite *BackendTestSuite) TestEthBlockByNumber() {
 				baseFee := sdk.NewInt(1)
 				RegisterBaseFee(queryClient, baseFee)
 			},
-			ethtypes.NewBlock(
+			ethtypes.NewBlockWithHeader(
 				ethrpc.EthHeaderFromTendermint(
 					emptyBlock.Header,
+					txResults,
 					ethtypes.Bloom{},
 					sdk.NewInt(1).BigInt(),
 				),
-				[]*ethtypes.Transaction{},
-				nil,
-				nil,
-				nil,
-			),
+			).WithBody([]*ethtypes.Transaction{}, nil),
 			true,
 		},
 		{
@@ -1492,17 +1585,14 @@ func (suite *BackendTestSuite) TestEthBlockByNumber() {
 				baseFee := sdk.NewInt(1)
 				RegisterBaseFee(queryClient, baseFee)
 			},
-			ethtypes.NewBlock(
+			ethtypes.NewBlockWithHeader(
 				ethrpc.EthHeaderFromTendermint(
-					emptyBlock.Header,
+					txBlock.Header,
+					txResults,
 					ethtypes.Bloom{},
 					sdk.NewInt(1).BigInt(),
 				),
-				[]*ethtypes.Transaction{msgHandleTx.AsTransaction()},
-				nil,
-				nil,
-				trie.NewStackTrie(nil),
-			),
+			).WithBody([]*ethtypes.Transaction{msgHandleTx.AsTransaction()}, nil),
 			true,
 		},
 	}
@@ -1532,6 +1622,8 @@ func (suite *BackendTestSuite) TestEthBlockByNumber() {
 func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 	msgHandleTx, bz := suite.buildEthereumTx()
 	emptyBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)
+	txBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{bz}, nil, nil)
+	txResults := []*types.ResponseDeliverTx{{Code: 0, GasUsed: 0}}
 
 	testCases := []struct {
 		name         string
@@ -1550,39 +1642,36 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 			},
 			&tmrpctypes.ResultBlockResults{
 				Height:     1,
-				TxsResults: []*types.ResponseDeliverTx{{Code: 0, GasUsed: 0}},
+				TxsResults: txResults,
 			},
 			func(baseFee sdk.Int, blockNum int64) {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 			},
-			ethtypes.NewBlock(
+			ethtypes.NewBlockWithHeader(
 				ethrpc.EthHeaderFromTendermint(
 					emptyBlock.Header,
+					txResults,
 					ethtypes.Bloom{},
 					sdk.NewInt(1).BigInt(),
 				),
-				[]*ethtypes.Transaction{},
-				nil,
-				nil,
-				nil,
-			),
+			).WithBody([]*ethtypes.Transaction{}, nil),
 			true,
 		},
 		{
 			"pass - block with tx",
 			sdk.NewInt(1).BigInt(),
 			&tmrpctypes.ResultBlock{
-				Block: tmtypes.MakeBlock(1, []tmtypes.Tx{bz}, nil, nil),
+				Block: txBlock,
 			},
 			&tmrpctypes.ResultBlockResults{
 				Height:     1,
-				TxsResults: []*types.ResponseDeliverTx{{Code: 0, GasUsed: 0}},
+				TxsResults: txResults,
 				EndBlockEvents: []types.Event{
 					{
 						Type: evmtypes.EventTypeBlockBloom,
 						Attributes: []types.EventAttribute{
-							{Key: []byte(bAttributeKeyEthereumBloom)},
+							{Key: []byte(evmtypes.AttributeKeyEthereumBloom)},
 						},
 					},
 				},
@@ -1591,17 +1680,14 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 			},
-			ethtypes.NewBlock(
+			ethtypes.NewBlockWithHeader(
 				ethrpc.EthHeaderFromTendermint(
-					emptyBlock.Header,
+					txBlock.Header,
+					txResults,
 					ethtypes.Bloom{},
 					sdk.NewInt(1).BigInt(),
 				),
-				[]*ethtypes.Transaction{msgHandleTx.AsTransaction()},
-				nil,
-				nil,
-				trie.NewStackTrie(nil),
-			),
+			).WithBody([]*ethtypes.Transaction{msgHandleTx.AsTransaction()}, nil),
 			true,
 		},
 	}
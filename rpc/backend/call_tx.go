@@ -24,7 +24,6 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
-	ethermint "github.com/SigmaGmbH/evm-module/types"
 	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -51,14 +50,9 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 	// The signer used should always be the 'latest' known one because we expect
 	// signers to be backwards-compatible with old transactions.
-	eip155ChainID, err := ethermint.ParseChainID(b.clientCtx.ChainID)
-	if err != nil {
-		return common.Hash{}, err
-	}
-
 	cfg := b.ChainConfig()
 	if cfg == nil {
-		cfg = evmtypes.DefaultChainConfig().EthereumConfig(eip155ChainID)
+		cfg = evmtypes.DefaultChainConfig().EthereumConfig(b.chainID)
 	}
 
 	signer := ethtypes.LatestSigner(cfg)
@@ -130,6 +124,11 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
 	}
 
+	// ensure the transaction fee is below the configured cap
+	if err := rpctypes.CheckTxFee(tx.GasPrice(), tx.Gas(), b.RPCTxFeeCap()); err != nil {
+		return common.Hash{}, err
+	}
+
 	ethereumTx := &evmtypes.MsgHandleTx{}
 	if err := ethereumTx.FromEthereumTx(tx); err != nil {
 		b.logger.Error("transaction converting failed", "error", err.Error())
@@ -332,9 +331,12 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	res, err := b.queryClient.EstimateGas(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	ctx, cancel := b.evmTimeoutContext(rpctypes.ContextWithHeight(blockNr.Int64()))
+	defer cancel()
+
+	res, err := b.queryClient.EstimateGas(ctx, &req)
 	if err != nil {
-		return 0, err
+		return 0, b.stateError(blockNr.Int64(), err)
 	}
 	return hexutil.Uint64(res.Gas), nil
 }
@@ -364,25 +366,14 @@ func (b *Backend) DoCall(
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
-	timeout := b.RPCEVMTimeout()
-
-	// Setup context so it may be canceled the call has completed
-	// or, in case of unmetered gas, setup a context with a timeout.
-	var cancel context.CancelFunc
-	if timeout > 0 {
-		ctx, cancel = context.WithTimeout(ctx, timeout)
-	} else {
-		ctx, cancel = context.WithCancel(ctx)
-	}
-
+	ctx, cancel := b.evmTimeoutContext(rpctypes.ContextWithHeight(blockNr.Int64()))
 	// Make sure the context is canceled when the call has completed
 	// this makes sure resources are cleaned up.
 	defer cancel()
 
 	res, err := b.queryClient.EthCall(ctx, &req)
 	if err != nil {
-		return nil, err
+		return nil, b.stateError(blockNr.Int64(), err)
 	}
 
 	if res.Failed() {
@@ -395,6 +386,16 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// evmTimeoutContext returns a context for EVM executing queries that is canceled after the
+// RPC EVM timeout, or only once the returned cancel function is called if there's no timeout.
+// The cancellation is propagated through gRPC to the node, which aborts the execution.
+func (b *Backend) evmTimeoutContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
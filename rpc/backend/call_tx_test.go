@@ -1,9 +1,11 @@
 package backend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/SigmaGmbH/evm-module/rpc/backend/mocks"
 	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
@@ -319,6 +321,16 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 			common.Hash{},
 			false,
 		},
+		{
+			"fail - transaction fee exceeds the cap",
+			func() {
+				suite.backend.allowUnprotectedTxs = true
+				suite.backend.cfg.JSONRPC.TxFeeCap = 1e-15
+			},
+			rlpEncodedBz,
+			common.Hash{},
+			false,
+		},
 		{
 			"fail - failed to get evm params",
 			func() {
@@ -374,6 +386,24 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestEVMTimeoutContext() {
+	suite.backend.cfg.JSONRPC.EVMTimeout = time.Minute
+	ctx, cancel := suite.backend.evmTimeoutContext(context.Background())
+	deadline, ok := ctx.Deadline()
+	suite.Require().True(ok)
+	suite.Require().WithinDuration(time.Now().Add(time.Minute), deadline, time.Second)
+	cancel()
+	suite.Require().ErrorIs(ctx.Err(), context.Canceled)
+
+	suite.backend.cfg.JSONRPC.EVMTimeout = 0
+	ctx, cancel = suite.backend.evmTimeoutContext(context.Background())
+	_, ok = ctx.Deadline()
+	suite.Require().False(ok)
+	suite.Require().NoError(ctx.Err())
+	cancel()
+	suite.Require().ErrorIs(ctx.Err(), context.Canceled)
+}
+
 func (suite *BackendTestSuite) TestDoCall() {
 	_, bz := suite.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
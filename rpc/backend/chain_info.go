@@ -19,33 +19,41 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"sync/atomic"
 
 	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
-	ethermint "github.com/SigmaGmbH/evm-module/types"
 	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
 	feemarkettypes "github.com/SigmaGmbH/evm-module/x/feemarket/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
-// ChainID is the EIP-155 replay-protection chain id for the current ethereum chain config.
+// ChainID is the EIP-155 replay-protection chain id for the current ethereum chain config. The id is
+// parsed from the cosmos chain-id at startup. Once the chain is past the EIP-155 fork block, which can't
+// be undone, it's returned without querying the node.
 func (b *Backend) ChainID() (*hexutil.Big, error) {
-	eip155ChainID, err := ethermint.ParseChainID(b.clientCtx.ChainID)
-	if err != nil {
-		panic(err)
+	if atomic.LoadUint32(&b.eip155Active) == 1 {
+		return (*hexutil.Big)(b.chainID), nil
 	}
+
 	// if current block is at or past the EIP-155 replay-protection fork block, return chainID from config
 	bn, err := b.BlockNumber()
 	if err != nil {
 		b.logger.Debug("failed to fetch latest block number", "error", err.Error())
-		return (*hexutil.Big)(eip155ChainID), nil
+		return (*hexutil.Big)(b.chainID), nil
 	}
 
-	if config := b.ChainConfig(); config.IsEIP155(new(big.Int).SetUint64(uint64(bn))) {
+	config := b.ChainConfig()
+	if config == nil {
+		return (*hexutil.Big)(b.chainID), nil
+	}
+	if config.IsEIP155(new(big.Int).SetUint64(uint64(bn))) {
+		atomic.StoreUint32(&b.eip155Active, 1)
 		return (*hexutil.Big)(config.ChainID), nil
 	}
 
@@ -76,6 +84,13 @@ func (b *Backend) GlobalMinGasPrice() (sdk.Dec, error) {
 // If the London hard fork is not activated at the current height, the query will
 // return nil.
 func (b *Backend) BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error) {
+	// the feemarket module keeps the base fee of every block in its latest
+	// state, so it remains available even if the historical state is pruned
+	feeRes, err := b.queryClient.FeeMarket.BlockBaseFee(b.ctx, &feemarkettypes.QueryBlockBaseFeeRequest{Height: blockRes.Height})
+	if err == nil && feeRes.BaseFee != nil {
+		return feeRes.BaseFee.BigInt(), nil
+	}
+
 	// return BaseFee if London hard fork is activated and feemarket is enabled
 	res, err := b.queryClient.BaseFee(rpctypes.ContextWithHeight(blockRes.Height), &evmtypes.QueryBaseFeeRequest{})
 	if err != nil || res.BaseFee == nil {
@@ -250,9 +265,10 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// SuggestGasTipCap returns the suggested tip cap. If the gas price oracle is enabled, it is based on
+// the priority fees paid in the recent blocks. Otherwise, or if the recent blocks don't include any
+// ethereum transaction, we return the maximum base fee change to help clients to mitigate the base
+// fee changes.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if baseFee == nil {
 		// london hardfork not enabled or feemarket not enabled
@@ -263,20 +279,37 @@ func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if err != nil {
 		return nil, err
 	}
-	// calculate the maximum base fee delta in current block, assuming all block gas limit is consumed
-	// ```
-	// GasTarget = GasLimit / ElasticityMultiplier
-	// Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
-	// ```
-	// The delta is at maximum when `GasUsed` is equal to `GasLimit`, which is:
-	// ```
-	// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
-	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
-	// ```
-	maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator)
-	if maxDelta < 0 {
-		// impossible if the parameter validation passed.
-		maxDelta = 0
+
+	tipCap, err := b.suggestTipCapFromHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	if tipCap == nil {
+		// calculate the maximum base fee delta in current block, assuming all block gas limit is consumed
+		// ```
+		// GasTarget = GasLimit / ElasticityMultiplier
+		// Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
+		// ```
+		// The delta is at maximum when `GasUsed` is equal to `GasLimit`, which is:
+		// ```
+		// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
+		//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
+		// ```
+		maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator)
+		if maxDelta < 0 {
+			// impossible if the parameter validation passed.
+			maxDelta = 0
+		}
+		tipCap = big.NewInt(maxDelta)
 	}
-	return big.NewInt(maxDelta), nil
+
+	// the effective gas price, i.e. base fee plus tip, must be at least the global min gas
+	// price, otherwise the tx is rejected by the ante handler
+	if minGasPrice := params.Params.MinGasPrice; !minGasPrice.IsNil() {
+		minTipCap := new(big.Int).Sub(minGasPrice.Ceil().TruncateInt().BigInt(), baseFee)
+		tipCap = math.BigMax(tipCap, minTipCap)
+	}
+
+	return tipCap, nil
 }
@@ -133,6 +133,17 @@ func (suite *BackendTestSuite) TestBaseFee() {
 			baseFee.BigInt(),
 			true,
 		},
+		{
+			"pass - base fee from feemarket block base fee store",
+			&tmrpctypes.ResultBlockResults{Height: 5},
+			func() {
+				feeMarketClient := mocks.NewFeeMarketQueryClient(suite.T())
+				suite.backend.queryClient.FeeMarket = feeMarketClient
+				RegisterBlockBaseFee(feeMarketClient, 5, baseFee)
+			},
+			baseFee.BigInt(),
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -266,6 +277,36 @@ func (suite *BackendTestSuite) TestSuggestGasTipCap() {
 			big.NewInt(0),
 			true,
 		},
+		{
+			"fail - Can't get FeeMarket params",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParamsError(feeMarketClient, int64(1))
+			},
+			big.NewInt(1000000000),
+			nil,
+			false,
+		},
+		{
+			"pass - max base fee delta above the global min gas price",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParamsWithMinGasPrice(feeMarketClient, int64(1), sdk.NewDec(500000000))
+			},
+			big.NewInt(1000000000),
+			big.NewInt(125000000),
+			true,
+		},
+		{
+			"pass - tip cap raised to the global min gas price",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParamsWithMinGasPrice(feeMarketClient, int64(1), sdk.NewDec(2000000000))
+			},
+			big.NewInt(1000000000),
+			big.NewInt(1000000000),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -30,35 +30,50 @@ import (
 // To use a mock method it has to be registered in a given test.
 var _ evmtypes.QueryClient = &mocks.EVMQueryClient{}
 
+// cancelableContextWithHeight matches the cancelable context with the given block height, which
+// the backend passes to the queries executing the EVM
+func cancelableContextWithHeight(height int64) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		heights := md.Get(grpctypes.GRPCBlockHeightHeader)
+		return ctx.Done() != nil && len(heights) == 1 && heights[0] == strconv.FormatInt(height, 10)
+	})
+}
+
 // TraceTransaction
 func RegisterTraceTransactionWithPredecessors(queryClient *mocks.EVMQueryClient, msgEthTx *evmtypes.MsgHandleTx, predecessors []*evmtypes.MsgHandleTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
-	queryClient.On("TraceTx", rpc.ContextWithHeight(1),
+	ctx := cancelableContextWithHeight(1)
+	queryClient.On("TraceTx", ctx,
 		&evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, Predecessors: predecessors, ChainId: 9000}).
 		Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
 }
 
 func RegisterTraceTransaction(queryClient *mocks.EVMQueryClient, msgEthTx *evmtypes.MsgHandleTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
-	queryClient.On("TraceTx", rpc.ContextWithHeight(1), &evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: 9000}).
+	ctx := cancelableContextWithHeight(1)
+	queryClient.On("TraceTx", ctx, &evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: 9000}).
 		Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
 }
 
 func RegisterTraceTransactionError(queryClient *mocks.EVMQueryClient, msgEthTx *evmtypes.MsgHandleTx) {
-	queryClient.On("TraceTx", rpc.ContextWithHeight(1), &evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: 9000}).
+	ctx := cancelableContextWithHeight(1)
+	queryClient.On("TraceTx", ctx, &evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: 9000}).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
 // TraceBlock
 func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgHandleTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
-	queryClient.On("TraceBlock", rpc.ContextWithHeight(1),
+	ctx := cancelableContextWithHeight(1)
+	queryClient.On("TraceBlock", ctx,
 		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, TraceConfig: &evmtypes.TraceConfig{}, ChainId: 9000}).
 		Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
 }
 
 func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
-	queryClient.On("TraceBlock", rpc.ContextWithHeight(1), &evmtypes.QueryTraceBlockRequest{}).
+	ctx := cancelableContextWithHeight(1)
+	queryClient.On("TraceBlock", ctx, &evmtypes.QueryTraceBlockRequest{}).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
@@ -152,7 +167,8 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
-	queryClient.On("EstimateGas", rpc.ContextWithHeight(1), &evmtypes.EthCallRequest{Args: bz, ChainId: args.ChainID.ToInt().Int64()}).
+	ctx := cancelableContextWithHeight(1)
+	queryClient.On("EstimateGas", ctx, &evmtypes.EthCallRequest{Args: bz, ChainId: args.ChainID.ToInt().Int64()}).
 		Return(&evmtypes.EstimateGasResponse{}, nil)
 }
 
@@ -231,6 +247,55 @@ func RegisterCodeError(queryClient *mocks.EVMQueryClient, addr common.Address) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterCodePruned(queryClient *mocks.EVMQueryClient, addr common.Address) {
+	queryClient.On("Code", rpc.ContextWithHeight(1), &evmtypes.QueryCodeRequest{Address: addr.String()}).
+		Return(nil, status.Error(codes.InvalidArgument, "failed to load state at height 1; version does not exist (latest height: 100)"))
+}
+
+// StateHistory
+func RegisterStateHistory(queryClient *mocks.EVMQueryClient, earliestHeight int64) {
+	queryClient.On("StateHistory", rpc.ContextWithHeight(1), &evmtypes.QueryStateHistoryRequest{}).
+		Return(&evmtypes.QueryStateHistoryResponse{EarliestHeight: earliestHeight, LatestHeight: 100}, nil)
+}
+
+func RegisterStateHistoryError(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("StateHistory", rpc.ContextWithHeight(1), &evmtypes.QueryStateHistoryRequest{}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// EpochKeys
+func RegisterEpochKeys(queryClient *mocks.EVMQueryClient, epochKeys []evmtypes.EpochKey) {
+	queryClient.On("EpochKeys", rpc.ContextWithHeight(1), &evmtypes.QueryEpochKeysRequest{}).
+		Return(&evmtypes.QueryEpochKeysResponse{EpochKeys: epochKeys}, nil)
+}
+
+func RegisterEpochKeysError(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("EpochKeys", rpc.ContextWithHeight(1), &evmtypes.QueryEpochKeysRequest{}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// BlockBlooms
+func RegisterBlockBlooms(queryClient *mocks.EVMQueryClient, from, to int64, blooms []evmtypes.BlockBloom) {
+	queryClient.On("BlockBlooms", rpc.ContextWithHeight(1), &evmtypes.QueryBlockBloomsRequest{FromBlock: from, ToBlock: to}).
+		Return(&evmtypes.QueryBlockBloomsResponse{Blooms: blooms}, nil)
+}
+
+func RegisterBlockBloomsError(queryClient *mocks.EVMQueryClient, from, to int64) {
+	queryClient.On("BlockBlooms", rpc.ContextWithHeight(1), &evmtypes.QueryBlockBloomsRequest{FromBlock: from, ToBlock: to}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// BlockLogs
+func RegisterBlockLogs(queryClient *mocks.EVMQueryClient, height int64, found bool, txLogs []evmtypes.TransactionLogs) {
+	queryClient.On("BlockLogs", rpc.ContextWithHeight(1), &evmtypes.QueryBlockLogsRequest{Height: height}).
+		Return(&evmtypes.QueryBlockLogsResponse{Found: found, TxLogs: txLogs}, nil)
+}
+
+func RegisterBlockLogsError(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("BlockLogs", rpc.ContextWithHeight(1), &evmtypes.QueryBlockLogsRequest{Height: height}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Storage
 func RegisterStorageAt(queryClient *mocks.EVMQueryClient, addr common.Address, key string, storage string) {
 	queryClient.On("Storage", rpc.ContextWithHeight(1), &evmtypes.QueryStorageRequest{Address: addr.String(), Key: key}).
@@ -1,6 +1,11 @@
 package backend
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/SigmaGmbH/evm-module/rpc/backend/mocks"
 	rpc "github.com/SigmaGmbH/evm-module/rpc/types"
 	feemarkettypes "github.com/SigmaGmbH/evm-module/x/feemarket/types"
@@ -15,7 +20,28 @@ func RegisterFeeMarketParams(feeMarketClient *mocks.FeeMarketQueryClient, height
 		Return(&feemarkettypes.QueryParamsResponse{Params: feemarkettypes.DefaultParams()}, nil)
 }
 
+func RegisterFeeMarketParamsWithMinGasPrice(feeMarketClient *mocks.FeeMarketQueryClient, height int64, minGasPrice sdk.Dec) {
+	params := feemarkettypes.DefaultParams()
+	params.MinGasPrice = minGasPrice
+	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
+		Return(&feemarkettypes.QueryParamsResponse{Params: params}, nil)
+}
+
 func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// BlockBaseFee
+func RegisterBlockBaseFee(feeMarketClient *mocks.FeeMarketQueryClient, height int64, baseFee sdk.Int) {
+	feeMarketClient.On("BlockBaseFee", rpc.ContextWithHeight(1), &feemarkettypes.QueryBlockBaseFeeRequest{Height: height}).
+		Return(&feemarkettypes.QueryBlockBaseFeeResponse{BaseFee: &baseFee}, nil)
+}
+
+// RegisterBlockBaseFeeNotFound registers an optional call for any height
+// returning that no base fee was stored for the block.
+func RegisterBlockBaseFeeNotFound(feeMarketClient *mocks.FeeMarketQueryClient) {
+	feeMarketClient.On("BlockBaseFee", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.NotFound, "base fee not found")).
+		Maybe()
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -44,6 +46,50 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetBlockBlooms returns the bloom filters persisted by the EVM module for the blocks within
+// the [from, to] range, by height. Blocks outside the retention window of the module are
+// omitted, their bloom has to be read from the block results.
+func (b *Backend) GetBlockBlooms(from, to int64) (map[int64]ethtypes.Bloom, error) {
+	blooms := make(map[int64]ethtypes.Bloom)
+	for start := from; start <= to; start += evmtypes.BlockLogsRetention {
+		end := start + evmtypes.BlockLogsRetention - 1
+		if end > to {
+			end = to
+		}
+
+		res, err := b.queryClient.BlockBlooms(b.ctx, &evmtypes.QueryBlockBloomsRequest{
+			FromBlock: start,
+			ToBlock:   end,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, blockBloom := range res.Blooms {
+			blooms[blockBloom.Height] = ethtypes.BytesToBloom(blockBloom.Bloom)
+		}
+	}
+	return blooms, nil
+}
+
+// GetStoredLogsByHeight returns all the logs from all the ethereum transactions in a block,
+// as persisted by the EVM module. It returns false if the logs of the block are not persisted.
+func (b *Backend) GetStoredLogsByHeight(height int64) ([][]*ethtypes.Log, bool, error) {
+	res, err := b.queryClient.BlockLogs(b.ctx, &evmtypes.QueryBlockLogsRequest{Height: height})
+	if err != nil {
+		return nil, false, err
+	}
+	if !res.Found {
+		return nil, false, nil
+	}
+
+	blockLogs := make([][]*ethtypes.Log, 0, len(res.TxLogs))
+	for _, txLogs := range res.TxLogs {
+		blockLogs = append(blockLogs, txLogs.EthLogs())
+	}
+	return blockLogs, true, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
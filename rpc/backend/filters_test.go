@@ -2,6 +2,7 @@ package backend
 
 import (
 	"encoding/json"
+	"math/big"
 
 	"github.com/SigmaGmbH/evm-module/rpc/backend/mocks"
 	ethrpc "github.com/SigmaGmbH/evm-module/rpc/types"
@@ -87,6 +88,131 @@ func (suite *BackendTestSuite) TestGetLogs() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockBlooms() {
+	bloom := ethtypes.BytesToBloom([]byte{0x1})
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		from         int64
+		to           int64
+		expBlooms    map[int64]ethtypes.Bloom
+		expPass      bool
+	}{
+		{
+			"fail - error querying block blooms",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockBloomsError(queryClient, 1, 10)
+			},
+			1,
+			10,
+			nil,
+			false,
+		},
+		{
+			"success - range within the retention window",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockBlooms(queryClient, 1, 10, []evmtypes.BlockBloom{{Height: 5, Bloom: bloom.Bytes()}})
+			},
+			1,
+			10,
+			map[int64]ethtypes.Bloom{5: bloom},
+			true,
+		},
+		{
+			"success - range split in chunks of the retention window",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockBlooms(queryClient, 1, evmtypes.BlockLogsRetention, []evmtypes.BlockBloom{{Height: 1, Bloom: bloom.Bytes()}})
+				RegisterBlockBlooms(queryClient, evmtypes.BlockLogsRetention+1, evmtypes.BlockLogsRetention+1, []evmtypes.BlockBloom{{Height: evmtypes.BlockLogsRetention + 1, Bloom: bloom.Bytes()}})
+			},
+			1,
+			evmtypes.BlockLogsRetention + 1,
+			map[int64]ethtypes.Bloom{1: bloom, evmtypes.BlockLogsRetention + 1: bloom},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			tc.registerMock()
+			blooms, err := suite.backend.GetBlockBlooms(tc.from, tc.to)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBlooms, blooms)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetStoredLogsByHeight() {
+	txHash := common.BigToHash(big.NewInt(1))
+	logs := []*evmtypes.Log{{Address: common.BigToAddress(big.NewInt(1)).Hex(), TxHash: txHash.Hex()}}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expLogs      [][]*ethtypes.Log
+		expFound     bool
+		expPass      bool
+	}{
+		{
+			"fail - error querying block logs",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockLogsError(queryClient, 1)
+			},
+			nil,
+			false,
+			false,
+		},
+		{
+			"pass - block logs not persisted",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockLogs(queryClient, 1, false, nil)
+			},
+			nil,
+			false,
+			true,
+		},
+		{
+			"pass - persisted block logs",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockLogs(queryClient, 1, true, []evmtypes.TransactionLogs{evmtypes.NewTransactionLogs(txHash, logs)})
+			},
+			[][]*ethtypes.Log{evmtypes.LogsToEthereum(logs)},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			tc.registerMock()
+			logs, found, err := suite.backend.GetStoredLogsByHeight(1)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFound, found)
+				suite.Require().Equal(tc.expLogs, logs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestBloomStatus() {
 	testCases := []struct {
 		name         string
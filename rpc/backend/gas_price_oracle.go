@@ -0,0 +1,159 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+
+	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// gasPriceOracleSampleSize is the number of lowest priority fees sampled from each block, as in
+// the go-ethereum gas price oracle. Sampling the lowest ones ignores the few senders overpaying.
+const gasPriceOracleSampleSize = 3
+
+// gasPriceOracle suggests a priority fee from the priority fees paid by the transactions of the
+// recent blocks. The suggestion is cached for the latest block, so repeated eth_gasPrice and
+// eth_maxPriorityFeePerGas calls don't resample the blocks.
+type gasPriceOracle struct {
+	blocks     int
+	percentile int
+
+	mtx        sync.Mutex
+	lastHeight int64
+	lastTipCap *big.Int
+}
+
+// newGasPriceOracle creates an oracle sampling the given number of blocks, returns nil if it is 0
+func newGasPriceOracle(blocks, percentile int) *gasPriceOracle {
+	if blocks <= 0 {
+		return nil
+	}
+
+	return &gasPriceOracle{
+		blocks:     blocks,
+		percentile: percentile,
+	}
+}
+
+// get returns the suggestion cached for the block height
+func (o *gasPriceOracle) get(height int64) (*big.Int, bool) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	if o.lastHeight != height || o.lastTipCap == nil {
+		return nil, false
+	}
+	return new(big.Int).Set(o.lastTipCap), true
+}
+
+// set caches the suggestion for the block height
+func (o *gasPriceOracle) set(height int64, tipCap *big.Int) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.lastHeight = height
+	o.lastTipCap = new(big.Int).Set(tipCap)
+}
+
+// suggestTipCapFromHistory returns the priority fee at the configured percentile of the lowest
+// priority fees paid in each of the recent blocks. It returns nil if the oracle is disabled or
+// the recent blocks don't include any ethereum transaction.
+func (b *Backend) suggestTipCapFromHistory() (*big.Int, error) {
+	oracle := b.gasPriceOracle
+	if oracle == nil {
+		return nil, nil
+	}
+
+	blockNumber, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	head := int64(blockNumber)
+
+	if tipCap, ok := oracle.get(head); ok {
+		return tipCap, nil
+	}
+
+	var samples []*big.Int
+	for height := head; height > 0 && height > head-int64(oracle.blocks); height-- {
+		blockSamples, err := b.blockTipCapSamples(height)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, blockSamples...)
+	}
+
+	if len(samples) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Cmp(samples[j]) < 0 })
+	tipCap := samples[(len(samples)-1)*oracle.percentile/100]
+
+	oracle.set(head, tipCap)
+	return tipCap, nil
+}
+
+// blockTipCapSamples returns the lowest effective priority fees paid by the ethereum
+// transactions of the block at the given height
+func (b *Backend) blockTipCapSamples(height int64) ([]*big.Int, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil || resBlock == nil {
+		return nil, err
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	var tips []*big.Int
+	for _, txBz := range resBlock.Block.Txs {
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgHandleTx)
+			if !ok {
+				continue
+			}
+
+			tip := ethMsg.AsTransaction().EffectiveGasTipValue(baseFee)
+			if tip == nil || tip.Sign() < 0 {
+				tip = big.NewInt(0)
+			}
+			tips = append(tips, tip)
+		}
+	}
+
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	if len(tips) > gasPriceOracleSampleSize {
+		tips = tips[:gasPriceOracleSampleSize]
+	}
+	return tips, nil
+}
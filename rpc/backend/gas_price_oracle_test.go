@@ -0,0 +1,110 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/SigmaGmbH/evm-module/rpc/backend/mocks"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestGasPriceOracle() {
+	suite.Require().Nil(newGasPriceOracle(0, 60))
+
+	oracle := newGasPriceOracle(2, 60)
+	_, ok := oracle.get(1)
+	suite.Require().False(ok)
+
+	oracle.set(1, big.NewInt(5))
+	tipCap, ok := oracle.get(1)
+	suite.Require().True(ok)
+	suite.Require().Equal(big.NewInt(5), tipCap)
+
+	// the suggestion is only cached for the latest block
+	_, ok = oracle.get(2)
+	suite.Require().False(ok)
+}
+
+func (suite *BackendTestSuite) TestSuggestGasTipCapFromHistory() {
+	baseFee := sdk.NewInt(10)
+
+	testCases := []struct {
+		name         string
+		gasPrices    map[int64][]int64
+		expGasTipCap *big.Int
+	}{
+		{
+			"pass - no ethereum txs in the recent blocks, fallback to the max base fee delta",
+			map[int64][]int64{1: {}, 2: {}},
+			big.NewInt(1),
+		},
+		{
+			"pass - percentile of the lowest priority fees of the recent blocks",
+			map[int64][]int64{1: {12}, 2: {100, 20, 15, 30}},
+			big.NewInt(5),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.gasPriceOracle = newGasPriceOracle(3, 60)
+
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			feeMarketClient := mocks.NewFeeMarketQueryClient(suite.T())
+			suite.backend.queryClient.FeeMarket = feeMarketClient
+			RegisterFeeMarketParams(feeMarketClient, 1)
+
+			// latest block is 2
+			var header metadata.MD
+			queryClient.On("Params", mock.Anything, &evmtypes.QueryParamsRequest{}, grpc.Header(&header)).
+				Return(&evmtypes.QueryParamsResponse{}, nil).
+				Run(func(args mock.Arguments) {
+					arg := args.Get(2).(grpc.HeaderCallOption)
+					h := metadata.MD{}
+					h.Set(grpctypes.GRPCBlockHeightHeader, "2")
+					*arg.HeaderAddr = h
+				})
+
+			for height, gasPrices := range tc.gasPrices {
+				height := height
+				txs := make([]types.Tx, 0, len(gasPrices))
+				for i, gasPrice := range gasPrices {
+					msg := evmtypes.NewTx(
+						suite.backend.chainID, uint64(i), &common.Address{}, big.NewInt(0), 100000,
+						big.NewInt(gasPrice), nil, nil, nil, nil, nil, nil,
+					)
+					msg.From = ""
+					txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+					suite.Require().NoError(txBuilder.SetMsgs(msg))
+					bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+					suite.Require().NoError(err)
+					txs = append(txs, bz)
+				}
+
+				block := types.MakeBlock(height, txs, nil, nil)
+				block.ChainID = ChainID
+				matchHeight := mock.MatchedBy(func(h *int64) bool { return h != nil && *h == height })
+				client.On("Block", mock.Anything, matchHeight).Return(&tmrpctypes.ResultBlock{Block: block}, nil)
+				client.On("BlockResults", mock.Anything, matchHeight).
+					Return(&tmrpctypes.ResultBlockResults{Height: height, TxsResults: []*abci.ResponseDeliverTx{}}, nil)
+				RegisterBlockBaseFee(feeMarketClient, height, baseFee)
+			}
+
+			tipCap, err := suite.backend.SuggestGasTipCap(baseFee.BigInt())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGasTipCap, tipCap)
+		})
+	}
+}
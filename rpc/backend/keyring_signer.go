@@ -0,0 +1,137 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// defaultUnlockDuration is the duration an account stays unlocked if no duration is given, as in geth
+const defaultUnlockDuration = 300 * time.Second
+
+// errKeyringSigningDisabled is returned by the keyring signing methods if keyring signing isn't enabled
+var errKeyringSigningDisabled = errors.New("signing with the node keyring is disabled, see the json-rpc enable-keyring-signing config")
+
+// keyringSigner restricts signing with the node keyring to the allowlisted accounts, while they're
+// unlocked. Signing is disabled if the signer is nil.
+type keyringSigner struct {
+	mtx      sync.Mutex
+	accounts map[common.Address]bool
+	unlocked map[common.Address]time.Time // zero time if unlocked until locked
+}
+
+// newKeyringSigner creates a signer for the given hex accounts, returns nil if keyring signing is disabled
+func newKeyringSigner(enabled bool, accounts []string) *keyringSigner {
+	if !enabled {
+		return nil
+	}
+
+	s := &keyringSigner{
+		accounts: make(map[common.Address]bool, len(accounts)),
+		unlocked: make(map[common.Address]time.Time),
+	}
+	for _, account := range accounts {
+		s.accounts[common.HexToAddress(account)] = true
+	}
+
+	return s
+}
+
+// unlock unlocks the account for the duration, or until it's locked if the duration is 0
+func (s *keyringSigner) unlock(address common.Address, duration time.Duration) error {
+	if !s.accounts[address] {
+		return fmt.Errorf("account %s is not a keyring signing account", address)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var expires time.Time
+	if duration > 0 {
+		expires = time.Now().Add(duration)
+	}
+	s.unlocked[address] = expires
+	return nil
+}
+
+// lock locks the account, returns false if it wasn't unlocked
+func (s *keyringSigner) lock(address common.Address) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	expires, ok := s.unlocked[address]
+	delete(s.unlocked, address)
+	return ok && (expires.IsZero() || time.Now().Before(expires))
+}
+
+// checkUnlocked returns an error if the account isn't allowlisted or isn't unlocked
+func (s *keyringSigner) checkUnlocked(address common.Address) error {
+	if !s.accounts[address] {
+		return fmt.Errorf("account %s is not a keyring signing account", address)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	expires, ok := s.unlocked[address]
+	if ok && !expires.IsZero() && !time.Now().Before(expires) {
+		delete(s.unlocked, address)
+		ok = false
+	}
+	if !ok {
+		return fmt.Errorf("authentication needed: account %s is locked, unlock it with personal_unlockAccount", address)
+	}
+
+	return nil
+}
+
+// UnlockAccount unlocks the keyring signing account for signing for the given duration in seconds.
+// It uses a default of 300 seconds if the duration is nil, and keeps the account unlocked until
+// it's locked if the duration is 0.
+func (b *Backend) UnlockAccount(address common.Address, duration *uint64) (bool, error) {
+	if b.keyringSigner == nil {
+		return false, errKeyringSigningDisabled
+	}
+
+	if _, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(address.Bytes())); err != nil {
+		return false, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+	}
+
+	d := defaultUnlockDuration
+	if duration != nil {
+		const maxDuration = uint64(1<<63-1) / uint64(time.Second)
+		if *duration > maxDuration {
+			return false, fmt.Errorf("unlock duration too large, max %d seconds", maxDuration)
+		}
+		d = time.Duration(*duration) * time.Second
+	}
+
+	if err := b.keyringSigner.unlock(address, d); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// LockAccount locks the keyring signing account, it returns false if it wasn't unlocked.
+func (b *Backend) LockAccount(address common.Address) bool {
+	if b.keyringSigner == nil {
+		return false
+	}
+
+	return b.keyringSigner.lock(address)
+}
+
+// checkKeyringSigning returns an error if the address can't sign with the node keyring
+func (b *Backend) checkKeyringSigning(address common.Address) error {
+	if b.keyringSigner == nil {
+		return errKeyringSigningDisabled
+	}
+
+	return b.keyringSigner.checkUnlocked(address)
+}
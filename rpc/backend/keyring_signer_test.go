@@ -0,0 +1,68 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+
+	"github.com/SigmaGmbH/evm-module/tests"
+)
+
+func (suite *BackendTestSuite) TestUnlockAccount() {
+	from, priv := tests.NewAddrKey()
+	other := tests.GenerateAddress()
+
+	// keyring signing is disabled by default
+	_, err := suite.backend.UnlockAccount(from, nil)
+	suite.Require().ErrorIs(err, errKeyringSigningDisabled)
+	suite.Require().False(suite.backend.LockAccount(from))
+
+	suite.backend.keyringSigner = newKeyringSigner(true, []string{from.Hex(), other.Hex()})
+
+	// the key has to be in the keyring
+	_, err = suite.backend.UnlockAccount(other, nil)
+	suite.Require().Error(err)
+
+	armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+	suite.Require().NoError(suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, ""))
+
+	// the account has to be allowlisted
+	suite.backend.keyringSigner = newKeyringSigner(true, []string{other.Hex()})
+	_, err = suite.backend.UnlockAccount(from, nil)
+	suite.Require().Error(err)
+
+	suite.backend.keyringSigner = newKeyringSigner(true, []string{from.Hex()})
+	suite.Require().Error(suite.backend.checkKeyringSigning(from))
+	suite.Require().False(suite.backend.LockAccount(from))
+
+	unlocked, err := suite.backend.UnlockAccount(from, nil)
+	suite.Require().NoError(err)
+	suite.Require().True(unlocked)
+	suite.Require().NoError(suite.backend.checkKeyringSigning(from))
+
+	suite.Require().True(suite.backend.LockAccount(from))
+	suite.Require().Error(suite.backend.checkKeyringSigning(from))
+
+	// a zero duration unlocks the account until it's locked
+	zero := uint64(0)
+	_, err = suite.backend.UnlockAccount(from, &zero)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.backend.checkKeyringSigning(from))
+	suite.Require().True(suite.backend.LockAccount(from))
+
+	tooLarge := uint64(1 << 63)
+	_, err = suite.backend.UnlockAccount(from, &tooLarge)
+	suite.Require().Error(err)
+}
+
+func (suite *BackendTestSuite) TestKeyringSignerExpiry() {
+	from := tests.GenerateAddress()
+	signer := newKeyringSigner(true, []string{from.Hex()})
+
+	suite.Require().NoError(signer.unlock(from, time.Millisecond))
+	time.Sleep(2 * time.Millisecond)
+	suite.Require().Error(signer.checkUnlocked(from))
+	suite.Require().False(signer.lock(from))
+
+	suite.Require().Nil(newKeyringSigner(false, []string{from.Hex()}))
+}
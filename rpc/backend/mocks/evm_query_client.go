@@ -107,6 +107,66 @@ func (_m *EVMQueryClient) BaseFee(ctx context.Context, in *types.QueryBaseFeeReq
 	return r0, r1
 }
 
+// BlockBlooms provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) BlockBlooms(ctx context.Context, in *types.QueryBlockBloomsRequest, opts ...grpc.CallOption) (*types.QueryBlockBloomsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryBlockBloomsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlockBloomsRequest, ...grpc.CallOption) *types.QueryBlockBloomsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBlockBloomsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBlockBloomsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockLogs provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) BlockLogs(ctx context.Context, in *types.QueryBlockLogsRequest, opts ...grpc.CallOption) (*types.QueryBlockLogsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryBlockLogsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlockLogsRequest, ...grpc.CallOption) *types.QueryBlockLogsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBlockLogsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBlockLogsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Code provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Code(ctx context.Context, in *types.QueryCodeRequest, opts ...grpc.CallOption) (*types.QueryCodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -137,6 +197,36 @@ func (_m *EVMQueryClient) Code(ctx context.Context, in *types.QueryCodeRequest,
 	return r0, r1
 }
 
+// ConfigHash provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) ConfigHash(ctx context.Context, in *types.QueryConfigHashRequest, opts ...grpc.CallOption) (*types.QueryConfigHashResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryConfigHashResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryConfigHashRequest, ...grpc.CallOption) *types.QueryConfigHashResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryConfigHashResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryConfigHashRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CosmosAccount provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosmosAccountRequest, opts ...grpc.CallOption) (*types.QueryCosmosAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -167,6 +257,36 @@ func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosm
 	return r0, r1
 }
 
+// EpochKeys provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) EpochKeys(ctx context.Context, in *types.QueryEpochKeysRequest, opts ...grpc.CallOption) (*types.QueryEpochKeysResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryEpochKeysResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryEpochKeysRequest, ...grpc.CallOption) *types.QueryEpochKeysResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryEpochKeysResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryEpochKeysRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EstimateGas provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *types.EthCallRequest, opts ...grpc.CallOption) (*types.EstimateGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -227,6 +347,36 @@ func (_m *EVMQueryClient) EthCall(ctx context.Context, in *types.EthCallRequest,
 	return r0, r1
 }
 
+// FrozenAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) FrozenAccounts(ctx context.Context, in *types.QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*types.QueryFrozenAccountsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFrozenAccountsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFrozenAccountsRequest, ...grpc.CallOption) *types.QueryFrozenAccountsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFrozenAccountsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFrozenAccountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -257,6 +407,36 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsReque
 	return r0, r1
 }
 
+// StateHistory provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StateHistory(ctx context.Context, in *types.QueryStateHistoryRequest, opts ...grpc.CallOption) (*types.QueryStateHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryStateHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStateHistoryRequest, ...grpc.CallOption) *types.QueryStateHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStateHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStateHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -287,6 +467,36 @@ func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageReq
 	return r0, r1
 }
 
+// StorageRange provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StorageRange(ctx context.Context, in *types.QueryStorageRangeRequest, opts ...grpc.CallOption) (*types.QueryStorageRangeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryStorageRangeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) *types.QueryStorageRangeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStorageRangeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceBlock provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBlockRequest, opts ...grpc.CallOption) (*types.QueryTraceBlockResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -407,6 +617,96 @@ func (_m *EVMQueryClient) NodePublicKey(ctx context.Context, in *types.QueryNode
 	return r0, r1
 }
 
+// StorageUsage provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StorageUsage(ctx context.Context, in *types.QueryStorageUsageRequest, opts ...grpc.CallOption) (*types.QueryStorageUsageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryStorageUsageResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageUsageRequest, ...grpc.CallOption) *types.QueryStorageUsageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStorageUsageResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStorageUsageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create2Address provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) Create2Address(ctx context.Context, in *types.QueryCreate2AddressRequest, opts ...grpc.CallOption) (*types.QueryCreate2AddressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryCreate2AddressResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCreate2AddressRequest, ...grpc.CallOption) *types.QueryCreate2AddressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryCreate2AddressResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryCreate2AddressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimulateParamsUpdate provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) SimulateParamsUpdate(ctx context.Context, in *types.QuerySimulateParamsUpdateRequest, opts ...grpc.CallOption) (*types.QuerySimulateParamsUpdateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QuerySimulateParamsUpdateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySimulateParamsUpdateRequest, ...grpc.CallOption) *types.QuerySimulateParamsUpdateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySimulateParamsUpdateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySimulateParamsUpdateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewEVMQueryClient interface {
 	mock.TestingT
 	Cleanup(func())
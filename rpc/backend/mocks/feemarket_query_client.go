@@ -47,6 +47,36 @@ func (_m *FeeMarketQueryClient) BaseFee(ctx context.Context, in *types.QueryBase
 	return r0, r1
 }
 
+// BlockBaseFee provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) BlockBaseFee(ctx context.Context, in *types.QueryBlockBaseFeeRequest, opts ...grpc.CallOption) (*types.QueryBlockBaseFeeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryBlockBaseFeeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBlockBaseFeeRequest, ...grpc.CallOption) *types.QueryBlockBaseFeeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBlockBaseFeeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBlockBaseFeeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockGas provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlockGasRequest, opts ...grpc.CallOption) (*types.QueryBlockGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -16,8 +16,10 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -79,13 +81,50 @@ func (b *Backend) Syncing() (interface{}, error) {
 		return false, nil
 	}
 
-	return map[string]interface{}{
+	// While a state sync snapshot is restored, the latest and earliest block are 0. Once it's
+	// restored, the earliest block is the height of the snapshot.
+	syncing := map[string]interface{}{
 		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
 		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
-		// "highestBlock":  nil, // NA
-		// "pulledStates":  nil, // NA
-		// "knownStates":   nil, // NA
-	}, nil
+		"highestBlock":  hexutil.Uint64(b.highestPeerBlock(status.SyncInfo.LatestBlockHeight)),
+	}
+
+	// the earliest block whose state can be queried, as the state of older blocks may be pruned
+	if history, err := b.StateHistory(); err == nil {
+		syncing["earliestStateBlock"] = hexutil.Uint64(history.EarliestHeight)
+	} else {
+		b.logger.Debug("failed to query the state history", "error", err.Error())
+	}
+	return syncing, nil
+}
+
+// highestPeerBlock returns the highest block committed by the connected peers, as reported by their
+// consensus state, or the given height if it's higher
+func (b *Backend) highestPeerBlock(height int64) int64 {
+	res, err := b.clientCtx.Client.DumpConsensusState(b.ctx)
+	if err != nil {
+		b.logger.Debug("failed to dump consensus state", "error", err.Error())
+		return height
+	}
+
+	for _, peer := range res.Peers {
+		var peerState struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &peerState); err != nil {
+			b.logger.Debug("failed to unmarshal peer state", "peer", peer.NodeAddress, "error", err.Error())
+			continue
+		}
+
+		// peers are in consensus for the block after their latest one
+		if peerHeight := peerState.RoundState.Height - 1; peerHeight > height {
+			height = peerHeight
+		}
+	}
+
+	return height
 }
 
 // SetEtherbase sets the etherbase of the miner
@@ -305,12 +344,12 @@ func (b *Backend) RPCGasCap() uint64 {
 	return b.cfg.JSONRPC.GasCap
 }
 
-// RPCEVMTimeout is the global evm timeout for eth-call variants.
+// RPCEVMTimeout is the global evm timeout for eth-call variants and tracing.
 func (b *Backend) RPCEVMTimeout() time.Duration {
 	return b.cfg.JSONRPC.EVMTimeout
 }
 
-// RPCGasCap is the global gas cap for eth-call variants.
+// RPCTxFeeCap is the global tx-fee cap for send-transaction variants.
 func (b *Backend) RPCTxFeeCap() float64 {
 	return b.cfg.JSONRPC.TxFeeCap
 }
@@ -335,6 +374,15 @@ func (b *Backend) RPCBlockRangeCap() int32 {
 	return b.cfg.JSONRPC.BlockRangeCap
 }
 
+// RPCFilterStatePath returns the file used to persist polling filters across restarts.
+// It returns an empty string if sticky filters are disabled.
+func (b *Backend) RPCFilterStatePath() string {
+	if !b.cfg.JSONRPC.EnableStickyFilters {
+		return ""
+	}
+	return filepath.Join(b.clientCtx.HomeDir, "data", "json-rpc-filters.json")
+}
+
 // RPCMinGasPrice returns the minimum gas price for a transaction obtained from
 // the node config. If set value is 0, it will default to 20.
 func (b *Backend) RPCMinGasPrice() int64 {
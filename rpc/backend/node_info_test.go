@@ -187,16 +187,79 @@ func (suite *BackendTestSuite) TestSyncing() {
 			true,
 		},
 		{
-			"pass - Node is catching up",
+			"pass - Node is catching up, can't get consensus state",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterStatus(client)
+				RegisterDumpConsensusStateError(client)
+				RegisterStateHistoryError(queryClient)
 				status, _ := client.Status(suite.backend.ctx)
 				status.SyncInfo.CatchingUp = true
 			},
 			map[string]interface{}{
 				"startingBlock": hexutil.Uint64(0),
 				"currentBlock":  hexutil.Uint64(0),
+				"highestBlock":  hexutil.Uint64(0),
+			},
+			true,
+		},
+		{
+			"pass - Node is catching up, highest block of peers",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStatus(client)
+				RegisterDumpConsensusState(client, 80, 101, 95)
+				RegisterStateHistoryError(queryClient)
+				status, _ := client.Status(suite.backend.ctx)
+				status.SyncInfo.CatchingUp = true
+				status.SyncInfo.EarliestBlockHeight = 50
+				status.SyncInfo.LatestBlockHeight = 90
+			},
+			map[string]interface{}{
+				"startingBlock": hexutil.Uint64(50),
+				"currentBlock":  hexutil.Uint64(90),
+				"highestBlock":  hexutil.Uint64(100),
+			},
+			true,
+		},
+		{
+			"pass - Node is restoring a state sync snapshot",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStatus(client)
+				RegisterDumpConsensusState(client, 1001)
+				RegisterStateHistoryError(queryClient)
+				status, _ := client.Status(suite.backend.ctx)
+				status.SyncInfo.CatchingUp = true
+			},
+			map[string]interface{}{
+				"startingBlock": hexutil.Uint64(0),
+				"currentBlock":  hexutil.Uint64(0),
+				"highestBlock":  hexutil.Uint64(1000),
+			},
+			true,
+		},
+		{
+			"pass - Node is catching up, earliest block with state",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStatus(client)
+				RegisterDumpConsensusState(client, 95)
+				RegisterStateHistory(queryClient, 60)
+				status, _ := client.Status(suite.backend.ctx)
+				status.SyncInfo.CatchingUp = true
+				status.SyncInfo.EarliestBlockHeight = 50
+				status.SyncInfo.LatestBlockHeight = 90
+			},
+			map[string]interface{}{
+				"startingBlock":      hexutil.Uint64(50),
+				"currentBlock":       hexutil.Uint64(90),
+				"highestBlock":       hexutil.Uint64(94),
+				"earliestStateBlock": hexutil.Uint64(60),
 			},
 			true,
 		},
@@ -30,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
 	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
 )
 
@@ -71,6 +72,12 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
+	// ensure the transaction fee is below the configured cap
+	ethTx := msg.AsTransaction()
+	if err := rpctypes.CheckTxFee(ethTx.GasPrice(), ethTx.Gas(), b.RPCTxFeeCap()); err != nil {
+		return common.Hash{}, err
+	}
+
 	// Query params to use the EVM denomination
 	res, err := b.queryClient.QueryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
 	if err != nil {
@@ -93,8 +100,6 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
-	ethTx := msg.AsTransaction()
-
 	// check the local node config in case unprotected txs are disabled
 	if !b.UnprotectedAllowed() && !ethTx.Protected() {
 		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
@@ -121,6 +126,10 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 
 // Sign signs the provided data using the private key of address via Geth's signature standard.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if err := b.checkKeyringSigning(address); err != nil {
+		return nil, err
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
 	_, err := b.clientCtx.Keyring.KeyByAddress(from)
@@ -142,6 +151,10 @@ func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Byte
 
 // SignTypedData signs EIP-712 conformant typed data
 func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	if err := b.checkKeyringSigning(address); err != nil {
+		return nil, err
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
 	_, err := b.clientCtx.Keyring.KeyByAddress(from)
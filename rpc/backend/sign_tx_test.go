@@ -167,6 +167,10 @@ func (suite *BackendTestSuite) TestSendTransaction() {
 
 func (suite *BackendTestSuite) TestSign() {
 	from, priv := tests.NewAddrKey()
+	importKey := func() {
+		armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+		suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+	}
 	testCases := []struct {
 		name         string
 		registerMock func()
@@ -174,9 +178,41 @@ func (suite *BackendTestSuite) TestSign() {
 		inputBz      hexutil.Bytes
 		expPass      bool
 	}{
+		{
+			"fail - keyring signing disabled",
+			func() {
+				importKey()
+			},
+			from,
+			nil,
+			false,
+		},
+		{
+			"fail - not a keyring signing account",
+			func() {
+				importKey()
+				suite.backend.keyringSigner = newKeyringSigner(true, []string{tests.GenerateAddress().Hex()})
+			},
+			from,
+			nil,
+			false,
+		},
+		{
+			"fail - account locked",
+			func() {
+				importKey()
+				suite.backend.keyringSigner = newKeyringSigner(true, []string{from.Hex()})
+			},
+			from,
+			nil,
+			false,
+		},
 		{
 			"fail - can't find key in Keyring",
-			func() {},
+			func() {
+				suite.backend.keyringSigner = newKeyringSigner(true, []string{from.Hex()})
+				suite.Require().NoError(suite.backend.keyringSigner.unlock(from, 0))
+			},
 			from,
 			nil,
 			false,
@@ -184,8 +220,10 @@ func (suite *BackendTestSuite) TestSign() {
 		{
 			"pass - sign nil data",
 			func() {
-				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
-				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				importKey()
+				suite.backend.keyringSigner = newKeyringSigner(true, []string{from.Hex()})
+				_, err := suite.backend.UnlockAccount(from, nil)
+				suite.Require().NoError(err)
 			},
 			from,
 			nil,
@@ -220,9 +258,22 @@ func (suite *BackendTestSuite) TestSignTypedData() {
 		inputTypedData apitypes.TypedData
 		expPass        bool
 	}{
+		{
+			"fail - keyring signing disabled",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+			},
+			from,
+			apitypes.TypedData{},
+			false,
+		},
 		{
 			"fail - can't find key in Keyring",
-			func() {},
+			func() {
+				suite.backend.keyringSigner = newKeyringSigner(true, []string{from.Hex()})
+				suite.Require().NoError(suite.backend.keyringSigner.unlock(from, 0))
+			},
 			from,
 			apitypes.TypedData{},
 			false,
@@ -232,6 +283,9 @@ func (suite *BackendTestSuite) TestSignTypedData() {
 			func() {
 				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
 				suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.backend.keyringSigner = newKeyringSigner(true, []string{from.Hex()})
+				_, err := suite.backend.UnlockAccount(from, nil)
+				suite.Require().NoError(err)
 			},
 			from,
 			apitypes.TypedData{},
@@ -0,0 +1,30 @@
+package backend
+
+import (
+	"strings"
+
+	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// missingStateError is the message of the SDK rejecting queries of heights whose state isn't stored
+const missingStateError = "failed to load state at height"
+
+// StateHistory returns the heights whose state is available on the node
+func (b *Backend) StateHistory() (*evmtypes.QueryStateHistoryResponse, error) {
+	return b.queryClient.StateHistory(b.ctx, &evmtypes.QueryStateHistoryRequest{})
+}
+
+// stateError returns a StatePrunedError if the query of the state at the height failed because the
+// node doesn't keep the state of the height, or the error of the query otherwise
+func (b *Backend) stateError(height int64, err error) error {
+	if err == nil || height <= 0 || !strings.Contains(err.Error(), missingStateError) {
+		return err
+	}
+
+	history, historyErr := b.StateHistory()
+	if historyErr != nil || height >= history.EarliestHeight {
+		return err
+	}
+	return &rpctypes.StatePrunedError{Height: height, EarliestHeight: history.EarliestHeight}
+}
@@ -0,0 +1,76 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/rpc/backend/mocks"
+	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
+	"github.com/SigmaGmbH/evm-module/tests"
+)
+
+func (suite *BackendTestSuite) TestStateError() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+
+	testCases := []struct {
+		name         string
+		registerMock func(common.Address)
+		expPruned    bool
+	}{
+		{
+			"fail - query error isn't caused by missing state",
+			func(addr common.Address) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterCodeError(queryClient, addr)
+			},
+			false,
+		},
+		{
+			"fail - state is missing, can't get the state history",
+			func(addr common.Address) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterCodePruned(queryClient, addr)
+				RegisterStateHistoryError(queryClient)
+			},
+			false,
+		},
+		{
+			"fail - state is missing at or after the earliest height",
+			func(addr common.Address) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterCodePruned(queryClient, addr)
+				RegisterStateHistory(queryClient, 1)
+			},
+			false,
+		},
+		{
+			"fail - state is pruned",
+			func(addr common.Address) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterCodePruned(queryClient, addr)
+				RegisterStateHistory(queryClient, 10)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			addr := tests.GenerateAddress()
+			tc.registerMock(addr)
+
+			_, err := suite.backend.GetCode(addr, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+			suite.Require().Error(err)
+
+			prunedErr, ok := err.(*rpctypes.StatePrunedError)
+			suite.Require().Equal(tc.expPruned, ok)
+			if tc.expPruned {
+				suite.Require().Equal(int64(1), prunedErr.Height)
+				suite.Require().Equal(int64(10), prunedErr.EarliestHeight)
+				suite.Require().Equal(-32002, prunedErr.ErrorCode())
+			}
+		})
+	}
+}
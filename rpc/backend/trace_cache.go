@@ -0,0 +1,105 @@
+package backend
+
+import (
+	"container/list"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+var (
+	traceCacheHitCounter  = metrics.NewRegisteredCounter("rpc/trace/cache/hit", nil)
+	traceCacheMissCounter = metrics.NewRegisteredCounter("rpc/trace/cache/miss", nil)
+)
+
+// traceCache keeps the results of recent transaction traces, so repeated traces of the same
+// transaction, e.g. from explorer pages, don't re-execute it through the enclave. Entries are
+// evicted in least recently used order once the cache is full, and expire after the TTL.
+type traceCache struct {
+	mtx     sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[string]*list.Element
+	order   *list.List // most recently used entry first
+}
+
+type traceCacheEntry struct {
+	key     string
+	result  interface{}
+	expires time.Time
+}
+
+// newTraceCache creates a cache of at most size traces, returns nil if the size is 0
+func newTraceCache(size int, ttl time.Duration) *traceCache {
+	if size <= 0 {
+		return nil
+	}
+
+	return &traceCache{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// get returns the cached trace for the key, if it has not expired yet
+func (c *traceCache) get(key string) (interface{}, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		traceCacheMissCounter.Inc(1)
+		return nil, false
+	}
+
+	entry := elem.Value.(*traceCacheEntry)
+	if c.ttl > 0 && time.Now().After(entry.expires) {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+		traceCacheMissCounter.Inc(1)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	traceCacheHitCounter.Inc(1)
+	return entry.result, true
+}
+
+// add caches the trace for the key, evicting the least recently used trace if the cache is full
+func (c *traceCache) add(key string, result interface{}) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	expires := time.Now().Add(c.ttl)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*traceCacheEntry)
+		entry.result, entry.expires = result, expires
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&traceCacheEntry{key: key, result: result, expires: expires})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*traceCacheEntry).key)
+	}
+}
+
+// traceCacheKey returns the cache key of the trace of the transaction with the given config, as
+// the tracer and its options change the result
+func traceCacheKey(hash common.Hash, config *evmtypes.TraceConfig) string {
+	configBz, err := json.Marshal(config)
+	if err != nil {
+		return ""
+	}
+	return hash.Hex() + string(configBz)
+}
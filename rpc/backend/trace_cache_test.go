@@ -0,0 +1,49 @@
+package backend
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestTraceCache() {
+	suite.Require().Nil(newTraceCache(0, time.Minute))
+
+	cache := newTraceCache(2, time.Minute)
+	hash := common.BigToHash(big.NewInt(1))
+	key := traceCacheKey(hash, nil)
+	suite.Require().NotEqual(key, traceCacheKey(hash, &evmtypes.TraceConfig{Tracer: "callTracer"}))
+
+	_, ok := cache.get(key)
+	suite.Require().False(ok)
+
+	cache.add(key, "trace1")
+	result, ok := cache.get(key)
+	suite.Require().True(ok)
+	suite.Require().Equal("trace1", result)
+
+	// the least recently used trace is evicted once the cache is full
+	key2 := traceCacheKey(common.BigToHash(big.NewInt(2)), nil)
+	key3 := traceCacheKey(common.BigToHash(big.NewInt(3)), nil)
+	cache.add(key2, "trace2")
+	_, ok = cache.get(key)
+	suite.Require().True(ok)
+	cache.add(key3, "trace3")
+
+	_, ok = cache.get(key2)
+	suite.Require().False(ok)
+	_, ok = cache.get(key)
+	suite.Require().True(ok)
+	_, ok = cache.get(key3)
+	suite.Require().True(ok)
+
+	// expired traces are not returned
+	cache = newTraceCache(2, time.Nanosecond)
+	cache.add(key, "trace1")
+	time.Sleep(time.Millisecond)
+	_, ok = cache.get(key)
+	suite.Require().False(ok)
+}
@@ -30,6 +30,13 @@ import (
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+	cacheKey := traceCacheKey(hash, config)
+	if b.traceCache != nil && cacheKey != "" {
+		if result, ok := b.traceCache.get(cacheKey); ok {
+			return result, nil
+		}
+	}
+
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(hash)
 	if err != nil {
@@ -112,7 +119,10 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
-	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
+	ctx, cancel := b.evmTimeoutContext(rpctypes.ContextWithHeight(contextHeight))
+	defer cancel()
+
+	traceResult, err := b.queryClient.TraceTx(ctx, &traceTxRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -125,6 +135,10 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		return nil, err
 	}
 
+	if b.traceCache != nil && cacheKey != "" {
+		b.traceCache.add(cacheKey, decodedResult)
+	}
+
 	return decodedResult, nil
 }
 
@@ -181,7 +195,10 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		ChainId:         b.chainID.Int64(),
 	}
 
-	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+	ctx, cancel := b.evmTimeoutContext(ctxWithHeight)
+	defer cancel()
+
+	res, err := b.queryClient.TraceBlock(ctx, traceBlockRequest)
 	if err != nil {
 		return nil, err
 	}
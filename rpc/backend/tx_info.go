@@ -162,6 +162,24 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		b.logger.Debug("block not found", "height", res.Height, "error", err.Error())
 		return nil, nil
 	}
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	return b.RPCReceiptFromTxResult(hash, res, resBlock, blockRes)
+}
+
+// RPCReceiptFromTxResult returns the JSON-RPC compatible receipt of the ethereum transaction with
+// the given hash and indexer result, included in the given block.
+func (b *Backend) RPCReceiptFromTxResult(
+	hash common.Hash,
+	res *ethermint.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) (map[string]interface{}, error) {
+	hexTx := hash.Hex()
 	tx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
 	if err != nil {
 		b.logger.Debug("decoding failed", "error", err.Error())
@@ -176,11 +194,6 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 
 	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
-	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
-		return nil, nil
-	}
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed)
 	}
@@ -252,12 +265,22 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["logs"] = [][]*ethtypes.Log{}
 	}
 
+	var parsedTx *rpctypes.ParsedTx
+	if parsedTxs, err := rpctypes.ParseTxResult(blockRes.TxsResults[res.TxIndex], tx); err != nil {
+		b.logger.Debug("failed to parse tx events", "hash", hexTx, "error", err.Error())
+	} else {
+		parsedTx = parsedTxs.GetTxByMsgIndex(int(res.MsgIndex))
+	}
+
 	// If the ContractAddress is 20 0x0 bytes, assume it is not a contract creation
 	if txData.GetTo() == nil {
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+	// the price paid by the tx is emitted since it was added, derive it from the base fee otherwise
+	if parsedTx != nil && parsedTx.EffectiveGasPrice != nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*parsedTx.EffectiveGasPrice)
+	} else if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
 			// tolerate the error for pruned node.
@@ -265,11 +288,91 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		} else {
 			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
 		}
+	} else {
+		receipt["effectiveGasPrice"] = hexutil.Big(*txData.GetGasPrice())
 	}
 
 	return receipt, nil
 }
 
+// EthReceiptsFromTendermintBlock returns the receipts of the Ethereum transactions of a Tendermint
+// block, in the order of EthMsgsFromTendermintBlock. The consensus fields match the ones returned by
+// GetTransactionReceipt, so the receipts can be used to build Merkle receipt proofs.
+func (b *Backend) EthReceiptsFromTendermintBlock(
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) (ethtypes.Receipts, error) {
+	block := resBlock.Block
+	receipts := ethtypes.Receipts{}
+
+	// cumulative gas used by the preceding txs of the block, including cosmos txs
+	blockGasUsed := uint64(0)
+	for i, txBz := range block.Txs {
+		txResult := blockRes.TxsResults[i]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResult) {
+			blockGasUsed += uint64(txResult.GasUsed)
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", block.Height, "error", err.Error())
+			blockGasUsed += uint64(txResult.GasUsed)
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tx events: block %d, index %d, %w", block.Height, i, err)
+		}
+
+		msgIndex := 0
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgHandleTx)
+			if !ok {
+				continue
+			}
+
+			parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+			if parsedTx == nil {
+				return nil, fmt.Errorf("ethereum tx not found in msgs: block %d, index %d", block.Height, i)
+			}
+
+			logs, err := TxLogsFromEvents(txResult.Events, msgIndex)
+			if err != nil {
+				b.logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
+			}
+
+			status := ethtypes.ReceiptStatusSuccessful
+			if parsedTx.Failed {
+				status = ethtypes.ReceiptStatusFailed
+			}
+
+			receipt := &ethtypes.Receipt{
+				Type:              ethMsg.AsTransaction().Type(),
+				Status:            status,
+				CumulativeGasUsed: blockGasUsed + parsedTxs.AccumulativeGasUsed(msgIndex),
+				Logs:              logs,
+				TxHash:            ethMsg.AsTransaction().Hash(),
+				GasUsed:           parsedTx.GasUsed,
+				BlockNumber:       big.NewInt(block.Height),
+				TransactionIndex:  uint(len(receipts)),
+			}
+			if receipt.Logs == nil {
+				receipt.Logs = []*ethtypes.Log{}
+			}
+			receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+
+			receipts = append(receipts, receipt)
+			msgIndex++
+		}
+
+		blockGasUsed += uint64(txResult.GasUsed)
+	}
+
+	return receipts, nil
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (b *Backend) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	b.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
@@ -12,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -613,6 +615,54 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestEthReceiptsFromTendermintBlock() {
+	msgHandleTx, _ := suite.buildEthereumTx()
+	txHash := msgHandleTx.AsTransaction().Hash()
+	txBz := suite.signAndEncodeEthTx(msgHandleTx)
+
+	ethTxEvents := func(gasUsed string, failed bool) []abci.Event {
+		attrs := []abci.EventAttribute{
+			{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+			{Key: []byte("txIndex"), Value: []byte("0")},
+			{Key: []byte("txGasUsed"), Value: []byte(gasUsed)},
+		}
+		if failed {
+			attrs = append(attrs, abci.EventAttribute{Key: []byte("ethereumTxFailed"), Value: []byte("execution reverted")})
+		}
+		return []abci.Event{{Type: evmtypes.EventTypeEthereumTx, Attributes: attrs}}
+	}
+
+	resBlock := &tmrpctypes.ResultBlock{
+		Block: types.MakeBlock(1, []types.Tx{[]byte("cosmos tx"), txBz}, nil, nil),
+	}
+	blockRes := &tmrpctypes.ResultBlockResults{
+		Height: 1,
+		TxsResults: []*abci.ResponseDeliverTx{
+			{Code: 1, GasUsed: 5000},
+			{Code: 0, GasUsed: 21000, Events: ethTxEvents("21000", true)},
+		},
+	}
+
+	receipts, err := suite.backend.EthReceiptsFromTendermintBlock(resBlock, blockRes)
+	suite.Require().NoError(err)
+	suite.Require().Len(receipts, 1)
+	suite.Require().Equal(ethtypes.ReceiptStatusFailed, receipts[0].Status)
+	// the gas of preceding cosmos txs is included, as in eth_getTransactionReceipt
+	suite.Require().Equal(uint64(26000), receipts[0].CumulativeGasUsed)
+	suite.Require().Equal(uint64(21000), receipts[0].GasUsed)
+	suite.Require().NotNil(receipts[0].Logs)
+
+	// the root commits to the consensus encoding of the receipts
+	encoded, err := receipts[0].MarshalBinary()
+	suite.Require().NoError(err)
+	var decoded ethtypes.Receipt
+	suite.Require().NoError(decoded.UnmarshalBinary(encoded))
+	suite.Require().Equal(
+		ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)),
+		ethtypes.DeriveSha(ethtypes.Receipts{&decoded}, trie.NewStackTrie(nil)),
+	)
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
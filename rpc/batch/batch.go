@@ -0,0 +1,162 @@
+package batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	// ErrCodeBatchTooLarge is the JSON-RPC error code of batches exceeding the max length, as in go-ethereum
+	ErrCodeBatchTooLarge = -32600
+	// ErrCodeResponseTooLarge is the JSON-RPC error code of calls exceeding the max response size, as in go-ethereum
+	ErrCodeResponseTooLarge = -32003
+
+	errMsgBatchTooLarge    = "batch too large"
+	errMsgResponseTooLarge = "response too large"
+
+	// maxRequestContentLength is the max size of an HTTP request body, as enforced by the go-ethereum rpc server
+	maxRequestContentLength = 1024 * 1024 * 5
+)
+
+// call is the part of a JSON-RPC call needed to answer it with an error
+type call struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// isCall returns false for notifications, which don't have a response
+func (c call) isCall() bool {
+	return c.Method != "" && len(c.ID) > 0 && !bytes.Equal(c.ID, []byte("null"))
+}
+
+// errorResponse returns the JSON-RPC error response of the call
+func errorResponse(id json.RawMessage, code int, msg string) json.RawMessage {
+	bz, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": msg,
+		},
+	})
+	return bz
+}
+
+// responseBuffer buffers the response of a single call
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+func (b *responseBuffer) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+func (b *responseBuffer) WriteHeader(status int) {
+	b.status = status
+}
+
+// Handler returns an HTTP handler serving the calls of JSON-RPC batch requests one by one with the
+// next handler, which serves single requests. Batches with more than maxLength calls are rejected
+// with a single error. Once the size of the responses exceeds maxResponseSize, the remaining calls
+// are answered with an error, as in go-ethereum. Zero values disable the corresponding limit.
+func Handler(next http.Handler, maxLength, maxResponseSize int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// pass the rest of oversized bodies on, so the next handler rejects them
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+		var msgs []json.RawMessage
+		if trimmed := bytes.TrimLeft(body, " \t\r\n"); len(trimmed) == 0 || trimmed[0] != '[' ||
+			len(body) > maxRequestContentLength || json.Unmarshal(body, &msgs) != nil || len(msgs) == 0 {
+			// single, malformed and empty batch requests are answered by the next handler
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		calls := make([]call, len(msgs))
+		for i, msg := range msgs {
+			// malformed calls are answered by the next handler
+			_ = json.Unmarshal(msg, &calls[i])
+		}
+
+		if maxLength > 0 && len(msgs) > maxLength {
+			// the protocol can't report an error for the whole batch, use the id of the first call
+			var id json.RawMessage
+			for _, c := range calls {
+				if c.isCall() {
+					id = c.ID
+					break
+				}
+			}
+			writeResponses(w, []json.RawMessage{errorResponse(id, ErrCodeBatchTooLarge, errMsgBatchTooLarge)})
+			return
+		}
+
+		responses := make([]json.RawMessage, 0, len(msgs))
+		size := 0
+		for i, msg := range msgs {
+			if r.Context().Err() != nil {
+				return
+			}
+
+			if maxResponseSize > 0 && size > maxResponseSize {
+				if calls[i].isCall() {
+					responses = append(responses, errorResponse(calls[i].ID, ErrCodeResponseTooLarge, errMsgResponseTooLarge))
+				}
+				continue
+			}
+
+			req := r.Clone(r.Context())
+			req.Body = io.NopCloser(bytes.NewReader(msg))
+			req.ContentLength = int64(len(msg))
+
+			buf := &responseBuffer{header: make(http.Header), status: http.StatusOK}
+			next.ServeHTTP(buf, req)
+			if buf.status != http.StatusOK {
+				// the request was rejected as a whole, e.g. for its content type
+				for k, v := range buf.header {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(buf.status)
+				_, _ = w.Write(buf.body.Bytes())
+				return
+			}
+
+			// notifications don't have a response
+			resp := bytes.TrimSpace(buf.body.Bytes())
+			if len(resp) == 0 {
+				continue
+			}
+			size += len(resp)
+			responses = append(responses, resp)
+		}
+
+		if len(responses) > 0 {
+			writeResponses(w, responses)
+		}
+	})
+}
+
+// writeResponses writes the batch response
+func writeResponses(w http.ResponseWriter, responses []json.RawMessage) {
+	bz, err := json.Marshal(responses)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
@@ -0,0 +1,143 @@
+package batch
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct{}
+
+func (testService) Echo(s string) string {
+	return s
+}
+
+type response struct {
+	ID     json.RawMessage `json:"id"`
+	Result string          `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func serve(t *testing.T, handler http.Handler, body, contentType string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	srv := ethrpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", testService{}))
+	defer srv.Stop()
+
+	batch := `[
+		{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["aaaaaaaaaa"]},
+		{"jsonrpc":"2.0","method":"test_echo","params":["notification"]},
+		{"jsonrpc":"2.0","id":2,"method":"test_echo","params":["bbbbbbbbbb"]},
+		{"jsonrpc":"2.0","id":3,"method":"test_echo","params":["cccccccccc"]}
+	]`
+
+	testCases := []struct {
+		name            string
+		maxLength       int
+		maxResponseSize int
+		body            string
+		expResponses    []response
+	}{
+		{
+			"unlimited",
+			0,
+			0,
+			batch,
+			[]response{{ID: json.RawMessage("1"), Result: "aaaaaaaaaa"}, {ID: json.RawMessage("2"), Result: "bbbbbbbbbb"}, {ID: json.RawMessage("3"), Result: "cccccccccc"}},
+		},
+		{
+			"batch too large",
+			3,
+			0,
+			batch,
+			[]response{{ID: json.RawMessage("1")}},
+		},
+		{
+			"response too large",
+			4,
+			50,
+			batch,
+			[]response{{ID: json.RawMessage("1"), Result: "aaaaaaaaaa"}, {ID: json.RawMessage("2"), Result: "bbbbbbbbbb"}, {ID: json.RawMessage("3")}},
+		},
+		{
+			"single request",
+			1,
+			1,
+			`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["aaaaaaaaaa"]}`,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := serve(t, Handler(srv, tc.maxLength, tc.maxResponseSize), tc.body, "application/json")
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			if tc.expResponses == nil {
+				var resp response
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				require.Nil(t, resp.Error)
+				require.Equal(t, "aaaaaaaaaa", resp.Result)
+				return
+			}
+
+			var resps []response
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resps))
+			require.Len(t, resps, len(tc.expResponses))
+			for i, exp := range tc.expResponses {
+				require.Equal(t, string(exp.ID), string(resps[i].ID))
+				if exp.Result == "" {
+					require.NotNil(t, resps[i].Error)
+					continue
+				}
+				require.Nil(t, resps[i].Error)
+				require.Equal(t, exp.Result, resps[i].Result)
+			}
+		})
+	}
+}
+
+func TestHandlerErrors(t *testing.T) {
+	srv := ethrpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", testService{}))
+	defer srv.Stop()
+	handler := Handler(srv, 2, 1)
+
+	var resps []response
+	rec := serve(t, handler, `[{"method":"test_echo","params":["a"]},{"id":"x","method":"test_echo","params":["a"]},{"id":1}]`, "application/json")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resps))
+	require.Len(t, resps, 1)
+	require.Equal(t, `"x"`, string(resps[0].ID))
+	require.Equal(t, ErrCodeBatchTooLarge, resps[0].Error.Code)
+
+	resps = nil
+	rec = serve(t, handler, `[{"id":1,"method":"test_echo","params":["a"]},{"id":2,"method":"test_echo","params":["a"]}]`, "application/json")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resps))
+	require.Len(t, resps, 2)
+	require.Nil(t, resps[0].Error)
+	require.Equal(t, ErrCodeResponseTooLarge, resps[1].Error.Code)
+
+	// only notifications
+	rec = serve(t, handler, `[{"method":"test_echo","params":["a"]}]`, "application/json")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Body.String())
+
+	// rejected as a whole
+	rec = serve(t, handler, `[{"id":1,"method":"test_echo","params":["a"]}]`, "text/plain")
+	require.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+}
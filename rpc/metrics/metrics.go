@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+	"unicode"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/SigmaGmbH/evm-module/rpc/ratelimit"
+)
+
+const (
+	namespace = "evm_rpc"
+
+	// unknownMethod is the label of calls of methods that aren't registered, so clients can't
+	// create arbitrary label values
+	unknownMethod = "unknown"
+
+	// maxRequestContentLength is the max size of an HTTP request body, as enforced by the go-ethereum rpc server
+	maxRequestContentLength = 1024 * 1024 * 5
+)
+
+// The JSON-RPC metrics are registered with the default Prometheus registerer, which is the registry
+// of the cosmos telemetry.
+var (
+	requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "requests_total",
+		Help:      "Number of JSON-RPC calls per method",
+	}, []string{"method"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "request_duration_seconds",
+		Help:      "Duration of JSON-RPC calls per method",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+	}, []string{"method"})
+
+	inFlightRequests = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "in_flight_requests",
+		Help:      "Number of JSON-RPC calls being served",
+	})
+
+	// WebsocketConnections is the number of open websocket connections
+	WebsocketConnections = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "websocket_connections",
+		Help:      "Number of open websocket connections",
+	})
+
+	// WebsocketSubscriptions is the number of active websocket subscriptions per subscription type
+	WebsocketSubscriptions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "websocket_subscriptions",
+		Help:      "Number of active websocket subscriptions per type",
+	}, []string{"type"})
+)
+
+func init() {
+	prometheus.MustRegister(requests, requestDuration, inFlightRequests, WebsocketConnections, WebsocketSubscriptions)
+}
+
+// Methods returns the names of the methods of the APIs, as registered with the go-ethereum rpc server
+func Methods(apis []ethrpc.API) map[string]bool {
+	methods := make(map[string]bool)
+	for _, api := range apis {
+		t := reflect.TypeOf(api.Service)
+		for i := 0; i < t.NumMethod(); i++ {
+			name := []rune(t.Method(i).Name)
+			name[0] = unicode.ToLower(name[0])
+			methods[api.Namespace+"_"+string(name)] = true
+		}
+	}
+	return methods
+}
+
+// Handler returns an HTTP handler recording the count, duration and number of in flight calls of
+// the JSON-RPC requests per method, while the next handler serves them. Batch requests must have
+// been split into single calls before.
+func Handler(next http.Handler, methods map[string]bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+		method := unknownMethod
+		if calls := ratelimit.RequestMethods(body); len(calls) == 1 && methods[calls[0]] {
+			method = calls[0]
+		}
+
+		inFlightRequests.Inc()
+		defer inFlightRequests.Dec()
+
+		start := time.Now()
+		next.ServeHTTP(w, r)
+
+		requests.WithLabelValues(method).Inc()
+		requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	})
+}
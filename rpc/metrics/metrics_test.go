@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct{}
+
+func (testService) Echo(s string) string {
+	return s
+}
+
+func (testService) BlockNumber() uint64 {
+	return 1
+}
+
+func TestMethods(t *testing.T) {
+	methods := Methods([]ethrpc.API{{Namespace: "test", Service: testService{}}})
+	require.Equal(t, map[string]bool{"test_echo": true, "test_blockNumber": true}, methods)
+}
+
+func TestHandler(t *testing.T) {
+	apis := []ethrpc.API{{Namespace: "test", Service: testService{}}}
+	srv := ethrpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", testService{}))
+	defer srv.Stop()
+
+	handler := Handler(srv, Methods(apis))
+	serve := func(body string) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"jsonrpc":"2.0"`)
+	}
+
+	echoCount := testutil.ToFloat64(requests.WithLabelValues("test_echo"))
+	unknownCount := testutil.ToFloat64(requests.WithLabelValues(unknownMethod))
+
+	serve(`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["a"]}`)
+	serve(`{"jsonrpc":"2.0","id":2,"method":"test_echo","params":["b"]}`)
+	serve(`{"jsonrpc":"2.0","id":3,"method":"test_random","params":[]}`)
+
+	require.Equal(t, echoCount+2, testutil.ToFloat64(requests.WithLabelValues("test_echo")))
+	require.Equal(t, unknownCount+1, testutil.ToFloat64(requests.WithLabelValues(unknownMethod)))
+	require.Zero(t, testutil.ToFloat64(inFlightRequests))
+	require.Positive(t, testutil.CollectAndCount(requestDuration, "evm_rpc_request_duration_seconds"))
+}
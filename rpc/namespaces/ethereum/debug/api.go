@@ -40,7 +40,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -321,6 +323,50 @@ func (a *API) GetBlockRlp(number uint64) (hexutil.Bytes, error) {
 	return rlp.EncodeToBytes(block)
 }
 
+// GetReceiptsRlp retrieves the consensus encoded receipts of a block together with the root of the
+// receipts trie, so receipt inclusion proofs can be generated and verified off chain. The root is
+// computed by the node, Tendermint headers don't commit to it.
+func (a *API) GetReceiptsRlp(blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.ReceiptsRlpResult, error) {
+	a.logger.Debug("debug_getReceiptsRlp", "block number or hash", blockNrOrHash)
+
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNum.Int64())
+	}
+
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d: %w", resBlock.Block.Height, err)
+	}
+
+	receipts, err := a.backend.EthReceiptsFromTendermintBlock(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := make([]hexutil.Bytes, len(receipts))
+	for i, receipt := range receipts {
+		if encoded[i], err = receipt.MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &rpctypes.ReceiptsRlpResult{
+		BlockHash:    common.BytesToHash(resBlock.Block.Hash()),
+		BlockNumber:  hexutil.Uint64(resBlock.Block.Height),
+		ReceiptsRoot: ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)),
+		Receipts:     encoded,
+	}, nil
+}
+
 // PrintBlock retrieves a block and returns its pretty printed form.
 func (a *API) PrintBlock(number uint64) (string, error) {
 	block, err := a.backend.EthBlockByNumber(rpctypes.BlockNumber(number))
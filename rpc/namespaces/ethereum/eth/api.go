@@ -302,6 +302,13 @@ func (e *PublicAPI) GetNodePublicKey(blockNrOrHash rpctypes.BlockNumberOrHash) (
 	return e.backend.GetNodePublicKey(blockNrOrHash)
 }
 
+// GetEpochKeys returns the enclave public keys of the state encryption key epochs, so clients can
+// encrypt transactions to the key of the epoch of the block they target
+func (e *PublicAPI) GetEpochKeys(blockNrOrHash rpctypes.BlockNumberOrHash) ([]rpctypes.EpochKeyResult, error) {
+	e.logger.Debug("eth_getEpochKeys", "block number or hash", blockNrOrHash)
+	return e.backend.GetEpochKeys(blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -400,11 +407,9 @@ func (e *PublicAPI) Mining() bool {
 
 // Syncing returns false in case the node is currently not syncing with the network. It can be up to date or has not
 // yet received the latest block headers from its pears. In case it is synchronizing:
-// - startingBlock: block number this node started to synchronize from
+// - startingBlock: block number this node started to synchronize from, the snapshot height after a state sync
 // - currentBlock:  block number this node is currently importing
-// - highestBlock:  block number of the highest block header this node has received from peers
-// - pulledStates:  number of state entries processed until now
-// - knownStates:   number of known state entries that still need to be pulled
+// - highestBlock:  block number of the highest block committed by its peers
 func (e *PublicAPI) Syncing() (interface{}, error) {
 	e.logger.Debug("eth_syncing")
 	return e.backend.Syncing()
@@ -423,6 +428,7 @@ func (e *PublicAPI) Coinbase() (string, error) {
 }
 
 // Sign signs the provided data using the private key of address via Geth's signature standard.
+// The address has to be an unlocked keyring signing account, see personal_unlockAccount.
 func (e *PublicAPI) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_sign", "address", address.Hex(), "data", common.Bytes2Hex(data))
 	return e.backend.Sign(address, data)
@@ -454,7 +460,8 @@ func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, err
 	return backend.TxLogsFromEvents(resBlockResult.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
 }
 
-// SignTypedData signs EIP-712 conformant typed data
+// SignTypedData signs EIP-712 conformant typed data.
+// The address has to be an unlocked keyring signing account, see personal_unlockAccount.
 func (e *PublicAPI) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_signTypedData", "address", address.Hex(), "data", typedData)
 	return e.backend.SignTypedData(address, typedData)
@@ -24,6 +24,7 @@ import (
 	"github.com/SigmaGmbH/evm-module/rpc/types"
 	"github.com/cosmos/cosmos-sdk/client"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -31,6 +32,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -51,6 +53,7 @@ type FilterAPI interface {
 
 // Backend defines the methods requided by the PublicFilterAPI backend
 type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
 	GetBlockByNumber(blockNum types.BlockNumber, fullTx bool) (map[string]interface{}, error)
 	HeaderByNumber(blockNum types.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
@@ -58,6 +61,8 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	GetBlockBlooms(from, to int64) (map[int64]ethtypes.Bloom, error)
+	GetStoredLogsByHeight(height int64) ([][]*ethtypes.Log, bool, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -65,6 +70,7 @@ type Backend interface {
 	RPCFilterCap() int32
 	RPCLogsCap() int32
 	RPCBlockRangeCap() int32
+	RPCFilterStatePath() string
 }
 
 // consider a filter inactive if it has not been polled for within deadline
@@ -78,7 +84,10 @@ type filter struct {
 	hashes   []common.Hash
 	crit     filters.FilterCriteria
 	logs     []*ethtypes.Log
-	s        *Subscription // associated subscription in event system
+	s        *Subscription // associated subscription in event system, nil for restored filters
+
+	lastHeight int64     // latest block height returned to the client
+	lastPolled time.Time // time of the latest poll, used to expire persisted filters
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -90,6 +99,8 @@ type PublicFilterAPI struct {
 	events    *EventSystem
 	filtersMu sync.Mutex
 	filters   map[rpc.ID]*filter
+	statePath string // file to persist filter cursors to, empty if disabled
+	dirty     bool   // filter cursors changed since the last flush
 }
 
 // NewPublicAPI returns a new PublicFilterAPI instance.
@@ -101,6 +112,12 @@ func NewPublicAPI(logger log.Logger, clientCtx client.Context, tmWSClient *rpccl
 		backend:   backend,
 		filters:   make(map[rpc.ID]*filter),
 		events:    NewEventSystem(logger, tmWSClient),
+		statePath: backend.RPCFilterStatePath(),
+	}
+
+	if api.statePath != "" {
+		api.restoreFilters()
+		go api.persistLoop()
 	}
 
 	go api.timeoutLoop()
@@ -120,8 +137,11 @@ func (api *PublicFilterAPI) timeoutLoop() {
 		for id, f := range api.filters {
 			select {
 			case <-f.deadline.C:
-				f.s.Unsubscribe(api.events)
+				if f.s != nil {
+					f.s.Unsubscribe(api.events)
+				}
 				delete(api.filters, id)
+				api.dirty = true
 			default:
 				continue
 			}
@@ -130,6 +150,91 @@ func (api *PublicFilterAPI) timeoutLoop() {
 	}
 }
 
+// restoreFilters installs the polling filters persisted before the RPC restart. Restored filters
+// aren't attached to the event system, their changes are queried from the blocks following the cursor.
+// Filters which were not polled within the deadline are dropped.
+func (api *PublicFilterAPI) restoreFilters() {
+	persisted, err := loadFilters(api.statePath)
+	if err != nil {
+		api.logger.Error("failed to load persisted filters", "path", api.statePath, "error", err.Error())
+		return
+	}
+
+	api.filtersMu.Lock()
+	defer api.filtersMu.Unlock()
+
+	for _, pf := range persisted {
+		idle := time.Since(pf.LastPolled)
+		if !isSticky(pf.Type) || idle >= deadline {
+			continue
+		}
+
+		if len(api.filters) >= int(api.backend.RPCFilterCap()) {
+			break
+		}
+
+		api.filters[pf.ID] = &filter{
+			typ:        pf.Type,
+			deadline:   time.NewTimer(deadline - idle),
+			hashes:     []common.Hash{},
+			crit:       pf.Criteria.FilterCriteria(),
+			lastHeight: pf.LastHeight,
+			lastPolled: pf.LastPolled,
+		}
+	}
+
+	api.logger.Debug("restored persisted filters", "count", len(api.filters))
+	api.dirty = true
+}
+
+// persistLoop periodically flushes the cursors of log and block filters to disk.
+// It is started when the api is created with sticky filters enabled.
+func (api *PublicFilterAPI) persistLoop() {
+	ticker := time.NewTicker(persistInterval)
+	defer ticker.Stop()
+
+	for {
+		<-ticker.C
+		api.filtersMu.Lock()
+		if !api.dirty {
+			api.filtersMu.Unlock()
+			continue
+		}
+
+		persisted := make([]persistedFilter, 0, len(api.filters))
+		for id, f := range api.filters {
+			if !isSticky(f.typ) {
+				continue
+			}
+
+			persisted = append(persisted, persistedFilter{
+				ID:         id,
+				Type:       f.typ,
+				Criteria:   newPersistedCriteria(f.crit),
+				LastHeight: f.lastHeight,
+				LastPolled: f.lastPolled,
+			})
+		}
+		api.dirty = false
+		api.filtersMu.Unlock()
+
+		if err := saveFilters(api.statePath, persisted); err != nil {
+			api.logger.Error("failed to persist filters", "path", api.statePath, "error", err.Error())
+		}
+	}
+}
+
+// latestHeight returns the latest block height, or the given fallback if it can't be queried.
+func (api *PublicFilterAPI) latestHeight(fallback int64) int64 {
+	height, err := api.backend.BlockNumber()
+	if err != nil {
+		api.logger.Debug("failed to query latest block number", "error", err.Error())
+		return fallback
+	}
+
+	return int64(height)
+}
+
 // NewPendingTransactionFilter creates a filter that fetches pending transaction hashes
 // as transactions enter the pending state.
 //
@@ -287,6 +392,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 	}
 
 	api.filters[headerSub.ID()] = &filter{typ: filters.BlocksSubscription, deadline: time.NewTimer(deadline), hashes: []common.Hash{}, s: headerSub}
+	api.trackFilter(api.filters[headerSub.ID()])
 
 	go func(headersCh <-chan coretypes.ResultEvent, errCh <-chan error) {
 		defer cancelSubs()
@@ -324,6 +430,17 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 	return headerSub.ID()
 }
 
+// trackFilter sets the initial cursor of a newly created filter, if sticky filters are enabled.
+func (api *PublicFilterAPI) trackFilter(f *filter) {
+	if api.statePath == "" {
+		return
+	}
+
+	f.lastHeight = api.latestHeight(0)
+	f.lastPolled = time.Now()
+	api.dirty = true
+}
+
 // NewHeads send a notification each time a new (header) block is appended to the chain.
 func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
@@ -357,9 +474,21 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 				}
 
 				baseFee := types.BaseFeeFromEvents(data.ResultBeginBlock.Events)
+				bloom, _ := types.BloomFromEvents(data.ResultEndBlock.Events)
+
+				// the event doesn't include the results of the transactions needed for the receipts root
+				var txResults []*abci.ResponseDeliverTx
+				if data.NumTxs > 0 {
+					height := data.Header.Height
+					blockRes, err := api.backend.TendermintBlockResultByNumber(&height)
+					if err != nil {
+						api.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
+						continue
+					}
+					txResults = blockRes.TxsResults
+				}
 
-				// TODO: fetch bloom from events
-				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
+				header := types.EthHeaderFromTendermint(data.Header, txResults, bloom, baseFee)
 				_ = notifier.Notify(rpcSub.ID, header)
 			case <-rpcSub.Err():
 				headersSub.Unsubscribe(api.events)
@@ -479,6 +608,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 		hashes:   []common.Hash{},
 		s:        logsSub,
 	}
+	api.trackFilter(api.filters[filterID])
 
 	go func(eventCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
@@ -524,6 +654,8 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 }
 
 // GetLogs returns logs matching the given argument that are stored within the state.
+// Queries exceeding the logs cap fail with the block range of the logs within the cap as
+// error data, so the query can be paginated by resuming from the block after it.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
 func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
@@ -562,13 +694,16 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	f, found := api.filters[id]
 	if found {
 		delete(api.filters, id)
+		api.dirty = true
 	}
 	api.filtersMu.Unlock()
 
 	if !found {
 		return false
 	}
-	f.s.Unsubscribe(api.events)
+	if f.s != nil {
+		f.s.Unsubscribe(api.events)
+	}
 	return true
 }
 
@@ -637,6 +772,16 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 	}
 	f.deadline.Reset(deadline)
 
+	if api.statePath != "" {
+		f.lastPolled = time.Now()
+		api.dirty = true
+
+		if f.s == nil {
+			return api.restoredFilterChanges(id, f)
+		}
+		f.lastHeight = api.latestHeight(f.lastHeight)
+	}
+
 	switch f.typ {
 	case filters.PendingTransactionsSubscription, filters.BlocksSubscription:
 		hashes := f.hashes
@@ -651,3 +796,46 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 		return nil, fmt.Errorf("invalid filter %s type %d", id, f.typ)
 	}
 }
+
+// restoredFilterChanges returns the changes of a filter restored after an RPC restart by querying
+// the blocks following its cursor. The queried range is limited by the block range cap.
+func (api *PublicFilterAPI) restoredFilterChanges(id rpc.ID, f *filter) (interface{}, error) {
+	from := f.lastHeight + 1
+	to := api.latestHeight(f.lastHeight)
+	if limit := from + int64(api.backend.RPCBlockRangeCap()) - 1; to > limit {
+		to = limit
+	}
+
+	switch f.typ {
+	case filters.BlocksSubscription:
+		hashes := []common.Hash{}
+		for height := from; height <= to; height++ {
+			block, err := api.backend.GetBlockByNumber(types.BlockNumber(height), false)
+			if err != nil {
+				return nil, err
+			}
+
+			hash, ok := block["hash"].(hexutil.Bytes)
+			if !ok {
+				return nil, fmt.Errorf("block %d not found", height)
+			}
+			hashes = append(hashes, common.BytesToHash(hash))
+		}
+		f.lastHeight = to
+		return returnHashes(hashes), nil
+	case filters.LogsSubscription:
+		if from > to {
+			return returnLogs(nil), nil
+		}
+
+		filter := NewRangeFilter(api.logger, api.backend, from, to, f.crit.Addresses, f.crit.Topics)
+		logs, err := filter.Logs(context.Background(), int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+		if err != nil {
+			return nil, err
+		}
+		f.lastHeight = to
+		return returnLogs(logs), nil
+	default:
+		return nil, fmt.Errorf("invalid filter %s type %d", id, f.typ)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -103,10 +104,45 @@ func newFilter(logger log.Logger, backend Backend, criteria filters.FilterCriter
 
 const (
 	maxToOverhang = 600
+
+	// errCodeLimitExceeded is the JSON-RPC error code of queries exceeding the logs cap, as defined in EIP-1474
+	errCodeLimitExceeded = -32005
 )
 
+// LimitExceededError is an API error returned if the logs of a query exceed the logs cap. Its data
+// is the block range of the logs within the cap, so clients can page through the logs by resuming the
+// query from the block after it.
+type LimitExceededError struct {
+	limit    int
+	from, to int64
+}
+
+// Error returns the standard error message of queries exceeding the logs cap
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("query returned more than %d results", e.limit)
+}
+
+// ErrorCode returns the JSON error code of queries exceeding the logs cap
+func (e *LimitExceededError) ErrorCode() int {
+	return errCodeLimitExceeded
+}
+
+// ErrorData returns the block range of the logs within the cap, or nil if the logs of the first block
+// exceed the cap
+func (e *LimitExceededError) ErrorData() interface{} {
+	if e.to < e.from {
+		return nil
+	}
+	return map[string]hexutil.Uint64{
+		"from": hexutil.Uint64(e.from),
+		"to":   hexutil.Uint64(e.to),
+	}
+}
+
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
+// If the logs exceed the log limit, a LimitExceededError with the block range to
+// resume from is returned.
 func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
 	logs := []*ethtypes.Log{}
 	var err error
@@ -129,7 +165,14 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 			return nil, err
 		}
 
-		return f.blockLogs(blockRes, bloom)
+		logs, err := f.blockLogs(blockRes, bloom)
+		if err != nil {
+			return nil, err
+		}
+		if len(logs) > logLimit {
+			return nil, &LimitExceededError{limit: logLimit, from: blockRes.Height, to: blockRes.Height - 1}
+		}
+		return logs, nil
 	}
 
 	// Figure out the limits of the filter range
@@ -169,7 +212,38 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	// blocks within the retention window of the EVM module are pre-filtered with their persisted
+	// bloom, so only the blocks that may contain matching logs are read
+	blooms, err := f.backend.GetBlockBlooms(from, to)
+	if err != nil {
+		f.logger.Debug("failed to fetch persisted block blooms", "from", from, "to", to, "error", err.Error())
+		blooms = nil
+	}
+
 	for height := from; height <= to; height++ {
+		// stop reading blocks once the request is cancelled
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if bloom, ok := blooms[height]; ok {
+			if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
+				continue
+			}
+
+			filtered, found, err := f.storedBlockLogs(height)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to fetch logs of block %d", height)
+			}
+			if found {
+				if len(logs)+len(filtered) > logLimit {
+					return nil, &LimitExceededError{limit: logLimit, from: from, to: height - 1}
+				}
+				logs = append(logs, filtered...)
+				continue
+			}
+		}
+
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
@@ -188,7 +262,7 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 
 		// check logs limit
 		if len(logs)+len(filtered) > logLimit {
-			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+			return nil, &LimitExceededError{limit: logLimit, from: from, to: height - 1}
 		}
 		logs = append(logs, filtered...)
 	}
@@ -219,6 +293,22 @@ func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtyp
 	return logs, nil
 }
 
+// storedBlockLogs returns the logs matching the filter criteria within a single block, read from
+// the logs persisted by the EVM module. It returns false if the logs of the block were pruned.
+func (f *Filter) storedBlockLogs(height int64) ([]*ethtypes.Log, bool, error) {
+	logsList, found, err := f.backend.GetStoredLogsByHeight(height)
+	if err != nil || !found {
+		return nil, false, err
+	}
+
+	unfiltered := make([]*ethtypes.Log, 0)
+	for _, logs := range logsList {
+		unfiltered = append(unfiltered, logs...)
+	}
+
+	return FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics), true, nil
+}
+
 func createBloomFilters(filters [][][]byte, logger log.Logger) [][]BloomIV {
 	bloomFilters := make([][]BloomIV, 0)
 	for _, filter := range filters {
@@ -0,0 +1,99 @@
+package filters
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// persistInterval defines how often the cursors of installed filters are flushed to disk
+var persistInterval = 5 * time.Second
+
+// persistedCriteria is the serializable form of the filter criteria. go-ethereum FilterCriteria
+// only supports decoding of the JSON-RPC representation, so it can't be used directly.
+type persistedCriteria struct {
+	BlockHash *common.Hash     `json:"block_hash,omitempty"`
+	FromBlock *big.Int         `json:"from_block,omitempty"`
+	ToBlock   *big.Int         `json:"to_block,omitempty"`
+	Addresses []common.Address `json:"addresses,omitempty"`
+	Topics    [][]common.Hash  `json:"topics,omitempty"`
+}
+
+// persistedFilter holds the cursor of a polling filter which survives RPC restarts.
+type persistedFilter struct {
+	ID         rpc.ID            `json:"id"`
+	Type       filters.Type      `json:"type"`
+	Criteria   persistedCriteria `json:"criteria"`
+	LastHeight int64             `json:"last_height"`
+	LastPolled time.Time         `json:"last_polled"`
+}
+
+// isSticky returns true if the filter of the given type can be restored after an RPC restart.
+// Pending transactions are not persisted anywhere, so pending transaction filters are dropped.
+func isSticky(typ filters.Type) bool {
+	return typ == filters.LogsSubscription || typ == filters.BlocksSubscription
+}
+
+func newPersistedCriteria(crit filters.FilterCriteria) persistedCriteria {
+	return persistedCriteria{
+		BlockHash: crit.BlockHash,
+		FromBlock: crit.FromBlock,
+		ToBlock:   crit.ToBlock,
+		Addresses: crit.Addresses,
+		Topics:    crit.Topics,
+	}
+}
+
+// FilterCriteria converts the persisted criteria back to the go-ethereum representation.
+func (c persistedCriteria) FilterCriteria() filters.FilterCriteria {
+	return filters.FilterCriteria{
+		BlockHash: c.BlockHash,
+		FromBlock: c.FromBlock,
+		ToBlock:   c.ToBlock,
+		Addresses: c.Addresses,
+		Topics:    c.Topics,
+	}
+}
+
+// loadFilters reads the persisted filter cursors from the given file. A missing file is not an error.
+func loadFilters(path string) ([]persistedFilter, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var persisted []persistedFilter
+	if err := json.Unmarshal(bz, &persisted); err != nil {
+		return nil, err
+	}
+
+	return persisted, nil
+}
+
+// saveFilters atomically writes the filter cursors to the given file.
+func saveFilters(path string, persisted []persistedFilter) error {
+	bz, err := json.Marshal(persisted)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, bz, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
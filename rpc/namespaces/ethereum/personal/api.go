@@ -83,12 +83,10 @@ func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 }
 
 // LockAccount will lock the account associated with the given address when it's unlocked.
-// It removes the key corresponding to the given address from the API's local keys.
+// It returns an indication if the account was unlocked.
 func (api *PrivateAccountAPI) LockAccount(address common.Address) bool {
 	api.logger.Debug("personal_lockAccount", "address", address.String())
-	api.logger.Info("personal_lockAccount not supported")
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false
+	return api.backend.LockAccount(address)
 }
 
 // NewAccount will create a new account and returns the address for the new account.
@@ -116,13 +114,16 @@ func (api *PrivateAccountAPI) NewAccount(password string) (common.Address, error
 	return addr, nil
 }
 
-// UnlockAccount will unlock the account associated with the given address with
-// the given password for duration seconds. If duration is nil it will use a
-// default of 300 seconds. It returns an indication if the account was unlocked.
-func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, _ string, _ *uint64) (bool, error) {
+// UnlockAccount will unlock the account associated with the given address for signing with
+// eth_sign, eth_signTypedData and personal_sign for duration seconds. If duration is nil it will
+// use a default of 300 seconds, if it's 0 the account stays unlocked until it's locked. It returns
+// an indication if the account was unlocked.
+//
+// Only the keyring signing accounts of the json-rpc config can be unlocked, and only if keyring
+// signing is enabled. The password is ignored, the keys are protected by the node keyring backend.
+func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, _ string, duration *uint64) (bool, error) {
 	api.logger.Debug("personal_unlockAccount", "address", addr.String())
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false, nil
+	return api.backend.UnlockAccount(addr, duration)
 }
 
 // SendTransaction will create a transaction from the given arguments and
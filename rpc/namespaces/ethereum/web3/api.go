@@ -16,26 +16,59 @@
 package web3
 
 import (
+	"math/big"
+
+	ethermint "github.com/SigmaGmbH/evm-module/types"
 	"github.com/SigmaGmbH/evm-module/version"
+	"github.com/cosmos/cosmos-sdk/client"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
+// ChainIDs are the chain ids of the node, returned by web3_chainIds
+type ChainIDs struct {
+	// EIP155ChainID is the EIP-155 replay-protection chain id, as returned by eth_chainId
+	EIP155ChainID *hexutil.Big `json:"eip155ChainId"`
+	// CosmosChainID is the chain-id of the cosmos chain, e.g. swisstronik_1291-1
+	CosmosChainID string `json:"cosmosChainId"`
+}
+
 // PublicAPI is the web3_ prefixed set of APIs in the Web3 JSON-RPC spec.
-type PublicAPI struct{}
+type PublicAPI struct {
+	chainIDs ChainIDs
+}
 
 // NewPublicAPI creates an instance of the Web3 API.
-func NewPublicAPI() *PublicAPI {
-	return &PublicAPI{}
+func NewPublicAPI(clientCtx client.Context) *PublicAPI {
+	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		panic(err)
+	}
+
+	return &PublicAPI{
+		chainIDs: ChainIDs{
+			EIP155ChainID: (*hexutil.Big)(chainID),
+			CosmosChainID: clientCtx.ChainID,
+		},
+	}
 }
 
 // ClientVersion returns the client version in the Web3 user agent format.
 func (a *PublicAPI) ClientVersion() string {
-	return version.Version()
+	return version.ClientVersion()
 }
 
 // Sha3 returns the keccak-256 hash of the passed-in input.
 func (a *PublicAPI) Sha3(input string) hexutil.Bytes {
 	return crypto.Keccak256(hexutil.Bytes(input))
 }
+
+// ChainIds returns the EIP-155 chain id of the node together with the cosmos chain-id it's parsed from.
+// It isn't part of the Web3 JSON-RPC spec.
+func (a *PublicAPI) ChainIds() ChainIDs {
+	return ChainIDs{
+		EIP155ChainID: (*hexutil.Big)(new(big.Int).Set(a.chainIDs.EIP155ChainID.ToInt())),
+		CosmosChainID: a.chainIDs.CosmosChainID,
+	}
+}
@@ -0,0 +1,31 @@
+package swisstronik
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/SigmaGmbH/evm-module/rpc/backend"
+	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
+)
+
+// PublicAPI is the swisstronik_ prefixed set of APIs, extending the Web3 JSON-RPC spec with
+// methods tailored to block explorers and indexers.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates an instance of the swisstronik API.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("client", "json-rpc"),
+		backend: backend,
+	}
+}
+
+// GetBlockFull returns the block identified by number with its full transaction objects under
+// "transactions", their receipts under "receipts" and, if withTraces is set, the traces of the
+// transactions under "traces".
+func (a *PublicAPI) GetBlockFull(blockNum rpctypes.BlockNumber, withTraces bool) (map[string]interface{}, error) {
+	a.logger.Debug("swisstronik_getBlockFull", "number", blockNum, "traces", withTraces)
+	return a.backend.GetBlockFull(blockNum, withTraces)
+}
@@ -0,0 +1,300 @@
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// ProxyTokenHeader is the header of requests forwarded by the websocket server, which were already
+	// accounted on the websocket connection
+	ProxyTokenHeader = "X-Rate-Limit-Proxy-Token"
+
+	// ErrCodeLimitExceeded is the JSON-RPC error code of rejected requests, as defined in EIP-1474
+	ErrCodeLimitExceeded = -32005
+
+	// maxRequestContentLength is the max size of an HTTP request body, as enforced by the go-ethereum rpc server
+	maxRequestContentLength = 1024 * 1024 * 5
+
+	// pruneInterval is the interval idle clients are removed in
+	pruneInterval = time.Minute
+)
+
+// ErrLimitExceeded is returned if the calls of a request exceed the rate limit of the client
+var ErrLimitExceeded = errors.New("rate limit exceeded")
+
+var (
+	rejectedCounter = metrics.NewRegisteredCounter("rpc/ratelimit/rejected", nil)
+	delayedCounter  = metrics.NewRegisteredCounter("rpc/ratelimit/delayed", nil)
+)
+
+// Config defines the rate limits of a client
+type Config struct {
+	// RequestsPerSecond is the rate of calls of a client
+	RequestsPerSecond float64
+	// Burst is the max number of calls of a client above the rate
+	Burst int
+	// Methods defines the expensive methods with a separate, lower limit per method
+	Methods []string
+	// MethodRequestsPerSecond is the rate of calls of a client per expensive method
+	MethodRequestsPerSecond float64
+	// MethodBurst is the max number of calls of a client per expensive method above the rate
+	MethodBurst int
+	// MaxWait is the max duration a request exceeding the limits is queued for before it's rejected
+	MaxWait time.Duration
+}
+
+// bucket is a token bucket, the tokens are negative while calls are queued
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// delay returns the duration until n tokens are available
+func (b *bucket) delay(now time.Time, n, rate float64, burst int) time.Duration {
+	tokens := math.Min(b.tokens+now.Sub(b.last).Seconds()*rate, float64(burst))
+	if tokens >= n {
+		return 0
+	}
+	return time.Duration((n - tokens) / rate * float64(time.Second))
+}
+
+// take removes n tokens from the bucket
+func (b *bucket) take(now time.Time, n, rate float64, burst int) {
+	b.tokens = math.Min(b.tokens+now.Sub(b.last).Seconds()*rate, float64(burst)) - n
+	b.last = now
+}
+
+// client holds the buckets of a client
+type client struct {
+	bucket
+	methods  map[string]*bucket
+	lastSeen time.Time
+}
+
+// Limiter limits the JSON-RPC calls per client IP with token buckets. Every call takes a token from
+// the bucket of the client, calls of expensive methods additionally take a token from the bucket of
+// the method. Requests exceeding the limits are queued for up to the max wait, and rejected otherwise.
+type Limiter struct {
+	cfg        Config
+	methods    map[string]bool
+	proxyToken string
+
+	mtx       sync.Mutex
+	clients   map[string]*client
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+// New creates a limiter with the given config
+func New(cfg Config) *Limiter {
+	methods := make(map[string]bool, len(cfg.Methods))
+	for _, method := range cfg.Methods {
+		methods[method] = true
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		panic(err)
+	}
+
+	return &Limiter{
+		cfg:        cfg,
+		methods:    methods,
+		proxyToken: hex.EncodeToString(token),
+		clients:    make(map[string]*client),
+		now:        time.Now,
+	}
+}
+
+// Wait accounts the calls of a request of the client and blocks until they're within the limits.
+// It returns ErrLimitExceeded without accounting the calls if they would have to wait longer than
+// the max wait.
+func (l *Limiter) Wait(ctx context.Context, clientID string, methods []string) error {
+	delay, err := l.reserve(clientID, methods)
+	if err != nil {
+		return err
+	}
+	if delay == 0 {
+		return nil
+	}
+
+	delayedCounter.Inc(1)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes the tokens of the calls and returns the duration until they're available
+func (l *Limiter) reserve(clientID string, methods []string) (time.Duration, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	c, ok := l.clients[clientID]
+	if !ok {
+		c = &client{
+			bucket:  bucket{tokens: float64(l.cfg.Burst), last: now},
+			methods: make(map[string]*bucket),
+		}
+		l.clients[clientID] = c
+	}
+	c.lastSeen = now
+
+	calls := make(map[string]float64)
+	for _, method := range methods {
+		if l.methods[method] {
+			calls[method]++
+		}
+	}
+
+	delay := c.delay(now, float64(len(methods)), l.cfg.RequestsPerSecond, l.cfg.Burst)
+	for method, n := range calls {
+		b, ok := c.methods[method]
+		if !ok {
+			b = &bucket{tokens: float64(l.cfg.MethodBurst), last: now}
+			c.methods[method] = b
+		}
+		if d := b.delay(now, n, l.cfg.MethodRequestsPerSecond, l.cfg.MethodBurst); d > delay {
+			delay = d
+		}
+	}
+
+	if delay > l.cfg.MaxWait {
+		rejectedCounter.Inc(1)
+		for method := range calls {
+			metrics.GetOrRegisterCounter("rpc/ratelimit/rejected/"+method, nil).Inc(1)
+		}
+		return 0, ErrLimitExceeded
+	}
+
+	c.take(now, float64(len(methods)), l.cfg.RequestsPerSecond, l.cfg.Burst)
+	for method, n := range calls {
+		c.methods[method].take(now, n, l.cfg.MethodRequestsPerSecond, l.cfg.MethodBurst)
+	}
+
+	return delay, nil
+}
+
+// prune removes the clients whose buckets are full again
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < pruneInterval {
+		return
+	}
+	l.lastPrune = now
+
+	refill := time.Duration(float64(l.cfg.Burst) / l.cfg.RequestsPerSecond * float64(time.Second))
+	if l.cfg.MethodRequestsPerSecond > 0 {
+		methodRefill := time.Duration(float64(l.cfg.MethodBurst) / l.cfg.MethodRequestsPerSecond * float64(time.Second))
+		if methodRefill > refill {
+			refill = methodRefill
+		}
+	}
+
+	for id, c := range l.clients {
+		// add the max wait, as the tokens of queued calls are negative
+		if now.Sub(c.lastSeen) > refill+l.cfg.MaxWait {
+			delete(l.clients, id)
+		}
+	}
+}
+
+// SetProxyToken marks the request as forwarded by the websocket server, so it isn't accounted twice
+func (l *Limiter) SetProxyToken(r *http.Request) {
+	r.Header.Set(ProxyTokenHeader, l.proxyToken)
+}
+
+// Handler returns an HTTP handler limiting the calls of the JSON-RPC requests per client IP before
+// passing them to the next handler. Rejected requests are answered with a JSON-RPC error and status
+// 429.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(ProxyTokenHeader) == l.proxyToken {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err := l.Wait(r.Context(), ClientID(r), RequestMethods(body)); err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write(ErrorResponse(err))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ClientID returns the IP of the client of the request
+func ClientID(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// RequestMethods returns the methods of the calls of a single or batch JSON-RPC request. Malformed
+// requests are accounted as a single call without method.
+func RequestMethods(body []byte) []string {
+	type call struct {
+		Method string `json:"method"`
+	}
+
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var batch []call
+		if err := json.Unmarshal(body, &batch); err == nil && len(batch) > 0 {
+			methods := make([]string, len(batch))
+			for i, c := range batch {
+				methods[i] = c.Method
+			}
+			return methods
+		}
+		return []string{""}
+	}
+
+	var c call
+	_ = json.Unmarshal(body, &c)
+	return []string{c.Method}
+}
+
+// ErrorResponse returns the JSON-RPC error response of a rejected request
+func ErrorResponse(err error) []byte {
+	bz, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      nil,
+		"error": map[string]interface{}{
+			"code":    ErrCodeLimitExceeded,
+			"message": err.Error(),
+		},
+	})
+	return bz
+}
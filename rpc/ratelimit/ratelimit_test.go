@@ -0,0 +1,168 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(now *time.Time) *Limiter {
+	l := New(Config{
+		RequestsPerSecond:       10,
+		Burst:                   5,
+		Methods:                 []string{"eth_getLogs"},
+		MethodRequestsPerSecond: 1,
+		MethodBurst:             2,
+		MaxWait:                 100 * time.Millisecond,
+	})
+	l.now = func() time.Time { return *now }
+	return l
+}
+
+func TestReserve(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newTestLimiter(&now)
+
+	// burst of the client
+	for i := 0; i < 5; i++ {
+		delay, err := l.reserve("1.1.1.1", []string{"eth_blockNumber"})
+		require.NoError(t, err)
+		require.Zero(t, delay)
+	}
+
+	// queued for up to the max wait
+	delay, err := l.reserve("1.1.1.1", []string{"eth_blockNumber"})
+	require.NoError(t, err)
+	require.Equal(t, 100*time.Millisecond, delay)
+
+	_, err = l.reserve("1.1.1.1", []string{"eth_blockNumber"})
+	require.ErrorIs(t, err, ErrLimitExceeded)
+
+	// other clients aren't affected
+	delay, err = l.reserve("2.2.2.2", []string{"eth_blockNumber"})
+	require.NoError(t, err)
+	require.Zero(t, delay)
+
+	// refilled at the rate
+	now = now.Add(time.Second)
+	delay, err = l.reserve("1.1.1.1", []string{"eth_blockNumber", "eth_chainId"})
+	require.NoError(t, err)
+	require.Zero(t, delay)
+}
+
+func TestReserveMethods(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newTestLimiter(&now)
+
+	// batch within the method burst
+	delay, err := l.reserve("1.1.1.1", []string{"eth_getLogs", "eth_getLogs"})
+	require.NoError(t, err)
+	require.Zero(t, delay)
+
+	// the method is exhausted, other methods aren't
+	_, err = l.reserve("1.1.1.1", []string{"eth_getLogs"})
+	require.ErrorIs(t, err, ErrLimitExceeded)
+
+	delay, err = l.reserve("1.1.1.1", []string{"eth_blockNumber"})
+	require.NoError(t, err)
+	require.Zero(t, delay)
+
+	// rejected calls aren't accounted
+	now = now.Add(time.Second)
+	delay, err = l.reserve("1.1.1.1", []string{"eth_getLogs"})
+	require.NoError(t, err)
+	require.Zero(t, delay)
+}
+
+func TestPrune(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newTestLimiter(&now)
+
+	_, err := l.reserve("1.1.1.1", []string{"eth_getLogs"})
+	require.NoError(t, err)
+	require.Len(t, l.clients, 1)
+
+	now = now.Add(pruneInterval)
+	_, err = l.reserve("2.2.2.2", []string{"eth_blockNumber"})
+	require.NoError(t, err)
+	require.Len(t, l.clients, 1)
+	require.Contains(t, l.clients, "2.2.2.2")
+}
+
+func TestWait(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newTestLimiter(&now)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, l.Wait(context.Background(), "1.1.1.1", []string{""}))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, l.Wait(ctx, "1.1.1.1", []string{""}), context.Canceled)
+}
+
+func TestRequestMethods(t *testing.T) {
+	testCases := []struct {
+		name string
+		body string
+		exp  []string
+	}{
+		{"single", `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`, []string{"eth_getLogs"}},
+		{"batch", ` [{"method":"eth_chainId"},{"method":"eth_getLogs"}]`, []string{"eth_chainId", "eth_getLogs"}},
+		{"empty batch", `[]`, []string{""}},
+		{"malformed", `{"method":`, []string{""}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, RequestMethods([]byte(tc.body)))
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := newTestLimiter(&now)
+
+	var served int
+	handler := l.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	}))
+
+	serve := func(body string, proxied bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = "1.1.1.1:1234"
+		if proxied {
+			l.SetProxyToken(req)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	body := `[{"method":"eth_getLogs"},{"method":"eth_getLogs"}]`
+	require.Equal(t, http.StatusOK, serve(body, false).Code)
+
+	rec := serve(body, false)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Contains(t, rec.Body.String(), "-32005")
+	require.Equal(t, 1, served)
+
+	// requests forwarded by the websocket server were already accounted
+	require.Equal(t, http.StatusOK, serve(body, true).Code)
+	require.Equal(t, 2, served)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = "1.1.1.1:1234"
+	req.Header.Set(ProxyTokenHeader, "invalid")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, 2, served)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// errCodeResourceUnavailable is the JSON-RPC error code of unavailable resources, as defined in EIP-1474
+const errCodeResourceUnavailable = -32002
+
+// StatePrunedError is an API error returned if the state of a queried height isn't kept by the node.
+// Its data is the earliest height whose state is available, so clients can tell pruned heights from
+// invalid ones and retry against an archive node.
+type StatePrunedError struct {
+	Height         int64
+	EarliestHeight int64
+}
+
+// Error returns the message of queries of pruned state
+func (e *StatePrunedError) Error() string {
+	return fmt.Sprintf("state of block %d is pruned, the earliest available block is %d", e.Height, e.EarliestHeight)
+}
+
+// ErrorCode returns the JSON error code of queries of pruned state
+func (e *StatePrunedError) ErrorCode() int {
+	return errCodeResourceUnavailable
+}
+
+// ErrorData returns the earliest height whose state is available
+func (e *StatePrunedError) ErrorData() interface{} {
+	return map[string]hexutil.Uint64{
+		"earliestBlock": hexutil.Uint64(e.EarliestHeight),
+	}
+}
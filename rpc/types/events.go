@@ -17,6 +17,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 
 	ethermint "github.com/SigmaGmbH/evm-module/types"
@@ -72,6 +73,8 @@ type ParsedTx struct {
 	EthTxIndex int32
 	GasUsed    uint64
 	Failed     bool
+	// price per gas paid by the tx, nil for blocks without it
+	EffectiveGasPrice *big.Int
 }
 
 // NewParsedTx initialize a ParsedTx
@@ -260,6 +263,12 @@ func fillTxAttribute(tx *ParsedTx, key []byte, value []byte) error {
 		tx.GasUsed = gasUsed
 	case evmtypes.AttributeKeyEthereumTxFailed:
 		tx.Failed = len(value) > 0
+	case evmtypes.AttributeKeyEffectiveGasPrice:
+		effectiveGasPrice, ok := new(big.Int).SetString(string(value), 10)
+		if !ok {
+			return fmt.Errorf("invalid effective gas price %s", value)
+		}
+		tx.EffectiveGasPrice = effectiveGasPrice
 	}
 	return nil
 }
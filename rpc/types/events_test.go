@@ -38,6 +38,7 @@ func TestParseTxResult(t *testing.T) {
 						{Key: []byte("txIndex"), Value: []byte("10")},
 						{Key: []byte("amount"), Value: []byte("1000")},
 						{Key: []byte("txGasUsed"), Value: []byte("21000")},
+						{Key: []byte("effectiveGasPrice"), Value: []byte("1000000000")},
 						{Key: []byte("txHash"), Value: []byte("14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57")},
 						{Key: []byte("recipient"), Value: []byte("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")},
 					}},
@@ -61,11 +62,12 @@ func TestParseTxResult(t *testing.T) {
 			},
 			[]*ParsedTx{
 				{
-					MsgIndex:   0,
-					Hash:       txHash,
-					EthTxIndex: 10,
-					GasUsed:    21000,
-					Failed:     false,
+					MsgIndex:          0,
+					Hash:              txHash,
+					EthTxIndex:        10,
+					GasUsed:           21000,
+					Failed:            false,
+					EffectiveGasPrice: big.NewInt(1000000000),
 				},
 				{
 					MsgIndex:   1,
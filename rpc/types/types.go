@@ -91,6 +91,24 @@ type FeeHistoryResult struct {
 	GasUsedRatio []float64        `json:"gasUsedRatio"`
 }
 
+// ReceiptsRlpResult represents the RLP encoded receipts of a block and the root of the receipts
+// trie built from them.
+type ReceiptsRlpResult struct {
+	BlockHash    common.Hash     `json:"blockHash"`
+	BlockNumber  hexutil.Uint64  `json:"blockNumber"`
+	ReceiptsRoot common.Hash     `json:"receiptsRoot"`
+	Receipts     []hexutil.Bytes `json:"receipts"`
+}
+
+// EpochKeyResult represents the enclave public key of a state encryption key epoch and the range
+// of blocks using it. The end block is nil for the latest epoch.
+type EpochKeyResult struct {
+	Epoch      hexutil.Uint64  `json:"epoch"`
+	StartBlock hexutil.Uint64  `json:"startBlock"`
+	EndBlock   *hexutil.Uint64 `json:"endBlock"`
+	PublicKey  hexutil.Bytes   `json:"publicKey"`
+}
+
 // SignTransactionResult represents a RLP encoded signed transaction.
 type SignTransactionResult struct {
 	Raw hexutil.Bytes         `json:"raw"`
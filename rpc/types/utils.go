@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -62,21 +63,46 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgHan
 	return ethTxs, nil
 }
 
-// EthHeaderFromTendermint is an util function that returns an Ethereum Header
-// from a tendermint Header.
-func EthHeaderFromTendermint(header tmtypes.Header, bloom ethtypes.Bloom, baseFee *big.Int) *ethtypes.Header {
-	txHash := ethtypes.EmptyRootHash
-	if len(header.DataHash) == 0 {
-		txHash = common.BytesToHash(header.DataHash)
+// The fields of Ethereum block headers without a Tendermint equivalent are mapped as follows:
+//   - sha3Uncles: the empty uncle hash, as there are no uncles
+//   - transactionsRoot: the data hash, i.e. the merkle root of all transactions of the block, or the
+//     empty root hash if the block has none
+//   - receiptsRoot: the merkle root of the results of the transactions, i.e. the last results hash of
+//     the next block, or the empty root hash if the block has no transactions
+//   - logsBloom: the bloom of the EVM logs of the block, emitted in the end block events
+//   - mixHash and nonce: zero, as there's no proof of work
+//   - size: the size of the protobuf encoded Tendermint block
+//   - withdrawals and withdrawalsRoot: empty, as there are no beacon chain withdrawals
+
+// TransactionsRoot returns the transactions root of the Ethereum header of a Tendermint header
+func TransactionsRoot(header tmtypes.Header) common.Hash {
+	if len(header.DataHash) == 0 || bytes.Equal(header.DataHash, merkle.HashFromByteSlices(nil)) {
+		return ethtypes.EmptyRootHash
+	}
+	return common.BytesToHash(header.DataHash)
+}
+
+// ReceiptsRoot returns the receipts root of the Ethereum header of a block with the given transaction
+// results
+func ReceiptsRoot(txResults []*abci.ResponseDeliverTx) common.Hash {
+	if len(txResults) == 0 {
+		return ethtypes.EmptyRootHash
 	}
+	return common.BytesToHash(tmtypes.NewResults(txResults).Hash())
+}
 
+// EthHeaderFromTendermint is an util function that returns an Ethereum Header
+// from a tendermint Header and the results of its transactions.
+func EthHeaderFromTendermint(
+	header tmtypes.Header, txResults []*abci.ResponseDeliverTx, bloom ethtypes.Bloom, baseFee *big.Int,
+) *ethtypes.Header {
 	return &ethtypes.Header{
 		ParentHash:  common.BytesToHash(header.LastBlockID.Hash.Bytes()),
 		UncleHash:   ethtypes.EmptyUncleHash,
 		Coinbase:    common.BytesToAddress(header.ProposerAddress),
 		Root:        common.BytesToHash(header.AppHash),
-		TxHash:      txHash,
-		ReceiptHash: ethtypes.EmptyRootHash,
+		TxHash:      TransactionsRoot(header),
+		ReceiptHash: ReceiptsRoot(txResults),
 		Bloom:       bloom,
 		Difficulty:  big.NewInt(0),
 		Number:      big.NewInt(header.Height),
@@ -90,6 +116,22 @@ func EthHeaderFromTendermint(header tmtypes.Header, bloom ethtypes.Bloom, baseFe
 	}
 }
 
+// BloomFromEvents returns the bloom of the EVM logs of a block from its end block events
+func BloomFromEvents(events []abci.Event) (ethtypes.Bloom, bool) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeBlockBloom {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if bytes.Equal(attr.Key, []byte(evmtypes.AttributeKeyEthereumBloom)) {
+				return ethtypes.BytesToBloom(attr.Value), true
+			}
+		}
+	}
+	return ethtypes.Bloom{}, false
+}
+
 // BlockMaxGasFromConsensusParams returns the gas limit for the current block from the chain consensus params.
 func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Context, blockHeight int64) (int64, error) {
 	resConsParams, err := clientCtx.Client.ConsensusParams(goCtx, &blockHeight)
@@ -108,20 +150,13 @@ func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Cont
 	return gasLimit, nil
 }
 
-// FormatBlock creates an ethereum block from a tendermint header and ethereum-formatted
-// transactions.
+// FormatBlock creates an ethereum block from a tendermint header, the results of its
+// transactions and ethereum-formatted transactions.
 func FormatBlock(
-	header tmtypes.Header, size int, gasLimit int64,
+	header tmtypes.Header, txResults []*abci.ResponseDeliverTx, size int, gasLimit int64,
 	gasUsed *big.Int, transactions []interface{}, bloom ethtypes.Bloom,
 	validatorAddr common.Address, baseFee *big.Int,
 ) map[string]interface{} {
-	var transactionsRoot common.Hash
-	if len(transactions) == 0 {
-		transactionsRoot = ethtypes.EmptyRootHash
-	} else {
-		transactionsRoot = common.BytesToHash(header.DataHash)
-	}
-
 	result := map[string]interface{}{
 		"number":           hexutil.Uint64(header.Height),
 		"hash":             hexutil.Bytes(header.Hash()),
@@ -138,14 +173,19 @@ func FormatBlock(
 		"gasLimit":         hexutil.Uint64(gasLimit), // Static gas limit
 		"gasUsed":          (*hexutil.Big)(gasUsed),
 		"timestamp":        hexutil.Uint64(header.Time.Unix()),
-		"transactionsRoot": transactionsRoot,
-		"receiptsRoot":     ethtypes.EmptyRootHash,
+		"transactionsRoot": TransactionsRoot(header),
+		"receiptsRoot":     ReceiptsRoot(txResults),
 
 		"uncles":          []common.Hash{},
 		"transactions":    transactions,
 		"totalDifficulty": (*hexutil.Big)(big.NewInt(0)),
+
+		// Shanghai fields, so post-Shanghai client libraries can decode the block
+		"withdrawals":     []interface{}{},
+		"withdrawalsRoot": ethtypes.EmptyRootHash,
 	}
 
+	// the base fee of the block, as kept in the fee market history
 	if baseFee != nil {
 		result["baseFeePerGas"] = (*hexutil.Big)(baseFee)
 	}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func TestEthHeaderFromTendermint(t *testing.T) {
+	emptyBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)
+	txBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{[]byte("tx")}, nil, nil)
+	txResults := []*abci.ResponseDeliverTx{{Code: 0, GasUsed: 21000}}
+
+	testCases := []struct {
+		name           string
+		header         tmtypes.Header
+		txResults      []*abci.ResponseDeliverTx
+		expTxRoot      []byte
+		expReceiptRoot []byte
+	}{
+		{
+			"block without txs",
+			emptyBlock.Header,
+			nil,
+			ethtypes.EmptyRootHash.Bytes(),
+			ethtypes.EmptyRootHash.Bytes(),
+		},
+		{
+			"block without data hash",
+			tmtypes.Header{Height: 1},
+			nil,
+			ethtypes.EmptyRootHash.Bytes(),
+			ethtypes.EmptyRootHash.Bytes(),
+		},
+		{
+			"block with txs",
+			txBlock.Header,
+			txResults,
+			txBlock.DataHash,
+			tmtypes.NewResults(txResults).Hash(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := EthHeaderFromTendermint(tc.header, tc.txResults, ethtypes.Bloom{}, nil)
+			require.Equal(t, tc.expTxRoot, header.TxHash.Bytes())
+			require.Equal(t, tc.expReceiptRoot, header.ReceiptHash.Bytes())
+			require.Equal(t, ethtypes.EmptyUncleHash, header.UncleHash)
+
+			block := FormatBlock(tc.header, tc.txResults, 100, 0, nil, nil, ethtypes.Bloom{}, [20]byte{}, nil)
+			require.Equal(t, header.TxHash, block["transactionsRoot"])
+			require.Equal(t, header.ReceiptHash, block["receiptsRoot"])
+		})
+	}
+}
+
+func TestFormatBlock(t *testing.T) {
+	header := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil).Header
+
+	block := FormatBlock(header, nil, 100, 0, nil, nil, ethtypes.Bloom{}, [20]byte{}, nil)
+	require.NotContains(t, block, "baseFeePerGas")
+	require.Equal(t, []interface{}{}, block["withdrawals"])
+	require.Equal(t, ethtypes.EmptyRootHash, block["withdrawalsRoot"])
+
+	block = FormatBlock(header, nil, 100, 0, nil, nil, ethtypes.Bloom{}, [20]byte{}, big.NewInt(1000))
+	require.Equal(t, (*hexutil.Big)(big.NewInt(1000)), block["baseFeePerGas"])
+}
+
+func TestBloomFromEvents(t *testing.T) {
+	bloom := ethtypes.BytesToBloom([]byte{1, 2, 3})
+
+	_, found := BloomFromEvents(nil)
+	require.False(t, found)
+
+	res, found := BloomFromEvents([]abci.Event{
+		{Type: "other"},
+		{
+			Type: evmtypes.EventTypeBlockBloom,
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(evmtypes.AttributeKeyEthereumBloom), Value: bloom.Bytes()},
+			},
+		},
+	})
+	require.True(t, found)
+	require.Equal(t, bloom, res)
+}
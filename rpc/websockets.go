@@ -25,24 +25,28 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
+	"golang.org/x/net/netutil"
 
 	"github.com/ethereum/go-ethereum/common"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
-	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/SigmaGmbH/evm-module/rpc/auth"
 	"github.com/SigmaGmbH/evm-module/rpc/ethereum/pubsub"
+	rpcmetrics "github.com/SigmaGmbH/evm-module/rpc/metrics"
 	rpcfilters "github.com/SigmaGmbH/evm-module/rpc/namespaces/ethereum/eth/filters"
+	"github.com/SigmaGmbH/evm-module/rpc/ratelimit"
 	"github.com/SigmaGmbH/evm-module/rpc/types"
 	"github.com/SigmaGmbH/evm-module/server/config"
 	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
@@ -81,39 +85,80 @@ type ErrorMessageJSON struct {
 }
 
 type websocketsServer struct {
-	rpcAddr  string // listen address of rest-server
-	wsAddr   string // listen address of ws server
-	certFile string
-	keyFile  string
-	api      *pubSubAPI
-	logger   log.Logger
+	rpcAddr            string // listen address of rest-server
+	wsAddr             string // listen address of ws server
+	certFile           string
+	keyFile            string
+	handshakeTimeout   time.Duration // 0 if the handshake has no timeout
+	maxOpenConnections int           // 0 if the connections are unlimited
+	api                *pubSubAPI
+	logger             log.Logger
+	limiter            *ratelimit.Limiter  // nil if rate limiting is disabled
+	auth               *auth.Authenticator // nil if no namespace requires authentication
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	limiter *ratelimit.Limiter,
+	authenticator *auth.Authenticator,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
-	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
 	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+		rpcAddr:            dialAddress(cfg.JSONRPC.Address),
+		wsAddr:             cfg.JSONRPC.WsAddress,
+		certFile:           cfg.TLS.CertificatePath,
+		keyFile:            cfg.TLS.KeyPath,
+		handshakeTimeout:   cfg.JSONRPC.HTTPTimeout,
+		maxOpenConnections: cfg.JSONRPC.MaxOpenConnections,
+		api:                newPubSubAPI(clientCtx, logger, tmWSClient),
+		logger:             logger,
+		limiter:            limiter,
+		auth:               authenticator,
 	}
 }
 
+// dialAddress returns the address the JSON-RPC server listening on the given address is reached at
+// from the node. Servers listening on all interfaces are reached through localhost.
+func dialAddress(listenAddress string) string {
+	host, port, err := net.SplitHostPort(listenAddress)
+	if err != nil {
+		return listenAddress
+	}
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
+
 func (s *websocketsServer) Start() {
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
 
+	// the timeout only applies to the handshake, the upgraded connections are long-lived
+	srv := &http.Server{
+		Addr:              s.wsAddr,
+		Handler:           ws,
+		ReadHeaderTimeout: s.handshakeTimeout,
+	}
+
 	go func() {
-		var err error
-		/* #nosec G114 -- http functions have no support for timeouts */
+		ln, err := net.Listen("tcp", s.wsAddr)
+		if err != nil {
+			s.logger.Error("failed to listen for WS", "error", err.Error())
+			return
+		}
+		if s.maxOpenConnections > 0 {
+			ln = netutil.LimitListener(ln, s.maxOpenConnections)
+		}
+
 		if s.certFile == "" || s.keyFile == "" {
-			err = http.ListenAndServe(s.wsAddr, ws)
+			err = srv.Serve(ln)
 		} else {
-			err = http.ListenAndServeTLS(s.wsAddr, s.certFile, s.keyFile, ws)
+			err = srv.ServeTLS(ln, s.certFile, s.keyFile)
 		}
 
 		if err != nil {
@@ -128,6 +173,7 @@ func (s *websocketsServer) Start() {
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	upgrader := websocket.Upgrader{
+		HandshakeTimeout: s.handshakeTimeout,
 		CheckOrigin: func(r *http.Request) bool {
 			return true
 		},
@@ -139,9 +185,14 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	rpcmetrics.WebsocketConnections.Inc()
+	defer rpcmetrics.WebsocketConnections.Dec()
+
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:           new(sync.Mutex),
+		conn:          conn,
+		clientID:      ratelimit.ClientID(r),
+		authenticated: s.auth == nil || s.auth.Authenticate(r) == nil,
 	})
 }
 
@@ -159,8 +210,10 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 }
 
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn          *websocket.Conn
+	mux           *sync.Mutex
+	clientID      string // IP of the client, used for rate limiting
+	authenticated bool   // if the handshake had valid credentials for the protected namespaces
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -201,6 +254,19 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		methods := ratelimit.RequestMethods(mb)
+		if s.limiter != nil {
+			if err := s.limiter.Wait(context.Background(), wsConn.clientID, methods); err != nil {
+				_ = wsConn.WriteJSON(json.RawMessage(ratelimit.ErrorResponse(err)))
+				continue
+			}
+		}
+
+		if !wsConn.authenticated && s.auth.Protected(methods) {
+			s.sendErrResponse(wsConn, "unauthorized: the connection wasn't authenticated for the requested namespace")
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -247,7 +313,14 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
 			}
-			subscriptions[subID] = unsubFn
+
+			// the type was validated by subscribe
+			gauge := rpcmetrics.WebsocketSubscriptions.WithLabelValues(params[0].(string))
+			gauge.Inc()
+			subscriptions[subID] = func() {
+				unsubFn()
+				gauge.Dec()
+			}
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -320,6 +393,14 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.limiter != nil {
+		// the calls were already accounted on the websocket connection
+		s.limiter.SetProxyToken(req)
+	}
+	if s.auth != nil {
+		// the calls were already authenticated on the websocket connection
+		s.auth.SetProxyToken(req)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -389,9 +470,6 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 		return nil, errors.Wrap(err, "error creating block filter")
 	}
 
-	// TODO: use events
-	baseFee := big.NewInt(params.InitialBaseFee)
-
 	go func() {
 		headersCh := sub.Event()
 		errCh := sub.Err()
@@ -408,7 +486,22 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					continue
 				}
 
-				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
+				baseFee := types.BaseFeeFromEvents(data.ResultBeginBlock.Events)
+				bloom, _ := types.BloomFromEvents(data.ResultEndBlock.Events)
+
+				// the event doesn't include the results of the transactions needed for the receipts root
+				var txResults []*abci.ResponseDeliverTx
+				if data.NumTxs > 0 {
+					height := data.Header.Height
+					blockRes, err := api.clientCtx.Client.BlockResults(context.Background(), &height)
+					if err != nil {
+						api.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
+						continue
+					}
+					txResults = blockRes.TxsResults
+				}
+
+				header := types.EthHeaderFromTendermint(data.Header, txResults, bloom, baseFee)
 
 				// write to ws conn
 				res := &SubscriptionNotification{
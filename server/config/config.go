@@ -18,9 +18,13 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"path"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/viper"
 
 	"github.com/tendermint/tendermint/libs/strings"
@@ -51,6 +55,18 @@ const (
 
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultQueryMaxHostCalls is the default max number of Connector requests of an eth_call execution
+	DefaultQueryMaxHostCalls uint64 = 500_000
+
+	// DefaultQueryTimeout is the default max duration of an eth_call, eth_estimateGas or tracing query
+	DefaultQueryTimeout = 30 * time.Second
+
+	// DefaultCodeCacheSize is the default max size in MiB of the contract code cached in memory
+	DefaultCodeCacheSize uint64 = 32
+
+	// DefaultArchive is the default of keeping the state of every height
+	DefaultArchive = false
+
 	DefaultGasCap uint64 = 25000000
 
 	DefaultFilterCap int32 = 200
@@ -63,6 +79,39 @@ const (
 
 	DefaultEVMTimeout = 600 * time.Second
 
+	// DefaultTraceCacheSize is the default max number of transaction traces kept in memory
+	DefaultTraceCacheSize = 1000
+
+	// DefaultTraceCacheTTL is the default duration a transaction trace is kept in memory
+	DefaultTraceCacheTTL = 10 * time.Minute
+
+	// DefaultGasPriceOracleBlocks is the default number of recent blocks the suggested priority fee is sampled from
+	DefaultGasPriceOracleBlocks = 20
+
+	// DefaultGasPriceOraclePercentile is the default percentile of the sampled priority fees that is suggested
+	DefaultGasPriceOraclePercentile = 60
+
+	// DefaultBatchRequestLimit is the default max number of calls of a JSON-RPC batch request
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultBatchResponseMaxSize is the default max size in bytes of the responses of a JSON-RPC batch request
+	DefaultBatchResponseMaxSize = 25_000_000
+
+	// DefaultRateLimitRequestsPerSecond is the default rate of JSON-RPC calls per client IP
+	DefaultRateLimitRequestsPerSecond = 50.0
+
+	// DefaultRateLimitBurst is the default max number of JSON-RPC calls per client IP above the rate
+	DefaultRateLimitBurst = 100
+
+	// DefaultRateLimitMethodRequestsPerSecond is the default rate of calls per client IP of each rate limited method
+	DefaultRateLimitMethodRequestsPerSecond = 2.0
+
+	// DefaultRateLimitMethodBurst is the default max number of calls per client IP of each rate limited method above the rate
+	DefaultRateLimitMethodBurst = 5
+
+	// DefaultRateLimitMaxWait is the default max duration a JSON-RPC request exceeding the rate limits is queued for
+	DefaultRateLimitMaxWait = time.Second
+
 	// default 1.0 eth
 	DefaultTxFeeCap float64 = 1.0
 
@@ -78,6 +127,15 @@ const (
 
 	// DefaultSeedExchangeServerAddress is the default address the seed exchange server binds to.
 	DefaultSeedExchangeServerAddress = "127.0.0.1:8999"
+
+	// DefaultWebhookMaxRetries is the default number of delivery retries for a webhook notification
+	DefaultWebhookMaxRetries = 5
+
+	// DefaultWebhookRetryBackoff is the default initial backoff between webhook delivery attempts
+	DefaultWebhookRetryBackoff = 1 * time.Second
+
+	// DefaultWebhookTimeout is the default timeout of a single webhook request
+	DefaultWebhookTimeout = 10 * time.Second
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -90,6 +148,7 @@ type Config struct {
 	EVM     EVMConfig     `mapstructure:"evm"`
 	JSONRPC JSONRPCConfig `mapstructure:"json-rpc"`
 	TLS     TLSConfig     `mapstructure:"tls"`
+	Webhook WebhookConfig `mapstructure:"webhook"`
 }
 
 // EVMConfig defines the application configuration values for the EVM.
@@ -99,6 +158,16 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// QueryMaxHostCalls defines the max number of Connector requests of a single eth_call or
+	// eth_estimateGas execution (0=unlimited).
+	QueryMaxHostCalls uint64 `mapstructure:"query-max-host-calls"`
+	// QueryTimeout defines the max duration of an eth_call or eth_estimateGas query (0=unlimited).
+	QueryTimeout time.Duration `mapstructure:"query-timeout"`
+	// CodeCacheSize defines the max size in MiB of the contract code cached in memory (0=disabled).
+	CodeCacheSize uint64 `mapstructure:"code-cache-size"`
+	// Archive defines if the node keeps the state of every height since it started, which requires
+	// the "nothing" pruning strategy.
+	Archive bool `mapstructure:"archive"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -107,11 +176,11 @@ type JSONRPCConfig struct {
 	API []string `mapstructure:"api"`
 	// Address defines the HTTP server to listen on
 	Address string `mapstructure:"address"`
-	// WsAddress defines the WebSocket server to listen on
+	// WsAddress defines the WebSocket server to listen on, the WebSocket server is disabled if it's empty
 	WsAddress string `mapstructure:"ws-address"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
-	// EVMTimeout is the global timeout for eth-call.
+	// EVMTimeout is the global timeout for eth-call, estimate gas and tracing.
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
@@ -133,10 +202,49 @@ type JSONRPCConfig struct {
 	// the node's RPC when global parameter is disabled.
 	AllowUnprotectedTxs bool `mapstructure:"allow-unprotected-txs"`
 	// MaxOpenConnections sets the maximum number of simultaneous connections
-	// for the server listener.
+	// for each of the HTTP and WebSocket server listeners.
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableStickyFilters defines if polling filters are persisted to survive RPC restarts.
+	EnableStickyFilters bool `mapstructure:"enable-sticky-filters"`
+	// TraceCacheSize defines the max number of `debug_traceTransaction` results kept in memory (0 = disabled).
+	TraceCacheSize int `mapstructure:"trace-cache-size"`
+	// TraceCacheTTL defines the duration a `debug_traceTransaction` result is kept in memory.
+	TraceCacheTTL time.Duration `mapstructure:"trace-cache-ttl"`
+	// GasPriceOracleBlocks defines the number of recent blocks the suggested priority fee is sampled from (0 = disabled).
+	GasPriceOracleBlocks int `mapstructure:"gas-price-oracle-blocks"`
+	// GasPriceOraclePercentile defines the percentile of the sampled priority fees that is suggested.
+	GasPriceOraclePercentile int `mapstructure:"gas-price-oracle-percentile"`
+	// EnableKeyringSigning enables `eth_sign`, `eth_signTypedData` and `personal_sign` with the keys of the
+	// node keyring, for the unlocked KeyringSigningAccounts only.
+	EnableKeyringSigning bool `mapstructure:"enable-keyring-signing"`
+	// KeyringSigningAccounts defines the hex addresses of the node keyring accounts that can be unlocked for signing.
+	KeyringSigningAccounts []string `mapstructure:"keyring-signing-accounts"`
+	// AuthNamespaces defines the JSON-RPC namespaces that can only be called with a valid API key or JWT.
+	AuthNamespaces []string `mapstructure:"auth-namespaces"`
+	// AuthAPIKeys defines the API keys accepted as bearer tokens for the AuthNamespaces.
+	AuthAPIKeys []string `mapstructure:"auth-api-keys"`
+	// AuthJWTSecrets defines the hex encoded secrets of the HS256 JWTs accepted as bearer tokens for the AuthNamespaces.
+	AuthJWTSecrets []string `mapstructure:"auth-jwt-secrets"`
+	// BatchRequestLimit defines the max number of calls of a batch request (0 = unlimited).
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// BatchResponseMaxSize defines the max size in bytes of the responses of a batch request (0 = unlimited).
+	BatchResponseMaxSize int `mapstructure:"batch-response-max-size"`
+	// EnableRateLimit enables rate limiting of the JSON-RPC calls per client IP.
+	EnableRateLimit bool `mapstructure:"enable-rate-limit"`
+	// RateLimitRequestsPerSecond defines the rate of JSON-RPC calls per client IP.
+	RateLimitRequestsPerSecond float64 `mapstructure:"rate-limit-requests-per-second"`
+	// RateLimitBurst defines the max number of JSON-RPC calls per client IP above the rate.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// RateLimitMethods defines the expensive methods limited separately with the method rate limit.
+	RateLimitMethods []string `mapstructure:"rate-limit-methods"`
+	// RateLimitMethodRequestsPerSecond defines the rate of calls per client IP of each of the RateLimitMethods.
+	RateLimitMethodRequestsPerSecond float64 `mapstructure:"rate-limit-method-requests-per-second"`
+	// RateLimitMethodBurst defines the max number of calls per client IP of each of the RateLimitMethods above the rate.
+	RateLimitMethodBurst int `mapstructure:"rate-limit-method-burst"`
+	// RateLimitMaxWait defines the max duration a request exceeding the rate limits is queued for before it's rejected.
+	RateLimitMaxWait time.Duration `mapstructure:"rate-limit-max-wait"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -151,6 +259,24 @@ type TLSConfig struct {
 	KeyPath string `mapstructure:"key-path"`
 }
 
+// WebhookConfig defines the receipt notification webhooks of the node.
+type WebhookConfig struct {
+	// Enable defines if receipt notification webhooks should be enabled.
+	Enable bool `mapstructure:"enable"`
+	// URLs defines the endpoints receipts are POSTed to.
+	URLs []string `mapstructure:"urls"`
+	// Addresses defines the sender, recipient or log emitter addresses to watch.
+	Addresses []string `mapstructure:"addresses"`
+	// Topics defines the log topics to watch.
+	Topics []string `mapstructure:"topics"`
+	// MaxRetries defines how many times a failed delivery is retried.
+	MaxRetries int `mapstructure:"max-retries"`
+	// RetryBackoff is the initial delay between delivery attempts, doubled after each failure.
+	RetryBackoff time.Duration `mapstructure:"retry-backoff"`
+	// Timeout is the timeout of a single delivery attempt.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 // AppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func AppConfig(denom string) (string, interface{}) {
@@ -179,6 +305,7 @@ func AppConfig(denom string) (string, interface{}) {
 		EVM:     *DefaultEVMConfig(),
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
+		Webhook: *DefaultWebhookConfig(),
 	}
 
 	customAppTemplate := config.DefaultConfigTemplate + DefaultConfigTemplate
@@ -193,14 +320,19 @@ func DefaultConfig() *Config {
 		EVM:     *DefaultEVMConfig(),
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
+		Webhook: *DefaultWebhookConfig(),
 	}
 }
 
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:            DefaultEVMTracer,
+		MaxTxGasWanted:    DefaultMaxTxGasWanted,
+		QueryMaxHostCalls: DefaultQueryMaxHostCalls,
+		QueryTimeout:      DefaultQueryTimeout,
+		CodeCacheSize:     DefaultCodeCacheSize,
+		Archive:           DefaultArchive,
 	}
 }
 
@@ -210,6 +342,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.QueryTimeout < 0 {
+		return errors.New("EVM query timeout duration cannot be negative")
+	}
+
 	return nil
 }
 
@@ -218,32 +354,62 @@ func GetDefaultAPINamespaces() []string {
 	return []string{"eth", "net", "web3"}
 }
 
+// GetDefaultRateLimitMethods returns the default list of expensive JSON-RPC methods that are rate limited separately
+func GetDefaultRateLimitMethods() []string {
+	return []string{
+		"eth_getLogs",
+		"eth_getFilterLogs",
+		"debug_traceTransaction",
+		"debug_traceBlockByNumber",
+		"debug_traceBlockByHash",
+	}
+}
+
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "swisstronik", "utils"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
 func DefaultJSONRPCConfig() *JSONRPCConfig {
 	return &JSONRPCConfig{
-		Enable:                   true,
-		API:                      GetDefaultAPINamespaces(),
-		Address:                  DefaultJSONRPCAddress,
-		WsAddress:                DefaultJSONRPCWsAddress,
-		GasCap:                   DefaultGasCap,
-		EVMTimeout:               DefaultEVMTimeout,
-		TxFeeCap:                 DefaultTxFeeCap,
-		FilterCap:                DefaultFilterCap,
-		FeeHistoryCap:            DefaultFeeHistoryCap,
-		BlockRangeCap:            DefaultBlockRangeCap,
-		LogsCap:                  DefaultLogsCap,
-		HTTPTimeout:              DefaultHTTPTimeout,
-		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
-		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
-		MaxOpenConnections:       DefaultMaxOpenConnections,
-		EnableIndexer:            false,
-		MetricsAddress:           DefaultJSONRPCMetricsAddress,
-		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		Enable:                           true,
+		API:                              GetDefaultAPINamespaces(),
+		Address:                          DefaultJSONRPCAddress,
+		WsAddress:                        DefaultJSONRPCWsAddress,
+		GasCap:                           DefaultGasCap,
+		EVMTimeout:                       DefaultEVMTimeout,
+		TxFeeCap:                         DefaultTxFeeCap,
+		FilterCap:                        DefaultFilterCap,
+		FeeHistoryCap:                    DefaultFeeHistoryCap,
+		BlockRangeCap:                    DefaultBlockRangeCap,
+		LogsCap:                          DefaultLogsCap,
+		HTTPTimeout:                      DefaultHTTPTimeout,
+		HTTPIdleTimeout:                  DefaultHTTPIdleTimeout,
+		AllowUnprotectedTxs:              DefaultAllowUnprotectedTxs,
+		MaxOpenConnections:               DefaultMaxOpenConnections,
+		EnableIndexer:                    false,
+		EnableStickyFilters:              false,
+		TraceCacheSize:                   DefaultTraceCacheSize,
+		TraceCacheTTL:                    DefaultTraceCacheTTL,
+		GasPriceOracleBlocks:             DefaultGasPriceOracleBlocks,
+		GasPriceOraclePercentile:         DefaultGasPriceOraclePercentile,
+		EnableKeyringSigning:             false,
+		KeyringSigningAccounts:           []string{},
+		AuthNamespaces:                   []string{},
+		AuthAPIKeys:                      []string{},
+		AuthJWTSecrets:                   []string{},
+		BatchRequestLimit:                DefaultBatchRequestLimit,
+		BatchResponseMaxSize:             DefaultBatchResponseMaxSize,
+		EnableRateLimit:                  false,
+		RateLimitRequestsPerSecond:       DefaultRateLimitRequestsPerSecond,
+		RateLimitBurst:                   DefaultRateLimitBurst,
+		RateLimitMethods:                 GetDefaultRateLimitMethods(),
+		RateLimitMethodRequestsPerSecond: DefaultRateLimitMethodRequestsPerSecond,
+		RateLimitMethodBurst:             DefaultRateLimitMethodBurst,
+		RateLimitMaxWait:                 DefaultRateLimitMaxWait,
+		MetricsAddress:                   DefaultJSONRPCMetricsAddress,
+		FixRevertGasRefundHeight:         DefaultFixRevertGasRefundHeight,
 	}
 }
 
@@ -253,6 +419,25 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("cannot enable JSON-RPC without defining any API namespace")
 	}
 
+	if c.Enable {
+		if _, _, err := net.SplitHostPort(c.Address); err != nil {
+			return fmt.Errorf("invalid JSON-RPC address %s: %w", c.Address, err)
+		}
+		// an empty WebSocket address disables the WebSocket server
+		if c.WsAddress != "" {
+			if _, _, err := net.SplitHostPort(c.WsAddress); err != nil {
+				return fmt.Errorf("invalid JSON-RPC WebSocket address %s: %w", c.WsAddress, err)
+			}
+			if c.WsAddress == c.Address {
+				return errors.New("JSON-RPC HTTP and WebSocket addresses must differ")
+			}
+		}
+	}
+
+	if c.MaxOpenConnections < 0 {
+		return errors.New("JSON-RPC max-open-connections cannot be negative")
+	}
+
 	if c.FilterCap < 0 {
 		return errors.New("JSON-RPC filter-cap cannot be negative")
 	}
@@ -285,6 +470,56 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.TraceCacheSize < 0 {
+		return errors.New("JSON-RPC trace cache size cannot be negative")
+	}
+
+	if c.TraceCacheTTL < 0 {
+		return errors.New("JSON-RPC trace cache TTL duration cannot be negative")
+	}
+
+	if c.GasPriceOracleBlocks < 0 {
+		return errors.New("JSON-RPC gas price oracle blocks cannot be negative")
+	}
+
+	if c.GasPriceOracleBlocks > int(c.FeeHistoryCap) {
+		return fmt.Errorf("JSON-RPC gas price oracle blocks cannot be higher than the feehistory-cap %d", c.FeeHistoryCap)
+	}
+
+	if c.GasPriceOraclePercentile < 0 || c.GasPriceOraclePercentile > 100 {
+		return errors.New("JSON-RPC gas price oracle percentile must be between 0 and 100")
+	}
+
+	if err := c.validateAuth(); err != nil {
+		return err
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.BatchResponseMaxSize < 0 {
+		return errors.New("JSON-RPC batch response max size cannot be negative")
+	}
+
+	if c.EnableRateLimit {
+		if c.RateLimitRequestsPerSecond <= 0 || c.RateLimitBurst <= 0 {
+			return errors.New("JSON-RPC rate limit requests per second and burst must be positive")
+		}
+		if len(c.RateLimitMethods) > 0 && (c.RateLimitMethodRequestsPerSecond <= 0 || c.RateLimitMethodBurst <= 0) {
+			return errors.New("JSON-RPC rate limit method requests per second and burst must be positive")
+		}
+		if c.RateLimitMaxWait < 0 {
+			return errors.New("JSON-RPC rate limit max wait duration cannot be negative")
+		}
+	}
+
+	for _, address := range c.KeyringSigningAccounts {
+		if !common.IsHexAddress(address) {
+			return fmt.Errorf("invalid JSON-RPC keyring signing account %s", address)
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -298,6 +533,38 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// validateAuth returns an error if the authentication of the JSON-RPC namespaces is misconfigured
+func (c JSONRPCConfig) validateAuth() error {
+	namespaces := make(map[string]bool)
+	for _, namespace := range GetAPINamespaces() {
+		namespaces[namespace] = true
+	}
+	for _, namespace := range c.AuthNamespaces {
+		if !namespaces[namespace] {
+			return fmt.Errorf("invalid JSON-RPC auth namespace %s", namespace)
+		}
+	}
+
+	if len(c.AuthNamespaces) > 0 && len(c.AuthAPIKeys) == 0 && len(c.AuthJWTSecrets) == 0 {
+		return errors.New("JSON-RPC auth namespaces require at least one API key or JWT secret")
+	}
+
+	for _, key := range c.AuthAPIKeys {
+		if key == "" {
+			return errors.New("JSON-RPC auth API key cannot be empty")
+		}
+	}
+
+	for _, secret := range c.AuthJWTSecrets {
+		bz, err := hexutil.Decode(secret)
+		if err != nil || len(bz) < 32 {
+			return errors.New("JSON-RPC auth JWT secret must be a 0x prefixed hex string of at least 32 bytes")
+		}
+	}
+
+	return nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -323,6 +590,62 @@ func (c TLSConfig) Validate() error {
 	return nil
 }
 
+// DefaultWebhookConfig returns the default webhook configuration
+func DefaultWebhookConfig() *WebhookConfig {
+	return &WebhookConfig{
+		Enable:       false,
+		URLs:         []string{},
+		Addresses:    []string{},
+		Topics:       []string{},
+		MaxRetries:   DefaultWebhookMaxRetries,
+		RetryBackoff: DefaultWebhookRetryBackoff,
+		Timeout:      DefaultWebhookTimeout,
+	}
+}
+
+// Validate returns an error if the webhook configuration fields are invalid.
+func (c WebhookConfig) Validate() error {
+	if c.Enable && len(c.URLs) == 0 {
+		return errors.New("cannot enable webhooks without defining any URL")
+	}
+
+	for _, rawURL := range c.URLs {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("invalid webhook URL %s: %w", rawURL, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid webhook URL %s, expected http or https scheme", rawURL)
+		}
+	}
+
+	for _, address := range c.Addresses {
+		if !common.IsHexAddress(address) {
+			return fmt.Errorf("invalid webhook address %s", address)
+		}
+	}
+
+	for _, topic := range c.Topics {
+		if len(common.FromHex(topic)) != common.HashLength {
+			return fmt.Errorf("invalid webhook topic %s", topic)
+		}
+	}
+
+	if c.MaxRetries < 0 {
+		return errors.New("webhook max-retries cannot be negative")
+	}
+
+	if c.RetryBackoff < 0 {
+		return errors.New("webhook retry backoff duration cannot be negative")
+	}
+
+	if c.Timeout < 0 {
+		return errors.New("webhook timeout duration cannot be negative")
+	}
+
+	return nil
+}
+
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	cfg, err := config.GetConfig(v)
@@ -333,32 +656,64 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			Tracer:            v.GetString("evm.tracer"),
+			MaxTxGasWanted:    v.GetUint64("evm.max-tx-gas-wanted"),
+			QueryMaxHostCalls: v.GetUint64("evm.query-max-host-calls"),
+			QueryTimeout:      v.GetDuration("evm.query-timeout"),
+			CodeCacheSize:     v.GetUint64("evm.code-cache-size"),
+			Archive:           v.GetBool("evm.archive"),
 		},
 		JSONRPC: JSONRPCConfig{
-			Enable:                   v.GetBool("json-rpc.enable"),
-			API:                      v.GetStringSlice("json-rpc.api"),
-			Address:                  v.GetString("json-rpc.address"),
-			WsAddress:                v.GetString("json-rpc.ws-address"),
-			GasCap:                   v.GetUint64("json-rpc.gas-cap"),
-			FilterCap:                v.GetInt32("json-rpc.filter-cap"),
-			FeeHistoryCap:            v.GetInt32("json-rpc.feehistory-cap"),
-			TxFeeCap:                 v.GetFloat64("json-rpc.txfee-cap"),
-			EVMTimeout:               v.GetDuration("json-rpc.evm-timeout"),
-			LogsCap:                  v.GetInt32("json-rpc.logs-cap"),
-			BlockRangeCap:            v.GetInt32("json-rpc.block-range-cap"),
-			HTTPTimeout:              v.GetDuration("json-rpc.http-timeout"),
-			HTTPIdleTimeout:          v.GetDuration("json-rpc.http-idle-timeout"),
-			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
-			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
-			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
-			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
+			Enable:                           v.GetBool("json-rpc.enable"),
+			API:                              v.GetStringSlice("json-rpc.api"),
+			Address:                          v.GetString("json-rpc.address"),
+			WsAddress:                        v.GetString("json-rpc.ws-address"),
+			GasCap:                           v.GetUint64("json-rpc.gas-cap"),
+			FilterCap:                        v.GetInt32("json-rpc.filter-cap"),
+			FeeHistoryCap:                    v.GetInt32("json-rpc.feehistory-cap"),
+			TxFeeCap:                         v.GetFloat64("json-rpc.txfee-cap"),
+			EVMTimeout:                       v.GetDuration("json-rpc.evm-timeout"),
+			LogsCap:                          v.GetInt32("json-rpc.logs-cap"),
+			BlockRangeCap:                    v.GetInt32("json-rpc.block-range-cap"),
+			HTTPTimeout:                      v.GetDuration("json-rpc.http-timeout"),
+			HTTPIdleTimeout:                  v.GetDuration("json-rpc.http-idle-timeout"),
+			MaxOpenConnections:               v.GetInt("json-rpc.max-open-connections"),
+			EnableIndexer:                    v.GetBool("json-rpc.enable-indexer"),
+			EnableStickyFilters:              v.GetBool("json-rpc.enable-sticky-filters"),
+			TraceCacheSize:                   v.GetInt("json-rpc.trace-cache-size"),
+			TraceCacheTTL:                    v.GetDuration("json-rpc.trace-cache-ttl"),
+			GasPriceOracleBlocks:             v.GetInt("json-rpc.gas-price-oracle-blocks"),
+			GasPriceOraclePercentile:         v.GetInt("json-rpc.gas-price-oracle-percentile"),
+			EnableKeyringSigning:             v.GetBool("json-rpc.enable-keyring-signing"),
+			KeyringSigningAccounts:           v.GetStringSlice("json-rpc.keyring-signing-accounts"),
+			AuthNamespaces:                   v.GetStringSlice("json-rpc.auth-namespaces"),
+			AuthAPIKeys:                      v.GetStringSlice("json-rpc.auth-api-keys"),
+			AuthJWTSecrets:                   v.GetStringSlice("json-rpc.auth-jwt-secrets"),
+			BatchRequestLimit:                v.GetInt("json-rpc.batch-request-limit"),
+			BatchResponseMaxSize:             v.GetInt("json-rpc.batch-response-max-size"),
+			EnableRateLimit:                  v.GetBool("json-rpc.enable-rate-limit"),
+			RateLimitRequestsPerSecond:       v.GetFloat64("json-rpc.rate-limit-requests-per-second"),
+			RateLimitBurst:                   v.GetInt("json-rpc.rate-limit-burst"),
+			RateLimitMethods:                 v.GetStringSlice("json-rpc.rate-limit-methods"),
+			RateLimitMethodRequestsPerSecond: v.GetFloat64("json-rpc.rate-limit-method-requests-per-second"),
+			RateLimitMethodBurst:             v.GetInt("json-rpc.rate-limit-method-burst"),
+			RateLimitMaxWait:                 v.GetDuration("json-rpc.rate-limit-max-wait"),
+			MetricsAddress:                   v.GetString("json-rpc.metrics-address"),
+			FixRevertGasRefundHeight:         v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
 			KeyPath:         v.GetString("tls.key-path"),
 		},
+		Webhook: WebhookConfig{
+			Enable:       v.GetBool("webhook.enable"),
+			URLs:         v.GetStringSlice("webhook.urls"),
+			Addresses:    v.GetStringSlice("webhook.addresses"),
+			Topics:       v.GetStringSlice("webhook.topics"),
+			MaxRetries:   v.GetInt("webhook.max-retries"),
+			RetryBackoff: v.GetDuration("webhook.retry-backoff"),
+			Timeout:      v.GetDuration("webhook.timeout"),
+		},
 	}, nil
 }
 
@@ -385,5 +740,9 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid tls config value: %s", err.Error())
 	}
 
+	if err := c.Webhook.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid webhook config value: %s", err.Error())
+	}
+
 	return c.Config.ValidateBasic()
 }
@@ -12,3 +12,202 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestEVMConfigValidate(t *testing.T) {
+	cfg := DefaultEVMConfig()
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, DefaultQueryTimeout, cfg.QueryTimeout)
+	require.Equal(t, DefaultCodeCacheSize, cfg.CodeCacheSize)
+	require.False(t, cfg.Archive)
+
+	cfg.QueryTimeout = -1
+	require.Error(t, cfg.Validate())
+}
+
+func TestWebhookConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *WebhookConfig)
+		expPass  bool
+	}{
+		{"default", func(*WebhookConfig) {}, true},
+		{"enabled without urls", func(cfg *WebhookConfig) { cfg.Enable = true }, false},
+		{
+			"valid",
+			func(cfg *WebhookConfig) {
+				cfg.Enable = true
+				cfg.URLs = []string{"https://example.com/hook"}
+				cfg.Addresses = []string{"0x1000000000000000000000000000000000000001"}
+				cfg.Topics = []string{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"}
+			},
+			true,
+		},
+		{"invalid url scheme", func(cfg *WebhookConfig) { cfg.URLs = []string{"ftp://example.com"} }, false},
+		{"invalid address", func(cfg *WebhookConfig) { cfg.Addresses = []string{"0x1234"} }, false},
+		{"invalid topic", func(cfg *WebhookConfig) { cfg.Topics = []string{"0x1234"} }, false},
+		{"negative retries", func(cfg *WebhookConfig) { cfg.MaxRetries = -1 }, false},
+		{"negative timeout", func(cfg *WebhookConfig) { cfg.Timeout = -1 }, false},
+	}
+
+	for _, tc := range testCases {
+		cfg := DefaultWebhookConfig()
+		tc.malleate(cfg)
+		err := cfg.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestJSONRPCConfigTraceCacheValidate(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, DefaultTraceCacheSize, cfg.TraceCacheSize)
+
+	cfg.TraceCacheSize = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.TraceCacheSize = -1
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.TraceCacheTTL = -1
+	require.Error(t, cfg.Validate())
+}
+
+func TestJSONRPCConfigGasPriceOracleValidate(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, DefaultGasPriceOracleBlocks, cfg.GasPriceOracleBlocks)
+	require.Equal(t, DefaultGasPriceOraclePercentile, cfg.GasPriceOraclePercentile)
+
+	cfg.GasPriceOracleBlocks = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.GasPriceOracleBlocks = -1
+	require.Error(t, cfg.Validate())
+
+	cfg.GasPriceOracleBlocks = int(cfg.FeeHistoryCap) + 1
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.GasPriceOraclePercentile = 101
+	require.Error(t, cfg.Validate())
+
+	cfg.GasPriceOraclePercentile = -1
+	require.Error(t, cfg.Validate())
+}
+
+func TestJSONRPCConfigKeyringSigningValidate(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+	require.False(t, cfg.EnableKeyringSigning)
+	require.Empty(t, cfg.KeyringSigningAccounts)
+
+	cfg.EnableKeyringSigning = true
+	cfg.KeyringSigningAccounts = []string{"0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E"}
+	require.NoError(t, cfg.Validate())
+
+	cfg.KeyringSigningAccounts = append(cfg.KeyringSigningAccounts, "swtr1invalid")
+	require.Error(t, cfg.Validate())
+}
+
+func TestJSONRPCConfigRateLimitValidate(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+	require.False(t, cfg.EnableRateLimit)
+	require.Equal(t, GetDefaultRateLimitMethods(), cfg.RateLimitMethods)
+
+	cfg.EnableRateLimit = true
+	require.NoError(t, cfg.Validate())
+
+	cfg.RateLimitRequestsPerSecond = 0
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.EnableRateLimit = true
+	cfg.RateLimitMethodBurst = 0
+	require.Error(t, cfg.Validate())
+
+	cfg.RateLimitMethods = nil
+	require.NoError(t, cfg.Validate())
+
+	cfg.RateLimitMaxWait = -1
+	require.Error(t, cfg.Validate())
+}
+
+func TestJSONRPCConfigBatchValidate(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, DefaultBatchRequestLimit, cfg.BatchRequestLimit)
+	require.Equal(t, DefaultBatchResponseMaxSize, cfg.BatchResponseMaxSize)
+
+	cfg.BatchRequestLimit = 0
+	cfg.BatchResponseMaxSize = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.BatchRequestLimit = -1
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.BatchResponseMaxSize = -1
+	require.Error(t, cfg.Validate())
+}
+
+func TestJSONRPCConfigAuthValidate(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+	require.Empty(t, cfg.AuthNamespaces)
+
+	cfg.AuthNamespaces = []string{"debug", "txpool"}
+	require.Error(t, cfg.Validate())
+
+	cfg.AuthAPIKeys = []string{"key"}
+	require.NoError(t, cfg.Validate())
+
+	cfg.AuthJWTSecrets = []string{"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}
+	require.NoError(t, cfg.Validate())
+
+	cfg.AuthJWTSecrets = []string{"0x0001"}
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.AuthNamespaces = []string{"unknown"}
+	cfg.AuthAPIKeys = []string{"key"}
+	require.Error(t, cfg.Validate())
+
+	cfg.AuthNamespaces = []string{"debug"}
+	cfg.AuthAPIKeys = []string{""}
+	require.Error(t, cfg.Validate())
+}
+
+func TestJSONRPCConfigServerValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *JSONRPCConfig)
+		expPass  bool
+	}{
+		{"default", func(*JSONRPCConfig) {}, true},
+		{"websocket disabled", func(cfg *JSONRPCConfig) { cfg.WsAddress = "" }, true},
+		{"disabled with invalid address", func(cfg *JSONRPCConfig) { cfg.Enable, cfg.Address = false, "8545" }, true},
+		{"invalid address", func(cfg *JSONRPCConfig) { cfg.Address = "8545" }, false},
+		{"invalid websocket address", func(cfg *JSONRPCConfig) { cfg.WsAddress = "8546" }, false},
+		{"same addresses", func(cfg *JSONRPCConfig) { cfg.WsAddress = cfg.Address }, false},
+		{"no namespaces", func(cfg *JSONRPCConfig) { cfg.API = nil }, false},
+		{"repeated namespace", func(cfg *JSONRPCConfig) { cfg.API = []string{"eth", "eth"} }, false},
+		{"negative connections", func(cfg *JSONRPCConfig) { cfg.MaxOpenConnections = -1 }, false},
+	}
+
+	for _, tc := range testCases {
+		cfg := DefaultJSONRPCConfig()
+		tc.malleate(cfg)
+		err := cfg.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
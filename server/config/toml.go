@@ -31,32 +31,49 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# QueryMaxHostCalls caps the number of state requests of the enclave during a single
+# eth_call/estimateGas/tracing execution (0=unlimited). Default: 500,000.
+query-max-host-calls = {{ .EVM.QueryMaxHostCalls }}
+
+# QueryTimeout aborts eth_call/estimateGas/tracing queries running longer than this duration
+# at their next state request (0=unlimited). Default: 30s.
+query-timeout = "{{ .EVM.QueryTimeout }}"
+
+# CodeCacheSize defines the max size in MiB of the contract code kept in memory, saving a store
+# read each time a cached contract is loaded (0=disabled). Default: 32.
+code-cache-size = {{ .EVM.CodeCacheSize }}
+
+# Archive declares that the node keeps the state of every height since it started, so historical
+# queries are only rejected below the height the node started from. It requires pruning = "nothing".
+archive = {{ .EVM.Archive }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
 
 [json-rpc]
 
-# Enable defines if the gRPC server should be enabled.
+# Enable defines if the JSON-RPC server should be enabled.
 enable = {{ .JSONRPC.Enable }}
 
 # Address defines the EVM RPC HTTP server address to bind to.
 address = "{{ .JSONRPC.Address }}"
 
-# Address defines the EVM WebSocket server address to bind to.
+# Address defines the EVM WebSocket server address to bind to. Leave it empty to disable the WebSocket server.
 ws-address = "{{ .JSONRPC.WsAddress }}"
 
-# API defines a list of JSON-RPC namespaces that should be enabled
-# Example: "eth,txpool,personal,net,debug,web3"
+# API defines a list of JSON-RPC namespaces that should be enabled, the node fails to start with an unknown namespace.
+# Available: "web3,eth,personal,net,txpool,debug,miner,swisstronik,utils"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
 gas-cap = {{ .JSONRPC.GasCap }}
 
-# EVMTimeout is the global timeout for eth_call. Default: 5s.
+# EVMTimeout is the global timeout for eth_call, eth_estimateGas and tracing. The query is cancelled
+# on the node once it expires (0=infinite). Default: 10m.
 evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 
-# TxFeeCap is the global tx-fee cap for send transaction. Default: 1eth.
+# TxFeeCap is the global tx-fee cap for eth_sendTransaction and eth_sendRawTransaction (0=no cap). Default: 1eth.
 txfee-cap = {{ .JSONRPC.TxFeeCap }}
 
 # FilterCap sets the global cap for total number of filters that can be created
@@ -66,12 +83,14 @@ filter-cap = {{ .JSONRPC.FilterCap }}
 feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 
 # LogsCap defines the max number of results can be returned from single 'eth_getLogs' query.
+# Queries exceeding it fail with the block range of the results within the cap as error data,
+# so clients can resume the query from the block after it.
 logs-cap = {{ .JSONRPC.LogsCap }}
 
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
-# HTTPTimeout is the read/write timeout of http json-rpc server.
+# HTTPTimeout is the read/write timeout of http json-rpc server, and the handshake timeout of the WebSocket server.
 http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 
 # HTTPIdleTimeout is the idle timeout of http json-rpc server.
@@ -82,14 +101,87 @@ http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
 
 # MaxOpenConnections sets the maximum number of simultaneous connections
-# for the server listener.
+# for each of the HTTP and WebSocket server listeners (0=unlimited).
 max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableStickyFilters persists the cursors of polling filters (eth_newFilter, eth_newBlockFilter)
+# to the node data directory, so short RPC restarts don't invalidate the client filter IDs.
+enable-sticky-filters = {{ .JSONRPC.EnableStickyFilters }}
+
+# TraceCacheSize defines the max number of 'debug_traceTransaction' results kept in memory, so
+# repeated traces of the same transaction don't re-execute it. 0 disables the cache.
+trace-cache-size = {{ .JSONRPC.TraceCacheSize }}
+
+# TraceCacheTTL defines the duration a 'debug_traceTransaction' result is kept in memory.
+trace-cache-ttl = "{{ .JSONRPC.TraceCacheTTL }}"
+
+# GasPriceOracleBlocks defines the number of recent blocks the priority fee suggested by
+# 'eth_maxPriorityFeePerGas' and 'eth_gasPrice' is sampled from. 0 disables the sampling.
+gas-price-oracle-blocks = {{ .JSONRPC.GasPriceOracleBlocks }}
+
+# GasPriceOraclePercentile defines the percentile of the sampled priority fees that is suggested.
+gas-price-oracle-percentile = {{ .JSONRPC.GasPriceOraclePercentile }}
+
+# EnableKeyringSigning enables 'eth_sign', 'eth_signTypedData' and 'personal_sign' with the keys of
+# the node keyring. Only the keyring-signing-accounts can sign, after being unlocked with
+# 'personal_unlockAccount'. Don't enable it on nodes with a publicly reachable JSON-RPC server.
+enable-keyring-signing = {{ .JSONRPC.EnableKeyringSigning }}
+
+# KeyringSigningAccounts defines the hex addresses of the node keyring accounts that can be unlocked for signing.
+keyring-signing-accounts = [{{range $index, $elmt := .JSONRPC.KeyringSigningAccounts}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# AuthNamespaces defines the JSON-RPC namespaces that can only be called with an 'Authorization: Bearer <token>'
+# header, where the token is one of the auth-api-keys or an HS256 JWT signed with one of the auth-jwt-secrets.
+# JWTs must have an 'iat' claim within 60 seconds of the node time. WebSocket connections are authenticated
+# on the handshake. Example: ["debug", "txpool", "personal"]
+auth-namespaces = [{{range $index, $elmt := .JSONRPC.AuthNamespaces}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# AuthAPIKeys defines the accepted API keys. Multiple keys can be valid at once, so they can be rotated.
+auth-api-keys = [{{range $index, $elmt := .JSONRPC.AuthAPIKeys}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# AuthJWTSecrets defines the 0x prefixed hex encoded secrets of at least 32 bytes of the accepted JWTs.
+# Multiple secrets can be valid at once, so they can be rotated.
+auth-jwt-secrets = [{{range $index, $elmt := .JSONRPC.AuthJWTSecrets}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# BatchRequestLimit defines the max number of calls of a JSON-RPC batch request (0=unlimited).
+# Larger batches are rejected with a single error.
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# BatchResponseMaxSize defines the max size in bytes of the responses of a JSON-RPC batch request (0=unlimited).
+# Once it's exceeded, the remaining calls of the batch are answered with error code -32003.
+batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
+
+# EnableRateLimit enables rate limiting of the JSON-RPC calls per client IP with token buckets, for the HTTP
+# and WebSocket servers. Batch requests count as one call per batch entry. Clients behind the same proxy share
+# the limit. Rejected requests are counted in the rpc/ratelimit/rejected metrics.
+enable-rate-limit = {{ .JSONRPC.EnableRateLimit }}
+
+# RateLimitRequestsPerSecond defines the rate of JSON-RPC calls per client IP.
+rate-limit-requests-per-second = {{ .JSONRPC.RateLimitRequestsPerSecond }}
+
+# RateLimitBurst defines the max number of JSON-RPC calls per client IP above the rate.
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# RateLimitMethods defines the expensive methods that are additionally limited per method.
+rate-limit-methods = [{{range $index, $elmt := .JSONRPC.RateLimitMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RateLimitMethodRequestsPerSecond defines the rate of calls per client IP of each of the rate-limit-methods.
+rate-limit-method-requests-per-second = {{ .JSONRPC.RateLimitMethodRequestsPerSecond }}
+
+# RateLimitMethodBurst defines the max number of calls per client IP of each of the rate-limit-methods above the rate.
+rate-limit-method-burst = {{ .JSONRPC.RateLimitMethodBurst }}
+
+# RateLimitMaxWait defines the max duration a request exceeding the rate limits is queued for. Requests
+# that would have to wait longer are rejected with error code -32005 (HTTP status 429).
+rate-limit-max-wait = "{{ .JSONRPC.RateLimitMaxWait }}"
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
+# Prometheus metrics of the cosmos telemetry registry, including the JSON-RPC per-method request
+# counts and latencies, in flight requests and websocket subscriptions, path: /metrics
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
@@ -106,4 +198,32 @@ certificate-path = "{{ .TLS.CertificatePath }}"
 
 # Key path defines the key.pem file path for the TLS configuration.
 key-path = "{{ .TLS.KeyPath }}"
+
+###############################################################################
+###                           Webhook Configuration                         ###
+###############################################################################
+
+[webhook]
+
+# Enable defines if receipts of matching EVM transactions are POSTed to the configured URLs.
+enable = {{ .Webhook.Enable }}
+
+# URLs defines the endpoints the receipt JSON is POSTed to.
+urls = [{{range $index, $elmt := .Webhook.URLs}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# Addresses defines the sender, recipient or log emitter addresses to notify about.
+# Leave both addresses and topics empty to notify about every EVM transaction.
+addresses = [{{range $index, $elmt := .Webhook.Addresses}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# Topics defines the log topics to notify about.
+topics = [{{range $index, $elmt := .Webhook.Topics}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# MaxRetries defines how many times a failed delivery is retried.
+max-retries = {{ .Webhook.MaxRetries }}
+
+# RetryBackoff is the initial delay between delivery attempts, doubled after each failure.
+retry-backoff = "{{ .Webhook.RetryBackoff }}"
+
+# Timeout is the timeout of a single delivery attempt.
+timeout = "{{ .Webhook.Timeout }}"
 `
@@ -50,22 +50,41 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable              = "json-rpc.enable"
-	JSONRPCAPI                 = "json-rpc.api"
-	JSONRPCAddress             = "json-rpc.address"
-	JSONWsAddress              = "json-rpc.ws-address"
-	JSONRPCGasCap              = "json-rpc.gas-cap"
-	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
-	JSONRPCFilterCap           = "json-rpc.filter-cap"
-	JSONRPCLogsCap             = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
-	JSONRPCFeeHistoryCap       = "json-rpc.feehistory-cap"
+	JSONRPCEnable                           = "json-rpc.enable"
+	JSONRPCAPI                              = "json-rpc.api"
+	JSONRPCAddress                          = "json-rpc.address"
+	JSONWsAddress                           = "json-rpc.ws-address"
+	JSONRPCGasCap                           = "json-rpc.gas-cap"
+	JSONRPCEVMTimeout                       = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap                         = "json-rpc.txfee-cap"
+	JSONRPCFilterCap                        = "json-rpc.filter-cap"
+	JSONRPCLogsCap                          = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap                    = "json-rpc.block-range-cap"
+	JSONRPCHTTPTimeout                      = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout                  = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs              = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections               = "json-rpc.max-open-connections"
+	JSONRPCEnableIndexer                    = "json-rpc.enable-indexer"
+	JSONRPCEnableStickyFilters              = "json-rpc.enable-sticky-filters"
+	JSONRPCTraceCacheSize                   = "json-rpc.trace-cache-size"
+	JSONRPCTraceCacheTTL                    = "json-rpc.trace-cache-ttl"
+	JSONRPCGasPriceOracleBlocks             = "json-rpc.gas-price-oracle-blocks"
+	JSONRPCGasPriceOraclePercentile         = "json-rpc.gas-price-oracle-percentile"
+	JSONRPCEnableKeyringSigning             = "json-rpc.enable-keyring-signing"
+	JSONRPCKeyringSigningAccounts           = "json-rpc.keyring-signing-accounts"
+	JSONRPCAuthNamespaces                   = "json-rpc.auth-namespaces"
+	JSONRPCAuthAPIKeys                      = "json-rpc.auth-api-keys"
+	JSONRPCAuthJWTSecrets                   = "json-rpc.auth-jwt-secrets"
+	JSONRPCBatchRequestLimit                = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize             = "json-rpc.batch-response-max-size"
+	JSONRPCEnableRateLimit                  = "json-rpc.enable-rate-limit"
+	JSONRPCRateLimitRequestsPerSecond       = "json-rpc.rate-limit-requests-per-second"
+	JSONRPCRateLimitBurst                   = "json-rpc.rate-limit-burst"
+	JSONRPCRateLimitMethods                 = "json-rpc.rate-limit-methods"
+	JSONRPCRateLimitMethodRequestsPerSecond = "json-rpc.rate-limit-method-requests-per-second"
+	JSONRPCRateLimitMethodBurst             = "json-rpc.rate-limit-method-burst"
+	JSONRPCRateLimitMaxWait                 = "json-rpc.rate-limit-max-wait"
+	JSONRPCFeeHistoryCap                    = "json-rpc.feehistory-cap"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -75,8 +94,12 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer            = "evm.tracer"
+	EVMMaxTxGasWanted    = "evm.max-tx-gas-wanted"
+	EVMQueryMaxHostCalls = "evm.query-max-host-calls"
+	EVMQueryTimeout      = "evm.query-timeout"
+	EVMCodeCacheSize     = "evm.code-cache-size"
+	EVMArchive           = "evm.archive"
 )
 
 // TLS flags
@@ -20,13 +20,23 @@ import (
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
+	tmlog "github.com/tendermint/tendermint/libs/log"
 
 	"github.com/SigmaGmbH/evm-module/rpc"
+	"github.com/SigmaGmbH/evm-module/rpc/auth"
+	"github.com/SigmaGmbH/evm-module/rpc/batch"
+	rpcmetrics "github.com/SigmaGmbH/evm-module/rpc/metrics"
+	"github.com/SigmaGmbH/evm-module/rpc/ratelimit"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethlog "github.com/ethereum/go-ethereum/log"
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
+	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
+	ethprometheus "github.com/ethereum/go-ethereum/metrics/prometheus"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/SigmaGmbH/evm-module/server/config"
@@ -41,6 +51,12 @@ func StartJSONRPC(ctx *server.Context,
 	config *config.Config,
 	indexer evmcommontypes.EVMTxIndexer,
 ) (*http.Server, chan struct{}, error) {
+	// the namespaces parse the EIP-155 chain id once at construction, so a chain-id without one fails here
+	// instead of when the APIs are created
+	if _, err := evmcommontypes.ParseChainID(clientCtx.ChainID); err != nil {
+		return nil, nil, err
+	}
+
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
 	logger := ctx.Logger.With("module", "geth")
@@ -61,7 +77,10 @@ func StartJSONRPC(ctx *server.Context,
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	apis, err := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -74,8 +93,43 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	var limiter *ratelimit.Limiter
+	if config.JSONRPC.EnableRateLimit {
+		limiter = ratelimit.New(ratelimit.Config{
+			RequestsPerSecond:       config.JSONRPC.RateLimitRequestsPerSecond,
+			Burst:                   config.JSONRPC.RateLimitBurst,
+			Methods:                 config.JSONRPC.RateLimitMethods,
+			MethodRequestsPerSecond: config.JSONRPC.RateLimitMethodRequestsPerSecond,
+			MethodBurst:             config.JSONRPC.RateLimitMethodBurst,
+			MaxWait:                 config.JSONRPC.RateLimitMaxWait,
+		})
+	}
+
+	var authenticator *auth.Authenticator
+	if len(config.JSONRPC.AuthNamespaces) > 0 {
+		secrets := make([][]byte, len(config.JSONRPC.AuthJWTSecrets))
+		for i, secret := range config.JSONRPC.AuthJWTSecrets {
+			secrets[i] = common.FromHex(secret)
+		}
+		authenticator = auth.New(auth.Config{
+			Namespaces: config.JSONRPC.AuthNamespaces,
+			APIKeys:    config.JSONRPC.AuthAPIKeys,
+			JWTSecrets: secrets,
+		})
+	}
+
+	var handler http.Handler = rpcmetrics.Handler(rpcServer, rpcmetrics.Methods(apis))
+	handler = batch.Handler(handler, config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
+	if authenticator != nil {
+		handler = authenticator.Handler(handler)
+	}
+	// rate limit before authenticating, so credentials can't be brute forced
+	if limiter != nil {
+		handler = limiter.Handler(handler)
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", handler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -118,11 +172,32 @@ func StartJSONRPC(ctx *server.Context,
 	case <-time.After(types.ServerStartTime): // assume JSON RPC server started successfully
 	}
 
+	if config.JSONRPC.WsAddress == "" {
+		return httpSrv, httpSrvDone, nil
+	}
+
 	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, limiter, authenticator)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// StartMetricsServer starts the EVM metrics server. It serves the go-ethereum metrics under /debug/metrics
+// and the Prometheus metrics of the cosmos telemetry registry, including the JSON-RPC metrics, under /metrics.
+func StartMetricsServer(address string, logger tmlog.Logger) {
+	m := http.NewServeMux()
+	m.Handle("/debug/metrics", ethmetricsexp.ExpHandler(ethmetrics.DefaultRegistry))
+	m.Handle("/debug/metrics/prometheus", ethprometheus.Handler(ethmetrics.DefaultRegistry))
+	m.Handle("/metrics", promhttp.Handler())
+
+	logger.Info("Starting metrics server", "address", address)
+	go func() {
+		//#nosec G114 -- the metrics server is bound to a local address by default
+		if err := http.ListenAndServe(address, m); err != nil {
+			logger.Error("failed to run metrics server", "error", err.Error())
+		}
+	}()
+}
@@ -49,8 +49,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 
-	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
-
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -63,10 +61,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/SigmaGmbH/evm-module/indexer"
+	"github.com/SigmaGmbH/evm-module/rpc/backend"
 	ethdebug "github.com/SigmaGmbH/evm-module/rpc/namespaces/ethereum/debug"
 	"github.com/SigmaGmbH/evm-module/server/config"
 	srvflags "github.com/SigmaGmbH/evm-module/server/flags"
 	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
+	"github.com/SigmaGmbH/evm-module/webhook"
 	"github.com/SigmaGmbH/librustgo"
 )
 
@@ -127,8 +127,16 @@ which accepts a path for the resulting pprof file.
 				return err
 			}
 
-			_, err = server.GetPruningOptionsFromFlags(serverCtx.Viper)
-			return err
+			pruningOpts, err := server.GetPruningOptionsFromFlags(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			// an archive node mustn't prune the state it claims to keep
+			if serverCtx.Viper.GetBool(srvflags.EVMArchive) && pruningOpts.GetPruningStrategy() != pruningtypes.PruningNothing {
+				return fmt.Errorf("%s requires the %s pruning strategy", srvflags.EVMArchive, pruningtypes.PruningOptionNothing)
+			}
+			return nil
 		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
@@ -209,11 +217,11 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCEnable, true, "Define if the JSON-RPC server should be enabled")
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, config.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
-	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on (empty disables the WS server)")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, config.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is uswtr (0=infinite)")       //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 photon)") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
-	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call, eth_estimateGas and tracing (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, config.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
@@ -221,11 +229,34 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableStickyFilters, false, "Persist json-rpc polling filters across restarts")
+	cmd.Flags().Int(srvflags.JSONRPCTraceCacheSize, config.DefaultTraceCacheSize, "Sets the max number of debug_traceTransaction results kept in memory (0=disabled)")
+	cmd.Flags().Duration(srvflags.JSONRPCTraceCacheTTL, config.DefaultTraceCacheTTL, "Sets the duration a debug_traceTransaction result is kept in memory")
+	cmd.Flags().Int(srvflags.JSONRPCGasPriceOracleBlocks, config.DefaultGasPriceOracleBlocks, "Sets the number of recent blocks the suggested priority fee is sampled from (0=disabled)") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCGasPriceOraclePercentile, config.DefaultGasPriceOraclePercentile, "Sets the percentile of the sampled priority fees that is suggested")               //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCEnableKeyringSigning, false, "Enables eth_sign, eth_signTypedData and personal_sign with the unlocked keyring signing accounts")
+	cmd.Flags().StringSlice(srvflags.JSONRPCKeyringSigningAccounts, []string{}, "Defines the hex addresses of the node keyring accounts that can be unlocked for signing")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthNamespaces, []string{}, "Defines the json-rpc namespaces that can only be called with a valid API key or JWT")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthAPIKeys, []string{}, "Defines the API keys accepted as bearer tokens for the json-rpc auth namespaces")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthJWTSecrets, []string{}, "Defines the hex encoded secrets of the JWTs accepted as bearer tokens for the json-rpc auth namespaces") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the max number of calls of a json-rpc batch request (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, config.DefaultBatchResponseMaxSize, "Sets the max size in bytes of the responses of a json-rpc batch request (0=unlimited)") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCEnableRateLimit, false, "Enables rate limiting of the json-rpc calls per client IP")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitRequestsPerSecond, config.DefaultRateLimitRequestsPerSecond, "Sets the rate of json-rpc calls per client IP")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, config.DefaultRateLimitBurst, "Sets the max number of json-rpc calls per client IP above the rate")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitMethods, config.GetDefaultRateLimitMethods(), "Defines the expensive json-rpc methods that are additionally limited per method")               //nolint:lll
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitMethodRequestsPerSecond, config.DefaultRateLimitMethodRequestsPerSecond, "Sets the rate of calls per client IP of each rate limited method")       //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitMethodBurst, config.DefaultRateLimitMethodBurst, "Sets the max number of calls per client IP of each rate limited method above the rate")              //nolint:lll
+	cmd.Flags().Duration(srvflags.JSONRPCRateLimitMaxWait, config.DefaultRateLimitMaxWait, "Sets the max duration a json-rpc request exceeding the rate limits is queued for before it's rejected") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Int32(srvflags.JSONRPCFeeHistoryCap, config.DefaultFeeHistoryCap, "Sets a max fee history depth")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMQueryMaxHostCalls, config.DefaultQueryMaxHostCalls, "the max number of enclave state requests of a single eth_call/estimateGas execution (0=unlimited)")  //nolint:lll
+	cmd.Flags().Duration(srvflags.EVMQueryTimeout, config.DefaultQueryTimeout, "the max duration of an eth_call/estimateGas query (0=unlimited)")                                            //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMCodeCacheSize, config.DefaultCodeCacheSize, "the max size in MiB of the contract code cached in memory (0=disabled)")                                     //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMArchive, config.DefaultArchive, "keep the state of every height since the node started (requires the nothing pruning strategy)")                            //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -433,7 +464,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	// Enable metrics if JSONRPC is enabled and --metrics is passed
 	// Flag not added in config to avoid user enabling in config without passing in CLI
 	if config.JSONRPC.Enable && ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
-		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
+		StartMetricsServer(config.JSONRPC.MetricsAddress, ctx.Logger)
 	}
 
 	var idxer evmcommontypes.EVMTxIndexer
@@ -597,6 +628,49 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		}()
 	}
 
+	if config.JSONRPC.Enable && config.Webhook.Enable {
+		genDoc, err := genDocProvider()
+		if err != nil {
+			return err
+		}
+
+		webhookLogger := ctx.Logger.With("module", "webhook")
+		webhookClientCtx := clientCtx.WithChainID(genDoc.ChainID)
+		evmBackend := backend.NewBackend(ctx, webhookLogger, webhookClientCtx, config.JSONRPC.AllowUnprotectedTxs, idxer)
+		notifier := webhook.NewNotifier(
+			config.Webhook.URLs,
+			config.Webhook.MaxRetries,
+			config.Webhook.RetryBackoff,
+			config.Webhook.Timeout,
+			webhookLogger,
+		)
+		webhookService := NewWebhookService(
+			clientCtx.Client,
+			evmBackend,
+			webhook.NewFilter(config.Webhook.Addresses, config.Webhook.Topics),
+			notifier,
+		)
+		webhookService.SetLogger(webhookLogger)
+
+		errCh := make(chan error)
+		go func() {
+			if err := webhookService.Start(); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(types.ServerStartTime): // assume server started successfully
+		}
+		defer func() {
+			if err := webhookService.Stop(); err != nil {
+				logger.Error("failed to stop webhook service", "error", err.Error())
+			}
+		}()
+	}
+
 	// At this point it is safe to block the process if we're in query only mode as
 	// we do not need to start Rosetta or handle any Tendermint related processes.
 	if gRPCOnly {
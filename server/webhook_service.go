@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/service"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/SigmaGmbH/evm-module/webhook"
+)
+
+const WebhookServiceName = "EVMWebhookService"
+
+// ReceiptBackend returns the receipts delivered by the webhook service.
+type ReceiptBackend interface {
+	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+}
+
+// WebhookService POSTs the receipts of new ethereum transactions matching the
+// configured filter to external URLs.
+type WebhookService struct {
+	service.BaseService
+
+	client   rpcclient.Client
+	backend  ReceiptBackend
+	filter   webhook.Filter
+	notifier *webhook.Notifier
+
+	cancel context.CancelFunc
+}
+
+// NewWebhookService returns a new service instance.
+func NewWebhookService(
+	client rpcclient.Client,
+	backend ReceiptBackend,
+	filter webhook.Filter,
+	notifier *webhook.Notifier,
+) *WebhookService {
+	ws := &WebhookService{client: client, backend: backend, filter: filter, notifier: notifier}
+	ws.BaseService = *service.NewBaseService(nil, WebhookServiceName, ws)
+	return ws
+}
+
+// OnStart implements service.Service by subscribing for new blocks
+// and notifying about the receipts of their ethereum transactions.
+func (ws *WebhookService) OnStart() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	ws.cancel = cancel
+
+	status, err := ws.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	lastBlock := status.SyncInfo.LatestBlockHeight
+
+	blockHeadersChan, err := ws.client.Subscribe(
+		ctx,
+		WebhookServiceName,
+		types.QueryForEvent(types.EventNewBlockHeader).String(),
+		0)
+	if err != nil {
+		return err
+	}
+
+	go ws.notifier.Run(ctx)
+
+	for {
+		var latestBlock int64
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg := <-blockHeadersChan:
+			latestBlock = msg.Data.(types.EventDataNewBlockHeader).Header.Height
+		case <-time.After(NewBlockWaitTimeout):
+			continue
+		}
+
+		// the header is published before the block is committed, so only the
+		// blocks preceding it are guaranteed to have their results available
+		for height := lastBlock + 1; height < latestBlock; height++ {
+			if err := ws.notifyBlock(ctx, height); err != nil {
+				ws.Logger.Error("failed to process block", "height", height, "err", err)
+				break
+			}
+			lastBlock = height
+		}
+	}
+}
+
+// OnStop implements service.Service by stopping the block loop and the
+// pending deliveries.
+func (ws *WebhookService) OnStop() {
+	if ws.cancel != nil {
+		ws.cancel()
+	}
+}
+
+func (ws *WebhookService) notifyBlock(ctx context.Context, height int64) error {
+	blockResult, err := ws.client.BlockResults(ctx, &height)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range webhook.EthTxHashes(blockResult.TxsResults) {
+		receipt, err := ws.backend.GetTransactionReceipt(hash)
+		if err != nil || receipt == nil {
+			ws.Logger.Error("failed to fetch receipt", "hash", hash.Hex(), "err", err)
+			continue
+		}
+		if !ws.filter.Match(receipt) {
+			continue
+		}
+		if err := ws.notifier.Enqueue(receipt); err != nil {
+			ws.Logger.Error("failed to enqueue receipt", "hash", hash.Hex(), "err", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,4 @@
+// Package fork contains integration tests for the JSON-RPC endpoints fork tooling like Foundry's
+// anvil and Hardhat requires to fork the state of a node. They run against an in-process network
+// with the norace build tag, see `make test-fork`. The anvil tests are skipped if it isn't installed.
+package fork
@@ -0,0 +1,168 @@
+//go:build norace
+// +build norace
+
+package fork_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/suite"
+
+	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
+	"github.com/SigmaGmbH/evm-module/testutil/network"
+	"github.com/SigmaGmbH/evm-module/version"
+)
+
+// forkHeight is the height of the local node forked in the tests
+const forkHeight = 3
+
+// ForkTestSuite checks the endpoints fork tooling requires against a local node, and forks it
+// with anvil.
+type ForkTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+	rpcURL  string
+	client  *rpc.Client
+	address common.Address
+}
+
+func TestForkTestSuite(t *testing.T) {
+	suite.Run(t, new(ForkTestSuite))
+}
+
+func (s *ForkTestSuite) SetupSuite() {
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), cfg)
+	s.Require().NoError(err)
+
+	_, err = s.network.WaitForHeight(forkHeight + 1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	s.rpcURL = fmt.Sprintf("http://%s", val.AppConfig.JSONRPC.Address)
+	s.address = common.BytesToAddress(val.Address)
+
+	s.client, err = rpc.Dial(s.rpcURL)
+	s.Require().NoError(err)
+}
+
+func (s *ForkTestSuite) TearDownSuite() {
+	s.client.Close()
+	s.network.Cleanup()
+}
+
+func (s *ForkTestSuite) TestClientVersion() {
+	var clientVersion string
+	s.Require().NoError(s.client.Call(&clientVersion, "web3_clientVersion"))
+
+	// name/version/os-arch/go
+	parts := strings.Split(clientVersion, "/")
+	s.Require().Len(parts, 4)
+	s.Require().Equal(version.ClientName, parts[0])
+	s.Require().True(strings.HasPrefix(parts[3], "go"))
+}
+
+func (s *ForkTestSuite) TestNetListening() {
+	var listening bool
+	s.Require().NoError(s.client.Call(&listening, "net_listening"))
+	s.Require().True(listening)
+}
+
+func (s *ForkTestSuite) TestHistoricalStorageAt() {
+	var value hexutil.Bytes
+	err := s.client.Call(&value, "eth_getStorageAt", s.address, "0x0", hexutil.Uint64(forkHeight))
+	s.Require().NoError(err)
+	s.Require().Len(value, common.HashLength)
+}
+
+func (s *ForkTestSuite) TestFeeHistory() {
+	var res rpctypes.FeeHistoryResult
+	err := s.client.Call(&res, "eth_feeHistory", hexutil.Uint64(2), hexutil.Uint64(forkHeight), []float64{25, 75})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(forkHeight-1), res.OldestBlock.ToInt().Int64())
+	s.Require().Len(res.GasUsedRatio, 2)
+	s.Require().Len(res.Reward, 2)
+}
+
+func (s *ForkTestSuite) TestBlockHeaderFields() {
+	var block map[string]interface{}
+	s.Require().NoError(s.client.Call(&block, "eth_getBlockByNumber", hexutil.Uint64(forkHeight), false))
+
+	for _, field := range []string{
+		"hash", "parentHash", "sha3Uncles", "miner", "stateRoot", "transactionsRoot", "receiptsRoot",
+		"logsBloom", "difficulty", "number", "gasLimit", "gasUsed", "timestamp", "extraData", "mixHash",
+		"nonce", "size", "baseFeePerGas", "withdrawals", "withdrawalsRoot",
+	} {
+		s.Require().Contains(block, field)
+	}
+}
+
+func (s *ForkTestSuite) TestAnvilFork() {
+	anvil, err := exec.LookPath("anvil")
+	if err != nil {
+		s.T().Skip("anvil is not installed")
+	}
+
+	port := freePort(s.T())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, anvil, "--silent",
+		"--port", fmt.Sprint(port),
+		"--fork-url", s.rpcURL,
+		"--fork-block-number", fmt.Sprint(forkHeight),
+	)
+	s.Require().NoError(cmd.Start())
+	defer func() {
+		cancel()
+		_ = cmd.Wait()
+	}()
+
+	fork, err := rpc.Dial(fmt.Sprintf("http://127.0.0.1:%d", port))
+	s.Require().NoError(err)
+	defer fork.Close()
+
+	// anvil fetches the fork block and chain id on startup
+	var forkChainID hexutil.Big
+	s.Require().Eventually(func() bool {
+		return fork.Call(&forkChainID, "eth_chainId") == nil
+	}, time.Minute, 500*time.Millisecond)
+
+	var chainID hexutil.Big
+	s.Require().NoError(s.client.Call(&chainID, "eth_chainId"))
+	s.Require().Equal(chainID.String(), forkChainID.String())
+
+	var block, forkBlock map[string]interface{}
+	s.Require().NoError(s.client.Call(&block, "eth_getBlockByNumber", hexutil.Uint64(forkHeight), false))
+	s.Require().NoError(fork.Call(&forkBlock, "eth_getBlockByNumber", hexutil.Uint64(forkHeight), false))
+	s.Require().Equal(block["hash"], forkBlock["hash"])
+	s.Require().Equal(block["stateRoot"], forkBlock["stateRoot"])
+
+	var balance, forkBalance hexutil.Big
+	s.Require().NoError(s.client.Call(&balance, "eth_getBalance", s.address, hexutil.Uint64(forkHeight)))
+	s.Require().NoError(fork.Call(&forkBalance, "eth_getBalance", s.address, "latest"))
+	s.Require().Equal(balance.String(), forkBalance.String())
+}
+
+// freePort returns a free local TCP port
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
@@ -0,0 +1,4 @@
+// Package nested contains end-to-end regression tests for ethereum txs embedded in authz, gov
+// and ICA messages. Such messages are executed by their modules' message routers, so the
+// ethereum ante handler doesn't run for them and they must be rejected before execution.
+package nested
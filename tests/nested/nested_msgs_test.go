@@ -0,0 +1,151 @@
+package nested_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/SigmaGmbH/evm-module/tests"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *NestedMsgsTestSuite) TestAuthzNestedEthTx() {
+	grantee := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	expiration := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		name string
+		msgs func(ethTx sdk.Msg) []sdk.Msg
+	}{
+		{
+			"MsgExec with ethereum tx",
+			func(ethTx sdk.Msg) []sdk.Msg {
+				msg := authz.NewMsgExec(grantee, []sdk.Msg{ethTx})
+				return []sdk.Msg{&msg}
+			},
+		},
+		{
+			"nested MsgExec with ethereum tx",
+			func(ethTx sdk.Msg) []sdk.Msg {
+				inner := authz.NewMsgExec(grantee, []sdk.Msg{ethTx})
+				outer := authz.NewMsgExec(grantee, []sdk.Msg{&inner})
+				return []sdk.Msg{&outer}
+			},
+		},
+		{
+			"MsgGrant for ethereum txs",
+			func(sdk.Msg) []sdk.Msg {
+				msg, err := authz.NewMsgGrant(
+					suite.address.Bytes(), grantee,
+					authz.NewGenericAuthorization(sdk.MsgTypeURL(&evmtypes.MsgHandleTx{})), &expiration,
+				)
+				suite.Require().NoError(err)
+				return []sdk.Msg{msg}
+			},
+		},
+		{
+			"MsgExec with MsgGrant for ethereum txs",
+			func(sdk.Msg) []sdk.Msg {
+				grant, err := authz.NewMsgGrant(
+					suite.address.Bytes(), grantee,
+					authz.NewGenericAuthorization(sdk.MsgTypeURL(&evmtypes.MsgHandleTx{})), &expiration,
+				)
+				suite.Require().NoError(err)
+				msg := authz.NewMsgExec(grantee, []sdk.Msg{grant})
+				return []sdk.Msg{&msg}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// the ethereum tx doesn't fit into the block
+			ethTx := suite.buildEthTx(2 * blockMaxGas)
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			balance := suite.balance()
+
+			res := suite.deliverTx(tc.msgs(ethTx)...)
+
+			// rejected by the ante handler before fees are deducted
+			suite.Require().Equal(sdkerrors.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+			suite.Require().Equal(sdkerrors.RootCodespace, res.Codespace)
+			suite.Require().Less(res.GasWanted, int64(blockMaxGas))
+			suite.Require().Equal(balance, suite.balance())
+			suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+
+			grants, err := suite.app.AuthzKeeper.GetAuthorizations(suite.ctx, grantee, suite.address.Bytes())
+			suite.Require().NoError(err)
+			suite.Require().Empty(grants)
+		})
+	}
+}
+
+func (suite *NestedMsgsTestSuite) TestGovProposalNestedEthTx() {
+	ethTx := suite.buildEthTx(2 * blockMaxGas)
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	balance := suite.balance()
+
+	// the signer of an ethereum tx is recovered from its signature, it can't be the gov account
+	_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{ethTx}, "")
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{ethTx}, sdk.NewCoins(), sdk.AccAddress(suite.address.Bytes()).String(), "")
+	suite.Require().NoError(err)
+
+	res := suite.deliverTx(proposal)
+
+	suite.Require().Equal(govtypes.ErrInvalidSigner.ABCICode(), res.Code, res.Log)
+	suite.Require().Equal(govtypes.ModuleName, res.Codespace)
+	// only the cosmos tx is charged, with its own gas limit
+	suite.Require().Equal(int64(txGasLimit), res.GasWanted)
+	suite.Require().Equal(balance.Sub(suite.txFee().AmountOf(evmtypes.DefaultEVMDenom)), suite.balance())
+	// the sequence is only incremented by the cosmos tx
+	suite.Require().Equal(nonce+1, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+	suite.Require().Empty(suite.app.GovKeeper.GetProposals(suite.ctx))
+}
+
+func (suite *NestedMsgsTestSuite) TestICAHostNestedEthTx() {
+	var (
+		connectionID      = "connection-0"
+		channelID         = "channel-0"
+		controllerPortID  = icatypes.ControllerPortPrefix + sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+		interchainAccount = authtypes.NewModuleAddress(controllerPortID)
+	)
+
+	suite.app.IBCKeeper.ChannelKeeper.SetChannel(suite.ctx, icatypes.HostPortID, channelID, channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.ORDERED,
+		channeltypes.NewCounterparty(controllerPortID, channelID),
+		[]string{connectionID}, icatypes.Version,
+	))
+	suite.app.ICAHostKeeper.SetInterchainAccountAddress(suite.ctx, connectionID, controllerPortID, interchainAccount.String())
+
+	ethTx := suite.buildEthTx(2 * blockMaxGas)
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	balance := suite.balance()
+
+	data, err := icatypes.SerializeCosmosTx(suite.app.AppCodec(), []proto.Message{ethTx})
+	suite.Require().NoError(err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), 1, controllerPortID, channelID, icatypes.HostPortID, channelID,
+		clienttypes.NewHeight(1, 100), 0,
+	)
+
+	// the signer of an ethereum tx is recovered from its signature, it can't be the interchain
+	// account
+	_, err = suite.app.ICAHostKeeper.OnRecvPacket(suite.ctx, packet)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(balance, suite.balance())
+	suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+}
@@ -0,0 +1,148 @@
+package nested_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/SigmaGmbH/evm-module/app"
+	"github.com/SigmaGmbH/evm-module/encoding"
+	"github.com/SigmaGmbH/evm-module/tests"
+	"github.com/SigmaGmbH/evm-module/testutil"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+const (
+	chainID = "ethermint_9000-1"
+
+	// blockMaxGas is the block gas limit of the test chain
+	blockMaxGas = 10_000_000
+	// txGasLimit is the gas limit of the cosmos txs wrapping the ethereum txs
+	txGasLimit = 200_000
+)
+
+// NestedMsgsTestSuite delivers cosmos txs that embed ethereum txs in authz, gov and ICA
+// messages through the full app, to make sure they can't be used to bypass the ethereum ante
+// handler, i.e. its fee deduction and block gas accounting.
+type NestedMsgsTestSuite struct {
+	suite.Suite
+
+	app       *app.EthermintApp
+	ctx       sdk.Context
+	txConfig  client.TxConfig
+	ethSigner ethtypes.Signer
+
+	priv    cryptotypes.PrivKey
+	address common.Address
+}
+
+func TestNestedMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(NestedMsgsTestSuite))
+}
+
+func (suite *NestedMsgsTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.txConfig = encoding.MakeConfig(app.ModuleBasics).TxConfig
+
+	header := tmproto.Header{Height: 1, ChainID: chainID, Time: time.Now().UTC()}
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+
+	consensusParams := suite.app.BaseApp.GetConsensusParams(suite.ctx)
+	consensusParams.Block.MaxGas = blockMaxGas
+	suite.app.BaseApp.StoreConsensusParams(suite.ctx, consensusParams)
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+	suite.app.EvmKeeper.WithChainID(suite.ctx)
+	suite.ethSigner = ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	suite.address, suite.priv = tests.NewAddrKey()
+	amount, ok := sdk.NewIntFromString("1000000000000000000000")
+	suite.Require().True(ok)
+	err := testutil.FundAccount(
+		suite.app.BankKeeper, suite.ctx, suite.address.Bytes(),
+		sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, amount)),
+	)
+	suite.Require().NoError(err)
+}
+
+// buildEthTx returns a signed ethereum transfer of the suite account with the given gas limit,
+// which exceeds the block gas limit if it's larger than blockMaxGas
+func (suite *NestedMsgsTestSuite) buildEthTx(gasLimit uint64) *evmtypes.MsgHandleTx {
+	to := tests.GenerateAddress()
+	msg := evmtypes.NewTx(
+		suite.app.EvmKeeper.ChainID(),
+		suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		&to,
+		big.NewInt(1),
+		gasLimit,
+		suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(suite.ethSigner, tests.NewSigner(suite.priv)))
+	return msg
+}
+
+// deliverTx signs a cosmos tx with the given messages by the suite account, paying the base fee
+// for txGasLimit, and delivers it to the app
+func (suite *NestedMsgsTestSuite) deliverTx(msgs ...sdk.Msg) abci.ResponseDeliverTx {
+	txBuilder := suite.txConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(msgs...))
+	txBuilder.SetGasLimit(txGasLimit)
+	txBuilder.SetFeeAmount(suite.txFee())
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.address.Bytes())
+	suite.Require().NotNil(acc)
+
+	signMode := suite.txConfig.SignModeHandler().DefaultMode()
+	sig := signing.SignatureV2{
+		PubKey:   suite.priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: acc.GetSequence(),
+	}
+	suite.Require().NoError(txBuilder.SetSignatures(sig))
+
+	signerData := authsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}
+	sig, err := tx.SignWithPrivKey(signMode, signerData, txBuilder, suite.priv, suite.txConfig, acc.GetSequence())
+	suite.Require().NoError(err)
+	suite.Require().NoError(txBuilder.SetSignatures(sig))
+
+	bz, err := suite.txConfig.TxEncoder()(txBuilder.GetTx())
+	suite.Require().NoError(err)
+
+	return suite.app.BaseApp.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+}
+
+// txFee returns the fee of the cosmos txs delivered by the suite
+func (suite *NestedMsgsTestSuite) txFee() sdk.Coins {
+	baseFee := sdk.NewIntFromBigInt(suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx))
+	return sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, baseFee.MulRaw(txGasLimit)))
+}
+
+// balance returns the evm denom balance of the suite account
+func (suite *NestedMsgsTestSuite) balance() sdk.Int {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), evmtypes.DefaultEVMDenom).Amount
+}
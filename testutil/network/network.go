@@ -105,6 +105,7 @@ type Config struct {
 	AppConstructor    AppConstructor      // the ABCI application constructor
 	GenesisState      simapp.GenesisState // custom gensis state to provide
 	TimeoutCommit     time.Duration       // the consensus commitment timeout
+	SkipEmptyBlocks   bool                // only create blocks with transactions, as soon as they are received
 	AccountTokens     sdkmath.Int         // the amount of unique validator tokens (e.g. 1000node0)
 	StakingTokens     sdkmath.Int         // the amount of tokens each validator has available to stake
 	BondedTokens      sdkmath.Int         // the amount of tokens each validator stakes
@@ -271,6 +272,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		ctx := server.NewDefaultContext()
 		tmCfg := ctx.Config
 		tmCfg.Consensus.TimeoutCommit = cfg.TimeoutCommit
+		tmCfg.Consensus.CreateEmptyBlocks = !cfg.SkipEmptyBlocks
 
 		// Only allow the first validator to expose an RPC, API and gRPC
 		// server/client due to Tendermint in-process constraints.
@@ -27,8 +27,12 @@ var (
 
 	GoVersion = ""
 	GoArch    = ""
+	GoOS      = ""
 )
 
+// ClientName is the name of the node in the client version reported over JSON-RPC
+const ClientName = "swisstronik"
+
 func init() {
 	if len(AppVersion) == 0 {
 		AppVersion = "dev"
@@ -36,6 +40,7 @@ func init() {
 
 	GoVersion = runtime.Version()
 	GoArch = runtime.GOARCH
+	GoOS = runtime.GOOS
 }
 
 func Version() string {
@@ -48,3 +53,18 @@ func Version() string {
 		GoArch,
 	)
 }
+
+// ClientVersion returns the version in the Web3 user agent format used by go-ethereum, e.g.
+// swisstronik/v1.0.0-e5eb32ac/linux-amd64/go1.18.5, which is parsed by tooling like Hardhat and Foundry.
+func ClientVersion() string {
+	appVersion := AppVersion
+	if len(GitCommit) > 0 {
+		commit := GitCommit
+		if len(commit) > 8 {
+			commit = commit[:8]
+		}
+		appVersion += "-" + commit
+	}
+
+	return fmt.Sprintf("%s/%s/%s-%s/%s", ClientName, appVersion, GoOS, GoArch, GoVersion)
+}
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// EthTxHashes returns the hashes of the ethereum transactions included in a
+// block, in execution order, from the events of its tx results.
+func EthTxHashes(txResults []*abci.ResponseDeliverTx) []common.Hash {
+	var hashes []common.Hash
+	seen := make(map[common.Hash]struct{})
+	for _, result := range txResults {
+		if result == nil {
+			continue
+		}
+		for _, event := range result.Events {
+			if event.Type != evmtypes.EventTypeEthereumTx {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) != evmtypes.AttributeKeyEthereumTxHash {
+					continue
+				}
+				hash := common.HexToHash(string(attr.Value))
+				// the ante handler and the msg handler both emit the hash
+				if _, ok := seen[hash]; ok {
+					continue
+				}
+				seen[hash] = struct{}{}
+				hashes = append(hashes, hash)
+			}
+		}
+	}
+	return hashes
+}
@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestEthTxHashes(t *testing.T) {
+	txHash := common.BigToHash(common.Big1)
+	txHash2 := common.BigToHash(common.Big2)
+
+	ethTxEvent := func(hash common.Hash) abci.Event {
+		return abci.Event{
+			Type:       "ethereum_tx",
+			Attributes: []abci.EventAttribute{{Key: []byte("ethereumTxHash"), Value: []byte(hash.Hex())}},
+		}
+	}
+
+	txResults := []*abci.ResponseDeliverTx{
+		{Events: []abci.Event{ethTxEvent(txHash), {Type: "message"}, ethTxEvent(txHash)}},
+		nil,
+		{Events: []abci.Event{{Type: "transfer"}}},
+		{Events: []abci.Event{ethTxEvent(txHash2)}},
+	}
+
+	require.Equal(t, []common.Hash{txHash, txHash2}, EthTxHashes(txResults))
+	require.Empty(t, EthTxHashes(nil))
+}
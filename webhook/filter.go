@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Filter selects the receipts a webhook is notified about. A receipt matches
+// if any of its sender, recipient, created contract or log emitter addresses is
+// watched, or if any of its log topics is watched. An empty filter matches
+// every receipt.
+type Filter struct {
+	addresses map[common.Address]struct{}
+	topics    map[common.Hash]struct{}
+}
+
+// NewFilter returns a filter watching the given hex encoded addresses and topics.
+func NewFilter(addresses, topics []string) Filter {
+	f := Filter{
+		addresses: make(map[common.Address]struct{}, len(addresses)),
+		topics:    make(map[common.Hash]struct{}, len(topics)),
+	}
+	for _, address := range addresses {
+		f.addresses[common.HexToAddress(address)] = struct{}{}
+	}
+	for _, topic := range topics {
+		f.topics[common.HexToHash(topic)] = struct{}{}
+	}
+	return f
+}
+
+// Match returns true if the receipt, as returned by eth_getTransactionReceipt,
+// should be delivered.
+func (f Filter) Match(receipt map[string]interface{}) bool {
+	if len(f.addresses) == 0 && len(f.topics) == 0 {
+		return true
+	}
+
+	for _, key := range []string{"from", "to", "contractAddress"} {
+		if address, ok := receiptAddress(receipt[key]); ok && f.watchesAddress(address) {
+			return true
+		}
+	}
+
+	logs, _ := receipt["logs"].([]*ethtypes.Log)
+	for _, log := range logs {
+		if log == nil {
+			continue
+		}
+		if f.watchesAddress(log.Address) {
+			return true
+		}
+		for _, topic := range log.Topics {
+			if _, ok := f.topics[topic]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (f Filter) watchesAddress(address common.Address) bool {
+	_, ok := f.addresses[address]
+	return ok
+}
+
+func receiptAddress(value interface{}) (common.Address, bool) {
+	switch address := value.(type) {
+	case common.Address:
+		return address, true
+	case *common.Address:
+		if address == nil {
+			return common.Address{}, false
+		}
+		return *address, true
+	default:
+		return common.Address{}, false
+	}
+}
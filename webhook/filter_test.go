@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterMatch(t *testing.T) {
+	watched := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	transferTopic := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	otherTopic := common.HexToHash("0x01")
+
+	receipt := func(from common.Address, to *common.Address, logs []*ethtypes.Log) map[string]interface{} {
+		return map[string]interface{}{
+			"from":            from,
+			"to":              to,
+			"contractAddress": nil,
+			"logs":            logs,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		filter   Filter
+		receipt  map[string]interface{}
+		expMatch bool
+	}{
+		{
+			"empty filter matches everything",
+			NewFilter(nil, nil),
+			receipt(other, &other, nil),
+			true,
+		},
+		{
+			"sender",
+			NewFilter([]string{watched.Hex()}, nil),
+			receipt(watched, &other, nil),
+			true,
+		},
+		{
+			"recipient",
+			NewFilter([]string{watched.Hex()}, nil),
+			receipt(other, &watched, nil),
+			true,
+		},
+		{
+			"created contract",
+			NewFilter([]string{watched.Hex()}, nil),
+			map[string]interface{}{"from": other, "to": (*common.Address)(nil), "contractAddress": watched},
+			true,
+		},
+		{
+			"log emitter",
+			NewFilter([]string{watched.Hex()}, nil),
+			receipt(other, &other, []*ethtypes.Log{{Address: watched}}),
+			true,
+		},
+		{
+			"log topic",
+			NewFilter(nil, []string{transferTopic.Hex()}),
+			receipt(other, &other, []*ethtypes.Log{{Address: other, Topics: []common.Hash{transferTopic}}}),
+			true,
+		},
+		{
+			"no match",
+			NewFilter([]string{watched.Hex()}, []string{transferTopic.Hex()}),
+			receipt(other, nil, []*ethtypes.Log{{Address: other, Topics: []common.Hash{otherTopic}}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expMatch, tc.filter.Match(tc.receipt), tc.name)
+	}
+}
@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// QueueSize is the number of receipts buffered for delivery. Receipts are
+// dropped once the queue is full so a slow endpoint never stalls the node.
+const QueueSize = 1024
+
+// Notifier POSTs receipt JSON to a set of URLs, retrying failed deliveries
+// with exponential backoff.
+type Notifier struct {
+	urls         []string
+	client       *http.Client
+	maxRetries   int
+	retryBackoff time.Duration
+	logger       log.Logger
+
+	queue chan []byte
+}
+
+// NewNotifier returns a notifier delivering to urls. A delivery is attempted
+// at most maxRetries+1 times, waiting retryBackoff after the first failure and
+// doubling the delay after each further one.
+func NewNotifier(urls []string, maxRetries int, retryBackoff, timeout time.Duration, logger log.Logger) *Notifier {
+	return &Notifier{
+		urls:         urls,
+		client:       &http.Client{Timeout: timeout},
+		maxRetries:   maxRetries,
+		retryBackoff: retryBackoff,
+		logger:       logger,
+		queue:        make(chan []byte, QueueSize),
+	}
+}
+
+// Run delivers queued receipts until the context is cancelled.
+func (n *Notifier) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case body := <-n.queue:
+			for _, url := range n.urls {
+				if err := n.Deliver(ctx, url, body); err != nil {
+					n.logger.Error("failed to deliver webhook", "url", url, "error", err.Error())
+				}
+			}
+		}
+	}
+}
+
+// Enqueue schedules the receipt for delivery to every URL.
+func (n *Notifier) Enqueue(receipt map[string]interface{}) error {
+	body, err := json.Marshal(receipt)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case n.queue <- body:
+		return nil
+	default:
+		return fmt.Errorf("webhook queue is full, dropping receipt %v", receipt["transactionHash"])
+	}
+}
+
+// Deliver POSTs body to url, retrying until it is accepted with a 2xx status,
+// the retries are exhausted or the context is cancelled.
+func (n *Notifier) Deliver(ctx context.Context, url string, body []byte) error {
+	backoff := n.retryBackoff
+
+	var err error
+	for attempt := 0; attempt <= n.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		if err = n.post(ctx, url, body); err == nil {
+			return nil
+		}
+		n.logger.Debug("webhook delivery attempt failed", "url", url, "attempt", attempt+1, "error", err.Error())
+	}
+
+	return fmt.Errorf("giving up after %d attempts: %w", n.maxRetries+1, err)
+}
+
+func (n *Notifier) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestNotifierDeliver(t *testing.T) {
+	testCases := []struct {
+		name       string
+		failures   int32
+		maxRetries int
+		expPass    bool
+		expCalls   int32
+	}{
+		{"first attempt", 0, 3, true, 1},
+		{"succeeds after retries", 2, 3, true, 3},
+		{"retries exhausted", 5, 2, false, 3},
+		{"no retries", 1, 0, false, 1},
+	}
+
+	for _, tc := range testCases {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			if atomic.AddInt32(&calls, 1) <= tc.failures {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		n := NewNotifier([]string{srv.URL}, tc.maxRetries, time.Millisecond, time.Second, log.NewNopLogger())
+		err := n.Deliver(context.Background(), srv.URL, []byte(`{}`))
+		srv.Close()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+		require.Equal(t, tc.expCalls, atomic.LoadInt32(&calls), tc.name)
+	}
+}
+
+func TestNotifierRun(t *testing.T) {
+	received := make(chan map[string]interface{}, 2)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var receipt map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &receipt))
+		received <- receipt
+	})
+	srv1 := httptest.NewServer(handler)
+	defer srv1.Close()
+	srv2 := httptest.NewServer(handler)
+	defer srv2.Close()
+
+	n := NewNotifier([]string{srv1.URL, srv2.URL}, 0, time.Millisecond, time.Second, log.NewNopLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go n.Run(ctx)
+
+	require.NoError(t, n.Enqueue(map[string]interface{}{"transactionHash": "0x01"}))
+	for i := 0; i < 2; i++ {
+		select {
+		case receipt := <-received:
+			require.Equal(t, "0x01", receipt["transactionHash"])
+		case <-time.After(5 * time.Second):
+			t.Fatal("receipt not delivered")
+		}
+	}
+}
+
+func TestNotifierEnqueueFull(t *testing.T) {
+	n := NewNotifier(nil, 0, time.Millisecond, time.Second, log.NewNopLogger())
+	for i := 0; i < QueueSize; i++ {
+		require.NoError(t, n.Enqueue(map[string]interface{}{}))
+	}
+	require.Error(t, n.Enqueue(map[string]interface{}{}))
+}
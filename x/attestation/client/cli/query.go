@@ -0,0 +1,263 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+// GetQueryCmd returns the parent command for all x/attestation CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the attestation module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetParamsCmd(),
+		GetNodesCmd(),
+		GetNodeCmd(),
+		GetAllowedMeasurementsCmd(),
+		GetKeyEpochsCmd(),
+		GetValidatorStatusCmd(),
+		GetRecoveryRequestsCmd(),
+		GetRecoveryRequestCmd(),
+	)
+	return cmd
+}
+
+// GetParamsCmd queries the attestation params
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the attestation params",
+		Long:  "Get the attestation parameter values.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetNodesCmd queries all registered nodes
+func GetNodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nodes",
+		Short: "Get all registered nodes, including the expired ones",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Nodes(cmd.Context(), &types.QueryNodesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nodes")
+	return cmd
+}
+
+// GetNodeCmd queries the node registered with an enclave public key
+func GetNodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node PUBLIC_KEY_HEX",
+		Short: "Get the node registered with the given enclave public key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Node(cmd.Context(), &types.QueryNodeRequest{PublicKey: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAllowedMeasurementsCmd queries the enclave measurements accepted for node registration
+func GetAllowedMeasurementsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowed-measurements",
+		Short: "Get the enclave measurements and the minimum security version accepted for node registration",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllowedMeasurements(cmd.Context(), &types.QueryAllowedMeasurementsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetKeyEpochsCmd queries the state encryption key epochs
+func GetKeyEpochsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-epochs",
+		Short: "Get the state encryption key epochs and the epoch of the current block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.KeyEpochs(cmd.Context(), &types.QueryKeyEpochsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorStatusCmd queries the attestation status of the nodes operated by a validator
+func GetValidatorStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-status VALIDATOR_ADDRESS",
+		Short: "Get the attestation status of the nodes operated by the given validator",
+		Long:  "Get the attestation status of the nodes operated by the given validator. Both the validator operator address and the account address of the operator are accepted.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorStatus(cmd.Context(), &types.QueryValidatorStatusRequest{ValidatorAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRecoveryRequestsCmd queries all enclave recovery requests
+func GetRecoveryRequestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recovery-requests",
+		Short: "Get all enclave recovery requests",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecoveryRequests(cmd.Context(), &types.QueryRecoveryRequestsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "recovery-requests")
+	return cmd
+}
+
+// GetRecoveryRequestCmd queries the recovery request of a new enclave
+func GetRecoveryRequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recovery-request PUBLIC_KEY_HEX",
+		Short: "Get the recovery request of the new enclave with the given public key, including the submitted shares",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecoveryRequest(cmd.Context(), &types.QueryRecoveryRequestRequest{PublicKey: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
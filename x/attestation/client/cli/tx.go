@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+// GetTxCmd returns the transaction commands for the attestation module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		NewRegisterNodeCmd(),
+		NewRequestRecoveryCmd(),
+		NewSubmitRecoveryShareCmd(),
+	)
+	return cmd
+}
+
+// NewRegisterNodeCmd registers the enclave of a node with its attestation quote
+func NewRegisterNodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-node PUBLIC_KEY_HEX QUOTE_FILE",
+		Short: "Register the enclave of a node with its attestation quote",
+		Long:  "Register the enclave of a node with its SGX DCAP attestation quote. The report data of the quote must start with the enclave public key.", //nolint:lll
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			publicKey, err := hexutil.Decode(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid public key: %w", err)
+			}
+
+			quote, err := os.ReadFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read quote: %w", err)
+			}
+
+			msg := &types.MsgRegisterNode{
+				Operator:  clientCtx.GetFromAddress().String(),
+				PublicKey: publicKey,
+				Quote:     quote,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRequestRecoveryCmd requests the recovery of the enclave registration of the operator
+func NewRequestRecoveryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-recovery PUBLIC_KEY_HEX QUOTE_FILE",
+		Short: "Request the recovery of the enclave registration with a new enclave",
+		Long:  "Request the recovery of the enclave registration after the sealed data of the enclave was lost. The new enclave is registered once enough attested validators re-shared the master seed to it.", //nolint:lll
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			publicKey, err := hexutil.Decode(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid public key: %w", err)
+			}
+
+			quote, err := os.ReadFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read quote: %w", err)
+			}
+
+			msg := &types.MsgRequestRecovery{
+				Operator:  clientCtx.GetFromAddress().String(),
+				PublicKey: publicKey,
+				Quote:     quote,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSubmitRecoveryShareCmd submits a recovery share produced by the enclave of the node
+func NewSubmitRecoveryShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-recovery-share NODE_PUBLIC_KEY_HEX RECOVERY_PUBLIC_KEY_HEX SHARE_FILE",
+		Short: "Submit the recovery share produced by the enclave of the node for a recovery request",
+		Long:  "Submit the share of the master seed re-shared by the registered enclave of the node to the new enclave of a recovery request. The share file holds the share encrypted to the recovery public key.", //nolint:lll
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodePublicKey, err := hexutil.Decode(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid node public key: %w", err)
+			}
+
+			recoveryPublicKey, err := hexutil.Decode(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid recovery public key: %w", err)
+			}
+
+			share, err := os.ReadFile(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to read share: %w", err)
+			}
+
+			msg := &types.MsgSubmitRecoveryShare{
+				Operator:          clientCtx.GetFromAddress().String(),
+				NodePublicKey:     nodePublicKey,
+				RecoveryPublicKey: recoveryPublicKey,
+				EncryptedShare:    share,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
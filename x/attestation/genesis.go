@@ -0,0 +1,45 @@
+package attestation
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/keeper"
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	err := k.SetParams(ctx, data.Params)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	for _, node := range data.Nodes {
+		// nodes without an attestation time are considered attested at genesis
+		if node.RegisteredTime.IsZero() {
+			node.RegisteredTime = ctx.BlockTime()
+		}
+		k.SetNode(ctx, node)
+	}
+
+	for _, request := range data.RecoveryRequests {
+		k.SetRecoveryRequest(ctx, request)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the attestation module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:           k.GetParams(ctx),
+		Nodes:            k.GetNodes(ctx),
+		RecoveryRequests: k.GetRecoveryRequests(ctx),
+	}
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+// EndBlock flags the nodes whose attestation is older than the attestation validity, so stale
+// enclaves are no longer trusted until their operator registers them with a fresh quote.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AttestationValidity == 0 {
+		return
+	}
+
+	for _, node := range k.GetNodes(ctx) {
+		if node.Expired || !params.IsAttestationExpired(node, ctx.BlockTime()) {
+			continue
+		}
+
+		node.Expired = true
+		k.SetNode(ctx, node)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireNode,
+				sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(node.PublicKey)),
+				sdk.NewAttribute(types.AttributeKeyOperator, node.Operator),
+				sdk.NewAttribute(types.AttributeKeyRegisteredHeight, strconv.FormatInt(node.RegisteredHeight, 10)),
+			),
+		)
+		k.Logger(ctx).Info("node attestation expired", "public-key", hex.EncodeToString(node.PublicKey), "operator", node.Operator)
+	}
+}
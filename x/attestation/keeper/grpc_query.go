@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// Nodes implements the Query/Nodes gRPC method
+func (k Keeper) Nodes(c context.Context, req *types.QueryNodesRequest) (*types.QueryNodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNode)
+
+	var nodes []types.Node
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var node types.Node
+		if err := k.cdc.Unmarshal(value, &node); err != nil {
+			return err
+		}
+		nodes = append(nodes, node)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryNodesResponse{
+		Nodes:      nodes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Node implements the Query/Node gRPC method
+func (k Keeper) Node(c context.Context, req *types.QueryNodeRequest) (*types.QueryNodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	publicKey, err := hex.DecodeString(strings.TrimPrefix(req.PublicKey, "0x"))
+	if err != nil || len(publicKey) != types.PublicKeySize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public key %s", req.PublicKey)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	node, found := k.GetNode(ctx, publicKey)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "node with public key %s not found", req.PublicKey)
+	}
+
+	return &types.QueryNodeResponse{
+		Node: node,
+	}, nil
+}
+
+// AllowedMeasurements implements the Query/AllowedMeasurements gRPC method
+func (k Keeper) AllowedMeasurements(c context.Context, _ *types.QueryAllowedMeasurementsRequest) (*types.QueryAllowedMeasurementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryAllowedMeasurementsResponse{
+		Measurements: params.AllowedMeasurements,
+		MinIsvSvn:    params.MinIsvSvn,
+	}, nil
+}
+
+// KeyEpochs implements the Query/KeyEpochs gRPC method
+func (k Keeper) KeyEpochs(c context.Context, _ *types.QueryKeyEpochsRequest) (*types.QueryKeyEpochsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryKeyEpochsResponse{
+		KeyEpochs:    k.evmKeeper.GetKeyEpochs(ctx),
+		CurrentEpoch: k.evmKeeper.GetKeyEpochAtHeight(ctx, ctx.BlockHeight()).Epoch,
+	}, nil
+}
+
+// ValidatorStatus implements the Query/ValidatorStatus gRPC method
+func (k Keeper) ValidatorStatus(c context.Context, req *types.QueryValidatorStatusRequest) (*types.QueryValidatorStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// nodes are registered by the account of the validator operator
+	var operator sdk.AccAddress
+	if valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress); err == nil {
+		operator = sdk.AccAddress(valAddr)
+	} else if operator, err = sdk.AccAddressFromBech32(req.ValidatorAddress); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s", req.ValidatorAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	res := &types.QueryValidatorStatusResponse{
+		Nodes: []types.Node{},
+	}
+	for _, node := range k.GetNodes(ctx) {
+		if node.Operator != operator.String() {
+			continue
+		}
+
+		res.Nodes = append(res.Nodes, node)
+		if !node.Expired && params.IsAllowed(node.Report()) {
+			res.Attested = true
+		}
+	}
+
+	return res, nil
+}
+
+// RecoveryRequests implements the Query/RecoveryRequests gRPC method
+func (k Keeper) RecoveryRequests(c context.Context, req *types.QueryRecoveryRequestsRequest) (*types.QueryRecoveryRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryRequest)
+
+	var requests []types.RecoveryRequest
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var request types.RecoveryRequest
+		if err := k.cdc.Unmarshal(value, &request); err != nil {
+			return err
+		}
+		requests = append(requests, request)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryRecoveryRequestsResponse{
+		RecoveryRequests: requests,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// RecoveryRequest implements the Query/RecoveryRequest gRPC method
+func (k Keeper) RecoveryRequest(c context.Context, req *types.QueryRecoveryRequestRequest) (*types.QueryRecoveryRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	publicKey, err := hex.DecodeString(strings.TrimPrefix(req.PublicKey, "0x"))
+	if err != nil || len(publicKey) != types.PublicKeySize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public key %s", req.PublicKey)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	request, found := k.GetRecoveryRequest(ctx, publicKey)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "recovery request for public key %s not found", req.PublicKey)
+	}
+
+	return &types.QueryRecoveryRequestResponse{
+		RecoveryRequest: request,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryNodes() {
+	res, err := suite.keeper.Nodes(suite.ctx, &types.QueryNodesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Nodes)
+
+	suite.Require().NoError(suite.registerNode(suite.operator, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)))
+	node, _ := suite.keeper.GetNode(suite.ctx, suite.publicKey)
+
+	res, err = suite.keeper.Nodes(suite.ctx, &types.QueryNodesRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Node{node}, res.Nodes)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+
+	nodeRes, err := suite.keeper.Node(suite.ctx, &types.QueryNodeRequest{PublicKey: hex.EncodeToString(suite.publicKey)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(node, nodeRes.Node)
+
+	nodeRes, err = suite.keeper.Node(suite.ctx, &types.QueryNodeRequest{PublicKey: "0x" + hex.EncodeToString(suite.publicKey)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(node, nodeRes.Node)
+
+	_, err = suite.keeper.Node(suite.ctx, &types.QueryNodeRequest{PublicKey: hex.EncodeToString(suite.mrSigner[:16])})
+	suite.Require().Error(err)
+
+	_, err = suite.keeper.Node(suite.ctx, &types.QueryNodeRequest{PublicKey: hex.EncodeToString(suite.mrSigner)})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryAllowedMeasurements() {
+	res, err := suite.keeper.AllowedMeasurements(suite.ctx, &types.QueryAllowedMeasurementsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Measurement{{MrSigner: suite.mrSigner}}, res.Measurements)
+	suite.Require().Zero(res.MinIsvSvn)
+}
+
+func (suite *KeeperTestSuite) TestQueryKeyEpochs() {
+	res, err := suite.keeper.KeyEpochs(suite.ctx, &types.QueryKeyEpochsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.KeyEpochs)
+	suite.Require().Zero(res.CurrentEpoch)
+
+	// the latest epoch starts in the next block
+	suite.evmKeeper.epochs = []evmtypes.KeyEpoch{{Epoch: 1, StartHeight: 1}, {Epoch: 2, StartHeight: 2}}
+	res, err = suite.keeper.KeyEpochs(suite.ctx, &types.QueryKeyEpochsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.evmKeeper.epochs, res.KeyEpochs)
+	suite.Require().Equal(uint64(1), res.CurrentEpoch)
+}
+
+func (suite *KeeperTestSuite) TestQueryValidatorStatus() {
+	operator, err := sdk.AccAddressFromBech32(suite.operator)
+	suite.Require().NoError(err)
+	valAddress := sdk.ValAddress(operator).String()
+
+	res, err := suite.keeper.ValidatorStatus(suite.ctx, &types.QueryValidatorStatusRequest{ValidatorAddress: valAddress})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Attested)
+	suite.Require().Empty(res.Nodes)
+
+	suite.Require().NoError(suite.registerNode(suite.operator, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)))
+	other := sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes()).String()
+	otherKey := append([]byte{}, suite.mrEnclave...)
+	_, err = suite.keeper.RegisterNode(suite.ctx, &types.MsgRegisterNode{
+		Operator:  other,
+		PublicKey: otherKey,
+		Quote:     newQuote(suite.mrEnclave, suite.mrSigner, otherKey, false),
+	})
+	suite.Require().NoError(err)
+
+	for _, address := range []string{valAddress, suite.operator} {
+		res, err = suite.keeper.ValidatorStatus(suite.ctx, &types.QueryValidatorStatusRequest{ValidatorAddress: address})
+		suite.Require().NoError(err)
+		suite.Require().True(res.Attested)
+		suite.Require().Len(res.Nodes, 1)
+		suite.Require().Equal(suite.publicKey, res.Nodes[0].PublicKey)
+	}
+
+	// expired nodes are reported but don't count as attested
+	node, _ := suite.keeper.GetNode(suite.ctx, suite.publicKey)
+	node.Expired = true
+	suite.keeper.SetNode(suite.ctx, node)
+	res, err = suite.keeper.ValidatorStatus(suite.ctx, &types.QueryValidatorStatusRequest{ValidatorAddress: valAddress})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Attested)
+	suite.Require().Len(res.Nodes, 1)
+
+	_, err = suite.keeper.ValidatorStatus(suite.ctx, &types.QueryValidatorStatusRequest{ValidatorAddress: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryRecoveryRequests() {
+	res, err := suite.keeper.RecoveryRequests(suite.ctx, &types.QueryRecoveryRequestsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.RecoveryRequests)
+
+	suite.setRecoveryThreshold(1)
+	suite.Require().NoError(suite.registerNode(suite.operator, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)))
+	recoveryKey := suite.mrEnclave
+	suite.Require().NoError(suite.requestRecovery(recoveryKey))
+	request, _ := suite.keeper.GetRecoveryRequest(suite.ctx, recoveryKey)
+
+	res, err = suite.keeper.RecoveryRequests(suite.ctx, &types.QueryRecoveryRequestsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RecoveryRequest{request}, res.RecoveryRequests)
+
+	requestRes, err := suite.keeper.RecoveryRequest(suite.ctx, &types.QueryRecoveryRequestRequest{PublicKey: hex.EncodeToString(recoveryKey)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(request, requestRes.RecoveryRequest)
+
+	_, err = suite.keeper.RecoveryRequest(suite.ctx, &types.QueryRecoveryRequestRequest{PublicKey: hex.EncodeToString(suite.publicKey)})
+	suite.Require().Error(err)
+}
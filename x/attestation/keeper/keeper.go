@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+// Keeper grants access to the attestation module state.
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// Store key required for the attestation Prefix KVStore.
+	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	// evmKeeper provides the state encryption key epochs
+	evmKeeper types.EVMKeeper
+
+	// quoteVerifier verifies the signature of attestation quotes, registration fails if it's not set
+	quoteVerifier types.QuoteVerifier
+}
+
+// NewKeeper generates new attestation module keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	storeKey storetypes.StoreKey,
+	evmKeeper types.EVMKeeper,
+) Keeper {
+	// ensure authority account is correctly formatted
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+		evmKeeper: evmKeeper,
+	}
+}
+
+// SetQuoteVerifier sets the verifier of attestation quote signatures. It must be set before the
+// keeper is passed to the module.
+func (k *Keeper) SetQuoteVerifier(verifier types.QuoteVerifier) *Keeper {
+	k.quoteVerifier = verifier
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/SigmaGmbH/evm-module/app"
+	"github.com/SigmaGmbH/evm-module/encoding"
+	"github.com/SigmaGmbH/evm-module/x/attestation/keeper"
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// mockQuoteVerifier accepts all quotes unless an error is set
+type mockQuoteVerifier struct {
+	err error
+}
+
+func (m *mockQuoteVerifier) VerifyQuote(_ []byte, _ time.Time) error {
+	return m.err
+}
+
+// mockEVMKeeper returns a fixed list of key epochs
+type mockEVMKeeper struct {
+	epochs []evmtypes.KeyEpoch
+}
+
+func (m *mockEVMKeeper) GetKeyEpochs(_ sdk.Context) []evmtypes.KeyEpoch {
+	return m.epochs
+}
+
+func (m *mockEVMKeeper) GetKeyEpochAtHeight(_ sdk.Context, height int64) evmtypes.KeyEpoch {
+	var epoch evmtypes.KeyEpoch
+	for _, e := range m.epochs {
+		if e.StartHeight <= height {
+			epoch = e
+		}
+	}
+	return epoch
+}
+
+// newQuote builds a version 3 quote with the given report fields and a dummy signature
+func newQuote(mrEnclave, mrSigner, publicKey []byte, debug bool) []byte {
+	quote := make([]byte, 48+384+4+64)
+	binary.LittleEndian.PutUint16(quote[0:], types.QuoteVersion)
+	binary.LittleEndian.PutUint16(quote[2:], 2)
+
+	body := quote[48:]
+	if debug {
+		body[48] = 0x02
+	}
+	copy(body[64:], mrEnclave)
+	copy(body[128:], mrSigner)
+	copy(body[320:], publicKey)
+	binary.LittleEndian.PutUint32(quote[48+384:], 64)
+
+	return quote
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	verifier  *mockQuoteVerifier
+	evmKeeper *mockEVMKeeper
+	operator  string
+	authority string
+	mrEnclave []byte
+	mrSigner  []byte
+	publicKey []byte
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	suite.ctx = testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test")).
+		WithBlockHeight(1).
+		WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+
+	suite.operator = sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes()).String()
+	suite.mrEnclave = bytes.Repeat([]byte{1}, types.MeasurementSize)
+	suite.mrSigner = bytes.Repeat([]byte{2}, types.MeasurementSize)
+	suite.publicKey = bytes.Repeat([]byte{3}, types.PublicKeySize)
+
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+	suite.authority = govAddress.String()
+	suite.verifier = &mockQuoteVerifier{}
+	suite.evmKeeper = &mockEVMKeeper{}
+	suite.keeper = keeper.NewKeeper(encCfg.Codec, govAddress, storeKey, suite.evmKeeper)
+	suite.keeper.SetQuoteVerifier(suite.verifier)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Measurement{{MrSigner: suite.mrSigner}}, 0, 0, 0)))
+}
+
+func (suite *KeeperTestSuite) registerNode(operator string, quote []byte) error {
+	_, err := suite.keeper.RegisterNode(suite.ctx, &types.MsgRegisterNode{
+		Operator:  operator,
+		PublicKey: suite.publicKey,
+		Quote:     quote,
+	})
+	return err
+}
+
+func (suite *KeeperTestSuite) TestRegisterNode() {
+	suite.Require().False(suite.keeper.IsNodeRegistered(suite.ctx, suite.publicKey))
+
+	err := suite.registerNode(suite.operator, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.IsNodeRegistered(suite.ctx, suite.publicKey))
+
+	node, found := suite.keeper.GetNode(suite.ctx, suite.publicKey)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Node{
+		PublicKey:        suite.publicKey,
+		Operator:         suite.operator,
+		MrEnclave:        suite.mrEnclave,
+		MrSigner:         suite.mrSigner,
+		RegisteredHeight: 1,
+		RegisteredTime:   suite.ctx.BlockTime(),
+	}, node)
+	suite.Require().Len(suite.keeper.GetNodes(suite.ctx), 1)
+
+	// the operator can register again, e.g. after an enclave upgrade
+	suite.ctx = suite.ctx.WithBlockHeight(2)
+	upgraded := bytes.Repeat([]byte{4}, types.MeasurementSize)
+	err = suite.registerNode(suite.operator, newQuote(upgraded, suite.mrSigner, suite.publicKey, false))
+	suite.Require().NoError(err)
+	node, _ = suite.keeper.GetNode(suite.ctx, suite.publicKey)
+	suite.Require().Equal(upgraded, node.MrEnclave)
+	suite.Require().Equal(int64(2), node.RegisteredHeight)
+
+	other := sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes()).String()
+	err = suite.registerNode(other, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false))
+	suite.Require().ErrorIs(err, types.ErrNodeAlreadyRegistered)
+}
+
+func (suite *KeeperTestSuite) TestRegisterNodeInvalid() {
+	testCases := []struct {
+		name     string
+		malleate func() []byte
+		expErr   error
+	}{
+		{
+			"debug enclave",
+			func() []byte { return newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, true) },
+			types.ErrInvalidQuote,
+		},
+		{
+			"report data doesn't commit to the public key",
+			func() []byte { return newQuote(suite.mrEnclave, suite.mrSigner, suite.mrEnclave, false) },
+			types.ErrInvalidQuote,
+		},
+		{
+			"measurement not allowed",
+			func() []byte { return newQuote(suite.mrEnclave, suite.mrEnclave, suite.publicKey, false) },
+			types.ErrMeasurementNotAllowed,
+		},
+		{
+			"invalid signature",
+			func() []byte {
+				suite.verifier.err = errors.New("invalid signature")
+				return newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)
+			},
+			types.ErrInvalidQuote,
+		},
+		{
+			"verifier not set",
+			func() []byte {
+				suite.keeper.SetQuoteVerifier(nil)
+				return newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)
+			},
+			types.ErrQuoteVerifierNotSet,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			err := suite.registerNode(suite.operator, tc.malleate())
+			suite.Require().ErrorIs(err, tc.expErr)
+			suite.Require().False(suite.keeper.IsNodeRegistered(suite.ctx, suite.publicKey))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams([]types.Measurement{{MrEnclave: suite.mrEnclave}}, 0, 0, 0)
+
+	_, err := suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: suite.operator, Params: params})
+	suite.Require().Error(err)
+
+	_, err = suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: suite.authority, Params: params})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.keeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestAllowRevokeMeasurement() {
+	upgraded := bytes.Repeat([]byte{4}, types.MeasurementSize)
+	upgradedQuote := newQuote(upgraded, upgraded, suite.publicKey, false)
+	suite.Require().ErrorIs(suite.registerNode(suite.operator, upgradedQuote), types.ErrMeasurementNotAllowed)
+
+	_, err := suite.keeper.AllowMeasurement(suite.ctx, &types.MsgAllowMeasurement{
+		Authority: suite.operator, Measurement: types.Measurement{MrSigner: upgraded},
+	})
+	suite.Require().Error(err, "only governance can allow measurements")
+
+	_, err = suite.keeper.AllowMeasurement(suite.ctx, &types.MsgAllowMeasurement{
+		Authority: suite.authority, Measurement: types.Measurement{MrSigner: upgraded},
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.IsEnclaveAllowed(suite.ctx, types.Report{MrSigner: upgraded}))
+	suite.Require().NoError(suite.registerNode(suite.operator, upgradedQuote))
+
+	// revoking the measurement deregisters the node
+	res, err := suite.keeper.RevokeMeasurement(suite.ctx, &types.MsgRevokeMeasurement{
+		Authority: suite.authority, Measurement: types.Measurement{MrSigner: upgraded},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.DeregisteredNodes)
+	suite.Require().False(suite.keeper.IsNodeRegistered(suite.ctx, suite.publicKey))
+	suite.Require().False(suite.keeper.IsEnclaveAllowed(suite.ctx, types.Report{MrSigner: upgraded}))
+
+	_, err = suite.keeper.RevokeMeasurement(suite.ctx, &types.MsgRevokeMeasurement{
+		Authority: suite.authority, Measurement: types.Measurement{MrSigner: upgraded},
+	})
+	suite.Require().ErrorIs(err, types.ErrMeasurementNotFound)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParamsMinIsvSvn() {
+	suite.Require().NoError(suite.registerNode(suite.operator, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)))
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MinIsvSvn = 1
+	_, err := suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: suite.authority, Params: params})
+	suite.Require().NoError(err)
+
+	// the node runs a vulnerable security version
+	suite.Require().False(suite.keeper.IsNodeRegistered(suite.ctx, suite.publicKey))
+	suite.Require().ErrorIs(
+		suite.registerNode(suite.operator, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)),
+		types.ErrMeasurementNotAllowed,
+	)
+}
+
+func (suite *KeeperTestSuite) TestAttestationExpiry() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AttestationValidity = time.Hour
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	quote := newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)
+	suite.Require().NoError(suite.registerNode(suite.operator, quote))
+	registered := suite.ctx.BlockTime()
+
+	suite.ctx = suite.ctx.WithBlockTime(registered.Add(time.Hour - time.Second))
+	suite.keeper.EndBlock(suite.ctx)
+	suite.Require().True(suite.keeper.IsNodeRegistered(suite.ctx, suite.publicKey))
+	suite.Require().Empty(suite.keeper.GetExpiredNodes(suite.ctx))
+
+	suite.ctx = suite.ctx.WithBlockTime(registered.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	suite.keeper.EndBlock(suite.ctx)
+	suite.Require().False(suite.keeper.IsNodeRegistered(suite.ctx, suite.publicKey))
+	suite.Require().Len(suite.keeper.GetExpiredNodes(suite.ctx), 1)
+	suite.Require().Len(suite.ctx.EventManager().Events(), 1)
+	suite.Require().Equal(types.EventTypeExpireNode, suite.ctx.EventManager().Events()[0].Type)
+
+	// expired nodes are flagged only once
+	suite.keeper.EndBlock(suite.ctx)
+	suite.Require().Len(suite.ctx.EventManager().Events(), 1)
+
+	// registering a fresh quote renews the attestation
+	suite.Require().NoError(suite.registerNode(suite.operator, quote))
+	suite.Require().True(suite.keeper.IsNodeRegistered(suite.ctx, suite.publicKey))
+	suite.Require().Empty(suite.keeper.GetExpiredNodes(suite.ctx))
+}
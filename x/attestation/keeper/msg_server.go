@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterNode implements the gRPC MsgServer interface. It verifies the attestation quote of the
+// enclave and registers its public key. A node can be registered again by its operator with a new
+// quote, e.g. after an enclave upgrade or to renew an expiring attestation.
+func (k *Keeper) RegisterNode(goCtx context.Context, msg *types.MsgRegisterNode) (*types.MsgRegisterNodeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	report, err := k.verifyQuote(ctx, msg.PublicKey, msg.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	if node, found := k.GetNode(ctx, msg.PublicKey); found && node.Operator != msg.Operator {
+		return nil, errorsmod.Wrapf(types.ErrNodeAlreadyRegistered, "operator %s", node.Operator)
+	}
+
+	node := types.Node{
+		PublicKey:        msg.PublicKey,
+		Operator:         msg.Operator,
+		MrEnclave:        report.MrEnclave,
+		MrSigner:         report.MrSigner,
+		IsvSvn:           uint32(report.IsvSvn),
+		RegisteredHeight: ctx.BlockHeight(),
+		RegisteredTime:   ctx.BlockTime(),
+	}
+	k.SetNode(ctx, node)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterNode,
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(node.PublicKey)),
+			sdk.NewAttribute(types.AttributeKeyOperator, node.Operator),
+			sdk.NewAttribute(types.AttributeKeyMrEnclave, hex.EncodeToString(node.MrEnclave)),
+			sdk.NewAttribute(types.AttributeKeyMrSigner, hex.EncodeToString(node.MrSigner)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgRegisterNodeResponse{}, nil
+}
+
+// RequestRecovery implements the gRPC MsgServer interface. It verifies the attestation quote of
+// the new enclave of an operator which already registered a node and stores the recovery
+// request, replacing a previous request of the operator. The enclaves of other attested
+// validators then re-share the master seed to the new enclave.
+func (k *Keeper) RequestRecovery(goCtx context.Context, msg *types.MsgRequestRecovery) (*types.MsgRequestRecoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsRecoveryEnabled() {
+		return nil, types.ErrRecoveryDisabled
+	}
+	if len(k.getOperatorNodes(ctx, msg.Operator)) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrNodeNotFound, "operator %s has no registered node to recover", msg.Operator)
+	}
+
+	report, err := k.verifyQuote(ctx, msg.PublicKey, msg.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	if node, found := k.GetNode(ctx, msg.PublicKey); found {
+		return nil, errorsmod.Wrapf(types.ErrNodeAlreadyRegistered, "operator %s", node.Operator)
+	}
+	if request, found := k.GetRecoveryRequest(ctx, msg.PublicKey); found && request.Operator != msg.Operator {
+		return nil, errorsmod.Wrapf(types.ErrNodeAlreadyRegistered, "recovery requested by operator %s", request.Operator)
+	}
+
+	// an operator recovers a single enclave at a time
+	for _, request := range k.GetRecoveryRequests(ctx) {
+		if request.Operator == msg.Operator {
+			k.DeleteRecoveryRequest(ctx, request.PublicKey)
+		}
+	}
+
+	request := types.RecoveryRequest{
+		Operator:        msg.Operator,
+		PublicKey:       msg.PublicKey,
+		MrEnclave:       report.MrEnclave,
+		MrSigner:        report.MrSigner,
+		IsvSvn:          uint32(report.IsvSvn),
+		RequestedHeight: ctx.BlockHeight(),
+		Shares:          []types.RecoveryShare{},
+	}
+	k.SetRecoveryRequest(ctx, request)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRequestRecovery,
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(request.PublicKey)),
+			sdk.NewAttribute(types.AttributeKeyOperator, request.Operator),
+			sdk.NewAttribute(types.AttributeKeyMrEnclave, hex.EncodeToString(request.MrEnclave)),
+			sdk.NewAttribute(types.AttributeKeyMrSigner, hex.EncodeToString(request.MrSigner)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgRequestRecoveryResponse{}, nil
+}
+
+// SubmitRecoveryShare implements the gRPC MsgServer interface. It stores the share re-shared by
+// the attested enclave of another validator to the new enclave of a recovery request. Once the
+// shares reach the recovery threshold, the new enclave replaces the nodes of the operator.
+func (k *Keeper) SubmitRecoveryShare(goCtx context.Context, msg *types.MsgSubmitRecoveryShare) (*types.MsgSubmitRecoveryShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.IsRecoveryEnabled() {
+		return nil, types.ErrRecoveryDisabled
+	}
+
+	request, found := k.GetRecoveryRequest(ctx, msg.RecoveryPublicKey)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRecoveryRequestNotFound, "public key %x", msg.RecoveryPublicKey)
+	}
+	if request.Recovered {
+		return nil, errorsmod.Wrap(types.ErrInvalidRecoveryShare, "enclave is already recovered")
+	}
+	if !params.IsAllowed(request.Node().Report()) {
+		return nil, errorsmod.Wrapf(
+			types.ErrMeasurementNotAllowed, "mr_enclave %x, mr_signer %x, isv_svn %d",
+			request.MrEnclave, request.MrSigner, request.IsvSvn,
+		)
+	}
+	if request.Operator == msg.Operator {
+		return nil, errorsmod.Wrap(types.ErrInvalidRecoveryShare, "operator can't share to its own enclave")
+	}
+	if request.HasShare(msg.Operator) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRecoveryShare, "operator %s already submitted a share", msg.Operator)
+	}
+
+	// only enclaves with a valid attestation hold the master seed
+	node, found := k.GetNode(ctx, msg.NodePublicKey)
+	if !found || node.Operator != msg.Operator {
+		return nil, errorsmod.Wrapf(types.ErrNodeNotFound, "operator %s has no node with public key %x", msg.Operator, msg.NodePublicKey)
+	}
+	if node.Expired || !params.IsAllowed(node.Report()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRecoveryShare, "node %x is not attested", msg.NodePublicKey)
+	}
+
+	request.Shares = append(request.Shares, types.RecoveryShare{
+		Operator:       msg.Operator,
+		NodePublicKey:  msg.NodePublicKey,
+		EncryptedShare: msg.EncryptedShare,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecoveryShare,
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(request.PublicKey)),
+			sdk.NewAttribute(types.AttributeKeyNodePublicKey, hex.EncodeToString(msg.NodePublicKey)),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	if uint32(len(request.Shares)) >= params.RecoveryThreshold {
+		k.recoverNode(ctx, &request)
+	}
+	k.SetRecoveryRequest(ctx, request)
+
+	return &types.MsgSubmitRecoveryShareResponse{Recovered: request.Recovered}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters and deregisters the nodes
+// which are no longer allowed. The update can only be performed if the
+// requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+	k.deregisterDisallowedNodes(ctx)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AllowMeasurement implements the gRPC MsgServer interface. When an AllowMeasurement
+// proposal passes, nodes running the enclave build can register, e.g. ahead of a
+// coordinated enclave upgrade.
+func (k *Keeper) AllowMeasurement(goCtx context.Context, req *types.MsgAllowMeasurement) (*types.MsgAllowMeasurementResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := params.AllowMeasurement(req.Measurement); err != nil {
+		return nil, err
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAllowMeasurement,
+			sdk.NewAttribute(types.AttributeKeyMrEnclave, hex.EncodeToString(req.Measurement.MrEnclave)),
+			sdk.NewAttribute(types.AttributeKeyMrSigner, hex.EncodeToString(req.Measurement.MrSigner)),
+		),
+	)
+
+	return &types.MsgAllowMeasurementResponse{}, nil
+}
+
+// RevokeMeasurement implements the gRPC MsgServer interface. When a RevokeMeasurement
+// proposal passes, the enclave build can no longer register and the nodes running it
+// are deregistered, unless another allowed measurement matches them.
+func (k *Keeper) RevokeMeasurement(goCtx context.Context, req *types.MsgRevokeMeasurement) (*types.MsgRevokeMeasurementResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := params.RevokeMeasurement(req.Measurement); err != nil {
+		return nil, err
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeMeasurement,
+			sdk.NewAttribute(types.AttributeKeyMrEnclave, hex.EncodeToString(req.Measurement.MrEnclave)),
+			sdk.NewAttribute(types.AttributeKeyMrSigner, hex.EncodeToString(req.Measurement.MrSigner)),
+		),
+	)
+
+	return &types.MsgRevokeMeasurementResponse{DeregisteredNodes: k.deregisterDisallowedNodes(ctx)}, nil
+}
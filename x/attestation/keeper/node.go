@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+// GetNode returns the node registered with the enclave public key
+func (k Keeper) GetNode(ctx sdk.Context, publicKey []byte) (types.Node, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNode)
+	bz := store.Get(publicKey)
+	if len(bz) == 0 {
+		return types.Node{}, false
+	}
+
+	var node types.Node
+	k.cdc.MustUnmarshal(bz, &node)
+	return node, true
+}
+
+// SetNode stores the registered node
+func (k Keeper) SetNode(ctx sdk.Context, node types.Node) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNode)
+	store.Set(node.PublicKey, k.cdc.MustMarshal(&node))
+}
+
+// DeleteNode removes the registration of the node
+func (k Keeper) DeleteNode(ctx sdk.Context, publicKey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNode)
+	store.Delete(publicKey)
+}
+
+// GetNodes returns all registered nodes
+func (k Keeper) GetNodes(ctx sdk.Context) []types.Node {
+	nodes := []types.Node{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixNode)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var node types.Node
+		k.cdc.MustUnmarshal(iterator.Value(), &node)
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+// IsNodeRegistered returns true if the enclave with the public key has a verified registration
+// which hasn't expired. Seed providers consult it before sharing the master seed with an enclave.
+func (k Keeper) IsNodeRegistered(ctx sdk.Context, publicKey []byte) bool {
+	node, found := k.GetNode(ctx, publicKey)
+	return found && !node.Expired
+}
+
+// GetExpiredNodes returns the nodes flagged because their attestation is older than the
+// attestation validity. They are trusted again once their operator registers a fresh quote.
+func (k Keeper) GetExpiredNodes(ctx sdk.Context) []types.Node {
+	nodes := []types.Node{}
+	for _, node := range k.GetNodes(ctx) {
+		if node.Expired {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// IsEnclaveAllowed returns true if the attested enclave matches an allowed measurement and
+// has the minimum security version. Seed providers consult it for the report of the enclave
+// requesting the seed.
+func (k Keeper) IsEnclaveAllowed(ctx sdk.Context, report types.Report) bool {
+	return k.GetParams(ctx).IsAllowed(report)
+}
+
+// deregisterDisallowedNodes removes the nodes whose enclave is no longer allowed by the params,
+// e.g. after the revocation of a vulnerable build. It returns the number of removed nodes.
+func (k Keeper) deregisterDisallowedNodes(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+
+	var removed uint64
+	for _, node := range k.GetNodes(ctx) {
+		if params.IsAllowed(node.Report()) {
+			continue
+		}
+
+		k.DeleteNode(ctx, node.PublicKey)
+		removed++
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeregisterNode,
+				sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(node.PublicKey)),
+				sdk.NewAttribute(types.AttributeKeyOperator, node.Operator),
+			),
+		)
+		k.Logger(ctx).Info("node deregistered", "public-key", hex.EncodeToString(node.PublicKey), "operator", node.Operator)
+	}
+
+	return removed
+}
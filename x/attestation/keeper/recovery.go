@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+// GetRecoveryRequest returns the recovery request of the new enclave public key
+func (k Keeper) GetRecoveryRequest(ctx sdk.Context, publicKey []byte) (types.RecoveryRequest, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryRequest)
+	bz := store.Get(publicKey)
+	if len(bz) == 0 {
+		return types.RecoveryRequest{}, false
+	}
+
+	var request types.RecoveryRequest
+	k.cdc.MustUnmarshal(bz, &request)
+	return request, true
+}
+
+// SetRecoveryRequest stores the recovery request
+func (k Keeper) SetRecoveryRequest(ctx sdk.Context, request types.RecoveryRequest) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryRequest)
+	store.Set(request.PublicKey, k.cdc.MustMarshal(&request))
+}
+
+// DeleteRecoveryRequest removes the recovery request of the new enclave public key
+func (k Keeper) DeleteRecoveryRequest(ctx sdk.Context, publicKey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryRequest)
+	store.Delete(publicKey)
+}
+
+// GetRecoveryRequests returns all recovery requests
+func (k Keeper) GetRecoveryRequests(ctx sdk.Context) []types.RecoveryRequest {
+	requests := []types.RecoveryRequest{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryRequest)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.RecoveryRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &request)
+		requests = append(requests, request)
+	}
+
+	return requests
+}
+
+// verifyQuote verifies the attestation quote of an enclave and returns its report. The quote
+// must be signed, commit to the enclave public key and match an allowed measurement.
+func (k Keeper) verifyQuote(ctx sdk.Context, publicKey, quote []byte) (types.Report, error) {
+	if k.quoteVerifier == nil {
+		return types.Report{}, types.ErrQuoteVerifierNotSet
+	}
+
+	report, err := types.ParseQuote(quote)
+	if err != nil {
+		return types.Report{}, err
+	}
+	if report.Debug {
+		return types.Report{}, errorsmod.Wrap(types.ErrInvalidQuote, "enclave runs in debug mode")
+	}
+	if !bytes.Equal(report.ReportData[:types.PublicKeySize], publicKey) {
+		return types.Report{}, errorsmod.Wrap(types.ErrInvalidQuote, "report data doesn't commit to the public key")
+	}
+	if !k.GetParams(ctx).IsAllowed(report) {
+		return types.Report{}, errorsmod.Wrapf(
+			types.ErrMeasurementNotAllowed, "mr_enclave %x, mr_signer %x, isv_svn %d",
+			report.MrEnclave, report.MrSigner, report.IsvSvn,
+		)
+	}
+	if err := k.quoteVerifier.VerifyQuote(quote, ctx.BlockTime()); err != nil {
+		return types.Report{}, errorsmod.Wrap(types.ErrInvalidQuote, err.Error())
+	}
+
+	return report, nil
+}
+
+// getOperatorNodes returns the nodes registered by the operator
+func (k Keeper) getOperatorNodes(ctx sdk.Context, operator string) []types.Node {
+	nodes := []types.Node{}
+	for _, node := range k.GetNodes(ctx) {
+		if node.Operator == operator {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// recoverNode registers the new enclave of the recovery request in place of the nodes of its
+// operator, which lost their sealed data. The request is kept with its shares, so the new
+// enclave can fetch and combine them, until the operator requests another recovery.
+func (k Keeper) recoverNode(ctx sdk.Context, request *types.RecoveryRequest) {
+	for _, node := range k.getOperatorNodes(ctx, request.Operator) {
+		k.DeleteNode(ctx, node.PublicKey)
+	}
+
+	node := request.Node()
+	node.RegisteredHeight = ctx.BlockHeight()
+	node.RegisteredTime = ctx.BlockTime()
+	k.SetNode(ctx, node)
+	request.Recovered = true
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecoverNode,
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(node.PublicKey)),
+			sdk.NewAttribute(types.AttributeKeyOperator, node.Operator),
+			sdk.NewAttribute(types.AttributeKeyMrEnclave, hex.EncodeToString(node.MrEnclave)),
+			sdk.NewAttribute(types.AttributeKeyMrSigner, hex.EncodeToString(node.MrSigner)),
+			sdk.NewAttribute(types.AttributeKeyShares, strconv.Itoa(len(request.Shares))),
+		),
+	)
+	k.Logger(ctx).Info("node recovered", "public-key", hex.EncodeToString(node.PublicKey), "operator", node.Operator)
+}
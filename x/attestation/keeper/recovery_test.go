@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/attestation/types"
+)
+
+// registerHelper registers a node of another operator which shares to the recovered enclave
+func (suite *KeeperTestSuite) registerHelper(operator string, publicKey []byte) {
+	_, err := suite.keeper.RegisterNode(suite.ctx, &types.MsgRegisterNode{
+		Operator:  operator,
+		PublicKey: publicKey,
+		Quote:     newQuote(suite.mrEnclave, suite.mrSigner, publicKey, false),
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) requestRecovery(publicKey []byte) error {
+	_, err := suite.keeper.RequestRecovery(suite.ctx, &types.MsgRequestRecovery{
+		Operator:  suite.operator,
+		PublicKey: publicKey,
+		Quote:     newQuote(suite.mrEnclave, suite.mrSigner, publicKey, false),
+	})
+	return err
+}
+
+func (suite *KeeperTestSuite) submitShare(operator string, nodePublicKey, recoveryPublicKey []byte) (bool, error) {
+	res, err := suite.keeper.SubmitRecoveryShare(suite.ctx, &types.MsgSubmitRecoveryShare{
+		Operator:          operator,
+		NodePublicKey:     nodePublicKey,
+		RecoveryPublicKey: recoveryPublicKey,
+		EncryptedShare:    []byte{0xaa},
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Recovered, nil
+}
+
+func (suite *KeeperTestSuite) setRecoveryThreshold(threshold uint32) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RecoveryThreshold = threshold
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+}
+
+func (suite *KeeperTestSuite) TestRecovery() {
+	helper1 := sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes()).String()
+	helper2 := sdk.AccAddress(common.HexToAddress("0x3000000000000000000000000000000000000003").Bytes()).String()
+	helperKey1 := bytes.Repeat([]byte{5}, types.PublicKeySize)
+	helperKey2 := bytes.Repeat([]byte{6}, types.PublicKeySize)
+	recoveryKey := bytes.Repeat([]byte{7}, types.PublicKeySize)
+
+	suite.Require().ErrorIs(suite.requestRecovery(recoveryKey), types.ErrRecoveryDisabled)
+
+	suite.setRecoveryThreshold(2)
+	suite.Require().ErrorIs(suite.requestRecovery(recoveryKey), types.ErrNodeNotFound, "nothing to recover")
+
+	suite.Require().NoError(suite.registerNode(suite.operator, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)))
+	suite.registerHelper(helper1, helperKey1)
+	suite.registerHelper(helper2, helperKey2)
+
+	suite.Require().ErrorIs(suite.requestRecovery(helperKey1), types.ErrNodeAlreadyRegistered)
+	suite.Require().NoError(suite.requestRecovery(recoveryKey))
+
+	request, found := suite.keeper.GetRecoveryRequest(suite.ctx, recoveryKey)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.operator, request.Operator)
+	suite.Require().Empty(request.Shares)
+
+	_, err := suite.submitShare(helper1, helperKey1, suite.publicKey)
+	suite.Require().ErrorIs(err, types.ErrRecoveryRequestNotFound)
+	_, err = suite.submitShare(suite.operator, suite.publicKey, recoveryKey)
+	suite.Require().ErrorIs(err, types.ErrInvalidRecoveryShare, "operator can't share to its own enclave")
+	_, err = suite.submitShare(helper1, helperKey2, recoveryKey)
+	suite.Require().ErrorIs(err, types.ErrNodeNotFound, "node of another operator")
+
+	recovered, err := suite.submitShare(helper1, helperKey1, recoveryKey)
+	suite.Require().NoError(err)
+	suite.Require().False(recovered)
+	_, err = suite.submitShare(helper1, helperKey1, recoveryKey)
+	suite.Require().ErrorIs(err, types.ErrInvalidRecoveryShare, "duplicate share")
+	suite.Require().False(suite.keeper.IsNodeRegistered(suite.ctx, recoveryKey))
+
+	// the threshold share registers the new enclave in place of the lost one
+	suite.ctx = suite.ctx.WithBlockHeight(2)
+	recovered, err = suite.submitShare(helper2, helperKey2, recoveryKey)
+	suite.Require().NoError(err)
+	suite.Require().True(recovered)
+	suite.Require().True(suite.keeper.IsNodeRegistered(suite.ctx, recoveryKey))
+	suite.Require().False(suite.keeper.IsNodeRegistered(suite.ctx, suite.publicKey))
+
+	node, _ := suite.keeper.GetNode(suite.ctx, recoveryKey)
+	suite.Require().Equal(suite.operator, node.Operator)
+	suite.Require().Equal(int64(2), node.RegisteredHeight)
+
+	// the shares stay available to the new enclave
+	request, _ = suite.keeper.GetRecoveryRequest(suite.ctx, recoveryKey)
+	suite.Require().True(request.Recovered)
+	suite.Require().Len(request.Shares, 2)
+
+	_, err = suite.submitShare(helper1, helperKey1, recoveryKey)
+	suite.Require().ErrorIs(err, types.ErrInvalidRecoveryShare, "already recovered")
+}
+
+func (suite *KeeperTestSuite) TestRecoveryReplacesRequest() {
+	suite.setRecoveryThreshold(1)
+	suite.Require().NoError(suite.registerNode(suite.operator, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)))
+
+	first := bytes.Repeat([]byte{7}, types.PublicKeySize)
+	second := bytes.Repeat([]byte{8}, types.PublicKeySize)
+	suite.Require().NoError(suite.requestRecovery(first))
+	suite.Require().NoError(suite.requestRecovery(second))
+
+	requests := suite.keeper.GetRecoveryRequests(suite.ctx)
+	suite.Require().Len(requests, 1)
+	suite.Require().Equal(second, requests[0].PublicKey)
+}
+
+func (suite *KeeperTestSuite) TestRecoveryHelperNotAttested() {
+	helper := sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes()).String()
+	helperKey := bytes.Repeat([]byte{5}, types.PublicKeySize)
+	recoveryKey := bytes.Repeat([]byte{7}, types.PublicKeySize)
+
+	suite.setRecoveryThreshold(1)
+	suite.Require().NoError(suite.registerNode(suite.operator, newQuote(suite.mrEnclave, suite.mrSigner, suite.publicKey, false)))
+	suite.registerHelper(helper, helperKey)
+	suite.Require().NoError(suite.requestRecovery(recoveryKey))
+
+	node, _ := suite.keeper.GetNode(suite.ctx, helperKey)
+	node.Expired = true
+	suite.keeper.SetNode(suite.ctx, node)
+
+	_, err := suite.submitShare(helper, helperKey, recoveryKey)
+	suite.Require().ErrorIs(err, types.ErrInvalidRecoveryShare)
+	suite.Require().False(suite.keeper.IsNodeRegistered(suite.ctx, recoveryKey))
+}
@@ -0,0 +1,2029 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/attestation/v1/attestation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the attestation module parameters
+type Params struct {
+	// allowed_measurements is the list of enclave measurements accepted for
+	// node registration
+	AllowedMeasurements []Measurement `protobuf:"bytes,1,rep,name=allowed_measurements,json=allowedMeasurements,proto3" json:"allowed_measurements" yaml:"allowed_measurements"`
+	// min_isv_svn is the minimum security version of registered enclaves
+	MinIsvSvn uint32 `protobuf:"varint,2,opt,name=min_isv_svn,json=minIsvSvn,proto3" json:"min_isv_svn,omitempty" yaml:"min_isv_svn"`
+	// attestation_validity is the period after which a node must register again
+	// with a fresh quote, nodes with an older attestation are flagged as
+	// expired. A zero period disables the expiry.
+	AttestationValidity time.Duration `protobuf:"bytes,3,opt,name=attestation_validity,json=attestationValidity,proto3,stdduration" json:"attestation_validity" yaml:"attestation_validity"`
+	// recovery_threshold is the number of attested validators which must submit
+	// a recovery share before a recovered enclave is registered. A zero
+	// threshold disables recovery.
+	RecoveryThreshold uint32 `protobuf:"varint,4,opt,name=recovery_threshold,json=recoveryThreshold,proto3" json:"recovery_threshold,omitempty" yaml:"recovery_threshold"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2375d045a5dcc5f8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowedMeasurements() []Measurement {
+	if m != nil {
+		return m.AllowedMeasurements
+	}
+	return nil
+}
+
+func (m *Params) GetMinIsvSvn() uint32 {
+	if m != nil {
+		return m.MinIsvSvn
+	}
+	return 0
+}
+
+func (m *Params) GetAttestationValidity() time.Duration {
+	if m != nil {
+		return m.AttestationValidity
+	}
+	return 0
+}
+
+func (m *Params) GetRecoveryThreshold() uint32 {
+	if m != nil {
+		return m.RecoveryThreshold
+	}
+	return 0
+}
+
+// Measurement defines an enclave identity approved by governance. An empty
+// field matches any value, e.g. a measurement with only mr_signer set accepts
+// every enclave signed by that key.
+type Measurement struct {
+	// mr_enclave is the 32 bytes hash of the enclave code and data
+	MrEnclave []byte `protobuf:"bytes,1,opt,name=mr_enclave,json=mrEnclave,proto3" json:"mr_enclave,omitempty"`
+	// mr_signer is the 32 bytes hash of the enclave signing key
+	MrSigner []byte `protobuf:"bytes,2,opt,name=mr_signer,json=mrSigner,proto3" json:"mr_signer,omitempty"`
+}
+
+func (m *Measurement) Reset()         { *m = Measurement{} }
+func (m *Measurement) String() string { return proto.CompactTextString(m) }
+func (*Measurement) ProtoMessage()    {}
+func (*Measurement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2375d045a5dcc5f8, []int{1}
+}
+func (m *Measurement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Measurement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Measurement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Measurement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Measurement.Merge(m, src)
+}
+func (m *Measurement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Measurement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Measurement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Measurement proto.InternalMessageInfo
+
+func (m *Measurement) GetMrEnclave() []byte {
+	if m != nil {
+		return m.MrEnclave
+	}
+	return nil
+}
+
+func (m *Measurement) GetMrSigner() []byte {
+	if m != nil {
+		return m.MrSigner
+	}
+	return nil
+}
+
+// Node defines an enclave registered on chain with a verified attestation
+// quote
+type Node struct {
+	// public_key is the x25519 public key of the enclave, committed to by the
+	// quote
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// operator is the bech32 address of the account which registered the node
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// mr_enclave is the MRENCLAVE of the attested enclave
+	MrEnclave []byte `protobuf:"bytes,3,opt,name=mr_enclave,json=mrEnclave,proto3" json:"mr_enclave,omitempty"`
+	// mr_signer is the MRSIGNER of the attested enclave
+	MrSigner []byte `protobuf:"bytes,4,opt,name=mr_signer,json=mrSigner,proto3" json:"mr_signer,omitempty"`
+	// isv_svn is the security version of the attested enclave
+	IsvSvn uint32 `protobuf:"varint,5,opt,name=isv_svn,json=isvSvn,proto3" json:"isv_svn,omitempty"`
+	// registered_height is the block height of the last registration
+	RegisteredHeight int64 `protobuf:"varint,6,opt,name=registered_height,json=registeredHeight,proto3" json:"registered_height,omitempty"`
+	// registered_time is the block time of the last registration
+	RegisteredTime time.Time `protobuf:"bytes,7,opt,name=registered_time,json=registeredTime,proto3,stdtime" json:"registered_time"`
+	// expired is true if the attestation of the node is older than the
+	// attestation validity, the node must register again to be trusted
+	Expired bool `protobuf:"varint,8,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (m *Node) Reset()         { *m = Node{} }
+func (m *Node) String() string { return proto.CompactTextString(m) }
+func (*Node) ProtoMessage()    {}
+func (*Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2375d045a5dcc5f8, []int{2}
+}
+func (m *Node) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Node.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Node.Merge(m, src)
+}
+func (m *Node) XXX_Size() int {
+	return m.Size()
+}
+func (m *Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_Node.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Node proto.InternalMessageInfo
+
+func (m *Node) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Node) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *Node) GetMrEnclave() []byte {
+	if m != nil {
+		return m.MrEnclave
+	}
+	return nil
+}
+
+func (m *Node) GetMrSigner() []byte {
+	if m != nil {
+		return m.MrSigner
+	}
+	return nil
+}
+
+func (m *Node) GetIsvSvn() uint32 {
+	if m != nil {
+		return m.IsvSvn
+	}
+	return 0
+}
+
+func (m *Node) GetRegisteredHeight() int64 {
+	if m != nil {
+		return m.RegisteredHeight
+	}
+	return 0
+}
+
+func (m *Node) GetRegisteredTime() time.Time {
+	if m != nil {
+		return m.RegisteredTime
+	}
+	return time.Time{}
+}
+
+func (m *Node) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+// RecoveryRequest defines the request of an operator which lost the sealed
+// data of its enclave to re-establish its registration with a new enclave.
+// The attested enclaves of other validators re-share the master seed to the
+// new enclave.
+type RecoveryRequest struct {
+	// operator is the bech32 address of the account which requested the
+	// recovery, it must have registered a node before
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// public_key is the x25519 public key of the new enclave, committed to by
+	// the quote
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// mr_enclave is the MRENCLAVE of the attested enclave
+	MrEnclave []byte `protobuf:"bytes,3,opt,name=mr_enclave,json=mrEnclave,proto3" json:"mr_enclave,omitempty"`
+	// mr_signer is the MRSIGNER of the attested enclave
+	MrSigner []byte `protobuf:"bytes,4,opt,name=mr_signer,json=mrSigner,proto3" json:"mr_signer,omitempty"`
+	// isv_svn is the security version of the attested enclave
+	IsvSvn uint32 `protobuf:"varint,5,opt,name=isv_svn,json=isvSvn,proto3" json:"isv_svn,omitempty"`
+	// requested_height is the block height of the request
+	RequestedHeight int64 `protobuf:"varint,6,opt,name=requested_height,json=requestedHeight,proto3" json:"requested_height,omitempty"`
+	// shares are the recovery shares submitted for the new enclave
+	Shares []RecoveryShare `protobuf:"bytes,7,rep,name=shares,proto3" json:"shares"`
+	// recovered is true once the threshold of shares was reached and the new
+	// enclave was registered
+	Recovered bool `protobuf:"varint,8,opt,name=recovered,proto3" json:"recovered,omitempty"`
+}
+
+func (m *RecoveryRequest) Reset()         { *m = RecoveryRequest{} }
+func (m *RecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*RecoveryRequest) ProtoMessage()    {}
+func (*RecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2375d045a5dcc5f8, []int{3}
+}
+func (m *RecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryRequest.Merge(m, src)
+}
+func (m *RecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryRequest proto.InternalMessageInfo
+
+func (m *RecoveryRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *RecoveryRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *RecoveryRequest) GetMrEnclave() []byte {
+	if m != nil {
+		return m.MrEnclave
+	}
+	return nil
+}
+
+func (m *RecoveryRequest) GetMrSigner() []byte {
+	if m != nil {
+		return m.MrSigner
+	}
+	return nil
+}
+
+func (m *RecoveryRequest) GetIsvSvn() uint32 {
+	if m != nil {
+		return m.IsvSvn
+	}
+	return 0
+}
+
+func (m *RecoveryRequest) GetRequestedHeight() int64 {
+	if m != nil {
+		return m.RequestedHeight
+	}
+	return 0
+}
+
+func (m *RecoveryRequest) GetShares() []RecoveryShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *RecoveryRequest) GetRecovered() bool {
+	if m != nil {
+		return m.Recovered
+	}
+	return false
+}
+
+// RecoveryShare defines a share of the master seed re-shared by the enclave of
+// an attested validator to the new enclave of a recovery request
+type RecoveryShare struct {
+	// operator is the bech32 address of the operator of the sharing node
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// node_public_key is the public key of the registered enclave which
+	// produced the share
+	NodePublicKey []byte `protobuf:"bytes,2,opt,name=node_public_key,json=nodePublicKey,proto3" json:"node_public_key,omitempty"`
+	// encrypted_share is the share encrypted to the public key of the new
+	// enclave
+	EncryptedShare []byte `protobuf:"bytes,3,opt,name=encrypted_share,json=encryptedShare,proto3" json:"encrypted_share,omitempty"`
+}
+
+func (m *RecoveryShare) Reset()         { *m = RecoveryShare{} }
+func (m *RecoveryShare) String() string { return proto.CompactTextString(m) }
+func (*RecoveryShare) ProtoMessage()    {}
+func (*RecoveryShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2375d045a5dcc5f8, []int{4}
+}
+func (m *RecoveryShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryShare.Merge(m, src)
+}
+func (m *RecoveryShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryShare proto.InternalMessageInfo
+
+func (m *RecoveryShare) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *RecoveryShare) GetNodePublicKey() []byte {
+	if m != nil {
+		return m.NodePublicKey
+	}
+	return nil
+}
+
+func (m *RecoveryShare) GetEncryptedShare() []byte {
+	if m != nil {
+		return m.EncryptedShare
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ethermint.attestation.v1.Params")
+	proto.RegisterType((*Measurement)(nil), "ethermint.attestation.v1.Measurement")
+	proto.RegisterType((*Node)(nil), "ethermint.attestation.v1.Node")
+	proto.RegisterType((*RecoveryRequest)(nil), "ethermint.attestation.v1.RecoveryRequest")
+	proto.RegisterType((*RecoveryShare)(nil), "ethermint.attestation.v1.RecoveryShare")
+}
+
+func init() {
+	proto.RegisterFile("ethermint/attestation/v1/attestation.proto", fileDescriptor_2375d045a5dcc5f8)
+}
+
+var fileDescriptor_2375d045a5dcc5f8 = []byte{
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0xe3, 0x24, 0x37, 0x1f, 0x93, 0xb6, 0x69, 0xa7, 0xd5, 0xbd, 0x6e, 0x7a, 0x9b, 0x44,
+	0x46, 0xd0, 0x00, 0x92, 0xa3, 0x16, 0x89, 0x05, 0xcb, 0x88, 0x4a, 0xad, 0xa0, 0xa8, 0x72, 0x2b,
+	0x16, 0x6c, 0x2c, 0x27, 0x3e, 0x38, 0x23, 0x3c, 0x9e, 0x30, 0x33, 0x31, 0x8d, 0x04, 0x3b, 0x1e,
+	0xa0, 0x4b, 0x1e, 0x82, 0x0d, 0x6f, 0xd1, 0x65, 0x97, 0xac, 0x02, 0x6a, 0xdf, 0xa0, 0x4f, 0x80,
+	0xfc, 0x11, 0xc7, 0x49, 0xda, 0xee, 0xd8, 0xf9, 0xfc, 0xcf, 0x99, 0xff, 0x1c, 0xff, 0xce, 0xb1,
+	0xd1, 0x13, 0x90, 0x7d, 0xe0, 0x94, 0x78, 0xb2, 0x6d, 0x49, 0x09, 0x42, 0x5a, 0x92, 0x30, 0xaf,
+	0xed, 0xef, 0xa6, 0x43, 0x7d, 0xc0, 0x99, 0x64, 0x58, 0x4d, 0x6a, 0xf5, 0x74, 0xd2, 0xdf, 0xad,
+	0x6d, 0x38, 0xcc, 0x61, 0x61, 0x51, 0x3b, 0x78, 0x8a, 0xea, 0x6b, 0x75, 0x87, 0x31, 0xc7, 0x85,
+	0x76, 0x18, 0x75, 0x87, 0xef, 0xdb, 0xf6, 0x90, 0xa7, 0xfc, 0x6a, 0x8d, 0xf9, 0xbc, 0x24, 0x34,
+	0x70, 0xa5, 0x83, 0xa8, 0x40, 0xfb, 0x9a, 0x43, 0x85, 0x63, 0x8b, 0x5b, 0x54, 0xe0, 0x2f, 0x68,
+	0xc3, 0x72, 0x5d, 0xf6, 0x09, 0x6c, 0x93, 0x82, 0x25, 0x86, 0x1c, 0x28, 0x78, 0x52, 0xa8, 0x4a,
+	0x33, 0xd7, 0xaa, 0xec, 0x3d, 0xd4, 0xef, 0x6a, 0x4d, 0x3f, 0x9a, 0x56, 0x77, 0x1e, 0x5c, 0x8c,
+	0x1b, 0x99, 0x9b, 0x71, 0x63, 0x6b, 0x64, 0x51, 0xf7, 0x85, 0x76, 0x9b, 0xa1, 0x66, 0xac, 0xc7,
+	0x72, 0xea, 0xa0, 0xc0, 0xcf, 0x51, 0x85, 0x12, 0xcf, 0x24, 0xc2, 0x37, 0x85, 0xef, 0xa9, 0xd9,
+	0xa6, 0xd2, 0x5a, 0xee, 0xfc, 0x7b, 0x33, 0x6e, 0xe0, 0xc8, 0x2a, 0x95, 0xd4, 0x8c, 0x32, 0x25,
+	0xde, 0xa1, 0xf0, 0x4f, 0x7c, 0x0f, 0x0f, 0xd1, 0x46, 0xaa, 0x1f, 0xd3, 0xb7, 0x5c, 0x62, 0x13,
+	0x39, 0x52, 0x73, 0x4d, 0xa5, 0x55, 0xd9, 0xdb, 0xd4, 0x23, 0x02, 0xfa, 0x84, 0x80, 0xfe, 0x32,
+	0x26, 0xd4, 0xd9, 0x99, 0x6b, 0xf5, 0x16, 0x13, 0xed, 0xdb, 0xaf, 0x86, 0x62, 0xac, 0xa7, 0x52,
+	0x6f, 0xe3, 0x0c, 0x7e, 0x8d, 0x30, 0x87, 0x1e, 0xf3, 0x81, 0x8f, 0x4c, 0xd9, 0xe7, 0x20, 0xfa,
+	0xcc, 0xb5, 0xd5, 0x7c, 0xd8, 0xf5, 0xf6, 0xcd, 0xb8, 0xb1, 0x19, 0xb9, 0x2e, 0xd6, 0x68, 0xc6,
+	0xda, 0x44, 0x3c, 0x4d, 0xb4, 0x43, 0x54, 0x49, 0xc1, 0xc0, 0xdb, 0x08, 0x51, 0x6e, 0x82, 0xd7,
+	0x73, 0x2d, 0x1f, 0x54, 0xa5, 0xa9, 0xb4, 0x96, 0x8c, 0x32, 0xe5, 0xfb, 0x91, 0x80, 0xb7, 0x50,
+	0x99, 0x72, 0x53, 0x10, 0xc7, 0x03, 0x1e, 0x82, 0x5a, 0x32, 0x4a, 0x94, 0x9f, 0x84, 0xb1, 0xf6,
+	0x3d, 0x8b, 0xf2, 0x6f, 0x98, 0x0d, 0x81, 0xc9, 0x60, 0xd8, 0x75, 0x49, 0xcf, 0xfc, 0x00, 0xa3,
+	0x89, 0x49, 0xa4, 0xbc, 0x82, 0x11, 0xae, 0xa1, 0x12, 0x1b, 0x00, 0xb7, 0x24, 0x8b, 0x3c, 0xca,
+	0x46, 0x12, 0xcf, 0xdd, 0x9f, 0xbb, 0xf7, 0xfe, 0xfc, 0xec, 0xfd, 0xf8, 0x3f, 0x54, 0x9c, 0xcc,
+	0xf0, 0x9f, 0x80, 0x86, 0x51, 0x20, 0xd1, 0xa0, 0x9e, 0xa2, 0x35, 0x0e, 0x0e, 0x11, 0x12, 0x38,
+	0xd8, 0x66, 0x1f, 0x88, 0xd3, 0x97, 0x6a, 0xa1, 0xa9, 0xb4, 0x72, 0xc6, 0xea, 0x34, 0x71, 0x10,
+	0xea, 0xf8, 0x08, 0x55, 0x53, 0xc5, 0xc1, 0xd6, 0xaa, 0xc5, 0x70, 0xa0, 0xb5, 0x85, 0x81, 0x9e,
+	0x4e, 0x56, 0xba, 0x53, 0x0a, 0x26, 0x7a, 0x1e, 0x8c, 0x6c, 0x65, 0x7a, 0x38, 0x48, 0x63, 0x15,
+	0x15, 0xe1, 0x6c, 0x40, 0x38, 0xd8, 0x6a, 0xa9, 0xa9, 0xb4, 0x4a, 0xc6, 0x24, 0xd4, 0x7e, 0x64,
+	0x51, 0xd5, 0x88, 0xe7, 0x61, 0xc0, 0xc7, 0x21, 0x08, 0x39, 0x83, 0x46, 0x59, 0x44, 0x93, 0xa2,
+	0x9a, 0x9d, 0xa7, 0xfa, 0x57, 0xc8, 0x3d, 0x46, 0xab, 0x3c, 0x6a, 0x6d, 0x1e, 0x5c, 0x35, 0xd1,
+	0x63, 0x6e, 0xfb, 0xa8, 0x20, 0xfa, 0x16, 0x07, 0xa1, 0x16, 0xc3, 0xcf, 0x76, 0xe7, 0xee, 0xcf,
+	0x76, 0xf2, 0xd6, 0x27, 0x41, 0x7d, 0x27, 0x1f, 0xb0, 0x33, 0xe2, 0xc3, 0xf8, 0x7f, 0x54, 0x8e,
+	0x97, 0x34, 0x21, 0x36, 0x15, 0xb4, 0xcf, 0x68, 0x79, 0xe6, 0xf0, 0xbd, 0xc0, 0x1e, 0xa1, 0xaa,
+	0xc7, 0x6c, 0x30, 0x17, 0xa8, 0x2d, 0x07, 0xf2, 0x71, 0x42, 0x6e, 0x07, 0x55, 0xc1, 0xeb, 0xf1,
+	0xd1, 0x20, 0x78, 0xc9, 0xb0, 0x8d, 0x18, 0xdf, 0x4a, 0x22, 0x47, 0x9d, 0x1e, 0x5c, 0x5c, 0xd5,
+	0x95, 0xcb, 0xab, 0xba, 0xf2, 0xfb, 0xaa, 0xae, 0x9c, 0x5f, 0xd7, 0x33, 0x97, 0xd7, 0xf5, 0xcc,
+	0xcf, 0xeb, 0x7a, 0xe6, 0x9d, 0xee, 0x10, 0xd9, 0x1f, 0x76, 0xf5, 0x1e, 0xa3, 0x6d, 0xf0, 0x29,
+	0x13, 0xed, 0xe9, 0xaf, 0xf7, 0x6c, 0xe6, 0xe7, 0x2b, 0x47, 0x03, 0x10, 0xdd, 0x42, 0xb8, 0x43,
+	0xcf, 0xfe, 0x0c, 0x00, 0x0f, 0x12, 0xae, 0xa2, 0xa2, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryThreshold != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.RecoveryThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AttestationValidity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AttestationValidity):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAttestation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.MinIsvSvn != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.MinIsvSvn))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedMeasurements) > 0 {
+		for iNdEx := len(m.AllowedMeasurements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedMeasurements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Measurement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Measurement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Measurement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MrSigner) > 0 {
+		i -= len(m.MrSigner)
+		copy(dAtA[i:], m.MrSigner)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.MrSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MrEnclave) > 0 {
+		i -= len(m.MrEnclave)
+		copy(dAtA[i:], m.MrEnclave)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.MrEnclave)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Node) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Node) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RegisteredTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RegisteredTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAttestation(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.RegisteredHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.RegisteredHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsvSvn != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.IsvSvn))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MrSigner) > 0 {
+		i -= len(m.MrSigner)
+		copy(dAtA[i:], m.MrSigner)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.MrSigner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MrEnclave) > 0 {
+		i -= len(m.MrEnclave)
+		copy(dAtA[i:], m.MrEnclave)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.MrEnclave)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recovered {
+		i--
+		if m.Recovered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.RequestedHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.RequestedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsvSvn != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.IsvSvn))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MrSigner) > 0 {
+		i -= len(m.MrSigner)
+		copy(dAtA[i:], m.MrSigner)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.MrSigner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MrEnclave) > 0 {
+		i -= len(m.MrEnclave)
+		copy(dAtA[i:], m.MrEnclave)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.MrEnclave)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedShare) > 0 {
+		i -= len(m.EncryptedShare)
+		copy(dAtA[i:], m.EncryptedShare)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EncryptedShare)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodePublicKey) > 0 {
+		i -= len(m.NodePublicKey)
+		copy(dAtA[i:], m.NodePublicKey)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.NodePublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMeasurements) > 0 {
+		for _, e := range m.AllowedMeasurements {
+			l = e.Size()
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	if m.MinIsvSvn != 0 {
+		n += 1 + sovAttestation(uint64(m.MinIsvSvn))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AttestationValidity)
+	n += 1 + l + sovAttestation(uint64(l))
+	if m.RecoveryThreshold != 0 {
+		n += 1 + sovAttestation(uint64(m.RecoveryThreshold))
+	}
+	return n
+}
+
+func (m *Measurement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MrEnclave)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.MrSigner)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *Node) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.MrEnclave)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.MrSigner)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.IsvSvn != 0 {
+		n += 1 + sovAttestation(uint64(m.IsvSvn))
+	}
+	if m.RegisteredHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.RegisteredHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RegisteredTime)
+	n += 1 + l + sovAttestation(uint64(l))
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *RecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.MrEnclave)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.MrSigner)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.IsvSvn != 0 {
+		n += 1 + sovAttestation(uint64(m.IsvSvn))
+	}
+	if m.RequestedHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.RequestedHeight))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	if m.Recovered {
+		n += 2
+	}
+	return n
+}
+
+func (m *RecoveryShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.NodePublicKey)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.EncryptedShare)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func sovAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestation(x uint64) (n int) {
+	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMeasurements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMeasurements = append(m.AllowedMeasurements, Measurement{})
+			if err := m.AllowedMeasurements[len(m.AllowedMeasurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIsvSvn", wireType)
+			}
+			m.MinIsvSvn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinIsvSvn |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationValidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AttestationValidity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryThreshold", wireType)
+			}
+			m.RecoveryThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Measurement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrEnclave", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrEnclave = append(m.MrEnclave[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrEnclave == nil {
+				m.MrEnclave = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrSigner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrSigner = append(m.MrSigner[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrSigner == nil {
+				m.MrSigner = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Node) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrEnclave", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrEnclave = append(m.MrEnclave[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrEnclave == nil {
+				m.MrEnclave = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrSigner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrSigner = append(m.MrSigner[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrSigner == nil {
+				m.MrSigner = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsvSvn", wireType)
+			}
+			m.IsvSvn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IsvSvn |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredHeight", wireType)
+			}
+			m.RegisteredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RegisteredTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrEnclave", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrEnclave = append(m.MrEnclave[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrEnclave == nil {
+				m.MrEnclave = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrSigner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrSigner = append(m.MrSigner[:0], dAtA[iNdEx:postIndex]...)
+			if m.MrSigner == nil {
+				m.MrSigner = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsvSvn", wireType)
+			}
+			m.IsvSvn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IsvSvn |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedHeight", wireType)
+			}
+			m.RequestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, RecoveryShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recovered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodePublicKey = append(m.NodePublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodePublicKey == nil {
+				m.NodePublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedShare = append(m.EncryptedShare[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedShare == nil {
+				m.EncryptedShare = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttestation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttestation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttestation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttestation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttestation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttestation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttestation = fmt.Errorf("proto: unexpected end of group")
+)
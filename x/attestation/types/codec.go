@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+	// ModuleCdc references the global attestation module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	registerNodeName      = "ethermint/attestation/MsgRegisterNode"
+	updateParamsName      = "ethermint/attestation/MsgUpdateParams"
+	allowMeasurementName  = "ethermint/attestation/MsgAllowMeasurement"
+	revokeMeasurementName = "ethermint/attestation/MsgRevokeMeasurement"
+	requestRecoveryName   = "ethermint/attestation/MsgRequestRecovery"
+	recoveryShareName     = "ethermint/attestation/MsgSubmitRecoveryShare"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces registers the client interfaces to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterNode{},
+		&MsgUpdateParams{},
+		&MsgAllowMeasurement{},
+		&MsgRevokeMeasurement{},
+		&MsgRequestRecovery{},
+		&MsgSubmitRecoveryShare{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterNode{}, registerNodeName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgAllowMeasurement{}, allowMeasurementName, nil)
+	cdc.RegisterConcrete(&MsgRevokeMeasurement{}, revokeMeasurementName, nil)
+	cdc.RegisterConcrete(&MsgRequestRecovery{}, requestRecoveryName, nil)
+	cdc.RegisterConcrete(&MsgSubmitRecoveryShare{}, recoveryShareName, nil)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrInvalidQuote = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrMeasurementNotAllowed
+	codeErrQuoteVerifierNotSet
+	codeErrNodeAlreadyRegistered
+	codeErrMeasurementNotFound
+	codeErrRecoveryDisabled
+	codeErrNodeNotFound
+	codeErrRecoveryRequestNotFound
+	codeErrInvalidRecoveryShare
+)
+
+var (
+	// ErrInvalidQuote returns an error if the attestation quote is malformed, doesn't pass
+	// verification or doesn't commit to the node public key
+	ErrInvalidQuote = errorsmod.Register(ModuleName, codeErrInvalidQuote, "invalid attestation quote")
+
+	// ErrMeasurementNotAllowed returns an error if the enclave measurement is not approved by governance
+	ErrMeasurementNotAllowed = errorsmod.Register(ModuleName, codeErrMeasurementNotAllowed, "enclave measurement not allowed")
+
+	// ErrQuoteVerifierNotSet returns an error if the app didn't set a quote verifier
+	ErrQuoteVerifierNotSet = errorsmod.Register(ModuleName, codeErrQuoteVerifierNotSet, "quote verifier not set")
+
+	// ErrNodeAlreadyRegistered returns an error if the node is registered by another operator
+	ErrNodeAlreadyRegistered = errorsmod.Register(ModuleName, codeErrNodeAlreadyRegistered, "node already registered")
+
+	// ErrMeasurementNotFound returns an error if the revoked measurement is not allowed
+	ErrMeasurementNotFound = errorsmod.Register(ModuleName, codeErrMeasurementNotFound, "measurement not found")
+
+	// ErrRecoveryDisabled returns an error if the recovery threshold param is zero
+	ErrRecoveryDisabled = errorsmod.Register(ModuleName, codeErrRecoveryDisabled, "enclave recovery disabled")
+
+	// ErrNodeNotFound returns an error if the operator has no registered node
+	ErrNodeNotFound = errorsmod.Register(ModuleName, codeErrNodeNotFound, "node not found")
+
+	// ErrRecoveryRequestNotFound returns an error if no recovery was requested for the enclave public key
+	ErrRecoveryRequestNotFound = errorsmod.Register(ModuleName, codeErrRecoveryRequestNotFound, "recovery request not found")
+
+	// ErrInvalidRecoveryShare returns an error if the recovery share can't be accepted for the request
+	ErrInvalidRecoveryShare = errorsmod.Register(ModuleName, codeErrInvalidRecoveryShare, "invalid recovery share")
+)
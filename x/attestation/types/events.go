@@ -0,0 +1,22 @@
+package types
+
+// attestation events
+const (
+	EventTypeRegisterNode      = "register_node"
+	EventTypeDeregisterNode    = "deregister_node"
+	EventTypeAllowMeasurement  = "allow_measurement"
+	EventTypeRevokeMeasurement = "revoke_measurement"
+	EventTypeExpireNode        = "expire_node"
+	EventTypeRequestRecovery   = "request_recovery"
+	EventTypeRecoveryShare     = "recovery_share"
+	EventTypeRecoverNode       = "recover_node"
+
+	AttributeKeyPublicKey        = "public_key"
+	AttributeKeyOperator         = "operator"
+	AttributeKeyMrEnclave        = "mr_enclave"
+	AttributeKeyMrSigner         = "mr_signer"
+	AttributeKeyIsvSvn           = "isv_svn"
+	AttributeKeyRegisteredHeight = "registered_height"
+	AttributeKeyNodePublicKey    = "node_public_key"
+	AttributeKeyShares           = "shares"
+)
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PublicKeySize is the size of the x25519 public key of an enclave
+const PublicKeySize = 32
+
+// MaxRecoveryShareSize is the max size of an encrypted recovery share
+const MaxRecoveryShareSize = 1024
+
+// DefaultGenesisState sets default attestation genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:           DefaultParams(),
+		Nodes:            []Node{},
+		RecoveryRequests: []RecoveryRequest{},
+	}
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, nodes []Node, recoveryRequests []RecoveryRequest) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		Nodes:            nodes,
+		RecoveryRequests: recoveryRequests,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Nodes))
+	for _, node := range gs.Nodes {
+		if err := node.Validate(); err != nil {
+			return err
+		}
+		key := hex.EncodeToString(node.PublicKey)
+		if seen[key] {
+			return fmt.Errorf("duplicate node %s", key)
+		}
+		seen[key] = true
+	}
+
+	seenRequests := make(map[string]bool, len(gs.RecoveryRequests))
+	for _, request := range gs.RecoveryRequests {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		key := hex.EncodeToString(request.PublicKey)
+		if seenRequests[key] {
+			return fmt.Errorf("duplicate recovery request %s", key)
+		}
+		seenRequests[key] = true
+	}
+
+	return gs.Params.Validate()
+}
+
+// Validate performs a stateless validation of the node
+func (n Node) Validate() error {
+	if len(n.PublicKey) != PublicKeySize {
+		return fmt.Errorf("node public key must be %d bytes, got %d", PublicKeySize, len(n.PublicKey))
+	}
+	if _, err := sdk.AccAddressFromBech32(n.Operator); err != nil {
+		return fmt.Errorf("invalid operator address %s: %w", n.Operator, err)
+	}
+	if len(n.MrEnclave) != MeasurementSize || len(n.MrSigner) != MeasurementSize {
+		return fmt.Errorf("measurements of node %x must be %d bytes", n.PublicKey, MeasurementSize)
+	}
+
+	return nil
+}
+
+// Report returns the attested fields of the node enclave
+func (n Node) Report() Report {
+	return Report{
+		MrEnclave: n.MrEnclave,
+		MrSigner:  n.MrSigner,
+		IsvSvn:    uint16(n.IsvSvn),
+	}
+}
+
+// Validate performs a stateless validation of the recovery request
+func (r RecoveryRequest) Validate() error {
+	if len(r.PublicKey) != PublicKeySize {
+		return fmt.Errorf("recovery public key must be %d bytes, got %d", PublicKeySize, len(r.PublicKey))
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Operator); err != nil {
+		return fmt.Errorf("invalid operator address %s: %w", r.Operator, err)
+	}
+	if len(r.MrEnclave) != MeasurementSize || len(r.MrSigner) != MeasurementSize {
+		return fmt.Errorf("measurements of recovery request %x must be %d bytes", r.PublicKey, MeasurementSize)
+	}
+
+	seen := make(map[string]bool, len(r.Shares))
+	for _, share := range r.Shares {
+		if _, err := sdk.AccAddressFromBech32(share.Operator); err != nil {
+			return fmt.Errorf("invalid share operator address %s: %w", share.Operator, err)
+		}
+		if share.Operator == r.Operator {
+			return fmt.Errorf("recovery request %x has a share of its own operator", r.PublicKey)
+		}
+		if seen[share.Operator] {
+			return fmt.Errorf("recovery request %x has duplicate shares of operator %s", r.PublicKey, share.Operator)
+		}
+		seen[share.Operator] = true
+
+		if len(share.NodePublicKey) != PublicKeySize {
+			return fmt.Errorf("share node public key must be %d bytes, got %d", PublicKeySize, len(share.NodePublicKey))
+		}
+		if len(share.EncryptedShare) == 0 || len(share.EncryptedShare) > MaxRecoveryShareSize {
+			return fmt.Errorf("encrypted share must be 1 to %d bytes", MaxRecoveryShareSize)
+		}
+	}
+
+	return nil
+}
+
+// HasShare returns true if the operator submitted a share for the request
+func (r RecoveryRequest) HasShare(operator string) bool {
+	for _, share := range r.Shares {
+		if share.Operator == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// Node returns the node registered once the recovery completes
+func (r RecoveryRequest) Node() Node {
+	return Node{
+		PublicKey: r.PublicKey,
+		Operator:  r.Operator,
+		MrEnclave: r.MrEnclave,
+		MrSigner:  r.MrSigner,
+		IsvSvn:    r.IsvSvn,
+	}
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	node := Node{
+		PublicKey:        bytes.Repeat([]byte{1}, PublicKeySize),
+		Operator:         sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes()).String(),
+		MrEnclave:        bytes.Repeat([]byte{2}, MeasurementSize),
+		MrSigner:         bytes.Repeat([]byte{3}, MeasurementSize),
+		RegisteredHeight: 10,
+	}
+	request := RecoveryRequest{
+		Operator:  node.Operator,
+		PublicKey: bytes.Repeat([]byte{4}, PublicKeySize),
+		MrEnclave: node.MrEnclave,
+		MrSigner:  node.MrSigner,
+		Shares: []RecoveryShare{{
+			Operator:       sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes()).String(),
+			NodePublicKey:  bytes.Repeat([]byte{5}, PublicKeySize),
+			EncryptedShare: []byte{6},
+		}},
+	}
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid", NewGenesisState(DefaultParams(), []Node{node}, nil), true},
+		{"invalid params", NewGenesisState(NewParams([]Measurement{{}}, 0, 0, 0), nil, nil), false},
+		{"duplicate node", NewGenesisState(DefaultParams(), []Node{node, node}, nil), false},
+		{
+			"short public key",
+			NewGenesisState(DefaultParams(), []Node{func() Node {
+				n := node
+				n.PublicKey = n.PublicKey[1:]
+				return n
+			}()}, nil),
+			false,
+		},
+		{
+			"invalid operator",
+			NewGenesisState(DefaultParams(), []Node{func() Node {
+				n := node
+				n.Operator = "invalid"
+				return n
+			}()}, nil),
+			false,
+		},
+		{
+			"missing measurement",
+			NewGenesisState(DefaultParams(), []Node{func() Node {
+				n := node
+				n.MrSigner = nil
+				return n
+			}()}, nil),
+			false,
+		},
+		{"valid recovery request", NewGenesisState(DefaultParams(), []Node{node}, []RecoveryRequest{request}), true},
+		{"duplicate recovery request", NewGenesisState(DefaultParams(), nil, []RecoveryRequest{request, request}), false},
+		{
+			"share of the requesting operator",
+			NewGenesisState(DefaultParams(), nil, []RecoveryRequest{func() RecoveryRequest {
+				r := request
+				r.Shares = []RecoveryShare{request.Shares[0]}
+				r.Shares[0].Operator = r.Operator
+				return r
+			}()}),
+			false,
+		},
+		{
+			"duplicate share",
+			NewGenesisState(DefaultParams(), nil, []RecoveryRequest{func() RecoveryRequest {
+				r := request
+				r.Shares = []RecoveryShare{request.Shares[0], request.Shares[0]}
+				return r
+			}()}),
+			false,
+		},
+		{
+			"empty share",
+			NewGenesisState(DefaultParams(), nil, []RecoveryRequest{func() RecoveryRequest {
+				r := request
+				r.Shares = []RecoveryShare{request.Shares[0]}
+				r.Shares[0].EncryptedShare = nil
+				return r
+			}()}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
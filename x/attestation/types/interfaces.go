@@ -0,0 +1,24 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// QuoteVerifier verifies the signature of an SGX DCAP quote and the collateral of its
+// certificate chain. It's implemented with the verification library of the enclave and must
+// be deterministic, i.e. it can only depend on the quote, the collateral embedded in the
+// binary and the given block time.
+type QuoteVerifier interface {
+	VerifyQuote(quote []byte, blockTime time.Time) error
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to query the state encryption key
+// epochs registered nodes must derive from the master seed
+type EVMKeeper interface {
+	GetKeyEpochs(ctx sdk.Context) []evmtypes.KeyEpoch
+	GetKeyEpochAtHeight(ctx sdk.Context, height int64) evmtypes.KeyEpoch
+}
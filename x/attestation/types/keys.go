@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName string name of module
+	ModuleName = "attestation"
+
+	// StoreKey key for the attestation store
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the attestation persistent store
+const (
+	prefixNode = iota + 1
+	prefixParams
+	prefixRecoveryRequest
+)
+
+// KVStore key prefixes
+var (
+	// KeyPrefixNode indexes registered nodes by their public key
+	KeyPrefixNode   = []byte{prefixNode}
+	KeyPrefixParams = []byte{prefixParams}
+	// KeyPrefixRecoveryRequest indexes recovery requests by the public key of the new enclave
+	KeyPrefixRecoveryRequest = []byte{prefixRecoveryRequest}
+)
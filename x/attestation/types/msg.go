@@ -0,0 +1,163 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterNode{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAllowMeasurement{}
+	_ sdk.Msg = &MsgRevokeMeasurement{}
+	_ sdk.Msg = &MsgRequestRecovery{}
+	_ sdk.Msg = &MsgSubmitRecoveryShare{}
+)
+
+// GetSigners returns the expected signers for a MsgRegisterNode message.
+func (m *MsgRegisterNode) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterNode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	if len(m.PublicKey) != PublicKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "public key must be %d bytes", PublicKeySize)
+	}
+	if _, err := ParseQuote(m.Quote); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterNode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgAllowMeasurement message.
+func (m *MsgAllowMeasurement) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAllowMeasurement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := m.Measurement.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAllowMeasurement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRevokeMeasurement message.
+func (m *MsgRevokeMeasurement) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRevokeMeasurement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := m.Measurement.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRevokeMeasurement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRequestRecovery message.
+func (m *MsgRequestRecovery) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRequestRecovery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	if len(m.PublicKey) != PublicKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "public key must be %d bytes", PublicKeySize)
+	}
+	if _, err := ParseQuote(m.Quote); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRequestRecovery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitRecoveryShare message.
+func (m *MsgSubmitRecoveryShare) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSubmitRecoveryShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	if len(m.NodePublicKey) != PublicKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "node public key must be %d bytes", PublicKeySize)
+	}
+	if len(m.RecoveryPublicKey) != PublicKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "recovery public key must be %d bytes", PublicKeySize)
+	}
+	if len(m.EncryptedShare) == 0 || len(m.EncryptedShare) > MaxRecoveryShareSize {
+		return errorsmod.Wrapf(ErrInvalidRecoveryShare, "encrypted share must be 1 to %d bytes", MaxRecoveryShareSize)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSubmitRecoveryShare) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MeasurementSize is the size of MRENCLAVE and MRSIGNER values
+const MeasurementSize = 32
+
+// DefaultAttestationValidity is the default period after which nodes must register again
+const DefaultAttestationValidity = 30 * 24 * time.Hour
+
+// NewParams creates a new Params instance
+func NewParams(allowedMeasurements []Measurement, minIsvSvn uint32, attestationValidity time.Duration, recoveryThreshold uint32) Params {
+	return Params{
+		AllowedMeasurements: allowedMeasurements,
+		MinIsvSvn:           minIsvSvn,
+		AttestationValidity: attestationValidity,
+		RecoveryThreshold:   recoveryThreshold,
+	}
+}
+
+// DefaultParams returns default attestation module parameters. No measurement is allowed, so
+// nodes can only register once governance approved an enclave build. Enclave recovery is
+// disabled until governance sets a recovery threshold.
+func DefaultParams() Params {
+	return NewParams([]Measurement{}, 0, DefaultAttestationValidity, 0)
+}
+
+// Validate performs basic validation on attestation parameters.
+func (p Params) Validate() error {
+	if p.AttestationValidity < 0 {
+		return fmt.Errorf("attestation validity cannot be negative: %s", p.AttestationValidity)
+	}
+
+	for i, measurement := range p.AllowedMeasurements {
+		if err := measurement.Validate(); err != nil {
+			return fmt.Errorf("invalid measurement %d: %w", i, err)
+		}
+		for _, other := range p.AllowedMeasurements[:i] {
+			if measurement.Equal(other) {
+				return fmt.Errorf("duplicate measurement %d", i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsAllowed returns true if the report matches one of the allowed measurements and its security
+// version is not lower than the minimum
+func (p Params) IsAllowed(report Report) bool {
+	if uint32(report.IsvSvn) < p.MinIsvSvn {
+		return false
+	}
+	for _, measurement := range p.AllowedMeasurements {
+		if measurement.Matches(report) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAttestationExpired returns true if the node attestation is older than the attestation
+// validity at the given block time
+func (p Params) IsAttestationExpired(node Node, blockTime time.Time) bool {
+	if p.AttestationValidity == 0 {
+		return false
+	}
+	return !blockTime.Before(node.RegisteredTime.Add(p.AttestationValidity))
+}
+
+// IsRecoveryEnabled returns true if operators can recover their enclave registration from the
+// shares of other attested validators
+func (p Params) IsRecoveryEnabled() bool {
+	return p.RecoveryThreshold > 0
+}
+
+// AllowMeasurement adds the measurement to the allowed measurements
+func (p *Params) AllowMeasurement(measurement Measurement) error {
+	if err := measurement.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	for _, allowed := range p.AllowedMeasurements {
+		if allowed.Equal(measurement) {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, "measurement is already allowed")
+		}
+	}
+
+	p.AllowedMeasurements = append(p.AllowedMeasurements, measurement)
+	return nil
+}
+
+// RevokeMeasurement removes the measurement from the allowed measurements
+func (p *Params) RevokeMeasurement(measurement Measurement) error {
+	for i, allowed := range p.AllowedMeasurements {
+		if allowed.Equal(measurement) {
+			p.AllowedMeasurements = append(p.AllowedMeasurements[:i:i], p.AllowedMeasurements[i+1:]...)
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(ErrMeasurementNotFound, "mr_enclave %x, mr_signer %x", measurement.MrEnclave, measurement.MrSigner)
+}
+
+// Validate performs a stateless validation of the measurement
+func (m Measurement) Validate() error {
+	if len(m.MrEnclave) == 0 && len(m.MrSigner) == 0 {
+		return fmt.Errorf("mr_enclave or mr_signer must be set")
+	}
+	if len(m.MrEnclave) != 0 && len(m.MrEnclave) != MeasurementSize {
+		return fmt.Errorf("mr_enclave must be %d bytes, got %d", MeasurementSize, len(m.MrEnclave))
+	}
+	if len(m.MrSigner) != 0 && len(m.MrSigner) != MeasurementSize {
+		return fmt.Errorf("mr_signer must be %d bytes, got %d", MeasurementSize, len(m.MrSigner))
+	}
+
+	return nil
+}
+
+// Equal returns true if both measurements have the same values
+func (m Measurement) Equal(other Measurement) bool {
+	return bytes.Equal(m.MrEnclave, other.MrEnclave) && bytes.Equal(m.MrSigner, other.MrSigner)
+}
+
+// Matches returns true if the report has the values set in the measurement
+func (m Measurement) Matches(report Report) bool {
+	if len(m.MrEnclave) != 0 && !bytes.Equal(m.MrEnclave, report.MrEnclave) {
+		return false
+	}
+	if len(m.MrSigner) != 0 && !bytes.Equal(m.MrSigner, report.MrSigner) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	mrEnclave := bytes.Repeat([]byte{1}, MeasurementSize)
+	mrSigner := bytes.Repeat([]byte{2}, MeasurementSize)
+
+	testCases := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"mr_enclave", NewParams([]Measurement{{MrEnclave: mrEnclave}}, 0, 0, 0), true},
+		{"mr_signer", NewParams([]Measurement{{MrSigner: mrSigner}}, 0, 0, 0), true},
+		{"both", NewParams([]Measurement{{MrEnclave: mrEnclave, MrSigner: mrSigner}, {MrSigner: mrSigner}}, 0, 0, 0), true},
+		{"empty measurement", NewParams([]Measurement{{}}, 0, 0, 0), false},
+		{"short mr_enclave", NewParams([]Measurement{{MrEnclave: mrEnclave[1:]}}, 0, 0, 0), false},
+		{"short mr_signer", NewParams([]Measurement{{MrSigner: mrSigner[1:]}}, 0, 0, 0), false},
+		{"duplicate", NewParams([]Measurement{{MrSigner: mrSigner}, {MrSigner: mrSigner}}, 0, 0, 0), false},
+		{"negative attestation validity", NewParams(nil, 0, -time.Hour, 0), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestParamsIsAllowed(t *testing.T) {
+	mrEnclave := bytes.Repeat([]byte{1}, MeasurementSize)
+	mrSigner := bytes.Repeat([]byte{2}, MeasurementSize)
+	other := bytes.Repeat([]byte{3}, MeasurementSize)
+
+	report := Report{MrEnclave: mrEnclave, MrSigner: mrSigner}
+
+	require.False(t, DefaultParams().IsAllowed(report))
+	require.True(t, NewParams([]Measurement{{MrEnclave: mrEnclave}}, 0, 0, 0).IsAllowed(report))
+	require.True(t, NewParams([]Measurement{{MrSigner: mrSigner}}, 0, 0, 0).IsAllowed(report))
+	require.True(t, NewParams([]Measurement{{MrEnclave: other}, {MrEnclave: mrEnclave, MrSigner: mrSigner}}, 0, 0, 0).IsAllowed(report))
+	require.False(t, NewParams([]Measurement{{MrEnclave: mrEnclave, MrSigner: other}}, 0, 0, 0).IsAllowed(report))
+}
+
+func TestParamsMinIsvSvn(t *testing.T) {
+	mrSigner := bytes.Repeat([]byte{2}, MeasurementSize)
+	params := NewParams([]Measurement{{MrSigner: mrSigner}}, 3, 0, 0)
+
+	require.False(t, params.IsAllowed(Report{MrSigner: mrSigner, IsvSvn: 2}))
+	require.True(t, params.IsAllowed(Report{MrSigner: mrSigner, IsvSvn: 3}))
+}
+
+func TestParamsIsAttestationExpired(t *testing.T) {
+	registered := time.Unix(1_700_000_000, 0)
+	node := Node{RegisteredTime: registered}
+	params := NewParams(nil, 0, time.Hour, 0)
+
+	require.False(t, params.IsAttestationExpired(node, registered.Add(time.Hour-time.Second)))
+	require.True(t, params.IsAttestationExpired(node, registered.Add(time.Hour)))
+	require.False(t, NewParams(nil, 0, 0, 0).IsAttestationExpired(node, registered.Add(365*24*time.Hour)), "expiry disabled")
+}
+
+func TestParamsAllowRevokeMeasurement(t *testing.T) {
+	mrEnclave := bytes.Repeat([]byte{1}, MeasurementSize)
+	mrSigner := bytes.Repeat([]byte{2}, MeasurementSize)
+	params := DefaultParams()
+
+	require.NoError(t, params.AllowMeasurement(Measurement{MrEnclave: mrEnclave}))
+	require.NoError(t, params.AllowMeasurement(Measurement{MrSigner: mrSigner}))
+	require.Error(t, params.AllowMeasurement(Measurement{MrSigner: mrSigner}), "duplicate")
+	require.Error(t, params.AllowMeasurement(Measurement{}), "invalid")
+	require.NoError(t, params.Validate())
+
+	require.NoError(t, params.RevokeMeasurement(Measurement{MrEnclave: mrEnclave}))
+	require.Equal(t, []Measurement{{MrSigner: mrSigner}}, params.AllowedMeasurements)
+	require.ErrorIs(t, params.RevokeMeasurement(Measurement{MrEnclave: mrEnclave}), ErrMeasurementNotFound)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Layout of an SGX DCAP quote, version 3
+const (
+	// QuoteVersion is the supported quote version
+	QuoteVersion = 3
+	// attestationKeyTypeECDSAP256 is the attestation key type of ECDSA-256-with-P-256 quotes
+	attestationKeyTypeECDSAP256 = 2
+
+	quoteHeaderSize = 48
+	reportBodySize  = 384
+	// minQuoteSize covers the header, the report body and the signature data length
+	minQuoteSize = quoteHeaderSize + reportBodySize + 4
+
+	// offsets of the report body fields
+	attributesOffset = 48
+	mrEnclaveOffset  = 64
+	mrSignerOffset   = 128
+	isvProdIDOffset  = 256
+	isvSvnOffset     = 258
+	reportDataOffset = 320
+
+	// attributeDebug is the attributes flag of enclaves launched in debug mode
+	attributeDebug = 0x02
+)
+
+// Report contains the fields of the enclave report in an attestation quote
+type Report struct {
+	MrEnclave  []byte
+	MrSigner   []byte
+	IsvProdID  uint16
+	IsvSvn     uint16
+	ReportData []byte
+	// Debug is set for enclaves launched in debug mode, whose memory can be read by the host
+	Debug bool
+}
+
+// ParseQuote parses the enclave report from an SGX DCAP quote. It only checks the structure of
+// the quote, the signature must be checked with a QuoteVerifier.
+func ParseQuote(quote []byte) (Report, error) {
+	if len(quote) < minQuoteSize {
+		return Report{}, errorsmod.Wrapf(ErrInvalidQuote, "quote is too short: %d bytes", len(quote))
+	}
+
+	if version := binary.LittleEndian.Uint16(quote[0:2]); version != QuoteVersion {
+		return Report{}, errorsmod.Wrapf(ErrInvalidQuote, "unsupported quote version %d", version)
+	}
+	if keyType := binary.LittleEndian.Uint16(quote[2:4]); keyType != attestationKeyTypeECDSAP256 {
+		return Report{}, errorsmod.Wrapf(ErrInvalidQuote, "unsupported attestation key type %d", keyType)
+	}
+
+	signatureSize := binary.LittleEndian.Uint32(quote[quoteHeaderSize+reportBodySize:])
+	if uint64(len(quote)) != uint64(minQuoteSize)+uint64(signatureSize) {
+		return Report{}, errorsmod.Wrapf(
+			ErrInvalidQuote, "quote size %d doesn't match signature data size %d", len(quote), signatureSize,
+		)
+	}
+
+	body := quote[quoteHeaderSize : quoteHeaderSize+reportBodySize]
+	return Report{
+		MrEnclave:  append([]byte{}, body[mrEnclaveOffset:mrEnclaveOffset+32]...),
+		MrSigner:   append([]byte{}, body[mrSignerOffset:mrSignerOffset+32]...),
+		IsvProdID:  binary.LittleEndian.Uint16(body[isvProdIDOffset:]),
+		IsvSvn:     binary.LittleEndian.Uint16(body[isvSvnOffset:]),
+		ReportData: append([]byte{}, body[reportDataOffset:reportDataOffset+64]...),
+		Debug:      binary.LittleEndian.Uint64(body[attributesOffset:])&attributeDebug != 0,
+	}, nil
+}
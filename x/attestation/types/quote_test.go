@@ -0,0 +1,63 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newQuote builds a version 3 quote with the given report fields and a dummy signature
+func newQuote(mrEnclave, mrSigner, reportData []byte, isvSvn uint16, debug bool) []byte {
+	quote := make([]byte, minQuoteSize+64)
+	binary.LittleEndian.PutUint16(quote[0:], QuoteVersion)
+	binary.LittleEndian.PutUint16(quote[2:], attestationKeyTypeECDSAP256)
+
+	body := quote[quoteHeaderSize:]
+	if debug {
+		body[attributesOffset] = attributeDebug
+	}
+	copy(body[mrEnclaveOffset:], mrEnclave)
+	copy(body[mrSignerOffset:], mrSigner)
+	binary.LittleEndian.PutUint16(body[isvSvnOffset:], isvSvn)
+	copy(body[reportDataOffset:], reportData)
+	binary.LittleEndian.PutUint32(quote[quoteHeaderSize+reportBodySize:], 64)
+
+	return quote
+}
+
+func TestParseQuote(t *testing.T) {
+	mrEnclave := bytes.Repeat([]byte{1}, MeasurementSize)
+	mrSigner := bytes.Repeat([]byte{2}, MeasurementSize)
+	reportData := bytes.Repeat([]byte{3}, 64)
+
+	report, err := ParseQuote(newQuote(mrEnclave, mrSigner, reportData, 7, false))
+	require.NoError(t, err)
+	require.Equal(t, Report{
+		MrEnclave:  mrEnclave,
+		MrSigner:   mrSigner,
+		IsvSvn:     7,
+		ReportData: reportData,
+	}, report)
+
+	report, err = ParseQuote(newQuote(mrEnclave, mrSigner, reportData, 7, true))
+	require.NoError(t, err)
+	require.True(t, report.Debug)
+
+	testCases := []struct {
+		name     string
+		malleate func(quote []byte) []byte
+	}{
+		{"too short", func(quote []byte) []byte { return quote[:minQuoteSize-1] }},
+		{"truncated signature", func(quote []byte) []byte { return quote[:len(quote)-1] }},
+		{"trailing bytes", func(quote []byte) []byte { return append(quote, 0) }},
+		{"unsupported version", func(quote []byte) []byte { quote[0] = 4; return quote }},
+		{"unsupported key type", func(quote []byte) []byte { quote[2] = 3; return quote }},
+	}
+
+	for _, tc := range testCases {
+		_, err := ParseQuote(tc.malleate(newQuote(mrEnclave, mrSigner, reportData, 7, false)))
+		require.ErrorIs(t, err, ErrInvalidQuote, tc.name)
+	}
+}
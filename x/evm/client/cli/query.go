@@ -16,12 +16,23 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+
 	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
+	ethermint "github.com/SigmaGmbH/evm-module/types"
 	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/SigmaGmbH/evm-module/x/evm/proof"
 	"github.com/SigmaGmbH/evm-module/x/evm/types"
 )
 
@@ -36,9 +47,19 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetAccountCmd(),
 		GetStorageCmd(),
+		GetStorageRangeCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetChainConfigCmd(),
+		GetAccountProofCmd(),
+		GetStorageUsageCmd(),
+		GetCreate2AddressCmd(),
+		GetSimulateParamsUpdateCmd(),
+		GetConfigHashCmd(),
+		GetEpochKeysCmd(),
+		GetFrozenAccountsCmd(),
 	)
 	return cmd
 }
@@ -83,6 +104,261 @@ func GetStorageCmd() *cobra.Command {
 	return cmd
 }
 
+// GetStorageRangeCmd queries a page of the storage slots of a contract
+func GetStorageRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-range ADDRESS",
+		Short: "Gets a page of the storage slots of a contract",
+		Long:  "Gets a page of the storage slots of a contract ordered by key. Pass the next key of a page with --page-key to get the following one. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryStorageRangeRequest{
+				Address:    address,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.StorageRange(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "storage-range")
+	return cmd
+}
+
+// GetStorageUsageCmd queries the storage used by a contract or by all contracts
+func GetStorageUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-usage [ADDRESS]",
+		Short: "Gets the number of storage slots and bytes used by a contract",
+		Long:  "Gets the number of storage slots and bytes used by a contract. If the address is not provided, it returns the storage used by all contracts.", //nolint:lll
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryStorageUsageRequest{}
+			if len(args) == 1 {
+				req.Address, err = accountToHex(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.StorageUsage(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const flagDeployer = "deployer"
+
+// GetCreate2AddressCmd computes the address of a contract deployed with CREATE2
+func GetCreate2AddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create2-address SALT INIT_CODE_HASH",
+		Short: "Gets the address of a contract deployed with CREATE2",
+		Long:  "Gets the address of a contract deployed with CREATE2 for the given salt and init code hash. If the deployer is not provided, it uses the canonical CREATE2 deployer.", //nolint:lll
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCreate2AddressRequest{
+				Salt:         args[0],
+				InitCodeHash: args[1],
+			}
+
+			deployer, err := cmd.Flags().GetString(flagDeployer)
+			if err != nil {
+				return err
+			}
+			if deployer != "" {
+				req.Deployer, err = accountToHex(deployer)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.Create2Address(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDeployer, "", "Address of the contract executing CREATE2")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSimulateParamsUpdateCmd validates proposed params and prints the changes they would apply
+func GetSimulateParamsUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-params-update PARAMS_FILE",
+		Short: "Validates the params of a MsgUpdateParams and prints the changes they would apply",
+		Long:  "Validates the params of a MsgUpdateParams against the current height and prints the changes they would apply, without applying them. The file contains the proposed params in JSON format.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySimulateParamsUpdateRequest{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &req.Params); err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateParamsUpdate(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetConfigHashCmd queries the hash of the consensus-relevant EVM configuration
+func GetConfigHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config-hash",
+		Short: "Get the hash of the consensus-relevant EVM configuration",
+		Long:  "Get the hash of the EVM params, the effective Ethereum chain config and the fee market params. Nodes returning a different hash at the same height will diverge from the network. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConfigHash(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryConfigHashRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEpochKeysCmd queries the public key material of the state encryption key epochs
+func GetEpochKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-keys",
+		Short: "Get the enclave public keys of the state encryption key epochs",
+		Long:  "Get the epoch number, height range and enclave public key of every state encryption key epoch. Transactions must be encrypted to the key of the epoch of the block executing them. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochKeys(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryEpochKeysRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFrozenAccountsCmd queries the accounts frozen through governance
+func GetFrozenAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-accounts",
+		Short: "Get the accounts frozen through governance",
+		Long:  "Get the hex addresses of the accounts frozen through governance. Transactions sent from frozen accounts are rejected. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenAccounts(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryFrozenAccountsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen-accounts")
+	return cmd
+}
+
 // GetCodeCmd queries the code field of a given address
 func GetCodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -120,6 +396,43 @@ func GetCodeCmd() *cobra.Command {
 	return cmd
 }
 
+// GetAccountCmd queries the balance, code hash and nonce of a given address
+func GetAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account ADDRESS",
+		Short: "Gets the balance, code hash and nonce of an account",
+		Long:  "Gets the balance in the evm denomination, the code hash and the nonce of an account. The address can be hex or bech32 encoded. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccountRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.Account(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries the fee market params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -147,3 +460,161 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetChainConfigCmd queries the effective chain config in go-ethereum genesis config JSON form
+func GetChainConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-config",
+		Short: "Get the effective Ethereum chain config",
+		Long: `Get the effective Ethereum chain config in go-ethereum genesis config JSON form (the "config" field of genesis.json),
+so external tools (evm t8n, retesteth) can be configured identically. If the height is not provided, it will use the latest height from context.`, //nolint:lll
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+			if err != nil {
+				return fmt.Errorf("invalid chain id, set it with --%s: %w", flags.FlagChainID, err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(res.Params.ChainConfig.EthereumConfig(chainID), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAccountProofCmd queries account state with ICS-23 proofs for external light clients
+func GetAccountProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-proof ADDRESS [KEY...]",
+		Short: "Get account and storage values with merkle proofs",
+		Long: `Get account, balance and storage values of the given keys with ICS-23 proofs, bundled with the signed Tendermint header
+committing to them, so external bridges can verify the state against the validator set. The proof can be verified with the x/evm/proof package.
+If the height is not provided, it will use the latest height which has a committed header.`, //nolint:lll
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hexAddress, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+			address := common.HexToAddress(hexAddress)
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			// state at height H is committed by the app hash of the header at height H+1
+			height := clientCtx.Height
+			if height == 0 {
+				status, err := node.Status(cmd.Context())
+				if err != nil {
+					return err
+				}
+				height = status.SyncInfo.LatestBlockHeight - 1
+			}
+
+			headerHeight := height + 1
+			commit, err := node.Commit(cmd.Context(), &headerHeight)
+			if err != nil {
+				return err
+			}
+			signedHeader, err := commit.SignedHeader.ToProto().Marshal()
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params, err := queryClient.Params(rpctypes.ContextWithHeight(height), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			res := proof.AccountProof{
+				Address:      address,
+				Height:       height,
+				SignedHeader: signedHeader,
+			}
+
+			if res.Account, err = queryStoreProof(clientCtx, authtypes.StoreKey, proof.AccountKey(address), height); err != nil {
+				return err
+			}
+
+			balanceKey := proof.BalanceKey(address, params.Params.EvmDenom)
+			if res.Balance, err = queryStoreProof(clientCtx, banktypes.StoreKey, balanceKey, height); err != nil {
+				return err
+			}
+
+			for _, key := range args[1:] {
+				slot := common.HexToHash(formatKeyToHash(key))
+				storage, err := queryStoreProof(clientCtx, types.StoreKey, proof.StorageKey(address, slot), height)
+				if err != nil {
+					return err
+				}
+				res.Storage = append(res.Storage, storage)
+			}
+
+			bz, err := json.MarshalIndent(res, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryStoreProof queries raw store value with its ICS-23 proof at the given height
+func queryStoreProof(clientCtx client.Context, storeName string, key []byte, height int64) (proof.StoreProof, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return proof.StoreProof{}, err
+	}
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	if err != nil {
+		return proof.StoreProof{}, err
+	}
+
+	bz, err := merkleProof.Marshal()
+	if err != nil {
+		return proof.StoreProof{}, err
+	}
+
+	return proof.StoreProof{
+		StoreName: storeName,
+		Key:       key,
+		Value:     res.Value,
+		Proof:     bz,
+	}, nil
+}
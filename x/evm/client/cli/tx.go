@@ -18,7 +18,9 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -28,6 +30,7 @@ import (
 	"github.com/spf13/cobra"
 
 	rpctypes "github.com/SigmaGmbH/evm-module/rpc/types"
+	ethermint "github.com/SigmaGmbH/evm-module/types"
 	"github.com/SigmaGmbH/evm-module/x/evm/types"
 )
 
@@ -49,11 +52,15 @@ func NewRawTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "raw TX_HEX",
 		Short: "Build cosmos transaction from raw ethereum transaction",
-		Args:  cobra.ExactArgs(1),
+		Long: `Broadcast a signed ethereum transaction, hex encoded in the RLP or typed transaction envelope as
+returned by eth_signTransaction. It's wrapped in a cosmos transaction with the ethereum extension option,
+so it doesn't need a cosmos key. Pass - to read the transaction from stdin, e.g. when it was signed on
+an air-gapped machine. With --generate-only, the cosmos transaction is printed instead.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			data, err := hexutil.Decode(args[0])
+			data, err := readRawTx(args[0], cmd.InOrStdin())
 			if err != nil {
-				return errors.Wrap(err, "failed to decode ethereum tx hex bytes")
+				return err
 			}
 
 			msg := &types.MsgHandleTx{}
@@ -70,6 +77,10 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
+			if err := validateRawTxChainID(msg, clientCtx.ChainID); err != nil {
+				return err
+			}
+
 			rsp, err := rpctypes.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
 			if err != nil {
 				return err
@@ -124,3 +135,39 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// readRawTx decodes the hex encoded ethereum transaction of the argument, or read from stdin if the
+// argument is -
+func readRawTx(arg string, stdin io.Reader) ([]byte, error) {
+	if arg == "-" {
+		bz, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read ethereum tx from stdin")
+		}
+		arg = string(bz)
+	}
+
+	data, err := hexutil.Decode(strings.TrimSpace(arg))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode ethereum tx hex bytes")
+	}
+	return data, nil
+}
+
+// validateRawTxChainID checks that the transaction was signed for the chain of the client, as it's
+// rejected by the node otherwise. Transactions without replay protection have no chain ID.
+func validateRawTxChainID(msg *types.MsgHandleTx, chainID string) error {
+	txChainID := msg.AsTransaction().ChainId()
+	if chainID == "" || txChainID.Sign() == 0 {
+		return nil
+	}
+
+	eip155ChainID, err := ethermint.ParseChainID(chainID)
+	if err != nil {
+		return err
+	}
+	if txChainID.Cmp(eip155ChainID) != 0 {
+		return fmt.Errorf("ethereum tx was signed for chain ID %s, but the chain ID of %s is %s", txChainID, chainID, eip155ChainID)
+	}
+	return nil
+}
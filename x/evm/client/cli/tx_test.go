@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func TestReadRawTx(t *testing.T) {
+	testCases := []struct {
+		name    string
+		arg     string
+		stdin   string
+		expData []byte
+		expPass bool
+	}{
+		{"argument", "0x0102", "", []byte{1, 2}, true},
+		{"stdin", "-", "0x0102\n", []byte{1, 2}, true},
+		{"missing 0x prefix", "0102", "", nil, false},
+		{"empty stdin", "-", "", nil, false},
+	}
+
+	for _, tc := range testCases {
+		data, err := readRawTx(tc.arg, strings.NewReader(tc.stdin))
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expData, data, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidateRawTxChainID(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	to := common.BigToAddress(big.NewInt(1))
+	signedTx := func(signer ethtypes.Signer) *types.MsgHandleTx {
+		tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{To: &to, Gas: 21000, GasPrice: big.NewInt(1)})
+		require.NoError(t, err)
+		bz, err := tx.MarshalBinary()
+		require.NoError(t, err)
+
+		msg := &types.MsgHandleTx{}
+		require.NoError(t, msg.UnmarshalBinary(bz))
+		return msg
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgHandleTx
+		chainID string
+		expPass bool
+	}{
+		{"matching chain ID", signedTx(ethtypes.NewEIP155Signer(big.NewInt(9000))), "ethermint_9000-1", true},
+		{"unknown client chain ID", signedTx(ethtypes.NewEIP155Signer(big.NewInt(9000))), "", true},
+		{"unprotected tx", signedTx(ethtypes.HomesteadSigner{}), "ethermint_9000-1", true},
+		{"other chain ID", signedTx(ethtypes.NewEIP155Signer(big.NewInt(1))), "ethermint_9000-1", false},
+		{"invalid client chain ID", signedTx(ethtypes.NewEIP155Signer(big.NewInt(9000))), "chain", false},
+	}
+
+	for _, tc := range testCases {
+		err := validateRawTxChainID(tc.msg, tc.chainID)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -39,11 +39,27 @@ func InitGenesis(
 ) []abci.ValidatorUpdate {
 	k.WithChainID(ctx)
 
+	if err := data.Params.ChainConfig.ValidateChainID(k.ChainID()); err != nil {
+		panic(fmt.Errorf("chain config doesn't match chain-id %s: %s", ctx.ChainID(), err))
+	}
+
 	err := k.SetParams(ctx, data.Params)
 	if err != nil {
 		panic(fmt.Errorf("error setting params %s", err))
 	}
 
+	for _, epoch := range data.KeyEpochs {
+		k.SetKeyEpoch(ctx, epoch)
+	}
+
+	for _, address := range data.FrozenAccounts {
+		k.SetAccountFrozen(ctx, common.HexToAddress(address))
+	}
+
+	for _, permissions := range data.ViewPermissions {
+		k.SetViewPermissions(ctx, permissions)
+	}
+
 	// ensure evm module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the EVM module account has not been set")
@@ -79,10 +95,27 @@ func InitGenesis(
 		k.SetCode(ctx, codeHash.Bytes(), code)
 
 		for _, storage := range account.Storage {
-			k.SetState(ctx, address, common.HexToHash(storage.Key), common.HexToHash(storage.Value).Bytes())
+			k.SetState(ctx, address, common.HexToHash(storage.Key), storage.StoredValue())
 		}
 	}
 
+	// exported encrypted storage can only be decrypted with the master seed of the exporting chain,
+	// which the enclave obtains from a node of that chain through the attested seed exchange
+	if err := k.VerifyEpochPublicKeys(data.EpochPublicKeys); err != nil {
+		panic(fmt.Errorf("error verifying the epoch public keys %s", err))
+	}
+
+	if err := k.InstallCreate2Deployer(ctx); err != nil {
+		panic(fmt.Errorf("error installing the CREATE2 deployer %s", err))
+	}
+
+	if err := k.InstallPreinstalls(ctx, data.Preinstalls); err != nil {
+		panic(fmt.Errorf("error installing the preinstalls %s", err))
+	}
+
+	// add the storage of the genesis accounts to the total storage usage
+	k.CommitTotalStorageUsage(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -110,8 +143,19 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 		return false
 	})
 
+	// the state can be exported on a machine without the enclave, the keys are left out then and
+	// InitGenesis can't check that the enclave of the new chain holds the master seed
+	epochPublicKeys, err := k.GetEpochPublicKeys(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to export the epoch public keys, the enclave is unavailable", "error", err.Error())
+	}
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:        ethGenAccounts,
+		Params:          k.GetParams(ctx),
+		KeyEpochs:       k.GetKeyEpochs(ctx),
+		FrozenAccounts:  k.GetFrozenAccounts(ctx),
+		ViewPermissions: k.GetViewPermissions(ctx),
+		EpochPublicKeys: epochPublicKeys,
 	}
 }
@@ -3,6 +3,7 @@ package evm_test
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"math/big"
+	"strings"
 
 	"github.com/SigmaGmbH/evm-module/crypto/ethsecp256k1"
 	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
@@ -133,6 +134,77 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 			},
 			false,
 		},
+		{
+			"preinstall",
+			func() {},
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Preinstalls: []types.Preinstall{
+					{Name: types.PreinstallMulticall3, Address: types.PreinstallAddresses[types.PreinstallMulticall3].Hex(), Code: "0x6000"},
+				},
+			},
+			false,
+		},
+		{
+			"preinstall replacing a contract",
+			func() {
+				err := suite.app.EvmKeeper.SetAccountCode(suite.ctx, types.PreinstallAddresses[types.PreinstallMulticall3], []byte{1})
+				suite.Require().NoError(err)
+			},
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Preinstalls: []types.Preinstall{
+					{Name: types.PreinstallMulticall3, Address: types.PreinstallAddresses[types.PreinstallMulticall3].Hex(), Code: "0x6000"},
+				},
+			},
+			true,
+		},
+		{
+			"view permissions",
+			func() {},
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				ViewPermissions: []types.ViewPermissions{
+					{Contract: address.Hex(), Restricted: true, Viewers: []string{"0x1000000000000000000000000000000000000001"}},
+				},
+			},
+			false,
+		},
+		{
+			"encrypted storage",
+			func() {
+				err := suite.app.EvmKeeper.SetBalance(suite.ctx, address, big.NewInt(1))
+				suite.Require().NoError(err)
+			},
+			&types.GenesisState{
+				Params:    types.DefaultParams(),
+				KeyEpochs: []types.KeyEpoch{{Epoch: 1, StartHeight: 1}},
+				Accounts: []types.GenesisAccount{
+					{
+						Address: address.String(),
+						Storage: types.Storage{
+							{Key: common.BytesToHash([]byte("key")).String(), Value: "0x" + strings.Repeat("ab", 48)},
+						},
+					},
+				},
+				EpochPublicKeys: []types.EpochPublicKey{
+					{Epoch: 0, PublicKey: common.Hash{}.Hex()},
+					{Epoch: 1, PublicKey: common.Hash{}.Hex()},
+				},
+			},
+			false,
+		},
+		{
+			"enclave without the master seed of the exported state",
+			func() {},
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				EpochPublicKeys: []types.EpochPublicKey{
+					{Epoch: 0, PublicKey: common.BytesToHash([]byte{1}).Hex()},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -153,7 +225,46 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 						_ = evm.InitGenesis(suite.ctx, suite.app.EvmKeeper, suite.app.AccountKeeper, *tc.genState)
 					},
 				)
+				for _, preinstall := range tc.genState.Preinstalls {
+					code, err := suite.app.EvmKeeper.GetAccountCode(suite.ctx, common.HexToAddress(preinstall.Address))
+					suite.Require().NoError(err)
+					suite.Require().Equal(common.FromHex(preinstall.Code), code)
+				}
+				suite.Require().Equal(tc.genState.ViewPermissions, suite.app.EvmKeeper.GetViewPermissions(suite.ctx))
+				for _, account := range tc.genState.Accounts {
+					addr := common.HexToAddress(account.Address)
+					for _, state := range account.Storage {
+						suite.Require().Equal(state.StoredValue(), suite.app.EvmKeeper.GetState(suite.ctx, addr, common.HexToHash(state.Key)))
+					}
+				}
 			}
 		})
 	}
 }
+
+func (suite *EvmTestSuite) TestExportGenesisEncryptedStorage() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	privkey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := common.HexToAddress(privkey.PubKey().Address().String())
+	suite.Require().NoError(k.SetBalance(suite.ctx, address, big.NewInt(1)))
+
+	key := common.BytesToHash([]byte("key"))
+	encrypted := common.FromHex(strings.Repeat("ab", 48))
+	k.SetState(suite.ctx, address, key, encrypted)
+	k.SetKeyEpoch(suite.ctx, types.KeyEpoch{Epoch: 1, StartHeight: 1})
+
+	genState := evm.ExportGenesis(suite.ctx, k, suite.app.AccountKeeper)
+	suite.Require().NoError(genState.Validate())
+	suite.Require().Len(genState.EpochPublicKeys, 2)
+
+	var storage types.Storage
+	for _, account := range genState.Accounts {
+		if common.HexToAddress(account.Address) == address {
+			storage = account.Storage
+		}
+	}
+	suite.Require().Equal(types.Storage{{Key: key.Hex(), Value: "0x" + strings.Repeat("ab", 48)}}, storage)
+}
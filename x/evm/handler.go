@@ -35,6 +35,12 @@ func NewHandler(server types.MsgServer) sdk.Handler {
 		case *types.MsgUpdateParams:
 			res, err := server.UpdateParams(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgFreezeAccount:
+			res, err := server.FreezeAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUnfreezeAccount:
+			res, err := server.UnfreezeAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			err := errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, err
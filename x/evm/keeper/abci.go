@@ -18,25 +18,53 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper, records the
+// current block hash in the BLOCKHASH ring buffer and executes the begin block hooks.
 func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+
+	if len(req.Hash) != 0 {
+		k.SetBlockHash(ctx, uint64(ctx.BlockHeight()), common.BytesToHash(req.Hash))
+	}
+
+	if params := k.GetParams(ctx); params.EnableBlockHooks {
+		k.RunBlockHooks(ctx, params.BeginBlockHooks)
+	}
 }
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// EndBlock executes the end block hooks, retrieves the bloom filter value from the transient
+// store and commits it to the KVStore together with the logs of the block, and notifies the
+// params listeners of any params change. The EVM end block logic doesn't update the validator
+// set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
+	// end block hooks run first, so their logs are included in the block bloom
+	if params := k.GetParams(infCtx); params.EnableBlockHooks {
+		k.RunBlockHooks(infCtx, params.EndBlockHooks)
+	}
+
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
+	k.PersistBlockLogs(infCtx, bloom)
+
+	telemetry.SetGauge(float32(k.GetTransientBoundaryBytes(infCtx)), "sgxvm", "boundary", "bytes")
+
+	storageUsage := k.GetTotalStorageUsage(infCtx)
+	telemetry.SetGauge(float32(storageUsage.Slots), "evm", "storage", "slots")
+	telemetry.SetGauge(float32(storageUsage.Bytes), "evm", "storage", "bytes")
+
+	telemetry.SetGauge(float32(k.resetPostponedTxs()), "tx", "msg", "ethereum_tx", "postponed")
+
+	k.NotifyParamsListeners(infCtx)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/tendermint/tendermint/abci/types"
 )
 
@@ -16,3 +18,97 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	suite.Require().Equal(1, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
 }
+
+func (suite *KeeperTestSuite) TestBeginBlock() {
+	hash := common.HexToHash("0xabcd")
+	height := uint64(suite.ctx.BlockHeight())
+
+	suite.app.EvmKeeper.BeginBlock(suite.ctx, types.RequestBeginBlock{Hash: hash.Bytes()})
+
+	// should record the block hash in the BLOCKHASH ring buffer
+	stored, found := suite.app.EvmKeeper.GetBlockHash(suite.ctx, height)
+	suite.Require().True(found)
+	suite.Require().Equal(hash, stored)
+
+	_, found = suite.app.EvmKeeper.GetBlockHash(suite.ctx, height+evmtypes.BlockHashWindow)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestBlockHooks() {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BeginBlockHooks = []evmtypes.BlockHook{
+		{Name: "transfer", Contract: common.HexToAddress("0x1000000000000000000000000000000000000001").Hex(), GasLimit: 50_000},
+		// doesn't cover the intrinsic gas
+		{Name: "out-of-gas", Contract: common.HexToAddress("0x1000000000000000000000000000000000000001").Hex(), GasLimit: 1},
+	}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+	suite.Require().Empty(ctx.EventManager().Events(), "block hooks are disabled")
+
+	params.EnableBlockHooks = true
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+
+	var hookEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == evmtypes.EventTypeBlockHook {
+			hookEvents = append(hookEvents, event)
+		}
+	}
+	suite.Require().Len(hookEvents, 2)
+
+	hasError := func(event sdk.Event) bool {
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == evmtypes.AttributeKeyBlockHookError {
+				return true
+			}
+		}
+		return false
+	}
+	suite.Require().False(hasError(hookEvents[0]))
+	suite.Require().True(hasError(hookEvents[1]))
+}
+
+type paramsRecorder struct {
+	changes []evmtypes.Params
+}
+
+func (r *paramsRecorder) OnParamsChanged(_ int64, prev, next evmtypes.Params) {
+	r.changes = append(r.changes, prev, next)
+}
+
+type panickingParamsListener struct{}
+
+func (panickingParamsListener) OnParamsChanged(int64, evmtypes.Params, evmtypes.Params) {
+	panic("listener failure")
+}
+
+func (suite *KeeperTestSuite) TestParamsListeners() {
+	recorder := &paramsRecorder{}
+	suite.app.EvmKeeper.AddParamsListener(panickingParamsListener{})
+	suite.app.EvmKeeper.AddParamsListener(recorder)
+
+	// the first end block only records the current params
+	suite.app.EvmKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
+	suite.Require().Empty(recorder.changes)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.EnableCreate = false
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// changes are not announced while checking txs
+	suite.app.EvmKeeper.EndBlock(suite.ctx.WithIsCheckTx(true), types.RequestEndBlock{})
+	suite.Require().Empty(recorder.changes)
+
+	suite.app.EvmKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
+	suite.Require().Len(recorder.changes, 2)
+	suite.Require().True(recorder.changes[0].EnableCreate)
+	suite.Require().False(recorder.changes[1].EnableCreate)
+
+	// unchanged params are not announced again
+	suite.app.EvmKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
+	suite.Require().Len(recorder.changes, 2)
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -48,6 +49,29 @@ func SetupSGXVMTestMessageCall(b *testing.B) (*KeeperTestSuite, common.Address)
 	return &suite, contractAddr
 }
 
+// nestedCallCode is the runtime code of a contract calling itself with the depth passed as input
+// decremented until it reaches 0, and writing the storage slot of the depth in every frame. The
+// SGXVM snapshots every nested call and journals every write in the enclave.
+var nestedCallCode = common.FromHex("6000358080558015602157600190036000526000600060206000600030" + "5af150005b00")
+
+func SetupSGXVMNestedCall(b *testing.B) (*KeeperTestSuite, common.Address) {
+	suite := KeeperTestSuite{}
+	suite.SetupSGXVMTestWithT(b)
+
+	amt := sdk.Coins{evmcommontypes.NewPhotonCoinInt64(1000000000000000000)}
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, amt)
+	require.NoError(b, err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), amt)
+	require.NoError(b, err)
+
+	contractAddr := common.BigToAddress(big.NewInt(0x1000))
+	err = suite.app.EvmKeeper.SetAccountCode(suite.ctx, contractAddr, nestedCallCode)
+	require.NoError(b, err)
+	suite.Commit()
+
+	return &suite, contractAddr
+}
+
 type SGXVMTxBuilder func(suite *KeeperTestSuite, contract common.Address) *types.MsgHandleTx
 
 func DoBenchmarkSGXVM(b *testing.B, txBuilder SGXVMTxBuilder) {
@@ -143,3 +167,38 @@ func BenchmarkMessageCallSGXVM(b *testing.B) {
 		require.False(b, rsp.Failed())
 	}
 }
+
+// BenchmarkNestedCallSGXVM measures deeply nested calls, which should scale linearly with the depth
+func BenchmarkNestedCallSGXVM(b *testing.B) {
+	for _, depth := range []int64{16, 64, 128} {
+		b.Run(fmt.Sprintf("depth %d", depth), func(b *testing.B) {
+			suite, contract := SetupSGXVMNestedCall(b)
+
+			input := common.BigToHash(big.NewInt(depth)).Bytes()
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := types.NewSGXVMTx(suite.app.EvmKeeper.ChainID(), nonce, &contract, big.NewInt(0), 25000000, big.NewInt(1), nil, nil, input, nil, suite.privateKey, suite.nodePublicKey)
+
+			msg.From = suite.address.Hex()
+			err := msg.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), suite.signer)
+			require.NoError(b, err)
+
+			b.ResetTimer()
+			b.StartTimer()
+			for i := 0; i < b.N; i++ {
+				ctx, _ := suite.ctx.CacheContext()
+
+				// deduct fee first
+				txData, err := types.UnpackTxData(msg.Data)
+				require.NoError(b, err)
+
+				fees := sdk.Coins{sdk.NewCoin(suite.EvmDenom(), sdkmath.NewIntFromBigInt(txData.Fee()))}
+				err = authante.DeductFees(suite.app.BankKeeper, suite.ctx, suite.app.AccountKeeper.GetAccount(ctx, msg.GetFrom()), fees)
+				require.NoError(b, err)
+
+				rsp, err := suite.app.EvmKeeper.HandleTx(sdk.WrapSDKContext(ctx), msg)
+				require.NoError(b, err)
+				require.False(b, rsp.Failed())
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// RunBlockHooks executes the given block hooks in order.
+// Every hook is called by the BlockHookSender and can use at most its own gas limit.
+// The state changes of failed hooks are discarded and a failure never aborts the block,
+// the outcome of every hook is reported through a block_hook event.
+func (k *Keeper) RunBlockHooks(ctx sdk.Context, hooks []types.BlockHook) {
+	for _, hook := range hooks {
+		gasUsed, err := k.runBlockHook(ctx, hook)
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyBlockHook, hook.Name),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, hook.Contract),
+			sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(gasUsed, 10)),
+		}
+		if err != nil {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyBlockHookError, err.Error()))
+			k.Logger(ctx).Error("block hook failed", "hook", hook.Name, "error", err)
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBlockHook, attrs...))
+	}
+}
+
+// runBlockHook executes a single block hook in a cached context, which is only written
+// if the call succeeds. Returns the gas used by the call.
+func (k *Keeper) runBlockHook(ctx sdk.Context, hook types.BlockHook) (gasUsed uint64, err error) {
+	cacheCtx, write := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+
+	// hooks run outside of the tx pipeline, a panic must not halt the chain
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("block hook panicked: %v", r)
+		}
+	}()
+
+	contract := common.HexToAddress(hook.Contract)
+	res, _, err := k.CallEVMWithData(cacheCtx, types.BlockHookSender, &contract, hook.Calldata, hook.GasLimit, true)
+	if err != nil {
+		return hook.GasLimit, err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return res.GasUsed, nil
+}
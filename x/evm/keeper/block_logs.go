@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// The logs of the ethereum transactions are collected in the transient store while the block
+// is processed and persisted together with the block bloom at end block, so log queries over
+// block ranges don't need to decode the Tendermint events of every block. Only the last
+// BlockLogsRetention blocks are kept.
+
+// SetTxLogsTransient records the logs of the ethereum transaction with the given index in
+// current block.
+func (k Keeper) SetTxLogsTransient(ctx sdk.Context, txIndex uint64, txLogs types.TransactionLogs) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxLogs)
+	store.Set(sdk.Uint64ToBigEndian(txIndex), k.cdc.MustMarshal(&txLogs))
+}
+
+// PersistBlockLogs stores the bloom and the transaction logs of current block and prunes the
+// block that left the retention window.
+func (k Keeper) PersistBlockLogs(ctx sdk.Context, bloom ethtypes.Bloom) {
+	height := ctx.BlockHeight()
+
+	bloomStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockBloom)
+	bloomStore.Set(sdk.Uint64ToBigEndian(uint64(height)), bloom.Bytes())
+
+	logsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockLogs)
+	iterator := sdk.KVStorePrefixIterator(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxLogs)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		txIndex := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixTransientTxLogs):])
+		logsStore.Set(types.BlockLogsKey(height, txIndex), iterator.Value())
+	}
+
+	if pruned := height - types.BlockLogsRetention; pruned > 0 {
+		k.deleteBlockLogs(ctx, pruned)
+	}
+}
+
+// GetBlockBloom returns the persisted bloom of the block at the given height. It returns false
+// if the block is outside the retention window.
+func (k Keeper) GetBlockBloom(ctx sdk.Context, height int64) (ethtypes.Bloom, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockBloom)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height)))
+	if bz == nil {
+		return ethtypes.Bloom{}, false
+	}
+
+	return ethtypes.BytesToBloom(bz), true
+}
+
+// GetBlockLogs returns the persisted transaction logs of the block at the given height, in
+// execution order. It returns false if the block is outside the retention window.
+func (k Keeper) GetBlockLogs(ctx sdk.Context, height int64) ([]types.TransactionLogs, bool) {
+	if _, found := k.GetBlockBloom(ctx, height); !found {
+		return nil, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockLogs)
+	iterator := sdk.KVStorePrefixIterator(store, sdk.Uint64ToBigEndian(uint64(height)))
+	defer iterator.Close()
+
+	txLogs := []types.TransactionLogs{}
+	for ; iterator.Valid(); iterator.Next() {
+		var logs types.TransactionLogs
+		k.cdc.MustUnmarshal(iterator.Value(), &logs)
+		txLogs = append(txLogs, logs)
+	}
+
+	return txLogs, true
+}
+
+// deleteBlockLogs removes the bloom and the transaction logs of the block at the given height
+func (k Keeper) deleteBlockLogs(ctx sdk.Context, height int64) {
+	bloomStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockBloom)
+	bloomStore.Delete(sdk.Uint64ToBigEndian(uint64(height)))
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockLogs)
+	iterator := sdk.KVStorePrefixIterator(store, sdk.Uint64ToBigEndian(uint64(height)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestPersistBlockLogs() {
+	k := suite.app.EvmKeeper
+	ctx := suite.ctx.WithBlockHeight(types.BlockLogsRetention)
+	txHash := common.BigToHash(big.NewInt(1))
+	log := &ethtypes.Log{
+		Address:     common.BigToAddress(big.NewInt(1001)),
+		Topics:      []common.Hash{common.BigToHash(big.NewInt(2))},
+		Data:        []byte("data"),
+		BlockNumber: uint64(ctx.BlockHeight() + 1),
+		TxHash:      txHash,
+		BlockHash:   common.BigToHash(big.NewInt(3)),
+	}
+	bloom := ethtypes.BytesToBloom(ethtypes.LogsBloom([]*ethtypes.Log{log}))
+
+	_, found := k.GetBlockLogs(ctx, ctx.BlockHeight())
+	suite.Require().False(found)
+
+	// blocks without logs are persisted with an empty bloom
+	k.PersistBlockLogs(ctx, ethtypes.Bloom{})
+
+	txLogs, found := k.GetBlockLogs(ctx, ctx.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().Empty(txLogs)
+
+	nextCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.SetTxLogsTransient(nextCtx, 1, types.NewTransactionLogsFromEth(txHash, []*ethtypes.Log{log}))
+	k.PersistBlockLogs(nextCtx, bloom)
+
+	storedBloom, found := k.GetBlockBloom(nextCtx, nextCtx.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().Equal(bloom, storedBloom)
+
+	txLogs, found = k.GetBlockLogs(nextCtx, nextCtx.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().Len(txLogs, 1)
+	suite.Require().Equal([]*ethtypes.Log{log}, txLogs[0].EthLogs())
+
+	res, err := k.BlockBlooms(nextCtx, &types.QueryBlockBloomsRequest{FromBlock: 2, ToBlock: nextCtx.BlockHeight()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BlockBloom{
+		{Height: ctx.BlockHeight(), Bloom: ethtypes.Bloom{}.Bytes()},
+		{Height: nextCtx.BlockHeight(), Bloom: bloom.Bytes()},
+	}, res.Blooms)
+
+	_, err = k.BlockBlooms(nextCtx, &types.QueryBlockBloomsRequest{FromBlock: 1, ToBlock: nextCtx.BlockHeight()})
+	suite.Require().Error(err)
+
+	// the block leaving the retention window is pruned
+	k.PersistBlockLogs(ctx.WithBlockHeight(2*types.BlockLogsRetention), ethtypes.Bloom{})
+
+	_, found = k.GetBlockBloom(ctx, ctx.BlockHeight())
+	suite.Require().False(found)
+
+	logsRes, err := k.BlockLogs(ctx, &types.QueryBlockLogsRequest{Height: ctx.BlockHeight()})
+	suite.Require().NoError(err)
+	suite.Require().False(logsRes.Found)
+
+	logsRes, err = k.BlockLogs(ctx, &types.QueryBlockLogsRequest{Height: nextCtx.BlockHeight()})
+	suite.Require().NoError(err)
+	suite.Require().True(logsRes.Found)
+}
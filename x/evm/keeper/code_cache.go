@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"container/list"
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// codeCache keeps the bytecode of the most recently loaded contracts, bounded by the total size of
+// the cached code. Code is addressed by its hash, so a cached entry is always the code stored under
+// the hash and the cache never has to be invalidated on rollbacks. It is node-local and held by
+// pointer, as the keeper is copied into other keepers and served concurrently to queries.
+type codeCache struct {
+	mu      sync.Mutex
+	maxSize uint64
+	size    uint64
+	order   *list.List
+	entries map[common.Hash]*list.Element
+}
+
+type codeCacheEntry struct {
+	hash common.Hash
+	code []byte
+}
+
+// SetCodeCacheSize sets the max total size in bytes of the contract code kept in memory by the
+// keeper. A size of 0 disables the cache.
+func (k *Keeper) SetCodeCacheSize(maxSize uint64) *Keeper {
+	if maxSize == 0 {
+		k.codeCache = nil
+		return k
+	}
+
+	k.codeCache = &codeCache{
+		maxSize: maxSize,
+		order:   list.New(),
+		entries: make(map[common.Hash]*list.Element),
+	}
+	return k
+}
+
+// get returns the cached code of the hash and marks it as the most recently used
+func (c *codeCache) get(hash common.Hash) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[hash]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*codeCacheEntry).code, true
+}
+
+// add caches the code of the hash, evicting the least recently used code until it fits. Empty code
+// and code larger than the whole cache aren't cached.
+func (c *codeCache) add(hash common.Hash, code []byte) {
+	if c == nil || len(code) == 0 || uint64(len(code)) > c.maxSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[hash]; ok {
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	for c.size+uint64(len(code)) > c.maxSize {
+		c.removeElement(c.order.Back())
+	}
+
+	c.entries[hash] = c.order.PushFront(&codeCacheEntry{hash: hash, code: code})
+	c.size += uint64(len(code))
+}
+
+// remove evicts the code of the hash
+func (c *codeCache) remove(hash common.Hash) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[hash]; ok {
+		c.removeElement(elem)
+	}
+}
+
+func (c *codeCache) removeElement(elem *list.Element) {
+	entry := c.order.Remove(elem).(*codeCacheEntry)
+	delete(c.entries, entry.hash)
+	c.size -= uint64(len(entry.code))
+}
+
+// consumeCodeReadGas charges the gas of reading the code from the store, so the gas used by a
+// transaction doesn't depend on the content of the node-local cache
+func consumeCodeReadGas(ctx sdk.Context, codeHash common.Hash, code []byte) {
+	gasConfig := ctx.KVGasConfig()
+	keyLen := len(types.KeyPrefixCode) + len(codeHash)
+
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(keyLen), storetypes.GasReadPerByteDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(code)), storetypes.GasReadPerByteDesc)
+}
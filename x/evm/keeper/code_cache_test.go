@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestCodeCache() {
+	k := suite.app.EvmKeeper
+	k.SetCodeCacheSize(150)
+	defer k.SetCodeCacheSize(0)
+
+	codeA := bytes.Repeat([]byte{0x60}, 100)
+	codeB := bytes.Repeat([]byte{0x61}, 100)
+	hashA, hashB := crypto.Keccak256Hash(codeA), crypto.Keccak256Hash(codeB)
+	k.SetCode(suite.ctx, hashA.Bytes(), codeA)
+	k.SetCode(suite.ctx, hashB.Bytes(), codeB)
+
+	// cached reads are charged like store reads
+	gasUsed := func() uint64 {
+		ctx := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		suite.Require().Equal(codeA, k.GetCode(ctx, hashA))
+		return ctx.GasMeter().GasConsumed()
+	}
+	suite.Require().Equal(gasUsed(), gasUsed())
+
+	// loading B evicts A, as both don't fit in the cache
+	suite.Require().Equal(codeB, k.GetCode(suite.ctx, hashB))
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.KeyPrefixCode)
+	store.Delete(hashA.Bytes())
+	store.Delete(hashB.Bytes())
+	suite.Require().Nil(k.GetCode(suite.ctx, hashA))
+	suite.Require().Equal(codeB, k.GetCode(suite.ctx, hashB))
+
+	// deleted code is evicted
+	k.SetCode(suite.ctx, hashB.Bytes(), nil)
+	suite.Require().Nil(k.GetCode(suite.ctx, hashB))
+}
@@ -16,6 +16,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
@@ -24,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 // EVMConfig creates the EVMConfig based on current state
@@ -76,3 +78,29 @@ func (k Keeper) VMConfig(ctx sdk.Context, msg core.Message, cfg *types.EVMConfig
 		ExtraEips: cfg.Params.EIPs(),
 	}
 }
+
+// EVMConfigHash hashes the consensus-relevant EVM configuration: the module params, the effective
+// Ethereum chain config and the fee market params. Nodes running with mismatched params or a
+// go-ethereum version resolving the chain config differently return a different hash, so
+// monitoring can detect them before they produce a divergent app hash.
+func (k *Keeper) EVMConfigHash(ctx sdk.Context, chainID *big.Int) (*types.QueryConfigHashResponse, error) {
+	params := k.GetParams(ctx)
+	feeMarketParams := k.feeMarketKeeper.GetParams(ctx)
+
+	chainConfig, err := json.Marshal(params.ChainConfig.EthereumConfig(chainID))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal chain config")
+	}
+
+	paramsHash := crypto.Keccak256Hash(k.cdc.MustMarshal(&params))
+	chainConfigHash := crypto.Keccak256Hash(chainConfig)
+	feeMarketParamsHash := crypto.Keccak256Hash(k.cdc.MustMarshal(&feeMarketParams))
+
+	return &types.QueryConfigHashResponse{
+		Hash:                crypto.Keccak256Hash(paramsHash.Bytes(), chainConfigHash.Bytes(), feeMarketParamsHash.Bytes()).Hex(),
+		ParamsHash:          paramsHash.Hex(),
+		ChainConfigHash:     chainConfigHash.Hex(),
+		FeeMarketParamsHash: feeMarketParamsHash.Hex(),
+		Height:              ctx.BlockHeight(),
+	}, nil
+}
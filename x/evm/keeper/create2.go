@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// InstallCreate2Deployer installs the canonical CREATE2 deployer. It does nothing if the
+// deployer code is already installed.
+func (k *Keeper) InstallCreate2Deployer(ctx sdk.Context) error {
+	account := k.GetAccountOrEmpty(ctx, types.Create2DeployerAddress)
+	if bytes.Equal(account.CodeHash, crypto.Keccak256(types.Create2DeployerCode)) {
+		return nil
+	}
+
+	return k.SetAccountCode(ctx, types.Create2DeployerAddress, types.Create2DeployerCode)
+}
+
+// InstallPreinstalls installs the code of the infrastructure contracts at genesis. It fails if an
+// account already has different code, so a preinstall can't replace a deployed contract.
+func (k *Keeper) InstallPreinstalls(ctx sdk.Context, preinstalls []types.Preinstall) error {
+	for _, preinstall := range preinstalls {
+		address := common.HexToAddress(preinstall.Address)
+		code := common.FromHex(preinstall.Code)
+		account := k.GetAccountOrEmpty(ctx, address)
+		if account.IsContract() && !bytes.Equal(account.CodeHash, crypto.Keccak256(code)) {
+			return errorsmod.Wrapf(types.ErrInvalidAccount, "preinstall %s: account %s has code", preinstall.Name, address)
+		}
+		if err := k.SetAccountCode(ctx, address, code); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestInstallCreate2Deployer() {
+	k := suite.app.EvmKeeper
+
+	// installed at genesis
+	code, err := k.GetAccountCode(suite.ctx, types.Create2DeployerAddress)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Create2DeployerCode, code)
+
+	// installing again keeps the account
+	nonce := k.GetNonce(suite.ctx, types.Create2DeployerAddress)
+	suite.Require().NoError(k.SetNonce(suite.ctx, types.Create2DeployerAddress, nonce+1))
+	suite.Require().NoError(k.InstallCreate2Deployer(suite.ctx))
+	suite.Require().Equal(nonce+1, k.GetNonce(suite.ctx, types.Create2DeployerAddress))
+}
+
+func (suite *KeeperTestSuite) TestQueryCreate2Address() {
+	k := suite.app.EvmKeeper
+	salt := common.BigToHash(common.Big1)
+	initCodeHash := crypto.Keccak256Hash([]byte{0x00})
+	deployer := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	res, err := k.Create2Address(sdk.WrapSDKContext(suite.ctx), &types.QueryCreate2AddressRequest{
+		Salt:         salt.Hex(),
+		InitCodeHash: initCodeHash.Hex(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(crypto.CreateAddress2(types.Create2DeployerAddress, salt, initCodeHash.Bytes()).Hex(), res.Address)
+
+	res, err = k.Create2Address(sdk.WrapSDKContext(suite.ctx), &types.QueryCreate2AddressRequest{
+		Deployer:     deployer.Hex(),
+		Salt:         salt.Hex(),
+		InitCodeHash: initCodeHash.Hex(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(crypto.CreateAddress2(deployer, salt, initCodeHash.Bytes()).Hex(), res.Address)
+
+	_, err = k.Create2Address(sdk.WrapSDKContext(suite.ctx), &types.QueryCreate2AddressRequest{
+		Deployer:     "invalid",
+		Salt:         salt.Hex(),
+		InitCodeHash: initCodeHash.Hex(),
+	})
+	suite.Require().Error(err)
+
+	_, err = k.Create2Address(sdk.WrapSDKContext(suite.ctx), &types.QueryCreate2AddressRequest{
+		Salt:         "0x01",
+		InitCodeHash: initCodeHash.Hex(),
+	})
+	suite.Require().Error(err)
+}
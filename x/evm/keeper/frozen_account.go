@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// Accounts frozen through governance can't send transactions, so they can't transfer their
+// funds, while they can still receive them. Frozen transactions are rejected by the ante
+// handler and once more when the message is applied.
+
+// SetAccountFrozen freezes the account
+func (k *Keeper) SetAccountFrozen(ctx sdk.Context, addr common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenAccount)
+	store.Set(addr.Bytes(), []byte{1})
+}
+
+// DeleteAccountFrozen unfreezes the account
+func (k *Keeper) DeleteAccountFrozen(ctx sdk.Context, addr common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenAccount)
+	store.Delete(addr.Bytes())
+}
+
+// IsAccountFrozen returns true if the account was frozen
+func (k *Keeper) IsAccountFrozen(ctx sdk.Context, addr common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenAccount)
+	return store.Has(addr.Bytes())
+}
+
+// GetFrozenAccounts returns the hex addresses of all frozen accounts
+func (k *Keeper) GetFrozenAccounts(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixFrozenAccount)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		address := common.BytesToAddress(iterator.Key()[len(types.KeyPrefixFrozenAccount):])
+		addresses = append(addresses, address.Hex())
+	}
+
+	return addresses
+}
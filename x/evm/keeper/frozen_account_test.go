@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestFreezeAccount() {
+	k := suite.app.EvmKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	address := common.BigToAddress(big.NewInt(1001))
+
+	_, err := k.FreezeAccount(suite.ctx, &types.MsgFreezeAccount{Authority: "foobar", Address: address.Hex()})
+	suite.Require().Error(err)
+	suite.Require().False(k.IsAccountFrozen(suite.ctx, address))
+
+	_, err = k.FreezeAccount(suite.ctx, &types.MsgFreezeAccount{Authority: authority, Address: address.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().True(k.IsAccountFrozen(suite.ctx, address))
+	suite.Require().Equal([]string{address.Hex()}, k.GetFrozenAccounts(suite.ctx))
+
+	res, err := k.FrozenAccounts(suite.ctx, &types.QueryFrozenAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{address.Hex()}, res.Addresses)
+
+	_, err = k.UnfreezeAccount(suite.ctx, &types.MsgUnfreezeAccount{Authority: "foobar", Address: address.Hex()})
+	suite.Require().Error(err)
+	suite.Require().True(k.IsAccountFrozen(suite.ctx, address))
+
+	_, err = k.UnfreezeAccount(suite.ctx, &types.MsgUnfreezeAccount{Authority: authority, Address: address.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().False(k.IsAccountFrozen(suite.ctx, address))
+
+	// only frozen accounts can be unfrozen
+	_, err = k.UnfreezeAccount(suite.ctx, &types.MsgUnfreezeAccount{Authority: authority, Address: address.Hex()})
+	suite.Require().Error(err)
+
+	// the admin authority can freeze accounts besides governance
+	admin := sdk.AccAddress(common.BigToAddress(big.NewInt(1002)).Bytes()).String()
+	_, err = k.FreezeAccount(suite.ctx, &types.MsgFreezeAccount{Authority: admin, Address: address.Hex()})
+	suite.Require().Error(err)
+
+	params := k.GetParams(suite.ctx)
+	params.AdminAuthority = admin
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+	_, err = k.FreezeAccount(suite.ctx, &types.MsgFreezeAccount{Authority: admin, Address: address.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().True(k.IsAccountFrozen(suite.ctx, address))
+
+	_, err = k.UnfreezeAccount(suite.ctx, &types.MsgUnfreezeAccount{Authority: admin, Address: address.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().False(k.IsAccountFrozen(suite.ctx, address))
+}
@@ -16,6 +16,7 @@
 package keeper
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
@@ -30,13 +31,30 @@ import (
 	"github.com/SigmaGmbH/evm-module/x/evm/types"
 )
 
+// initCodeWordGas is the gas charged per 32 byte word of init code since Shanghai (EIP-3860), it's
+// not defined by the go-ethereum version in use
+const initCodeWordGas = 2
+
 // GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
 func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *params.ChainConfig, isContractCreation bool) (uint64, error) {
 	height := big.NewInt(ctx.BlockHeight())
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	gas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	if isContractCreation && cfg.IsShanghai(height) {
+		words := (uint64(len(msg.Data())) + 31) / 32
+		if (math.MaxUint64-gas)/initCodeWordGas < words {
+			return 0, core.ErrGasUintOverflow
+		}
+		gas += words * initCodeWordGas
+	}
+
+	return gas, nil
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -29,8 +29,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -64,6 +67,9 @@ func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*typ
 	addr := common.HexToAddress(req.Address)
 
 	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.validateQueryState(ctx); err != nil {
+		return nil, err
+	}
 	acct := k.GetAccountOrEmpty(ctx, addr)
 
 	return &types.QueryAccountResponse{
@@ -151,6 +157,9 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*typ
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.validateQueryState(ctx); err != nil {
+		return nil, err
+	}
 
 	balanceInt := k.GetBalance(ctx, common.HexToAddress(req.Address))
 
@@ -173,6 +182,9 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.validateQueryState(ctx); err != nil {
+		return nil, err
+	}
 
 	address := common.HexToAddress(req.Address)
 	key := common.HexToHash(req.Key)
@@ -186,6 +198,45 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// StorageRange implements the Query/StorageRange gRPC method
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (*types.QueryStorageRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmcommontypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxStorageRangeLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit exceeds %d storage slots", types.MaxStorageRangeLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.validateQueryState(ctx); err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(common.HexToAddress(req.Address)))
+
+	storage := types.Storage{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		storage = append(storage, types.NewState(common.BytesToHash(key), common.BytesToHash(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryStorageRangeResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -200,6 +251,9 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.Que
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.validateQueryState(ctx); err != nil {
+		return nil, err
+	}
 
 	address := common.HexToAddress(req.Address)
 	acct := k.GetAccountWithoutBalance(ctx, address)
@@ -214,6 +268,16 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.Que
 	}, nil
 }
 
+// validateQueryState returns an error if the evm state doesn't exist at the queried height, e.g.
+// before the module was added to the chain. Heights pruned by the node are already rejected by
+// the multistore before the query is handled.
+func (k Keeper) validateQueryState(ctx sdk.Context) error {
+	if k.GetParams(ctx).EvmDenom == "" {
+		return status.Errorf(codes.NotFound, "evm state is not available at height %d", ctx.BlockHeight())
+	}
+	return nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -230,7 +294,8 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, cancel := k.withQueryBudget(c, sdk.UnwrapSDKContext(c))
+	defer cancel()
 
 	var args types.CallArgs
 	err := json.Unmarshal(req.Args, &args)
@@ -255,6 +320,11 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// the enclave decrypts the state of the called contracts, so the call is run for its sender, who
+	// must be permitted to view restricted contracts. The sender of signed calls is recovered from
+	// the signature.
+	ctx = WithViewer(ctx, msg.From())
+
 	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
 	txContext, err := CreateSGXVMContextFromMessage(ctx, &k, msg)
@@ -263,7 +333,9 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	}
 	// pass false to not commit StateDB
 	res, err := k.ApplyMessageWithConfig(ctx, msg, false, cfg, txConfig, txContext)
-	if err != nil {
+	if errorsmod.IsOf(err, types.ErrViewNotPermitted) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	} else if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -276,7 +348,9 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, cancel := k.withQueryBudget(c, sdk.UnwrapSDKContext(c))
+	defer cancel()
+
 	chainID, err := getChainID(ctx, req.ChainId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -336,6 +410,9 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// the sender isn't authenticated, so restricted contracts can't be viewed
+	ctx = WithViewer(ctx, common.Address{})
+
 	// NOTE: the errors from the executable below should be consistent with go-ethereum,
 	// so we don't wrap them with the gRPC status code
 
@@ -418,7 +495,9 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, cancel := k.withQueryBudget(c, sdk.UnwrapSDKContext(c))
+	defer cancel()
+
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -465,7 +544,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
 	}
 
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
+	result, _, details, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -477,7 +556,8 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}
 
 	return &types.QueryTraceTxResponse{
-		Data: resultData,
+		Data:    resultData,
+		Details: details,
 	}, nil
 }
 
@@ -500,7 +580,9 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, cancel := k.withQueryBudget(c, sdk.UnwrapSDKContext(c))
+	defer cancel()
+
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -516,6 +598,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
 	txsLength := len(req.Txs)
 	results := make([]*types.TxTraceResult, 0, txsLength)
+	details := make([]types.TraceTxDetails, 0, txsLength)
 
 	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 	for i, tx := range req.Txs {
@@ -523,14 +606,16 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, txDetails, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
 		if err != nil {
 			result.Error = err.Error()
+			txDetails = &types.TraceTxDetails{TxHash: ethTx.Hash().Hex()}
 		} else {
 			txConfig.LogIndex = logIndex
 			result.Result = traceResult
 		}
 		results = append(results, &result)
+		details = append(details, *txDetails)
 	}
 
 	resultData, err := json.Marshal(results)
@@ -539,11 +624,12 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}
 
 	return &types.QueryTraceBlockResponse{
-		Data: resultData,
+		Data:    resultData,
+		Details: details,
 	}, nil
 }
 
-// traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
+// traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, details, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
 	cfg *types.EVMConfig,
@@ -553,7 +639,7 @@ func (k *Keeper) traceTx(
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
-) (*interface{}, uint, error) {
+) (*interface{}, uint, *types.TraceTxDetails, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
 		tracer    tracers.Tracer
@@ -563,7 +649,7 @@ func (k *Keeper) traceTx(
 	)
 	msg, err := tx.AsMessage(signer, cfg.BaseFee)
 	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
+		return nil, 0, nil, status.Error(codes.Internal, err.Error())
 	}
 
 	if traceConfig == nil {
@@ -594,14 +680,14 @@ func (k *Keeper) traceTx(
 
 	if traceConfig.Tracer != "" {
 		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerJSONConfig); err != nil {
-			return nil, 0, status.Error(codes.Internal, err.Error())
+			return nil, 0, nil, status.Error(codes.Internal, err.Error())
 		}
 	}
 
 	// Define a meaningful timeout of a single transaction trace
 	if traceConfig.Timeout != "" {
 		if timeout, err = time.ParseDuration(traceConfig.Timeout); err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
+			return nil, 0, nil, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
 		}
 	}
 
@@ -618,20 +704,95 @@ func (k *Keeper) traceTx(
 
 	txContext, err := CreateSGXVMContextFromMessage(ctx, k, msg)
 	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
-	res, err := k.ApplyMessageWithConfig(ctx, msg, commitMessage, cfg, txConfig, txContext)
+		return nil, 0, nil, status.Error(codes.Internal, err.Error())
+	}
+	// execute in a cache context, so the accessed state can still be read as it was before. The
+	// execution is aborted at its next state request once the trace timeout expires.
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithContext(deadlineCtx)
+	// the trace exposes the state of the traced contracts to anyone, so restricted contracts can't be
+	// traced
+	cacheCtx = WithViewer(cacheCtx, common.Address{})
+	res, accessList, err := k.applyMessageWithConfig(cacheCtx, msg, commitMessage, cfg, txConfig, txContext)
+	if errorsmod.IsOf(err, types.ErrViewNotPermitted) {
+		return nil, 0, nil, status.Error(codes.PermissionDenied, err.Error())
+	} else if err != nil {
+		return nil, 0, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	details, err := k.traceTxDetails(ctx, cfg, msg, txConfig.TxHash, res, accessList)
 	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
+		return nil, 0, nil, status.Error(codes.Internal, err.Error())
+	}
+	if commitMessage {
+		commit()
 	}
 
 	var result interface{}
 	result, err = tracer.GetResult()
 	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
+		return nil, 0, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &result, txConfig.LogIndex + uint(len(res.Logs)), details, nil
+}
+
+// traceTxDetails returns the gas breakdown of the traced message and the state of the accounts
+// it accessed, read from the context it was executed on
+func (k *Keeper) traceTxDetails(
+	ctx sdk.Context,
+	cfg *types.EVMConfig,
+	msg core.Message,
+	txHash common.Hash,
+	res *types.MsgEthereumTxResponse,
+	accessList *AccessListTracker,
+) (*types.TraceTxDetails, error) {
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, msg.To() == nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var executionGas uint64
+	if res.GasUsed > intrinsicGas {
+		executionGas = res.GasUsed - intrinsicGas
 	}
 
-	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
+	// precompiles are pre-warmed, but don't have any state
+	precompiles := make(map[common.Address]bool)
+	for _, addr := range activePrecompileAddresses(ctx, cfg) {
+		precompiles[addr] = true
+	}
+
+	overrides := make([]types.TraceStateOverride, 0)
+	for _, tuple := range accessList.AccessList() {
+		if precompiles[tuple.Address] {
+			continue
+		}
+
+		acct := k.GetAccountOrEmpty(ctx, tuple.Address)
+		storageKeys := make([]string, len(tuple.StorageKeys))
+		for i, key := range tuple.StorageKeys {
+			storageKeys[i] = key.Hex()
+		}
+		overrides = append(overrides, types.TraceStateOverride{
+			Address:     tuple.Address.Hex(),
+			Balance:     acct.Balance.String(),
+			Nonce:       acct.Nonce,
+			CodeHash:    common.BytesToHash(acct.CodeHash).Hex(),
+			StorageKeys: storageKeys,
+		})
+	}
+
+	return &types.TraceTxDetails{
+		TxHash: txHash.Hex(),
+		Gas: types.TraceGasBreakdown{
+			GasLimit:     msg.Gas(),
+			IntrinsicGas: intrinsicGas,
+			ExecutionGas: executionGas,
+			GasUsed:      res.GasUsed,
+		},
+		StateOverrides: overrides,
+	}, nil
 }
 
 // BaseFee implements the Query/BaseFee gRPC method
@@ -662,6 +823,87 @@ func (k Keeper) NodePublicKey(_ context.Context, _ *types.QueryNodePublicKey) (*
 	return res, nil
 }
 
+// StorageUsage implements the Query/StorageUsage gRPC method
+func (k Keeper) StorageUsage(c context.Context, req *types.QueryStorageUsageRequest) (*types.QueryStorageUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Address == "" {
+		return &types.QueryStorageUsageResponse{StorageUsage: k.GetTotalStorageUsage(ctx)}, nil
+	}
+
+	if err := evmcommontypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryStorageUsageResponse{
+		StorageUsage: k.GetStorageUsage(ctx, common.HexToAddress(req.Address)),
+	}, nil
+}
+
+// Create2Address implements the Query/Create2Address gRPC method
+func (k Keeper) Create2Address(_ context.Context, req *types.QueryCreate2AddressRequest) (*types.QueryCreate2AddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	deployer := types.Create2DeployerAddress
+	if req.Deployer != "" {
+		if err := evmcommontypes.ValidateAddress(req.Deployer); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		deployer = common.HexToAddress(req.Deployer)
+	}
+
+	address, err := types.ComputeCreate2Address(deployer, req.Salt, req.InitCodeHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCreate2AddressResponse{Address: address.Hex()}, nil
+}
+
+// SimulateParamsUpdate implements the Query/SimulateParamsUpdate gRPC method
+func (k Keeper) SimulateParamsUpdate(
+	c context.Context,
+	req *types.QuerySimulateParamsUpdateRequest,
+) (*types.QuerySimulateParamsUpdateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	current := k.GetParams(ctx)
+
+	if err := types.ValidateParamsUpdate(current, req.Params, ctx.BlockHeight()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySimulateParamsUpdateResponse{
+		Changes: types.DiffParams(current, req.Params),
+	}, nil
+}
+
+// ConfigHash implements the Query/ConfigHash gRPC method
+func (k Keeper) ConfigHash(c context.Context, _ *types.QueryConfigHashRequest) (*types.QueryConfigHashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chainID, err := getChainID(ctx, 0)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to parse chain id")
+	}
+
+	res, err := k.EVMConfigHash(ctx, chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -669,3 +911,114 @@ func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	}
 	return big.NewInt(chainID), nil
 }
+
+// EpochKeys implements the Query/EpochKeys gRPC method
+func (k Keeper) EpochKeys(c context.Context, _ *types.QueryEpochKeysRequest) (*types.QueryEpochKeysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the enclave derives a single key pair from the master seed, which is used in every epoch
+	publicKey, err := k.GetNodePublicKey()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// epoch 0 is implicit and starts at genesis
+	epochs := append([]types.KeyEpoch{{}}, k.GetKeyEpochs(ctx)...)
+
+	epochKeys := make([]types.EpochKey, len(epochs))
+	for i, epoch := range epochs {
+		epochKeys[i] = types.EpochKey{
+			Epoch:       epoch.Epoch,
+			StartHeight: epoch.StartHeight,
+			PublicKey:   publicKey.Hex(),
+		}
+		if i+1 < len(epochs) {
+			epochKeys[i].EndHeight = epochs[i+1].StartHeight - 1
+		}
+	}
+
+	return &types.QueryEpochKeysResponse{
+		EpochKeys:    epochKeys,
+		CurrentEpoch: k.GetKeyEpochAtHeight(ctx, ctx.BlockHeight()).Epoch,
+	}, nil
+}
+
+// FrozenAccounts implements the Query/FrozenAccounts gRPC method
+func (k Keeper) FrozenAccounts(c context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFrozenAccount)
+
+	var addresses []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryFrozenAccountsResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BlockBlooms implements the Query/BlockBlooms gRPC method
+func (k Keeper) BlockBlooms(c context.Context, req *types.QueryBlockBloomsRequest) (*types.QueryBlockBloomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.FromBlock > req.ToBlock {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block range [%d, %d]", req.FromBlock, req.ToBlock)
+	}
+
+	if req.ToBlock-req.FromBlock >= types.BlockLogsRetention {
+		return nil, status.Errorf(codes.InvalidArgument, "block range exceeds %d blocks", types.BlockLogsRetention)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	blooms := []types.BlockBloom{}
+	for height := req.FromBlock; height <= req.ToBlock; height++ {
+		bloom, found := k.GetBlockBloom(ctx, height)
+		if !found {
+			continue
+		}
+		blooms = append(blooms, types.BlockBloom{Height: height, Bloom: bloom.Bytes()})
+	}
+
+	return &types.QueryBlockBloomsResponse{Blooms: blooms}, nil
+}
+
+// BlockLogs implements the Query/BlockLogs gRPC method
+func (k Keeper) BlockLogs(c context.Context, req *types.QueryBlockLogsRequest) (*types.QueryBlockLogsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	txLogs, found := k.GetBlockLogs(ctx, req.Height)
+
+	return &types.QueryBlockLogsResponse{
+		Found:  found,
+		TxLogs: txLogs,
+	}, nil
+}
+
+// StateHistory implements the Query/StateHistory gRPC method
+func (k Keeper) StateHistory(_ context.Context, _ *types.QueryStateHistoryRequest) (*types.QueryStateHistoryResponse, error) {
+	if k.stateHistory == nil {
+		return nil, status.Error(codes.Unavailable, "state history of the node is not available")
+	}
+
+	return &types.QueryStateHistoryResponse{
+		Archive:        k.archive,
+		EarliestHeight: k.EarliestStateHeight(),
+		LatestHeight:   k.stateHistory.LatestVersion(),
+	}, nil
+}
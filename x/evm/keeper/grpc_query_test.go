@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/SigmaGmbH/evm-module/crypto/deoxys"
 	"math/big"
@@ -11,10 +12,16 @@ import (
 	"github.com/SigmaGmbH/evm-module/tests"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/SigmaGmbH/evm-module/server/config"
 	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
@@ -285,6 +292,53 @@ func (suite *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryStorageRange() {
+	var storage types.Storage
+	for i := byte(1); i <= 5; i++ {
+		key := common.BytesToHash([]byte{i})
+		value := common.BytesToHash([]byte{i, i})
+		suite.app.EvmKeeper.SetState(suite.ctx, suite.address, key, value.Bytes())
+		storage = append(storage, types.NewState(key, value))
+	}
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.queryClient.StorageRange(ctx, &types.QueryStorageRangeRequest{Address: invalidAddress})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    suite.address.String(),
+		Pagination: &query.PageRequest{Limit: types.MaxStorageRangeLimit + 1},
+	})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.StorageRange(ctx, &types.QueryStorageRangeRequest{Address: suite.address.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(storage, res.Storage)
+
+	// page through the storage with the next key as cursor
+	var paged types.Storage
+	pageReq := &query.PageRequest{Limit: 2}
+	for {
+		res, err := suite.queryClient.StorageRange(ctx, &types.QueryStorageRangeRequest{
+			Address:    suite.address.String(),
+			Pagination: pageReq,
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.Storage), 2)
+		paged = append(paged, res.Storage...)
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	}
+	suite.Require().Equal(storage, paged)
+
+	// contracts without storage return an empty page
+	res, err = suite.queryClient.StorageRange(ctx, &types.QueryStorageRangeRequest{Address: tests.GenerateAddress().String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Storage)
+}
+
 func (suite *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -958,3 +1012,128 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTraceTxDetails() {
+	suite.SetupSGXVMTest()
+
+	k := suite.app.EvmKeeper
+	chainCfg := k.GetParams(suite.ctx).ChainConfig.EthereumConfig(k.ChainID())
+	suite.Require().NoError(k.SetBalance(suite.ctx, suite.address, big.NewInt(1000)))
+
+	msg, _, err := newEthMsgTx(
+		k.GetNonce(suite.ctx, suite.address),
+		suite.ctx.BlockHeight(),
+		suite.address,
+		chainCfg,
+		suite.signer,
+		ethtypes.LatestSignerForChainID(k.ChainID()),
+		ethtypes.AccessListTxType,
+		nil,
+		nil,
+		big.NewInt(100),
+	)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+		Msg:         msg,
+		BlockNumber: suite.ctx.BlockHeight(),
+		ChainId:     k.ChainID().Int64(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.Details)
+
+	details := res.Details
+	suite.Require().Equal(msg.AsTransaction().Hash().Hex(), details.TxHash)
+	suite.Require().Equal(types.TraceGasBreakdown{
+		GasLimit:     ethparams.TxGas,
+		IntrinsicGas: ethparams.TxGas,
+		ExecutionGas: 0,
+		GasUsed:      ethparams.TxGas,
+	}, details.Gas)
+
+	// the sender and the recipient are accessed, with their state before the transfer
+	overrides := make(map[string]types.TraceStateOverride)
+	for _, override := range details.StateOverrides {
+		overrides[override.Address] = override
+	}
+	suite.Require().Len(overrides, 2)
+	suite.Require().Equal("1000", overrides[suite.address.Hex()].Balance)
+	suite.Require().Equal("0", overrides[common.Address{}.Hex()].Balance)
+
+	// tracing doesn't commit the transfer
+	suite.Require().Equal(big.NewInt(1000), k.GetBalance(suite.ctx, suite.address))
+}
+
+func (suite *KeeperTestSuite) TestHistoricalQueries() {
+	suite.SetupTest()
+
+	k := suite.app.EvmKeeper
+	address := tests.GenerateAddress()
+	key := common.BigToHash(big.NewInt(1))
+	code := []byte("code")
+
+	// query sends the query at the height through the ABCI query path, like the gRPC server does for
+	// the x-cosmos-block-height header
+	query := func(method string, req, res codec.ProtoMarshaler, height int64) error {
+		bz, err := req.Marshal()
+		suite.Require().NoError(err)
+		abciRes := suite.app.Query(abci.RequestQuery{Path: "/ethermint.evm.v1.Query/" + method, Data: bz, Height: height})
+		if abciRes.Code != 0 {
+			return errors.New(abciRes.Log)
+		}
+		return res.Unmarshal(abciRes.Value)
+	}
+
+	suite.Require().NoError(k.SetBalance(suite.ctx, address, big.NewInt(100)))
+	k.SetState(suite.ctx, address, key, common.BigToHash(big.NewInt(1)).Bytes())
+	suite.Commit()
+	firstHeight := suite.ctx.BlockHeight() - 1
+
+	suite.Require().NoError(k.SetBalance(suite.ctx, address, big.NewInt(200)))
+	k.SetState(suite.ctx, address, key, common.BigToHash(big.NewInt(2)).Bytes())
+	suite.Require().NoError(k.SetAccountCode(suite.ctx, address, code))
+	suite.Commit()
+	secondHeight := suite.ctx.BlockHeight() - 1
+
+	testCases := []struct {
+		height     int64
+		balance    string
+		state      common.Hash
+		code       []byte
+		isContract bool
+	}{
+		{firstHeight, "100", common.BigToHash(big.NewInt(1)), nil, false},
+		{secondHeight, "200", common.BigToHash(big.NewInt(2)), code, true},
+	}
+
+	for _, tc := range testCases {
+		var balanceRes types.QueryBalanceResponse
+		suite.Require().NoError(query("Balance", &types.QueryBalanceRequest{Address: address.Hex()}, &balanceRes, tc.height))
+		suite.Require().Equal(tc.balance, balanceRes.Balance)
+
+		var accountRes types.QueryAccountResponse
+		suite.Require().NoError(query("Account", &types.QueryAccountRequest{Address: address.Hex()}, &accountRes, tc.height))
+		suite.Require().Equal(tc.balance, accountRes.Balance)
+		suite.Require().Equal(tc.isContract, accountRes.CodeHash != common.BytesToHash(types.EmptyCodeHash).Hex())
+
+		var storageRes types.QueryStorageResponse
+		suite.Require().NoError(query("Storage", &types.QueryStorageRequest{Address: address.Hex(), Key: key.Hex()}, &storageRes, tc.height))
+		suite.Require().Equal(tc.state.Hex(), storageRes.Value)
+
+		var codeRes types.QueryCodeResponse
+		suite.Require().NoError(query("Code", &types.QueryCodeRequest{Address: address.Hex()}, &codeRes, tc.height))
+		suite.Require().Equal(tc.code, codeRes.Code)
+	}
+
+	// heights that are not committed yet can't be queried
+	var balanceRes types.QueryBalanceResponse
+	suite.Require().Error(query("Balance", &types.QueryBalanceRequest{Address: address.Hex()}, &balanceRes, secondHeight+10))
+
+	// the evm state doesn't exist at heights before the module was added
+	ctx, _ := suite.ctx.CacheContext()
+	ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.KeyPrefixParams)
+	_, err := k.Balance(sdk.WrapSDKContext(ctx), &types.QueryBalanceRequest{Address: address.Hex()})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+	_, err = k.Account(sdk.WrapSDKContext(ctx), &types.QueryAccountRequest{Address: address.Hex()})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+}
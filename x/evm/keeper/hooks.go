@@ -23,7 +23,10 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks    = MultiEvmHooks{}
+	_ types.EvmPreHooks = MultiEvmPreHooks{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -42,3 +45,21 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	}
 	return nil
 }
+
+// MultiEvmPreHooks combine multiple evm pre hooks, all hook functions are run in array sequence
+type MultiEvmPreHooks []types.EvmPreHooks
+
+// NewMultiEvmPreHooks combine multiple evm pre hooks
+func NewMultiEvmPreHooks(hooks ...types.EvmPreHooks) MultiEvmPreHooks {
+	return hooks
+}
+
+// PreTxProcessing delegate the call to underlying hooks, it stops at the first hook returning an error
+func (mh MultiEvmPreHooks) PreTxProcessing(ctx sdk.Context, msg core.Message, cfg *types.EVMConfig) error {
+	for i := range mh {
+		if err := mh[i].PreTxProcessing(ctx, msg, cfg); err != nil {
+			return errorsmod.Wrapf(err, "EVM pre hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
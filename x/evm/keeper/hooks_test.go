@@ -4,11 +4,14 @@ import (
 	"errors"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 
+	"github.com/SigmaGmbH/evm-module/app/ante"
 	"github.com/SigmaGmbH/evm-module/x/evm/keeper"
 	"github.com/SigmaGmbH/evm-module/x/evm/types"
 )
@@ -20,6 +23,20 @@ func (dh FailureHook) PostTxProcessing(sdk.Context, core.Message, *ethtypes.Rece
 	return errors.New("post tx processing failed")
 }
 
+// PreHook funds the marker account and returns the configured error
+type PreHook struct {
+	keeper *keeper.Keeper
+	marker common.Address
+	err    error
+}
+
+func (h PreHook) PreTxProcessing(ctx sdk.Context, _ core.Message, _ *types.EVMConfig) error {
+	if err := h.keeper.SetBalance(ctx, h.marker, big.NewInt(1)); err != nil {
+		return err
+	}
+	return h.err
+}
+
 func (suite *KeeperTestSuite) TestEvmHooks() {
 	testCases := []struct {
 		msg       string
@@ -55,3 +72,120 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+func (suite *KeeperTestSuite) TestEvmPreHooks() {
+	testCases := []struct {
+		msg       string
+		hookErr   error
+		expRevert bool
+	}{
+		{"successful hook", nil, false},
+		{"failing hook reverts the tx", errors.New("pre tx processing failed"), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupSGXVMTest()
+
+			k := suite.app.EvmKeeper
+			marker := common.BigToAddress(big.NewInt(1001))
+			k.SetPreHooks(keeper.NewMultiEvmPreHooks(PreHook{keeper: k, marker: marker, err: tc.hookErr}))
+
+			chainCfg := k.GetParams(suite.ctx).ChainConfig.EthereumConfig(k.ChainID())
+			msg, _, err := newEthMsgTx(
+				k.GetNonce(suite.ctx, suite.address),
+				suite.ctx.BlockHeight(),
+				suite.address,
+				chainCfg,
+				suite.signer,
+				ethtypes.LatestSignerForChainID(k.ChainID()),
+				ethtypes.AccessListTxType,
+				nil,
+				nil,
+				big.NewInt(1000),
+			)
+			suite.Require().NoError(err)
+			suite.Require().NoError(k.SetBalance(suite.ctx, suite.address, big.NewInt(1000)))
+
+			res, err := k.HandleTx(suite.ctx, msg)
+			suite.Require().NoError(err)
+
+			if tc.expRevert {
+				// the tx is not executed and the state written by the hooks is discarded
+				suite.Require().True(res.Failed())
+				suite.Require().Equal(types.ErrPreTxProcessing.Error(), res.VmError)
+				suite.Require().Equal(params.TxGas, res.GasUsed)
+				suite.Require().Empty(res.Logs)
+				suite.Require().Equal(big.NewInt(1000), k.GetBalance(suite.ctx, suite.address))
+				suite.Require().Equal(new(big.Int), k.GetBalance(suite.ctx, marker))
+			} else {
+				suite.Require().False(res.Failed())
+				suite.Require().Equal(big.NewInt(1), k.GetBalance(suite.ctx, marker))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPreTxProcessingRevert() {
+	suite.SetupSGXVMTest()
+
+	k := suite.app.EvmKeeper
+	hookErr := errors.New("pre tx processing failed")
+	k.SetPreHooks(keeper.NewMultiEvmPreHooks(PreHook{keeper: k, marker: common.BigToAddress(big.NewInt(1001)), err: hookErr}))
+
+	gasLimit := uint64(100000)
+	gasPrice := big.NewInt(10)
+	ethTx := ethtypes.NewTx(&ethtypes.AccessListTx{
+		ChainID:  k.ChainID(),
+		Nonce:    k.GetNonce(suite.ctx, suite.address),
+		GasPrice: gasPrice,
+		Gas:      gasLimit,
+		To:       &common.Address{},
+		Value:    new(big.Int),
+	})
+	msg := &types.MsgHandleTx{}
+	msg.FromEthereumTx(ethTx)
+	msg.From = suite.address.Hex()
+	suite.Require().NoError(msg.Sign(suite.ethSigner, suite.signer))
+
+	// charge the fees and increment the nonce the same way the ante handler does
+	balance := big.NewInt(2000000)
+	suite.Require().NoError(k.SetBalance(suite.ctx, suite.address, balance))
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+	suite.Require().NoError(k.DeductTxCostsFromUserBalance(
+		suite.ctx, sdk.Coins{sdk.NewCoin(suite.denom, sdkmath.NewIntFromBigInt(fee))}, suite.address,
+	))
+
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(msg))
+	_, err := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, k).AnteHandle(
+		suite.ctx, txBuilder.GetTx(), false,
+		func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+	)
+	suite.Require().NoError(err)
+
+	postState := k.GetPostStateTransient(suite.ctx)
+	res, err := k.HandleTx(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// the tx is reverted like a failed execution, with the minimum amount of gas charged
+	gasUsed := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gasLimit)).
+		Mul(k.GetMinGasMultiplier(suite.ctx)).
+		TruncateInt().
+		Uint64()
+	suite.Require().Greater(gasUsed, params.TxGas)
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(types.ErrPreTxProcessing.Error(), res.VmError)
+	suite.Require().Equal(gasUsed, res.GasUsed)
+	suite.Require().Empty(res.Logs)
+
+	suite.Require().EqualError(
+		types.NewExecErrorWithReason(res.Ret), "execution reverted: "+types.ErrPreTxProcessing.Error(),
+	)
+
+	// the leftover gas is refunded and the nonce still advances
+	charged := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasUsed))
+	suite.Require().Equal(new(big.Int).Sub(balance, charged), k.GetBalance(suite.ctx, suite.address))
+	suite.Require().Equal(ethTx.Nonce()+1, k.GetNonce(suite.ctx, suite.address))
+	suite.Require().NotEqual(postState, k.GetPostStateTransient(suite.ctx))
+}
@@ -19,6 +19,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"fmt"
 	"github.com/ethereum/go-ethereum/crypto"
+	"math"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
@@ -28,9 +29,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/tendermint/tendermint/libs/log"
 
 	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
@@ -67,9 +70,32 @@ type Keeper struct {
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
+	// EVM Hooks for tx pre-processing
+	preHooks types.EvmPreHooks
 
 	// Legacy subspace
 	ss paramstypes.Subspace
+
+	// node-local listeners notified when the params change
+	paramsNotifier *paramsNotifier
+
+	// node-local budget of eth_call and eth_estimateGas queries
+	queryBudget types.QueryBudget
+
+	// node-local cache of the contract code most recently loaded by hash
+	codeCache *codeCache
+
+	// node-local cache of the senders recovered from the signatures of transactions
+	senderCache *lru.Cache
+
+	// node-local heights whose state is stored by the node
+	stateHistory types.StateHistory
+
+	// node-local switch declaring that the node keeps the state of every height
+	archive bool
+
+	// node-local number of transactions postponed in the current block
+	postponedTxs *postponedTxs
 }
 
 // NewKeeper generates new evm module keeper
@@ -104,6 +130,9 @@ func NewKeeper(
 		storeKey:        storeKey,
 		transientKey:    transientKey,
 		ss:              ss,
+		paramsNotifier:  &paramsNotifier{},
+		postponedTxs:    &postponedTxs{},
+		senderCache:     newSenderCache(),
 	}
 }
 
@@ -171,6 +200,35 @@ func (k Keeper) SetBlockBloomTransient(ctx sdk.Context, bloom *big.Int) {
 	store.Set(heightBz, bloom.Bytes())
 }
 
+// ----------------------------------------------------------------------------
+// Block Hash
+// Required by the BLOCKHASH opcode.
+// ----------------------------------------------------------------------------
+
+// SetBlockHash stores the hash of the block at the given height in the persistent ring
+// buffer, overwriting the entry of the block that left the BLOCKHASH window.
+func (k Keeper) SetBlockHash(ctx sdk.Context, height uint64, hash common.Hash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockHash)
+	value := append(sdk.Uint64ToBigEndian(height), hash.Bytes()...)
+	store.Set(types.BlockHashKey(height), value)
+}
+
+// GetBlockHash returns the hash of the block at the given height from the persistent ring
+// buffer. It returns false if the slot is empty or was already overwritten by a later block.
+func (k Keeper) GetBlockHash(ctx sdk.Context, height uint64) (common.Hash, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockHash)
+	bz := store.Get(types.BlockHashKey(height))
+	if len(bz) != 8+common.HashLength {
+		return common.Hash{}, false
+	}
+
+	if sdk.BigEndianToUint64(bz[:8]) != height {
+		return common.Hash{}, false
+	}
+
+	return common.BytesToHash(bz[8:]), true
+}
+
 // ----------------------------------------------------------------------------
 // Tx
 // ----------------------------------------------------------------------------
@@ -218,14 +276,21 @@ func (k Keeper) SetLogSizeTransient(ctx sdk.Context, logSize uint64) {
 // Storage
 // ----------------------------------------------------------------------------
 
-// GetAccountStorage return state storage associated with an account
+// GetAccountStorage return state storage associated with an account.
+// Values are returned as stored, so encrypted values are kept whole.
 func (k Keeper) GetAccountStorage(ctx sdk.Context, address common.Address) types.Storage {
 	storage := types.Storage{}
 
-	k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
-		storage = append(storage, types.NewState(key, value))
-		return true
-	})
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		storage = append(storage, types.State{
+			Key:   common.BytesToHash(iterator.Key()).Hex(),
+			Value: hexutil.Encode(iterator.Value()),
+		})
+	}
 
 	return storage
 }
@@ -245,6 +310,25 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// SetPreHooks sets the pre-processing hooks for the EVM module
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetPreHooks(eh types.EvmPreHooks) *Keeper {
+	if k.preHooks != nil {
+		panic("cannot set evm pre hooks twice")
+	}
+
+	k.preHooks = eh
+	return k
+}
+
+// PreTxProcessing delegate the call to the pre hooks. If no hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PreTxProcessing(ctx sdk.Context, msg core.Message, cfg *types.EVMConfig) error {
+	if k.preHooks == nil {
+		return nil
+	}
+	return k.preHooks.PreTxProcessing(ctx, msg, cfg)
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -332,6 +416,16 @@ func (k Keeper) getBaseFee(ctx sdk.Context, london bool) *big.Int {
 	return baseFee
 }
 
+// GetMinGasPrice returns the MinGasPrice param from the fee market module
+func (k Keeper) GetMinGasPrice(ctx sdk.Context) sdk.Dec {
+	fmkParmas := k.feeMarketKeeper.GetParams(ctx)
+	if fmkParmas.MinGasPrice.IsNil() {
+		// in case we are executing eth_call on a legacy block, returns a zero value.
+		return sdk.ZeroDec()
+	}
+	return fmkParmas.MinGasPrice
+}
+
 // GetMinGasMultiplier returns the MinGasMultiplier param from the fee market module
 func (k Keeper) GetMinGasMultiplier(ctx sdk.Context) sdk.Dec {
 	fmkParmas := k.feeMarketKeeper.GetParams(ctx)
@@ -375,6 +469,94 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	return result, nil
 }
 
+// GetTransientBoundaryBytes returns the number of bytes passed between Connector and SGXVM in current block.
+func (k Keeper) GetTransientBoundaryBytes(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBoundaryBytes)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddTransientBoundaryBytes accumulates the number of bytes passed between Connector and SGXVM in current block.
+func (k Keeper) AddTransientBoundaryBytes(ctx sdk.Context, size uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := sdk.Uint64ToBigEndian(k.GetTransientBoundaryBytes(ctx) + size)
+	store.Set(types.KeyPrefixTransientBoundaryBytes, bz)
+}
+
+// HasTxHashTransient returns true if an ethereum transaction with the hash was processed in current block.
+func (k Keeper) HasTxHashTransient(ctx sdk.Context, txHash common.Hash) bool {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxHash)
+	return store.Has(txHash.Bytes())
+}
+
+// SetTxHashTransient records the hash of an ethereum transaction processed in current block.
+func (k Keeper) SetTxHashTransient(ctx sdk.Context, txHash common.Hash) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxHash)
+	store.Set(txHash.Bytes(), []byte{1})
+}
+
+// GetPendingTxTransient returns the mempool priority and the effective gas price of the pending
+// ethereum transaction of the sender with the given nonce, i.e. the one that passed CheckTx since
+// the last commit.
+func (k Keeper) GetPendingTxTransient(ctx sdk.Context, sender common.Address, nonce uint64) (int64, *big.Int, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPendingTx)
+	bz := store.Get(types.PendingTxKey(sender, nonce))
+	if len(bz) < 8 {
+		return 0, nil, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz[:8])), new(big.Int).SetBytes(bz[8:]), true
+}
+
+// SetPendingTxTransient records the mempool priority and the effective gas price of the pending
+// ethereum transaction of the sender with the given nonce.
+func (k Keeper) SetPendingTxTransient(ctx sdk.Context, sender common.Address, nonce uint64, priority int64, gasPrice *big.Int) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPendingTx)
+	store.Set(types.PendingTxKey(sender, nonce), append(sdk.Uint64ToBigEndian(uint64(priority)), gasPrice.Bytes()...))
+}
+
+// GetSenderPriorityTransient returns the lowest mempool priority of the pending ethereum
+// transactions of the sender with a nonce lower than the given one. It returns false if the
+// sender has no such pending transactions.
+func (k Keeper) GetSenderPriorityTransient(ctx sdk.Context, sender common.Address, nonce uint64) (int64, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPendingTx)
+	iterator := store.Iterator(types.PendingTxKey(sender, 0), types.PendingTxKey(sender, nonce))
+	defer iterator.Close()
+
+	priority, found := int64(math.MaxInt64), false
+	for ; iterator.Valid(); iterator.Next() {
+		if p := int64(sdk.BigEndianToUint64(iterator.Value()[:8])); p < priority {
+			priority = p
+		}
+		found = true
+	}
+
+	return priority, found
+}
+
+// GetPostStateTransient returns the commitment of the state written by the VM so far in current
+// block. Before the first write it's the app hash of the previous block.
+func (k Keeper) GetPostStateTransient(ctx sdk.Context) common.Hash {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientPostState)
+	if len(bz) == 0 {
+		return common.BytesToHash(ctx.BlockHeader().AppHash)
+	}
+
+	return common.BytesToHash(bz)
+}
+
+// AddPostStateWrite folds a state write request of the VM into the post-state commitment of
+// current block. The commitment is reverted together with the write if the tx is reverted.
+func (k Keeper) AddPostStateWrite(ctx sdk.Context, req []byte) {
+	postState := crypto.Keccak256Hash(k.GetPostStateTransient(ctx).Bytes(), req)
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientPostState, postState.Bytes())
+}
+
 // GetAccount returns nil if account is not exist, returns error if it's not `EthAccountI`
 func (k *Keeper) GetAccount(ctx sdk.Context, addr common.Address) *types.Account {
 	acct := k.GetAccountWithoutBalance(ctx, addr)
@@ -399,9 +581,17 @@ func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash)
 }
 
 // GetCode loads contract code from database, implements `statedb.Keeper` interface.
+// The returned code may be shared with the code cache and must not be modified.
 func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
+	if code, ok := k.codeCache.get(codeHash); ok {
+		consumeCodeReadGas(ctx, codeHash, code)
+		return code
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCode)
-	return store.Get(codeHash.Bytes())
+	code := store.Get(codeHash.Bytes())
+	k.codeCache.add(codeHash, code)
+	return code
 }
 
 // ForEachStorage iterate contract storage, callback return false to break early
@@ -496,6 +686,7 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account types.
 // SetState update contract storage, delete if value is empty.
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	k.updateStorageUsage(ctx, addr, store.Get(key.Bytes()), value)
 	action := "updated"
 	if len(value) == 0 {
 		store.Delete(key.Bytes())
@@ -518,6 +709,7 @@ func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
 	action := "updated"
 	if len(code) == 0 {
 		store.Delete(codeHash)
+		k.codeCache.remove(common.BytesToHash(codeHash))
 		action = "deleted"
 	} else {
 		store.Set(codeHash, code)
@@ -496,17 +496,17 @@ func (suite *KeeperTestSuite) TestGetAccountStorage() {
 		expRes   []int
 	}{
 		{
-			"Only one account that's not a contract (no storage)",
+			"One wallet and the CREATE2 deployer (no storage)",
 			func() {},
-			[]int{0},
+			[]int{0, 0},
 		},
 		{
-			"Two accounts - one contract (with storage), one wallet",
+			"Three accounts - one contract (with storage), one wallet, the CREATE2 deployer",
 			func() {
 				supply := big.NewInt(100)
 				suite.DeployTestContract(suite.T(), suite.address, supply)
 			},
-			[]int{2, 0},
+			[]int{2, 0, 0},
 		},
 	}
 
@@ -514,7 +514,7 @@ func (suite *KeeperTestSuite) TestGetAccountStorage() {
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
 			tc.malleate()
-			i := 0
+			var res []int
 			suite.app.AccountKeeper.IterateAccounts(suite.ctx, func(account authtypes.AccountI) bool {
 				ethAccount, ok := account.(evmcommontypes.EthAccountI)
 				if !ok {
@@ -525,10 +525,11 @@ func (suite *KeeperTestSuite) TestGetAccountStorage() {
 				addr := ethAccount.EthAddress()
 				storage := suite.app.EvmKeeper.GetAccountStorage(suite.ctx, addr)
 
-				suite.Require().Equal(tc.expRes[i], len(storage))
-				i++
+				res = append(res, len(storage))
 				return false
 			})
+			// accounts are iterated in address order, which depends on the random test address
+			suite.Require().ElementsMatch(tc.expRes, res)
 		})
 	}
 }
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// Key epochs are the periods a state encryption key is used for. Epoch 0 is implicit and starts
+// at genesis, further epochs can only be set in the genesis state. The pinned librustgo derives a
+// single key pair from the master seed and isn't passed the epoch, so the key can't be rotated and
+// the epoch of storage cells isn't recorded.
+
+// SetKeyEpoch stores the state encryption key epoch
+func (k *Keeper) SetKeyEpoch(ctx sdk.Context, epoch types.KeyEpoch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixKeyEpoch)
+	store.Set(types.KeyEpochKey(epoch.Epoch), k.cdc.MustMarshal(&epoch))
+}
+
+// GetKeyEpochs returns the key epochs after epoch 0 ordered by epoch number
+func (k *Keeper) GetKeyEpochs(ctx sdk.Context) []types.KeyEpoch {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixKeyEpoch)
+	defer iterator.Close()
+
+	var epochs []types.KeyEpoch
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.KeyEpoch
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		epochs = append(epochs, epoch)
+	}
+
+	return epochs
+}
+
+// GetKeyEpochAtHeight returns the key epoch used by the block at the given height
+func (k *Keeper) GetKeyEpochAtHeight(ctx sdk.Context, height int64) types.KeyEpoch {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.KeyPrefixKeyEpoch)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.KeyEpoch
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		if epoch.StartHeight <= height {
+			return epoch
+		}
+	}
+
+	return types.KeyEpoch{}
+}
+
+// GetEpochPublicKeys returns the public keys of epoch 0 and every further key epoch. They identify
+// the master seed the storage is encrypted with. The enclave derives a single key pair from the
+// master seed, so every epoch has the node public key.
+func (k *Keeper) GetEpochPublicKeys(ctx sdk.Context) ([]types.EpochPublicKey, error) {
+	publicKey, err := k.GetNodePublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := append([]types.KeyEpoch{{}}, k.GetKeyEpochs(ctx)...)
+
+	keys := make([]types.EpochPublicKey, len(epochs))
+	for i, epoch := range epochs {
+		keys[i] = types.EpochPublicKey{Epoch: epoch.Epoch, PublicKey: publicKey.Hex()}
+	}
+
+	return keys, nil
+}
+
+// VerifyEpochPublicKeys checks that the enclave derives the exported epoch public keys, i.e. that it
+// holds the master seed of the chain the encrypted storage was exported from. The enclave isn't
+// queried without keys, since the storage of a new chain isn't encrypted yet.
+func (k *Keeper) VerifyEpochPublicKeys(keys []types.EpochPublicKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	publicKey, err := k.GetNodePublicKey()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if publicKey != common.HexToHash(key.PublicKey) {
+			return fmt.Errorf(
+				"enclave public key %s of epoch %d doesn't match the exported key %s, the enclave doesn't hold the master seed of the exported state",
+				publicKey.Hex(), key.Epoch, key.PublicKey,
+			)
+		}
+	}
+
+	return nil
+}
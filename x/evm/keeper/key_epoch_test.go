@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryEpochKeys() {
+	k := suite.app.EvmKeeper
+
+	// epoch 0 is implicit and open-ended while no further epoch is set
+	res, err := k.EpochKeys(suite.ctx, &types.QueryEpochKeysRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.EpochKeys, 1)
+	suite.Require().Equal(uint64(0), res.EpochKeys[0].Epoch)
+	suite.Require().Zero(res.EpochKeys[0].EndHeight)
+	suite.Require().NotEmpty(res.EpochKeys[0].PublicKey)
+	suite.Require().Equal(uint64(0), res.CurrentEpoch)
+
+	height := suite.ctx.BlockHeight()
+	k.SetKeyEpoch(suite.ctx, types.KeyEpoch{Epoch: 1, StartHeight: height + 1})
+
+	// the new epoch is listed before it starts, so clients can encrypt for the next block
+	res, err = k.EpochKeys(suite.ctx, &types.QueryEpochKeysRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.EpochKeys, 2)
+	suite.Require().Equal(height, res.EpochKeys[0].EndHeight)
+	suite.Require().Equal(uint64(1), res.EpochKeys[1].Epoch)
+	suite.Require().Equal(height+1, res.EpochKeys[1].StartHeight)
+	suite.Require().Zero(res.EpochKeys[1].EndHeight)
+	suite.Require().Equal(uint64(0), res.CurrentEpoch)
+
+	// every epoch uses the node key
+	nodePublicKey, err := k.GetNodePublicKey()
+	suite.Require().NoError(err)
+	for _, epochKey := range res.EpochKeys {
+		suite.Require().Equal(nodePublicKey.Hex(), epochKey.PublicKey)
+	}
+
+	res, err = k.EpochKeys(suite.ctx.WithBlockHeight(height+1), &types.QueryEpochKeysRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.CurrentEpoch)
+}
+
+func (suite *KeeperTestSuite) TestVerifyEpochPublicKeys() {
+	k := suite.app.EvmKeeper
+
+	// without exported keys the enclave isn't queried
+	suite.Require().NoError(k.VerifyEpochPublicKeys(nil))
+
+	keys, err := k.GetEpochPublicKeys(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.VerifyEpochPublicKeys(keys))
+
+	otherKeys := []types.EpochPublicKey{{Epoch: 0, PublicKey: common.BigToHash(big.NewInt(1)).Hex()}}
+	suite.Require().Error(k.VerifyEpochPublicKeys(otherKeys))
+}
@@ -18,10 +18,15 @@ package keeper
 import (
 	v4 "github.com/SigmaGmbH/evm-module/x/evm/migrations/v4"
 	v5 "github.com/SigmaGmbH/evm-module/x/evm/migrations/v5"
+	v6 "github.com/SigmaGmbH/evm-module/x/evm/migrations/v6"
 	"github.com/SigmaGmbH/evm-module/x/evm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
+// FirstMigrationVersion is the oldest consensus version of the module store which can be migrated
+const FirstMigrationVersion uint64 = 3
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper         Keeper
@@ -36,6 +41,18 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 	}
 }
 
+// Migrations returns the in-place store migrations keyed by the consensus version they migrate
+// from. A store change adds the migration to the next version here and bumps the consensus version
+// of the module.
+func (m Migrator) Migrations() map[uint64]module.MigrationHandler {
+	return map[uint64]module.MigrationHandler{
+		3: m.Migrate3to4,
+		4: m.Migrate4to5,
+		5: m.Migrate5to6,
+		6: m.Migrate6to7,
+	}
+}
+
 // Migrate3to4 migrates the store from consensus version 3 to 4
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
@@ -45,3 +62,13 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate5to6 migrates the store from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate6to7 migrates the store from consensus version 6 to 7
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return m.keeper.InstallCreate2Deployer(ctx)
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/SigmaGmbH/evm-module/x/evm"
 	evmkeeper "github.com/SigmaGmbH/evm-module/x/evm/keeper"
 	"github.com/SigmaGmbH/evm-module/x/evm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,3 +40,15 @@ func (suite *KeeperTestSuite) TestMigrations() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrationsCoverConsensusVersions() {
+	migrator := evmkeeper.NewMigrator(*suite.app.EvmKeeper, newMockSubspace(types.DefaultParams()))
+	migrations := migrator.Migrations()
+
+	// every version up to the current one migrates to the next
+	consensusVersion := evm.AppModuleBasic{}.ConsensusVersion()
+	suite.Require().Len(migrations, int(consensusVersion-evmkeeper.FirstMigrationVersion))
+	for version := evmkeeper.FirstMigrationVersion; version < consensusVersion; version++ {
+		suite.Require().Contains(migrations, version)
+	}
+}
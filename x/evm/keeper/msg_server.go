@@ -21,6 +21,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/SigmaGmbH/evm-module/x/evm/types"
 )
@@ -43,3 +45,68 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// FreezeAccount implements the gRPC MsgServer interface. When a FreezeAccount
+// proposal passes, the transactions sent from the account are rejected. The
+// account can only be frozen if the requested authority is the Cosmos SDK
+// governance module account or the admin authority.
+func (k *Keeper) FreezeAccount(goCtx context.Context, req *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdminAuthority(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	address := common.HexToAddress(req.Address)
+	k.SetAccountFrozen(ctx, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreeze,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.Hex()),
+		),
+	)
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+// UnfreezeAccount implements the gRPC MsgServer interface. When an
+// UnfreezeAccount proposal passes, the account can send transactions again.
+// The account can only be unfrozen if the requested authority is the Cosmos
+// SDK governance module account or the admin authority.
+func (k *Keeper) UnfreezeAccount(goCtx context.Context, req *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdminAuthority(ctx, req.Authority); err != nil {
+		return nil, err
+	}
+
+	address := common.HexToAddress(req.Address)
+	if !k.IsAccountFrozen(ctx, address) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s is not frozen", address.Hex())
+	}
+	k.DeleteAccountFrozen(ctx, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreeze,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.Hex()),
+		),
+	)
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+// checkAdminAuthority returns an error if the requested authority is neither the
+// Cosmos SDK governance module account nor the admin authority set in the params.
+// The admin authority allows an x/group policy or a multisig account to execute
+// admin operations without waiting for a governance proposal.
+func (k *Keeper) checkAdminAuthority(ctx sdk.Context, authority string) error {
+	if k.authority.String() == authority {
+		return nil
+	}
+
+	if admin := k.GetParams(ctx).AdminAuthority; admin != "" && admin == authority {
+		return nil
+	}
+
+	return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s or the admin authority, got %s", k.authority.String(), authority)
+}
@@ -113,3 +113,29 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestHandleTxDuplicateHash() {
+	chainCfg := suite.app.EvmKeeper.GetParams(suite.ctx).ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	msg, _, err := newEthMsgTx(
+		suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		suite.ctx.BlockHeight(),
+		suite.address,
+		chainCfg,
+		suite.signer,
+		signer,
+		ethtypes.AccessListTxType,
+		nil,
+		nil,
+		big.NewInt(0),
+	)
+	suite.Require().NoError(err)
+
+	// the hash was processed by a previous message of the block
+	suite.Require().False(suite.app.EvmKeeper.HasTxHashTransient(suite.ctx, msg.AsTransaction().Hash()))
+	suite.app.EvmKeeper.SetTxHashTransient(suite.ctx, msg.AsTransaction().Hash())
+
+	_, err = suite.app.EvmKeeper.HandleTx(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrDuplicateTx)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// paramsNotifier keeps the node-local params listeners together with the params last announced
+// to them. It is held by pointer so listeners added after the keeper has been copied into other
+// keepers are still notified.
+type paramsNotifier struct {
+	mu        sync.Mutex
+	listeners []types.ParamsListener
+	last      *types.Params
+}
+
+// AddParamsListener registers a node-local listener notified whenever the EVM params change.
+// Listeners don't affect consensus and may be registered at any time before the node starts.
+func (k *Keeper) AddParamsListener(listener types.ParamsListener) *Keeper {
+	k.paramsNotifier.mu.Lock()
+	defer k.paramsNotifier.mu.Unlock()
+
+	k.paramsNotifier.listeners = append(k.paramsNotifier.listeners, listener)
+	return k
+}
+
+// NotifyParamsListeners compares the committed params with the ones last announced and notifies
+// the listeners if they differ. The first call only records the params, since listeners are
+// expected to load the initial values on their own. A panicking listener is logged and skipped
+// so it can't halt the chain.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) NotifyParamsListeners(ctx sdk.Context) {
+	n := k.paramsNotifier
+	if n == nil || ctx.IsCheckTx() {
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if len(n.listeners) == 0 {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if n.last == nil {
+		n.last = &params
+		return
+	}
+
+	if bytes.Equal(k.cdc.MustMarshal(n.last), k.cdc.MustMarshal(&params)) {
+		return
+	}
+
+	prev := *n.last
+	n.last = &params
+
+	for _, listener := range n.listeners {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					k.Logger(ctx).Error("params listener panicked", "listener", fmt.Sprintf("%T", listener), "panic", r)
+				}
+			}()
+			listener.OnParamsChanged(ctx.BlockHeight(), prev, params)
+		}()
+	}
+}
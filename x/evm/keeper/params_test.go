@@ -2,6 +2,9 @@ package keeper_test
 
 import (
 	"reflect"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/SigmaGmbH/evm-module/x/evm/types"
 )
@@ -98,3 +101,55 @@ func (suite *KeeperTestSuite) TestParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSimulateParamsUpdate() {
+	k := suite.app.EvmKeeper
+	current := k.GetParams(suite.ctx)
+
+	proposed := current
+	proposed.EnableCreate = !current.EnableCreate
+	res, err := k.SimulateParamsUpdate(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QuerySimulateParamsUpdateRequest{Params: proposed},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ParamChange{{
+		Key:      "enable_create",
+		OldValue: strconv.FormatBool(current.EnableCreate),
+		NewValue: strconv.FormatBool(proposed.EnableCreate),
+	}}, res.Changes)
+	// the params are not applied
+	suite.Require().Equal(current, k.GetParams(suite.ctx))
+
+	proposed = current
+	proposed.EvmDenom = ""
+	_, err = k.SimulateParamsUpdate(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QuerySimulateParamsUpdateRequest{Params: proposed},
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryConfigHash() {
+	k := suite.app.EvmKeeper
+
+	res, err := k.ConfigHash(sdk.WrapSDKContext(suite.ctx), &types.QueryConfigHashRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockHeight(), res.Height)
+
+	// the hash is deterministic
+	again, err := k.ConfigHash(sdk.WrapSDKContext(suite.ctx), &types.QueryConfigHashRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res, again)
+
+	params := k.GetParams(suite.ctx)
+	params.ChainConfig.CancunBlock = nil
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+	changed, err := k.ConfigHash(sdk.WrapSDKContext(suite.ctx), &types.QueryConfigHashRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(res.Hash, changed.Hash)
+	suite.Require().NotEqual(res.ParamsHash, changed.ParamsHash)
+	suite.Require().NotEqual(res.ChainConfigHash, changed.ChainConfigHash)
+	suite.Require().Equal(res.FeeMarketParamsHash, changed.FeeMarketParamsHash)
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import "sync/atomic"
+
+// postponedTxs counts the transactions of the current block which were rejected because their gas
+// limit exceeded the gas left in the block. The counter is node-local and never part of the state,
+// since the writes of a rejected transaction are discarded.
+type postponedTxs struct {
+	count uint64
+}
+
+// AddPostponedTx accounts a transaction which didn't fit in the remaining block gas
+func (k Keeper) AddPostponedTx() {
+	atomic.AddUint64(&k.postponedTxs.count, 1)
+}
+
+// GetPostponedTxs returns the number of transactions postponed in the current block
+func (k Keeper) GetPostponedTxs() uint64 {
+	return atomic.LoadUint64(&k.postponedTxs.count)
+}
+
+// resetPostponedTxs returns the number of transactions postponed in the current block and resets
+// the counter for the next block
+func (k Keeper) resetPostponedTxs() uint64 {
+	return atomic.SwapUint64(&k.postponedTxs.count, 0)
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// activePrecompileAddresses returns the addresses of the Ethereum precompiles active at the height
+// of the context
+func activePrecompileAddresses(ctx sdk.Context, cfg *types.EVMConfig) []common.Address {
+	height := big.NewInt(ctx.BlockHeight())
+	return vm.ActivePrecompiles(cfg.ChainConfig.Rules(height, cfg.ChainConfig.MergeNetsplitBlock != nil))
+}
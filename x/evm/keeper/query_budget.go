@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// queryBudgetKey is the context key of the budget of eth_call, eth_estimateGas and tracing queries
+type queryBudgetKey struct{}
+
+// queryBudgetTracker counts the Connector requests of a single execution
+type queryBudgetTracker struct {
+	ctx          context.Context
+	maxHostCalls uint64
+	hostCalls    uint64
+}
+
+// SetQueryBudget sets the budget of eth_call, eth_estimateGas and tracing queries. It's node-local and never
+// applied to transactions.
+func (k *Keeper) SetQueryBudget(budget types.QueryBudget) *Keeper {
+	k.queryBudget = budget
+	return k
+}
+
+// withQueryBudget returns the query context with the budget of the node applied. Executions in the
+// returned context are aborted at their next Connector request once the budget is exhausted, the
+// timeout expired or the query was cancelled by the client.
+func (k Keeper) withQueryBudget(c context.Context, ctx sdk.Context) (sdk.Context, context.CancelFunc) {
+	goCtx, cancel := context.WithCancel(c)
+	if k.queryBudget.Timeout > 0 {
+		goCtx, cancel = context.WithTimeout(c, k.queryBudget.Timeout)
+	}
+
+	goCtx = context.WithValue(goCtx, queryBudgetKey{}, k.queryBudget)
+	return ctx.WithContext(goCtx), cancel
+}
+
+// newQueryBudgetTracker returns the budget tracker of an execution, or nil if the execution isn't
+// part of a budgeted query
+func newQueryBudgetTracker(ctx sdk.Context) *queryBudgetTracker {
+	budget, ok := ctx.Context().Value(queryBudgetKey{}).(types.QueryBudget)
+	if !ok {
+		return nil
+	}
+
+	return &queryBudgetTracker{ctx: ctx.Context(), maxHostCalls: budget.MaxHostCalls}
+}
+
+// consume accounts a Connector request and returns an error if the budget is exhausted
+func (t *queryBudgetTracker) consume() error {
+	if t == nil {
+		return nil
+	}
+
+	t.hostCalls++
+	if t.maxHostCalls > 0 && t.hostCalls > t.maxHostCalls {
+		return errorsmod.Wrapf(types.ErrQueryBudgetExceeded, "more than %d host calls", t.maxHostCalls)
+	}
+	if err := t.ctx.Err(); err != nil {
+		return errorsmod.Wrap(types.ErrQueryBudgetExceeded, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// senderCacheSize is the number of recovered senders kept by the keeper. It covers the mempool and
+// the transactions of several full blocks.
+const senderCacheSize = 8192
+
+// A MsgHandleTx is converted into a new go-ethereum transaction each time it is used, so the sender
+// cached by go-ethereum in the transaction is lost and the ECDSA signature is recovered again by the
+// ante handlers and the state transition, in CheckTx and once more in DeliverTx. The keeper keeps the
+// recovered senders by transaction hash instead, so the signature of a transaction is recovered once
+// by the node, usually when it enters the mempool.
+
+type senderCacheEntry struct {
+	signer ethtypes.Signer
+	from   common.Address
+}
+
+// cachingSigner is a signer looking up the senders recovered by the signer in the sender cache. As in
+// the cache of go-ethereum, a sender is only reused by an equal signer, since the signer decides
+// whether a transaction type is supported.
+type cachingSigner struct {
+	ethtypes.Signer
+	cache *lru.Cache
+}
+
+// SenderCachingSigner returns the signer reusing the senders of transactions it has already recovered
+func (k *Keeper) SenderCachingSigner(signer ethtypes.Signer) ethtypes.Signer {
+	if k.senderCache == nil {
+		return signer
+	}
+	return cachingSigner{Signer: signer, cache: k.senderCache}
+}
+
+// Sender returns the cached sender of the transaction, recovering and caching it on a miss
+func (s cachingSigner) Sender(tx *ethtypes.Transaction) (common.Address, error) {
+	hash := tx.Hash()
+	if cached, ok := s.cache.Get(hash); ok {
+		entry := cached.(senderCacheEntry)
+		if entry.signer.Equal(s.Signer) {
+			return entry.from, nil
+		}
+	}
+
+	from, err := s.Signer.Sender(tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	s.cache.Add(hash, senderCacheEntry{signer: s.Signer, from: from})
+	return from, nil
+}
+
+// Equal returns true if the signers are equal, regardless of caching
+func (s cachingSigner) Equal(other ethtypes.Signer) bool {
+	if caching, ok := other.(cachingSigner); ok {
+		other = caching.Signer
+	}
+	return s.Signer.Equal(other)
+}
+
+func newSenderCache() *lru.Cache {
+	cache, err := lru.New(senderCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
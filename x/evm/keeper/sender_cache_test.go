@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (suite *KeeperTestSuite) TestSenderCachingSigner() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	to := common.BigToAddress(big.NewInt(1001))
+	tx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   suite.app.EvmKeeper.ChainID(),
+		To:        &to,
+		Gas:       21000,
+		GasFeeCap: big.NewInt(1),
+	}), suite.ethSigner, key)
+	suite.Require().NoError(err)
+
+	signer := suite.app.EvmKeeper.SenderCachingSigner(suite.ethSigner)
+	suite.Require().True(signer.Equal(suite.ethSigner))
+
+	// every decoded copy of the transaction has the same sender
+	for i := 0; i < 2; i++ {
+		bz, err := tx.MarshalBinary()
+		suite.Require().NoError(err)
+		decoded := new(ethtypes.Transaction)
+		suite.Require().NoError(decoded.UnmarshalBinary(bz))
+
+		sender, err := ethtypes.Sender(signer, decoded)
+		suite.Require().NoError(err)
+		suite.Require().Equal(from, sender)
+	}
+
+	// cached senders are only reused by equal signers
+	otherSigner := suite.app.EvmKeeper.SenderCachingSigner(ethtypes.LatestSignerForChainID(big.NewInt(1)))
+	_, err = otherSigner.Sender(tx)
+	suite.Require().Error(err)
+	_, err = suite.app.EvmKeeper.SenderCachingSigner(ethtypes.HomesteadSigner{}).Sender(tx)
+	suite.Require().Error(err)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"encoding/json"
 	"fmt"
 	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
@@ -11,15 +12,16 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/params"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"math/big"
 	"strconv"
+	"time"
 )
 
 // HandleTx receives a transaction which is then
@@ -33,6 +35,14 @@ func (k *Keeper) HandleTx(goCtx context.Context, msg *types.MsgHandleTx) (*types
 	tx := msg.AsTransaction()
 	txIndex := k.GetTxIndexTransient(ctx)
 
+	// a crafted cosmos tx can carry the same ethereum tx in several messages, which would emit
+	// duplicate receipts for one hash and corrupt the indexer
+	txHash := tx.Hash()
+	if k.HasTxHashTransient(ctx, txHash) {
+		return nil, errorsmod.Wrapf(types.ErrDuplicateTx, "tx hash %s", txHash.Hex())
+	}
+	k.SetTxHashTransient(ctx, txHash)
+
 	labels := []metrics.Label{
 		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
 	}
@@ -83,6 +93,13 @@ func (k *Keeper) HandleTx(goCtx context.Context, msg *types.MsgHandleTx) (*types
 		sdk.NewAttribute(types.AttributeKeyTxIndex, strconv.FormatUint(txIndex, 10)),
 		// add event for eth tx gas used, we can't get it from cosmos tx result when it contains multiple eth tx msgs.
 		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(response.GasUsed, 10)),
+		// add event for the commitment of the state written by the block up to the tx
+		sdk.NewAttribute(types.AttributeKeyPostState, k.GetPostStateTransient(ctx).Hex()),
+	}
+
+	if response.EffectiveGasPrice != nil {
+		// add event for the price per gas paid by the tx, it depends on the base fee of the block
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEffectiveGasPrice, response.EffectiveGasPrice.String()))
 	}
 
 	if len(ctx.TxBytes()) > 0 {
@@ -142,7 +159,7 @@ func (k *Keeper) ApplySGXVMTransaction(ctx sdk.Context, tx *ethtypes.Transaction
 	}
 
 	// get the signer according to the chain rules from the config and block height
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	signer := k.SenderCachingSigner(ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight())))
 	msg, err := tx.AsMessage(signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
@@ -159,16 +176,28 @@ func (k *Keeper) ApplySGXVMTransaction(ctx sdk.Context, tx *ethtypes.Transaction
 	if k.hooks != nil {
 		// Create a cache context to revert state when tx hooks fails,
 		// the cache context is only committed when both tx and hooks executed successfully.
-		// Didn't use `Snapshot` because the context stack has exponential complexity on certain operations,
-		// thus restricted to be used only inside `ApplyMessage`.
+		// Snapshots of nested calls are journaled by the SGXVM in the enclave, which only passes the
+		// writes of the tx to the Connector, so a single cache context is created per tx.
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, true, cfg, txConfig, txContext)
+	var res *types.MsgEthereumTxResponse
+	if err = k.applyPreTxProcessing(tmpCtx, msg, cfg); err != nil {
+		// If pre hooks return error, revert the tx without executing it.
+		k.Logger(ctx).Error("tx pre processing failed", "error", err)
+		res, err = k.preTxProcessingRevert(ctx, msg, cfg, txConfig)
+	} else {
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, true, cfg, txConfig, txContext)
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
 
+	// the message gas price is already capped to base fee + priority fee for dynamic fee txs
+	effectiveGasPrice := sdkmath.NewIntFromBigInt(msg.GasPrice())
+	res.EffectiveGasPrice = &effectiveGasPrice
+	res.TxType = uint32(tx.Type())
+
 	logs := types.LogsToEthereum(res.Logs)
 
 	// Compute block bloom filter
@@ -194,7 +223,6 @@ func (k *Keeper) ApplySGXVMTransaction(ctx sdk.Context, tx *ethtypes.Transaction
 
 	receipt := &ethtypes.Receipt{
 		Type:              tx.Type(),
-		PostState:         nil, // TODO: intermediate state root
 		CumulativeGasUsed: cumulativeGasUsed,
 		Bloom:             bloomReceipt,
 		Logs:              logs,
@@ -236,6 +264,10 @@ func (k *Keeper) ApplySGXVMTransaction(ctx sdk.Context, tx *ethtypes.Transaction
 		k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(receipt.Logs)))
 	}
 
+	if len(res.Logs) > 0 {
+		k.SetTxLogsTransient(ctx, uint64(txConfig.TxIndex), types.NewTransactionLogs(txConfig.TxHash, res.Logs))
+	}
+
 	k.SetTxIndexTransient(ctx, uint64(txConfig.TxIndex)+1)
 
 	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
@@ -243,11 +275,100 @@ func (k *Keeper) ApplySGXVMTransaction(ctx sdk.Context, tx *ethtypes.Transaction
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
 	}
 
+	// track the block gas used by EVM txs for the base fee calculation of the next block
+	if _, err := k.feeMarketKeeper.AddTransientEvmGasUsed(ctx, res.GasUsed); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to add transient evm gas used")
+	}
+
 	// reset the gas meter for current cosmos transaction
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 	return res, nil
 }
 
+// applyPreTxProcessing runs the pre hooks in a cache context, which is only committed if all of
+// them succeed
+func (k *Keeper) applyPreTxProcessing(ctx sdk.Context, msg core.Message, cfg *types.EVMConfig) error {
+	if k.preHooks == nil {
+		return nil
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.PreTxProcessing(cacheCtx, msg, cfg); err != nil {
+		return err
+	}
+
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// preTxProcessingRevert returns the result of a tx rejected by the pre hooks. It's reverted
+// without being executed and only charged its intrinsic gas, so the result doesn't depend on the
+// reason of the rejection.
+func (k *Keeper) preTxProcessingRevert(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *types.EVMConfig,
+	txConfig types.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	gasUsed, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, msg.To() == nil)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+	}
+	gasUsed = k.chargedGas(ctx, msg, gasUsed)
+
+	ret, err := revertReason(types.ErrPreTxProcessing.Error())
+	if err != nil {
+		return nil, err
+	}
+
+	// the rejected tx still advanced the nonce of the sender and paid fees, the post-state
+	// commitment has to differ from the one of the previous tx
+	k.AddPostStateWrite(ctx, txConfig.TxHash.Bytes())
+
+	return &types.MsgEthereumTxResponse{
+		GasUsed: gasUsed,
+		VmError: types.ErrPreTxProcessing.Error(),
+		Ret:     ret,
+		Logs:    []*types.Log{},
+		Hash:    txConfig.TxHash.Hex(),
+	}, nil
+}
+
+// chargedGas returns the gas charged for a tx using the given amount of gas. A minimum amount of
+// gas is charged if the gas limit is considerably higher than the gas used, so the gas wanted of
+// the block stays aligned with the gas charged.
+func (k *Keeper) chargedGas(ctx sdk.Context, msg core.Message, gasUsed uint64) uint64 {
+	minimumGasUsed := sdk.NewDecFromBigInt(new(big.Int).SetUint64(msg.Gas())).
+		Mul(k.GetMinGasMultiplier(ctx)).
+		TruncateInt().
+		Uint64()
+	if gasUsed < minimumGasUsed {
+		gasUsed = minimumGasUsed
+	}
+	if gasUsed > msg.Gas() {
+		gasUsed = msg.Gas()
+	}
+
+	return gasUsed
+}
+
+// revertReason ABI encodes the reason as the return data of a Solidity `Error(string)` revert
+func revertReason(reason string) ([]byte, error) {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to encode revert reason")
+	}
+
+	return append(crypto.Keccak256([]byte("Error(string)"))[:4], data...), nil
+}
+
+// ApplyMessageWithConfig executes the message in the SGXVM with the given config
 func (k *Keeper) ApplyMessageWithConfig(
 	ctx sdk.Context,
 	msg core.Message,
@@ -256,31 +377,95 @@ func (k *Keeper) ApplyMessageWithConfig(
 	txConfig types.TxConfig,
 	txContext *librustgo.TransactionContext,
 ) (*types.MsgEthereumTxResponse, error) {
+	res, _, err := k.applyMessageWithConfig(ctx, msg, commit, cfg, txConfig, txContext)
+	return res, err
+}
+
+// applyMessageWithConfig executes the message and also returns the addresses and storage slots
+// accessed by the execution
+func (k *Keeper) applyMessageWithConfig(
+	ctx sdk.Context,
+	msg core.Message,
+	commit bool,
+	cfg *types.EVMConfig,
+	txConfig types.TxConfig,
+	txContext *librustgo.TransactionContext,
+) (*types.MsgEthereumTxResponse, *AccessListTracker, error) {
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To() == nil {
-		return nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
 	} else if !cfg.Params.EnableCall && msg.To() != nil {
-		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
+	}
+
+	// frozen accounts can't send transactions, queries are still allowed
+	if commit && k.IsAccountFrozen(ctx, msg.From()) {
+		return nil, nil, errorsmod.Wrapf(types.ErrAccountFrozen, "sender %s", msg.From().Hex())
 	}
 
 	leftoverGas := msg.Gas()
 	contractCreation := msg.To() == nil
+
+	// EIP-3860 limits the init code of creation transactions, it's checked before the VM is invoked
+	if maxInitCodeSize := cfg.Params.InitCodeSizeLimit(); contractCreation && uint64(len(msg.Data())) > maxInitCodeSize {
+		return nil, nil, errorsmod.Wrapf(
+			types.ErrMaxInitCodeSizeExceeded, "init code size %d exceeds limit %d", len(msg.Data()), maxInitCodeSize,
+		)
+	}
+
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+		return nil, nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+	}
+
+	// track the addresses and slots touched by the VM. The enclave prices gas on its own, so the
+	// tracker only reports them and doesn't affect gas
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	accessList := NewAccessListTracker(rules, msg.From(), msg.To(), msg.AccessList())
+
+	// EIP-6780 restricts selfdestruct to accounts created in the same transaction
+	var selfDestructs *SelfDestructTracker
+	if cfg.ChainConfig.IsCancun(big.NewInt(ctx.BlockHeight())) {
+		selfDestructs = NewSelfDestructTracker()
 	}
 
+	var deployment *ContractDeployment
+	if contractCreation {
+		deployment = &ContractDeployment{
+			Contract: crypto.CreateAddress(msg.From(), msg.Nonce()),
+			Deployer: msg.From(),
+		}
+	}
+
+	var (
+		connectorErr  error
+		boundaryBytes uint64
+	)
 	connector := Connector{
-		Context:   ctx,
-		EVMKeeper: k,
+		Context:       ctx,
+		EVMKeeper:     k,
+		GetHashFn:     k.GetHashFn(ctx),
+		AccessList:    accessList,
+		SelfDestructs: selfDestructs,
+		MaxCodeSize:   cfg.Params.CodeSizeLimit(),
+		Deployment:    deployment,
+		fatalErr:      &connectorErr,
+		boundaryBytes: &boundaryBytes,
+		budget:        newQueryBudgetTracker(ctx),
+	}
+
+	labels := []metrics.Label{telemetry.NewLabel("execution", "call")}
+	if contractCreation {
+		labels = []metrics.Label{telemetry.NewLabel("execution", "create")}
 	}
+	start := time.Now()
 
 	var res *librustgo.HandleTransactionResponse
 	if contractCreation {
@@ -310,27 +495,35 @@ func (k *Keeper) ApplyMessageWithConfig(
 		)
 	}
 
+	metrics.MeasureSinceWithLabels([]string{"sgxvm", "handle_tx", "latency"}, start, labels)
+	telemetry.IncrCounterWithLabels([]string{"sgxvm", "handle_tx", "total"}, 1, labels)
+	k.AddTransientBoundaryBytes(ctx, connector.BoundaryBytes())
+	k.CommitTotalStorageUsage(ctx)
+
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	// queries exceeding the budget or viewing restricted contracts fail instead of returning a
+	// reverted result
+	if fatalErr := connector.FatalError(); errorsmod.IsOf(fatalErr, types.ErrQueryBudgetExceeded, types.ErrViewNotPermitted) {
+		return nil, nil, fatalErr
 	}
 
-	// calculate gas refund
-	if msg.Gas() < leftoverGas {
-		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+	// Go-side state failures are reported by the VM as an ordinary revert,
+	// surface the actual reason instead
+	vmError := res.VmError
+	if fatalErr := connector.FatalError(); fatalErr != nil {
+		vmError = fatalErr.Error()
 	}
-	// refund gas
-	temporaryGasUsed := msg.Gas() - leftoverGas
-	refundQuotient := params.RefundQuotientEIP3529
-	leftoverGas += GasToRefund(0, temporaryGasUsed, refundQuotient) // TODO: SGXVM should return gas to refund
 
 	logs := SGXVMLogsToEthereum(res.Logs, txConfig, txContext.BlockNumber)
 	return &types.MsgEthereumTxResponse{
-		GasUsed: res.GasUsed,
-		VmError: res.VmError,
+		GasUsed: k.chargedGas(ctx, msg, res.GasUsed),
+		VmError: vmError,
 		Ret:     res.Ret,
 		Logs:    types.NewLogsFromEth(logs),
 		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+	}, accessList, nil
 }
 
 func (k *Keeper) GetNodePublicKey() (common.Hash, error) {
@@ -342,20 +535,29 @@ func (k *Keeper) GetNodePublicKey() (common.Hash, error) {
 	return publicKey, nil
 }
 
+// CreateSGXVMContext returns the context the transaction is executed with in the enclave. Its gas
+// price is the price the sender pays, i.e. base fee plus priority fee for dynamic fee txs.
 func CreateSGXVMContext(ctx sdk.Context, k *Keeper, tx *ethtypes.Transaction) (*librustgo.TransactionContext, error) {
 	cfg, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.eip155ChainID)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
 
+	// tx.GasPrice() is the fee cap of dynamic fee txs, it's only paid in full without base fee
+	baseFee, gasPrice := new(big.Int), tx.GasPrice()
+	if cfg.BaseFee != nil {
+		baseFee = cfg.BaseFee
+		gasPrice = types.EffectiveGasPrice(cfg.BaseFee, tx.GasFeeCap(), tx.GasTipCap())
+	}
+
 	return &librustgo.TransactionContext{
 		BlockCoinbase:      cfg.CoinBase.Bytes(),
 		BlockNumber:        uint64(ctx.BlockHeight()),
-		BlockBaseFeePerGas: cfg.BaseFee.Bytes(),
+		BlockBaseFeePerGas: baseFee.Bytes(),
 		Timestamp:          uint64(ctx.BlockHeader().Time.Unix()),
 		BlockGasLimit:      evmcommontypes.BlockGasLimit(ctx),
 		ChainId:            k.eip155ChainID.Uint64(),
-		GasPrice:           tx.GasPrice().Bytes(),
+		GasPrice:           gasPrice.Bytes(),
 	}, nil
 }
 
@@ -365,10 +567,16 @@ func CreateSGXVMContextFromMessage(ctx sdk.Context, k *Keeper, msg core.Message)
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
 
+	// there is no base fee before London
+	baseFee := new(big.Int)
+	if cfg.BaseFee != nil {
+		baseFee = cfg.BaseFee
+	}
+
 	return &librustgo.TransactionContext{
 		BlockCoinbase:      cfg.CoinBase.Bytes(),
 		BlockNumber:        uint64(ctx.BlockHeight()),
-		BlockBaseFeePerGas: cfg.BaseFee.Bytes(),
+		BlockBaseFeePerGas: baseFee.Bytes(),
 		Timestamp:          uint64(ctx.BlockHeader().Time.Unix()),
 		BlockGasLimit:      evmcommontypes.BlockGasLimit(ctx),
 		ChainId:            k.eip155ChainID.Uint64(),
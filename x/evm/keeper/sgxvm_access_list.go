@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// AccessListTracker tracks addresses and storage slots accessed during a single SGXVM
+// transaction. The enclave prices gas on its own, so the tracker doesn't affect gas, it
+// only reports the accessed state.
+type AccessListTracker struct {
+	addresses map[common.Address]map[common.Hash]struct{}
+}
+
+// NewAccessListTracker returns tracker holding the entries geth StateDB.PrepareAccessList adds:
+// sender, destination, active precompiles and entries of EIP-2930 access list. Before Berlin
+// the tracker starts empty.
+func NewAccessListTracker(
+	rules params.Rules,
+	sender common.Address,
+	dst *common.Address,
+	list ethtypes.AccessList,
+) *AccessListTracker {
+	tracker := &AccessListTracker{
+		addresses: make(map[common.Address]map[common.Hash]struct{}),
+	}
+
+	if !rules.IsBerlin {
+		return tracker
+	}
+
+	tracker.AddAddress(sender)
+	if dst != nil {
+		tracker.AddAddress(*dst)
+	}
+	for _, addr := range vm.ActivePrecompiles(rules) {
+		tracker.AddAddress(addr)
+	}
+	for _, tuple := range list {
+		tracker.AddAddress(tuple.Address)
+		for _, key := range tuple.StorageKeys {
+			tracker.AddSlot(tuple.Address, key)
+		}
+	}
+
+	return tracker
+}
+
+// ContainsAddress returns true if address is warm
+func (t *AccessListTracker) ContainsAddress(addr common.Address) bool {
+	_, ok := t.addresses[addr]
+	return ok
+}
+
+// ContainsSlot returns whether address and storage slot are warm
+func (t *AccessListTracker) ContainsSlot(addr common.Address, slot common.Hash) (addressOk bool, slotOk bool) {
+	slots, addressOk := t.addresses[addr]
+	if !addressOk {
+		return false, false
+	}
+	_, slotOk = slots[slot]
+	return addressOk, slotOk
+}
+
+// AddAddress marks address as warm. Returns true if address was cold before.
+func (t *AccessListTracker) AddAddress(addr common.Address) bool {
+	if _, ok := t.addresses[addr]; ok {
+		return false
+	}
+	t.addresses[addr] = make(map[common.Hash]struct{})
+	return true
+}
+
+// AddSlot marks address and storage slot as warm. Returns whether address and slot were cold before.
+func (t *AccessListTracker) AddSlot(addr common.Address, slot common.Hash) (addrAdded bool, slotAdded bool) {
+	addrAdded = t.AddAddress(addr)
+	if _, ok := t.addresses[addr][slot]; ok {
+		return addrAdded, false
+	}
+	t.addresses[addr][slot] = struct{}{}
+	return addrAdded, true
+}
+
+// AccessList returns all warm addresses and storage slots as EIP-2930 access list,
+// sorted to be deterministic.
+func (t *AccessListTracker) AccessList() ethtypes.AccessList {
+	list := make(ethtypes.AccessList, 0, len(t.addresses))
+	for addr, slots := range t.addresses {
+		keys := make([]common.Hash, 0, len(slots))
+		for slot := range slots {
+			keys = append(keys, slot)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+		})
+		list = append(list, ethtypes.AccessTuple{Address: addr, StorageKeys: keys})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i].Address.Bytes(), list[j].Address.Bytes()) < 0
+	})
+
+	return list
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	evmkeeper "github.com/SigmaGmbH/evm-module/x/evm/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func (suite *KeeperTestSuite) TestNewAccessListTracker() {
+	sender := common.BigToAddress(big.NewInt(1001))
+	dst := common.BigToAddress(big.NewInt(1002))
+	listed := common.BigToAddress(big.NewInt(1003))
+	slot := common.BigToHash(big.NewInt(1))
+	list := ethtypes.AccessList{{Address: listed, StorageKeys: []common.Hash{slot}}}
+
+	testCases := []struct {
+		name  string
+		rules params.Rules
+		warm  bool
+	}{
+		{"pre-warmed after Berlin", params.Rules{IsBerlin: true}, true},
+		{"nothing pre-warmed before Berlin", params.Rules{}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tracker := evmkeeper.NewAccessListTracker(tc.rules, sender, &dst, list)
+
+			suite.Require().Equal(tc.warm, tracker.ContainsAddress(sender))
+			suite.Require().Equal(tc.warm, tracker.ContainsAddress(dst))
+			suite.Require().Equal(tc.warm, tracker.ContainsAddress(common.BytesToAddress([]byte{1})))
+			_, slotOk := tracker.ContainsSlot(listed, slot)
+			suite.Require().Equal(tc.warm, slotOk)
+
+			addrAdded, slotAdded := tracker.AddSlot(listed, slot)
+			suite.Require().Equal(!tc.warm, addrAdded)
+			suite.Require().Equal(!tc.warm, slotAdded)
+		})
+	}
+}
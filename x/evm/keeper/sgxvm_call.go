@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// CallEVMWithData executes a call or, if contract is nil, a contract creation on behalf of
+// another module. Unlike ethereum transactions the message doesn't pass the ante handler,
+// so the sender pays no fees. The sender nonce is only increased after contract creations,
+// so calls made on behalf of users don't invalidate their pending transactions.
+// Gas used by the execution is consumed from the context gas meter.
+// Returns the execution result and the address of the created contract for creations.
+func (k *Keeper) CallEVMWithData(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	gasLimit uint64,
+	commit bool,
+) (*types.MsgEthereumTxResponse, common.Address, error) {
+	nonce := k.GetNonce(ctx, from)
+	msg := ethtypes.NewMessage(
+		from,
+		contract,
+		nonce,
+		big.NewInt(0), // amount
+		gasLimit,
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		data,
+		ethtypes.AccessList{},
+		!commit, // isFake
+	)
+
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		return nil, common.Address{}, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	txContext, err := CreateSGXVMContextFromMessage(ctx, k, msg)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	res, err := k.ApplyMessageWithConfig(ctx, msg, commit, cfg, txConfig, txContext)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	if res.Failed() {
+		return nil, common.Address{}, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm call")
+
+	var contractAddr common.Address
+	if contract == nil {
+		contractAddr = crypto.CreateAddress(from, nonce)
+
+		if commit {
+			if err := k.SetNonce(ctx, from, nonce+1); err != nil {
+				return nil, common.Address{}, err
+			}
+		}
+	}
+
+	return res, contractAddr, nil
+}
@@ -1,13 +1,26 @@
 package keeper
 
 import (
-	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
 	"github.com/SigmaGmbH/librustgo"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/protobuf/proto"
-	"math/big"
+)
+
+const (
+	// maxStorageValueSize defines max size of (possibly encrypted) storage cell value accepted from SGXVM
+	maxStorageValueSize = 256
 )
 
 // Connector allows our VM interact with existing Cosmos application.
@@ -19,15 +32,167 @@ type Connector struct {
 	EVMKeeper *Keeper
 	// Context used to make Keeper calls available
 	Context sdk.Context
+	// AccessList tracks addresses and storage slots touched by the VM, tracking is disabled if nil
+	AccessList *AccessListTracker
+	// SelfDestructs enforces EIP-6780 selfdestruct semantics, pre-Cancun semantics are used if nil
+	SelfDestructs *SelfDestructTracker
+	// MaxCodeSize limits the size of deployed contract code, the EIP-170 default is used if 0
+	MaxCodeSize uint64
+	// Deployment is the contract deployed by a contract creation transaction, nil for calls
+	Deployment *ContractDeployment
+	// fatalErr stores the first error returned to the VM, if set
+	fatalErr *error
+	// boundaryBytes accumulates the size of requests and responses passed to the VM, if set
+	boundaryBytes *uint64
+	// budget aborts eth_call and eth_estimateGas executions exceeding the query budget, if set
+	budget *queryBudgetTracker
+}
+
+// ContractDeployment is the contract deployed by a contract creation transaction and its sender, which
+// is reported as the deployer of the contract
+type ContractDeployment struct {
+	Contract common.Address
+	Deployer common.Address
 }
 
-func (q Connector) Query(req []byte) ([]byte, error) {
+// Query handles protobuf-encoded request from SGXVM. Returned errors are typed and
+// fatal for the VM. The first error is recorded to be surfaced in the transaction
+// response.
+func (q Connector) Query(req []byte) (res []byte, err error) {
+	// malformed request must not panic the node
+	defer func() {
+		if r := recover(); r != nil {
+			res, err = nil, errorsmod.Wrapf(types.ErrConnectorInvalidRequest, "panic while handling request: %v", r)
+		}
+	}()
+
+	if err = q.budget.consume(); err == nil {
+		res, err = q.query(req)
+	}
+	if err != nil && q.fatalErr != nil && *q.fatalErr == nil {
+		*q.fatalErr = err
+	}
+	if q.boundaryBytes != nil {
+		*q.boundaryBytes += uint64(len(req) + len(res))
+	}
+
+	return res, err
+}
+
+// BoundaryBytes returns the size of requests and responses passed to the VM
+func (q Connector) BoundaryBytes() uint64 {
+	if q.boundaryBytes == nil {
+		return 0
+	}
+	return *q.boundaryBytes
+}
+
+// requestType returns the name of request type used in metrics labels
+func requestType(req *librustgo.CosmosRequest) string {
+	name := fmt.Sprintf("%T", req.Req)
+	return strings.TrimPrefix(name, "*librustgo.CosmosRequest_")
+}
+
+// touchAddress marks address touched by the VM as warm
+func (q Connector) touchAddress(address common.Address) {
+	if q.AccessList != nil {
+		q.AccessList.AddAddress(address)
+	}
+}
+
+// touchSlot marks storage slot touched by the VM as warm
+func (q Connector) touchSlot(address common.Address, index common.Hash) {
+	if q.AccessList != nil {
+		q.AccessList.AddSlot(address, index)
+	}
+}
+
+// trackCreation marks account as created in the current transaction if it has neither nonce nor code
+// before being modified by the VM, the same way as geth marks accounts passed to CreateAccount
+func (q Connector) trackCreation(address common.Address) {
+	if q.SelfDestructs == nil || q.SelfDestructs.IsCreated(address) {
+		return
+	}
+
+	account := q.EVMKeeper.GetAccountWithoutBalance(q.Context, address)
+	if account == nil || (account.Nonce == 0 && !account.IsContract()) {
+		q.SelfDestructs.MarkCreated(address)
+	}
+}
+
+// emitContractCreated emits the event of a contract deployed by the VM. Only the sender of a contract
+// creation transaction is reported as deployer, the VM doesn't report the contract creating a contract.
+func (q Connector) emitContractCreated(address common.Address, code []byte) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAddress, address.Hex()),
+		sdk.NewAttribute(types.AttributeKeyCodeHash, crypto.Keccak256Hash(code).Hex()),
+	}
+	if q.Deployment != nil && q.Deployment.Contract == address {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyDeployer, q.Deployment.Deployer.Hex()))
+	}
+
+	q.Context.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeContractCreated, attrs...))
+}
+
+// emitContractDestroyed emits the event of a contract deleted by selfdestruct. The VM moves the balance
+// to the beneficiary before, but doesn't report the beneficiary with the removal.
+func (q Connector) emitContractDestroyed(address common.Address) {
+	q.Context.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContractDestroyed,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.Hex()),
+		),
+	)
+}
+
+// FatalError returns the first error returned to the VM
+func (q Connector) FatalError() error {
+	if q.fatalErr == nil {
+		return nil
+	}
+	return *q.fatalErr
+}
+
+func (q Connector) query(req []byte) ([]byte, error) {
 	// Decode protobuf
 	decodedRequest := &librustgo.CosmosRequest{}
 	if err := proto.Unmarshal(req, decodedRequest); err != nil {
+		return nil, errorsmod.Wrap(types.ErrConnectorInvalidRequest, err.Error())
+	}
+
+	labels := []metrics.Label{telemetry.NewLabel("request_type", requestType(decodedRequest))}
+	telemetry.IncrCounterWithLabels([]string{"sgxvm", "connector", "query", "total"}, 1, labels)
+	defer metrics.MeasureSinceWithLabels([]string{"sgxvm", "connector", "query", "latency"}, time.Now(), labels)
+
+	maxCodeSize := q.MaxCodeSize
+	if maxCodeSize == 0 {
+		maxCodeSize = types.DefaultMaxCodeSize
+	}
+	if err := validateRequest(decodedRequest, maxCodeSize); err != nil {
 		return nil, err
 	}
 
+	res, err := q.handle(decodedRequest)
+	if err == nil && isStateWrite(decodedRequest) {
+		q.EVMKeeper.AddPostStateWrite(q.Context, req)
+	}
+	return res, err
+}
+
+// isStateWrite returns true if the request modifies the state
+func isStateWrite(req *librustgo.CosmosRequest) bool {
+	switch req.Req.(type) {
+	case *librustgo.CosmosRequest_InsertAccount,
+		*librustgo.CosmosRequest_InsertStorageCell,
+		*librustgo.CosmosRequest_InsertAccountCode,
+		*librustgo.CosmosRequest_RemoveStorageCell,
+		*librustgo.CosmosRequest_Remove:
+		return true
+	}
+	return false
+}
+
+func (q Connector) handle(decodedRequest *librustgo.CosmosRequest) ([]byte, error) {
 	switch request := decodedRequest.Req.(type) {
 	// Handle request for account data such as balance and nonce
 	case *librustgo.CosmosRequest_GetAccount:
@@ -61,7 +226,116 @@ func (q Connector) Query(req []byte) ([]byte, error) {
 		return q.BlockHash(request)
 	}
 
-	return nil, errors.New("wrong query received")
+	return nil, errorsmod.Wrapf(types.ErrConnectorInvalidRequest, "wrong query received: %T", decodedRequest.Req)
+}
+
+// validateRequest checks presence and sizes of all fields of the decoded request
+func validateRequest(req *librustgo.CosmosRequest, maxCodeSize uint64) error {
+	switch request := req.Req.(type) {
+	case *librustgo.CosmosRequest_GetAccount:
+		if request.GetAccount == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty get account request")
+		}
+		return validateAddress(request.GetAccount.Address)
+	case *librustgo.CosmosRequest_InsertAccount:
+		if request.InsertAccount == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty insert account request")
+		}
+		if err := validateAddress(request.InsertAccount.Address); err != nil {
+			return err
+		}
+		return validateUint256(request.InsertAccount.Balance, "balance")
+	case *librustgo.CosmosRequest_ContainsKey:
+		if request.ContainsKey == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty contains key request")
+		}
+		return validateAddress(request.ContainsKey.Key)
+	case *librustgo.CosmosRequest_AccountCode:
+		if request.AccountCode == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty account code request")
+		}
+		return validateAddress(request.AccountCode.Address)
+	case *librustgo.CosmosRequest_StorageCell:
+		if request.StorageCell == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty storage cell request")
+		}
+		if err := validateAddress(request.StorageCell.Address); err != nil {
+			return err
+		}
+		return validateHash(request.StorageCell.Index, "storage index")
+	case *librustgo.CosmosRequest_InsertStorageCell:
+		if request.InsertStorageCell == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty insert storage cell request")
+		}
+		if err := validateAddress(request.InsertStorageCell.Address); err != nil {
+			return err
+		}
+		if err := validateHash(request.InsertStorageCell.Index, "storage index"); err != nil {
+			return err
+		}
+		if len(request.InsertStorageCell.Value) > maxStorageValueSize {
+			return errorsmod.Wrapf(
+				types.ErrConnectorInvalidRequest,
+				"storage value size %d exceeds limit %d", len(request.InsertStorageCell.Value), maxStorageValueSize,
+			)
+		}
+		return nil
+	case *librustgo.CosmosRequest_InsertAccountCode:
+		if request.InsertAccountCode == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty insert account code request")
+		}
+		if err := validateAddress(request.InsertAccountCode.Address); err != nil {
+			return err
+		}
+		if uint64(len(request.InsertAccountCode.Code)) > maxCodeSize {
+			return errorsmod.Wrapf(
+				types.ErrConnectorInvalidRequest,
+				"code size %d exceeds limit %d", len(request.InsertAccountCode.Code), maxCodeSize,
+			)
+		}
+		return nil
+	case *librustgo.CosmosRequest_RemoveStorageCell:
+		if request.RemoveStorageCell == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty remove storage cell request")
+		}
+		if err := validateAddress(request.RemoveStorageCell.Address); err != nil {
+			return err
+		}
+		return validateHash(request.RemoveStorageCell.Index, "storage index")
+	case *librustgo.CosmosRequest_Remove:
+		if request.Remove == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty remove request")
+		}
+		return validateAddress(request.Remove.Address)
+	case *librustgo.CosmosRequest_BlockHash:
+		if request.BlockHash == nil {
+			return errorsmod.Wrap(types.ErrConnectorInvalidRequest, "empty block hash request")
+		}
+		return validateUint256(request.BlockHash.Number, "block number")
+	}
+
+	return nil
+}
+
+func validateAddress(address []byte) error {
+	if len(address) != common.AddressLength {
+		return errorsmod.Wrapf(types.ErrConnectorInvalidRequest, "invalid address length %d", len(address))
+	}
+	return nil
+}
+
+func validateHash(hash []byte, name string) error {
+	if len(hash) != common.HashLength {
+		return errorsmod.Wrapf(types.ErrConnectorInvalidRequest, "invalid %s length %d", name, len(hash))
+	}
+	return nil
+}
+
+func validateUint256(value []byte, name string) error {
+	if _, err := types.DecodeUint256(value); err != nil {
+		return errorsmod.Wrapf(types.ErrConnectorInvalidRequest, "invalid %s: %s", name, err)
+	}
+	return nil
 }
 
 // GetAccount handles incoming protobuf-encoded request for account data such as balance and nonce.
@@ -69,10 +343,16 @@ func (q Connector) Query(req []byte) ([]byte, error) {
 func (q Connector) GetAccount(req *librustgo.CosmosRequest_GetAccount) ([]byte, error) {
 	//println("Connector::Query GetAccount invoked")
 	ethAddress := common.BytesToAddress(req.GetAccount.Address)
+	q.touchAddress(ethAddress)
 	account := q.EVMKeeper.GetAccountOrEmpty(q.Context, ethAddress)
 
+	balance, err := types.EncodeUint256(account.Balance)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrConnectorStoreCorruption, err.Error())
+	}
+
 	return proto.Marshal(&librustgo.QueryGetAccountResponse{
-		Balance: account.Balance.Bytes(),
+		Balance: balance,
 		Nonce:   account.Nonce,
 	})
 }
@@ -81,6 +361,7 @@ func (q Connector) GetAccount(req *librustgo.CosmosRequest_GetAccount) ([]byte,
 func (q Connector) ContainsKey(req *librustgo.CosmosRequest_ContainsKey) ([]byte, error) {
 	//println("Connector::Query ContainsKey invoked")
 	ethAddress := common.BytesToAddress(req.ContainsKey.Key)
+	q.touchAddress(ethAddress)
 	account := q.EVMKeeper.GetAccountWithoutBalance(q.Context, ethAddress)
 	return proto.Marshal(&librustgo.QueryContainsKeyResponse{Contains: account != nil})
 }
@@ -90,15 +371,18 @@ func (q Connector) ContainsKey(req *librustgo.CosmosRequest_ContainsKey) ([]byte
 func (q Connector) InsertAccountCode(req *librustgo.CosmosRequest_InsertAccountCode) ([]byte, error) {
 	//println("Connector::Query InsertAccountCode invoked")
 	ethAddress := common.BytesToAddress(req.InsertAccountCode.Address)
+	q.touchAddress(ethAddress)
+	q.trackCreation(ethAddress)
 	if err := q.EVMKeeper.SetAccountCode(q.Context, ethAddress, req.InsertAccountCode.Code); err != nil {
-		return nil, err
+		return nil, errorsmod.Wrap(types.ErrConnectorStoreCorruption, err.Error())
 	}
 
 	// TODO: For some reason, if we broadcast transaction using JSON-RPC it doesn't store account code
 	updAcc := q.EVMKeeper.GetAccountOrEmpty(q.Context, ethAddress)
 	if !updAcc.IsContract() {
-		return nil, errors.New("contract was not deployed")
+		return nil, errorsmod.Wrap(types.ErrConnectorStoreCorruption, "contract was not deployed")
 	}
+	q.emitContractCreated(ethAddress, req.InsertAccountCode.Code)
 
 	return proto.Marshal(&librustgo.QueryInsertAccountCodeResponse{})
 }
@@ -108,19 +392,32 @@ func (q Connector) RemoveStorageCell(req *librustgo.CosmosRequest_RemoveStorageC
 	//println("Connector::Query RemoveStorageCell invoked")
 	address := common.BytesToAddress(req.RemoveStorageCell.Address)
 	index := common.BytesToHash(req.RemoveStorageCell.Index)
+	q.touchSlot(address, index)
 
 	q.EVMKeeper.SetState(q.Context, address, index, common.Hash{}.Bytes())
 
 	return proto.Marshal(&librustgo.QueryRemoveStorageCellResponse{})
 }
 
-// Remove handles incoming protobuf-encoded request for removing smart contract (selfdestruct)
+// Remove handles incoming protobuf-encoded request for removing smart contract (selfdestruct).
+// After Cancun (EIP-6780) only accounts created in the same transaction are deleted, for other
+// accounts only the balance is cleared, since it is already moved to the beneficiary by the VM.
 func (q Connector) Remove(req *librustgo.CosmosRequest_Remove) ([]byte, error) {
 	//println("Connector::Query Remove invoked")
 	ethAddress := common.BytesToAddress(req.Remove.Address)
+	q.touchAddress(ethAddress)
+
+	if q.SelfDestructs != nil && !q.SelfDestructs.IsCreated(ethAddress) {
+		if err := q.EVMKeeper.SetBalance(q.Context, ethAddress, new(big.Int)); err != nil {
+			return nil, errorsmod.Wrap(types.ErrConnectorStoreCorruption, err.Error())
+		}
+		return proto.Marshal(&librustgo.QueryRemoveResponse{})
+	}
+
 	if err := q.EVMKeeper.DeleteAccount(q.Context, ethAddress); err != nil {
-		return nil, err
+		return nil, errorsmod.Wrap(types.ErrConnectorStoreCorruption, err.Error())
 	}
+	q.emitContractDestroyed(ethAddress)
 
 	return proto.Marshal(&librustgo.QueryRemoveResponse{})
 }
@@ -129,9 +426,19 @@ func (q Connector) Remove(req *librustgo.CosmosRequest_Remove) ([]byte, error) {
 func (q Connector) BlockHash(req *librustgo.CosmosRequest_BlockHash) ([]byte, error) {
 	//println("Connector::Query BlockHash invoked")
 
-	blockNumber := &big.Int{}
-	blockNumber.SetBytes(req.BlockHash.Number)
-	blockHash := q.GetHashFn(blockNumber.Uint64())
+	getHashFn := q.GetHashFn
+	if getHashFn == nil {
+		getHashFn = q.EVMKeeper.GetHashFn(q.Context)
+	}
+
+	blockNumber, err := types.DecodeUint256(req.BlockHash.Number)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrConnectorInvalidRequest, err.Error())
+	}
+	if !blockNumber.IsUint64() {
+		return proto.Marshal(&librustgo.QueryBlockHashResponse{Hash: common.Hash{}.Bytes()})
+	}
+	blockHash := getHashFn(blockNumber.Uint64())
 
 	return proto.Marshal(&librustgo.QueryBlockHashResponse{Hash: blockHash.Bytes()})
 }
@@ -142,6 +449,7 @@ func (q Connector) InsertStorageCell(req *librustgo.CosmosRequest_InsertStorageC
 
 	ethAddress := common.BytesToAddress(req.InsertStorageCell.Address)
 	index := common.BytesToHash(req.InsertStorageCell.Index)
+	q.touchSlot(ethAddress, index)
 
 	q.EVMKeeper.SetState(q.Context, ethAddress, index, req.InsertStorageCell.Value)
 
@@ -153,6 +461,10 @@ func (q Connector) GetStorageCell(req *librustgo.CosmosRequest_StorageCell) ([]b
 	//println("Connector::Query Request value of storage cell")
 	ethAddress := common.BytesToAddress(req.StorageCell.Address)
 	index := common.BytesToHash(req.StorageCell.Index)
+	if err := q.EVMKeeper.checkView(q.Context, ethAddress); err != nil {
+		return nil, err
+	}
+	q.touchSlot(ethAddress, index)
 	value := q.EVMKeeper.GetState(q.Context, ethAddress, index)
 
 	return proto.Marshal(&librustgo.QueryGetAccountStorageCellResponse{Value: value})
@@ -163,6 +475,11 @@ func (q Connector) GetStorageCell(req *librustgo.CosmosRequest_StorageCell) ([]b
 func (q Connector) GetAccountCode(req *librustgo.CosmosRequest_AccountCode) ([]byte, error) {
 	//println("Connector::Query Request account code")
 	ethAddress := common.BytesToAddress(req.AccountCode.Address)
+	// the code is loaded for every call frame, so queries can't enter contracts they may not view
+	if err := q.EVMKeeper.checkView(q.Context, ethAddress); err != nil {
+		return nil, err
+	}
+	q.touchAddress(ethAddress)
 	account := q.EVMKeeper.GetAccountWithoutBalance(q.Context, ethAddress)
 	if account == nil {
 		return proto.Marshal(&librustgo.QueryGetAccountCodeResponse{
@@ -182,20 +499,24 @@ func (q Connector) GetAccountCode(req *librustgo.CosmosRequest_AccountCode) ([]b
 func (q Connector) InsertAccount(req *librustgo.CosmosRequest_InsertAccount) ([]byte, error) {
 	//println("Connector::Query Request to insert account code")
 	ethAddress := common.BytesToAddress(req.InsertAccount.Address)
+	q.touchAddress(ethAddress)
+	q.trackCreation(ethAddress)
 
-	balance := &big.Int{}
-	balance.SetBytes(req.InsertAccount.Balance)
+	balance, err := types.DecodeUint256(req.InsertAccount.Balance)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrConnectorInvalidRequest, err.Error())
+	}
 	nonce := req.InsertAccount.Nonce
 
 	account := q.EVMKeeper.GetAccountOrEmpty(q.Context, ethAddress)
 	if err := q.EVMKeeper.SetBalance(q.Context, ethAddress, balance); err != nil {
-		return nil, err
+		return nil, errorsmod.Wrap(types.ErrConnectorStoreCorruption, err.Error())
 	}
 
 	account.Balance = balance
 	account.Nonce = nonce
 	if err := q.EVMKeeper.SetAccount(q.Context, ethAddress, account); err != nil {
-		return nil, err
+		return nil, errorsmod.Wrap(types.ErrConnectorStoreCorruption, err.Error())
 	}
 
 	return proto.Marshal(&librustgo.QueryInsertAccountResponse{})
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/SigmaGmbH/librustgo"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	evmkeeper "github.com/SigmaGmbH/evm-module/x/evm/keeper"
+)
+
+// FuzzConnectorQuery checks that arbitrary requests from SGXVM never panic the node
+func FuzzConnectorQuery(f *testing.F) {
+	seeds := []*librustgo.CosmosRequest{
+		{Req: &librustgo.CosmosRequest_GetAccount{}},
+		{Req: &librustgo.CosmosRequest_GetAccount{GetAccount: &librustgo.QueryGetAccount{Address: common.Address{}.Bytes()}}},
+		{Req: &librustgo.CosmosRequest_InsertAccount{InsertAccount: &librustgo.QueryInsertAccount{Address: []byte{1}, Balance: []byte{1}}}},
+		{Req: &librustgo.CosmosRequest_StorageCell{StorageCell: &librustgo.QueryGetAccountStorageCell{Address: common.Address{}.Bytes()}}},
+		{Req: &librustgo.CosmosRequest_InsertStorageCell{InsertStorageCell: &librustgo.QueryInsertStorageCell{}}},
+		{Req: &librustgo.CosmosRequest_InsertAccountCode{InsertAccountCode: &librustgo.QueryInsertAccountCode{Code: []byte{0x60}}}},
+		{Req: &librustgo.CosmosRequest_BlockHash{BlockHash: &librustgo.QueryBlockHash{Number: make([]byte, 64)}}},
+	}
+	for _, seed := range seeds {
+		bz, err := proto.Marshal(seed)
+		require.NoError(f, err)
+		f.Add(bz)
+	}
+
+	suite := KeeperTestSuite{}
+	suite.SetupTestWithT(f)
+
+	f.Fuzz(func(t *testing.T, req []byte) {
+		ctx, _ := suite.ctx.CacheContext()
+		connector := evmkeeper.Connector{
+			Context:   ctx,
+			EVMKeeper: suite.app.EvmKeeper,
+		}
+
+		require.NotPanics(t, func() {
+			_, _ = connector.Query(req)
+		})
+	})
+}
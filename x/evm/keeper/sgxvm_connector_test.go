@@ -2,9 +2,13 @@ package keeper_test
 
 import (
 	evmkeeper "github.com/SigmaGmbH/evm-module/x/evm/keeper"
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
 	"github.com/SigmaGmbH/librustgo"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/golang/protobuf/proto"
 	"math/big"
 	"math/rand"
@@ -133,6 +137,7 @@ func (suite *KeeperTestSuite) TestSGXVMConnector() {
 				decodingError := proto.Unmarshal(responseBytes, response)
 				suite.Require().NoError(decodingError)
 
+				suite.Require().Len(response.Balance, types.Uint256Size)
 				returnedBalance := &big.Int{}
 				returnedBalance.SetBytes(response.Balance)
 				suite.Require().Equal(balanceToSet, returnedBalance)
@@ -232,6 +237,217 @@ func (suite *KeeperTestSuite) TestSGXVMConnector() {
 				suite.Require().Equal(bytecode, accountCodeResponse.Code)
 			},
 		},
+		{
+			"Should return typed error for malformed request",
+			func() {
+				_, err := connector.Query([]byte{0xff, 0xff, 0xff})
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, types.ErrConnectorInvalidRequest)
+			},
+		},
+		{
+			"Should mark touched storage slots as warm",
+			func() {
+				trackingConnector := evmkeeper.Connector{
+					Context:    suite.ctx,
+					EVMKeeper:  suite.app.EvmKeeper,
+					AccessList: evmkeeper.NewAccessListTracker(params.Rules{}, common.Address{}, nil, nil),
+				}
+
+				address := common.BigToAddress(big.NewInt(rand.Int63n(100000)))
+				index := common.BigToHash(big.NewInt(1))
+				request, err := proto.Marshal(&librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_StorageCell{
+						StorageCell: &librustgo.QueryGetAccountStorageCell{
+							Address: address.Bytes(),
+							Index:   index.Bytes(),
+						},
+					},
+				})
+				suite.Require().NoError(err)
+
+				_, slotOk := trackingConnector.AccessList.ContainsSlot(address, index)
+				suite.Require().False(slotOk)
+
+				_, err = trackingConnector.Query(request)
+				suite.Require().NoError(err)
+
+				addressOk, slotOk := trackingConnector.AccessList.ContainsSlot(address, index)
+				suite.Require().True(addressOk)
+				suite.Require().True(slotOk)
+			},
+		},
+		{
+			"Should only delete accounts created in the same transaction after Cancun",
+			func() {
+				existing := common.BigToAddress(big.NewInt(rand.Int63n(100000)))
+				err := insertAccount(&connector, existing, big.NewInt(1000), big.NewInt(1))
+				suite.Require().NoError(err)
+
+				cancunConnector := evmkeeper.Connector{
+					Context:       suite.ctx,
+					EVMKeeper:     suite.app.EvmKeeper,
+					SelfDestructs: evmkeeper.NewSelfDestructTracker(),
+				}
+
+				created := common.BigToAddress(big.NewInt(100000 + rand.Int63n(100000)))
+				err = insertAccount(&cancunConnector, created, big.NewInt(1000), big.NewInt(1))
+				suite.Require().NoError(err)
+				suite.Require().True(cancunConnector.SelfDestructs.IsCreated(created))
+				suite.Require().False(cancunConnector.SelfDestructs.IsCreated(existing))
+
+				for _, address := range []common.Address{existing, created} {
+					request, err := proto.Marshal(&librustgo.CosmosRequest{
+						Req: &librustgo.CosmosRequest_Remove{
+							Remove: &librustgo.QueryRemove{Address: address.Bytes()},
+						},
+					})
+					suite.Require().NoError(err)
+
+					_, err = cancunConnector.Query(request)
+					suite.Require().NoError(err)
+				}
+
+				account := suite.app.EvmKeeper.GetAccount(suite.ctx, existing)
+				suite.Require().NotNil(account)
+				suite.Require().Equal(uint64(1), account.Nonce)
+				suite.Require().Equal(int64(0), account.Balance.Int64())
+				suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, created))
+			},
+		},
+		{
+			"Should emit events for created and destroyed contracts",
+			func() {
+				ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+				deployer := common.BigToAddress(big.NewInt(rand.Int63n(100000)))
+				deployed := common.BigToAddress(big.NewInt(100000 + rand.Int63n(100000)))
+				factoryCreated := common.BigToAddress(big.NewInt(200000 + rand.Int63n(100000)))
+				code := []byte{0x60, 0x00}
+
+				connector := evmkeeper.Connector{
+					Context:    ctx,
+					EVMKeeper:  suite.app.EvmKeeper,
+					Deployment: &evmkeeper.ContractDeployment{Contract: deployed, Deployer: deployer},
+				}
+				for _, address := range []common.Address{deployed, factoryCreated} {
+					request, err := proto.Marshal(&librustgo.CosmosRequest{
+						Req: &librustgo.CosmosRequest_InsertAccountCode{InsertAccountCode: &librustgo.QueryInsertAccountCode{
+							Address: address.Bytes(),
+							Code:    code,
+						}},
+					})
+					suite.Require().NoError(err)
+					_, err = connector.Query(request)
+					suite.Require().NoError(err)
+				}
+				request, err := proto.Marshal(&librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_Remove{Remove: &librustgo.QueryRemove{Address: factoryCreated.Bytes()}},
+				})
+				suite.Require().NoError(err)
+				_, err = connector.Query(request)
+				suite.Require().NoError(err)
+
+				codeHash := crypto.Keccak256Hash(code).Hex()
+				suite.Require().Equal(sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeContractCreated,
+						sdk.NewAttribute(types.AttributeKeyAddress, deployed.Hex()),
+						sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash),
+						sdk.NewAttribute(types.AttributeKeyDeployer, deployer.Hex()),
+					),
+					sdk.NewEvent(
+						types.EventTypeContractCreated,
+						sdk.NewAttribute(types.AttributeKeyAddress, factoryCreated.Hex()),
+						sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash),
+					),
+					sdk.NewEvent(
+						types.EventTypeContractDestroyed,
+						sdk.NewAttribute(types.AttributeKeyAddress, factoryCreated.Hex()),
+					),
+				}, ctx.EventManager().Events())
+			},
+		},
+		{
+			"Should reject requests with invalid field sizes",
+			func() {
+				requests := []*librustgo.CosmosRequest{
+					{Req: &librustgo.CosmosRequest_GetAccount{}},
+					{Req: &librustgo.CosmosRequest_GetAccount{GetAccount: &librustgo.QueryGetAccount{Address: []byte{1}}}},
+					{Req: &librustgo.CosmosRequest_StorageCell{StorageCell: &librustgo.QueryGetAccountStorageCell{
+						Address: common.Address{}.Bytes(),
+						Index:   []byte{1},
+					}}},
+					{Req: &librustgo.CosmosRequest_InsertStorageCell{InsertStorageCell: &librustgo.QueryInsertStorageCell{
+						Address: common.Address{}.Bytes(),
+						Index:   common.Hash{}.Bytes(),
+						Value:   make([]byte, 1024),
+					}}},
+					{Req: &librustgo.CosmosRequest_InsertAccount{InsertAccount: &librustgo.QueryInsertAccount{
+						Address: common.Address{}.Bytes(),
+						Balance: make([]byte, 33),
+					}}},
+					{Req: &librustgo.CosmosRequest_InsertAccountCode{InsertAccountCode: &librustgo.QueryInsertAccountCode{
+						Address: common.Address{}.Bytes(),
+						Code:    make([]byte, params.MaxCodeSize+1),
+					}}},
+				}
+
+				for _, req := range requests {
+					request, err := proto.Marshal(req)
+					suite.Require().NoError(err)
+
+					_, err = connector.Query(request)
+					suite.Require().ErrorIs(err, types.ErrConnectorInvalidRequest)
+				}
+			},
+		},
+		{
+			"Should limit inserted code to the max code size",
+			func() {
+				connector := evmkeeper.Connector{
+					Context:     suite.ctx,
+					EVMKeeper:   suite.app.EvmKeeper,
+					MaxCodeSize: 10,
+				}
+				insertCode := func(size int) error {
+					request, err := proto.Marshal(&librustgo.CosmosRequest{
+						Req: &librustgo.CosmosRequest_InsertAccountCode{InsertAccountCode: &librustgo.QueryInsertAccountCode{
+							Address: common.BigToAddress(big.NewInt(1)).Bytes(),
+							Code:    make([]byte, size),
+						}},
+					})
+					suite.Require().NoError(err)
+					_, err = connector.Query(request)
+					return err
+				}
+
+				suite.Require().NoError(insertCode(10))
+				suite.Require().ErrorIs(insertCode(11), types.ErrConnectorInvalidRequest)
+			},
+		},
+		{
+			"Should commit state writes to the post state",
+			func() {
+				connector := evmkeeper.Connector{
+					Context:   suite.ctx.WithBlockHeader(tmproto.Header{AppHash: common.BigToHash(big.NewInt(1)).Bytes()}),
+					EVMKeeper: suite.app.EvmKeeper,
+				}
+				k := connector.EVMKeeper
+
+				// the post state starts with the app hash of the previous block
+				postState := k.GetPostStateTransient(connector.Context)
+				suite.Require().Equal(common.BigToHash(big.NewInt(1)), postState)
+
+				address := common.BigToAddress(big.NewInt(rand.Int63n(100000)))
+				suite.Require().NoError(insertAccount(&connector, address, big.NewInt(10000), big.NewInt(1)))
+				afterFirst := k.GetPostStateTransient(connector.Context)
+				suite.Require().NotEqual(postState, afterFirst)
+
+				// the same write changes the commitment again, it's chained over the block
+				suite.Require().NoError(insertAccount(&connector, address, big.NewInt(10000), big.NewInt(1)))
+				suite.Require().NotEqual(afterFirst, k.GetPostStateTransient(connector.Context))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SelfDestructTracker tracks accounts created during a single SGXVM transaction to enforce
+// EIP-6780 selfdestruct semantics after Cancun: only accounts created in the same transaction
+// are deleted, otherwise only their balance is moved to the beneficiary.
+type SelfDestructTracker struct {
+	created map[common.Address]struct{}
+}
+
+// NewSelfDestructTracker returns tracker without created accounts
+func NewSelfDestructTracker() *SelfDestructTracker {
+	return &SelfDestructTracker{
+		created: make(map[common.Address]struct{}),
+	}
+}
+
+// MarkCreated marks account as created in the current transaction
+func (t *SelfDestructTracker) MarkCreated(addr common.Address) {
+	t.created[addr] = struct{}{}
+}
+
+// IsCreated returns true if account was created in the current transaction
+func (t *SelfDestructTracker) IsCreated(addr common.Address) bool {
+	_, ok := t.created[addr]
+	return ok
+}
@@ -51,6 +51,27 @@ func (suite *KeeperTestSuite) TestNativeCurrencyTransfer() {
 			},
 			false,
 		},
+		{
+			"Transfer funds dynamic fee tx",
+			func() {
+				transferAmount = 1000
+				msg, _, err = newEthMsgTx(
+					suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+					suite.ctx.BlockHeight(),
+					suite.address,
+					chainCfg,
+					suite.signer,
+					signer,
+					ethtypes.DynamicFeeTxType,
+					nil,
+					nil,
+					big.NewInt(transferAmount),
+				)
+				suite.Require().NoError(err)
+				expectedGasUsed = params.TxGas
+			},
+			false,
+		},
 		{
 			"Exceeding balance transfer tx",
 			func() {
@@ -385,3 +406,110 @@ func (suite *KeeperTestSuite) TestEmptyDataWithPublicKey() {
 	suite.Require().Empty(rsp.VmError)
 	suite.Require().True(len(rsp.Ret) != 0)
 }
+
+func (suite *KeeperTestSuite) TestCreateSGXVMContextGasPrice() {
+	testCases := []struct {
+		name             string
+		enableFeemarket  bool
+		enableLondonHF   bool
+		txType           byte
+		expectedGasPrice *big.Int
+	}{
+		{"legacy tx", true, true, ethtypes.LegacyTxType, big.NewInt(1)},
+		{"access list tx", true, true, ethtypes.AccessListTxType, big.NewInt(1)},
+		{"dynamic fee tx, base fee plus priority fee", true, true, ethtypes.DynamicFeeTxType, big.NewInt(3)},
+		{"dynamic fee tx, feemarket disabled pays the priority fee", false, true, ethtypes.DynamicFeeTxType, big.NewInt(2)},
+		{"legacy tx, pre-London without base fee", false, false, ethtypes.LegacyTxType, big.NewInt(1)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = tc.enableFeemarket
+			suite.enableLondonHF = tc.enableLondonHF
+			suite.SetupSGXVMTest()
+
+			if tc.enableFeemarket {
+				suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(1))
+			}
+
+			cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, suite.ctx.BlockHeader().ProposerAddress, suite.app.EvmKeeper.ChainID())
+			suite.Require().NoError(err)
+			signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+			msg, _, err := newEthMsgTx(
+				suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				suite.ctx.BlockHeight(),
+				suite.address,
+				cfg.ChainConfig,
+				suite.signer,
+				signer,
+				tc.txType,
+				nil,
+				nil,
+				nil,
+			)
+			suite.Require().NoError(err)
+
+			tx := msg.AsTransaction()
+			txContext, err := keeper.CreateSGXVMContext(suite.ctx, suite.app.EvmKeeper, tx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedGasPrice, new(big.Int).SetBytes(txContext.GasPrice))
+
+			// the refund is made at the gas price of the message, which must match the price the
+			// fees were deducted with
+			ethMessage, err := tx.AsMessage(signer, cfg.BaseFee)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedGasPrice, ethMessage.GasPrice())
+
+			txData, err := types.UnpackTxData(msg.Data)
+			suite.Require().NoError(err)
+			fees, err := keeper.VerifyFee(txData, types.DefaultEVMDenom, cfg.BaseFee, true, true, false)
+			suite.Require().NoError(err)
+			expectedFees := new(big.Int).Mul(tc.expectedGasPrice, new(big.Int).SetUint64(tx.Gas()))
+			suite.Require().Equal(expectedFees, fees.AmountOf(types.DefaultEVMDenom).BigInt())
+		})
+	}
+	suite.enableFeemarket = false
+	suite.enableLondonHF = true
+}
+
+func (suite *KeeperTestSuite) TestActivePrecompiles() {
+	suite.SetupSGXVMTest()
+
+	active := types.Precompile{Address: "0x0000000000000000000000000000000000000400", BaseGas: 100, WordGas: 3, ActivationHeight: 0}
+	pending := types.Precompile{Address: "0x0000000000000000000000000000000000000401", BaseGas: 100, ActivationHeight: suite.ctx.BlockHeight() + 1}
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.Precompiles = []types.Precompile{active, pending}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	suite.Require().Equal([]types.Precompile{active}, suite.app.EvmKeeper.GetParams(suite.ctx).ActivePrecompiles(suite.ctx.BlockHeight()))
+}
+
+func (suite *KeeperTestSuite) TestMaxInitCodeSize() {
+	suite.SetupSGXVMTest()
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(10))
+	suite.Require().NoError(err)
+	data := append(types.ERC20Contract.Bin, ctorArgs...)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.MaxCodeSize = uint64(len(data)) - 1
+	params.MaxInitCodeSize = uint64(len(data)) - 1
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	deployTx := types.NewSGXVMTxContract(
+		chainID,
+		suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		nil,       // amount
+		1_000_000, // gasLimit
+		nil,       // gasPrice
+		nil, nil,
+		data, // input
+		nil,  // accesses
+	)
+	deployTx.From = suite.address.Hex()
+	suite.Require().NoError(deployTx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	_, err = suite.app.EvmKeeper.HandleTx(sdk.WrapSDKContext(suite.ctx), deployTx)
+	suite.Require().ErrorIs(err, types.ErrMaxInitCodeSizeExceeded)
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"fmt"
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// SnapshotFormat is the format of the payloads of the EVM snapshot extension
+const SnapshotFormat uint32 = 1
+
+var _ snapshottypes.ExtensionSnapshotter = &Snapshotter{}
+
+// Snapshotter is the state sync snapshot extension of the EVM module. The EVM store, including the
+// code and the encrypted storage, is restored with the multistore, but it can only be used by an
+// enclave holding the master seed it was encrypted with. The extension therefore adds the epoch public
+// keys derived by the enclave of the snapshotting node. The restoring node checks the integrity of
+// the restored store and that its enclave derives the same keys, before the node starts serving the
+// synced state.
+type Snapshotter struct {
+	cms    sdk.MultiStore
+	keeper *Keeper
+}
+
+// NewSnapshotter returns the snapshot extension of the EVM module
+func NewSnapshotter(cms sdk.MultiStore, keeper *Keeper) *Snapshotter {
+	return &Snapshotter{
+		cms:    cms,
+		keeper: keeper,
+	}
+}
+
+// SnapshotName implements ExtensionSnapshotter
+func (s *Snapshotter) SnapshotName() string {
+	return types.ModuleName
+}
+
+// SnapshotFormat implements ExtensionSnapshotter
+func (s *Snapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements ExtensionSnapshotter
+func (s *Snapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// PruneSnapshotHeight implements Snapshotter. The extension keeps no state of its own.
+func (s *Snapshotter) PruneSnapshotHeight(int64) {}
+
+// SetSnapshotInterval implements Snapshotter. The extension keeps no state of its own.
+func (s *Snapshotter) SetSnapshotInterval(uint64) {}
+
+// Snapshot writes the epoch public keys of the state at the snapshot height, one payload per epoch
+func (s *Snapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	cacheMS, err := s.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return err
+	}
+	ctx := sdk.NewContext(cacheMS, tmproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+
+	keys, err := s.keeper.GetEpochPublicKeys(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to derive the epoch public keys")
+	}
+	for _, key := range keys {
+		bz, err := key.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := snapshottypes.WriteExtensionItem(protoWriter, bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Restore reads the epoch public keys and verifies the restored EVM store against them. It returns
+// the next snapshot item, which belongs to the following extension.
+func (s *Snapshotter) Restore(height uint64, format uint32, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	if format != SnapshotFormat {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+
+	var (
+		item snapshottypes.SnapshotItem
+		keys []types.EpochPublicKey
+	)
+	for {
+		item = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+		}
+
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			break
+		}
+		var key types.EpochPublicKey
+		if err := key.Unmarshal(payload.Payload); err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid epoch public key")
+		}
+		keys = append(keys, key)
+	}
+
+	ctx := sdk.NewContext(s.cms, tmproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+	if err := s.keeper.VerifyRestoredState(ctx, keys); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	return item, nil
+}
+
+// VerifyRestoredState checks the EVM store restored by state sync. The code must match its hashes and
+// the enclave must derive the epoch public keys of the snapshot, i.e. hold the master seed of the
+// chain, since it can't execute transactions or decrypt the state otherwise.
+func (k *Keeper) VerifyRestoredState(ctx sdk.Context, keys []types.EpochPublicKey) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("snapshot has no epoch public keys")
+	}
+	if err := types.ValidateEpochPublicKeys(keys, k.GetKeyEpochs(ctx)); err != nil {
+		return errorsmod.Wrap(err, "invalid snapshot epoch public keys")
+	}
+
+	codeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCode)
+	codeIterator := codeStore.Iterator(nil, nil)
+	defer codeIterator.Close()
+	for ; codeIterator.Valid(); codeIterator.Next() {
+		codeHash := common.BytesToHash(codeIterator.Key())
+		if crypto.Keccak256Hash(codeIterator.Value()) != codeHash {
+			return fmt.Errorf("restored code doesn't match its hash %s", codeHash)
+		}
+	}
+
+	if err := k.VerifyEpochPublicKeys(keys); err != nil {
+		return errorsmod.Wrap(err, "request the master seed from an attested node before state sync")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/ethereum/go-ethereum/common"
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/keeper"
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestSnapshotter() {
+	suite.Commit()
+	snapshotter := keeper.NewSnapshotter(suite.app.CommitMultiStore(), suite.app.EvmKeeper)
+	height := uint64(suite.app.LastBlockHeight())
+
+	var buf bytes.Buffer
+	suite.Require().NoError(snapshotter.Snapshot(height, protoio.NewDelimitedWriter(&buf)))
+
+	item, err := snapshotter.Restore(height, keeper.SnapshotFormat, protoio.NewDelimitedReader(&buf, 1<<20))
+	suite.Require().NoError(err)
+	suite.Require().Nil(item.GetExtensionPayload())
+
+	_, err = snapshotter.Restore(height, keeper.SnapshotFormat+1, protoio.NewDelimitedReader(&buf, 1<<20))
+	suite.Require().ErrorIs(err, snapshottypes.ErrUnknownFormat)
+}
+
+func (suite *KeeperTestSuite) TestVerifyRestoredState() {
+	k := suite.app.EvmKeeper
+	keys, err := k.GetEpochPublicKeys(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.VerifyRestoredState(suite.ctx, keys))
+
+	// the snapshot must come with the keys of all epochs
+	suite.Require().Error(k.VerifyRestoredState(suite.ctx, nil))
+
+	// the enclave must hold the master seed of the snapshot
+	otherKeys := []types.EpochPublicKey{{Epoch: 0, PublicKey: common.BigToHash(big.NewInt(1)).Hex()}}
+	suite.Require().Error(k.VerifyRestoredState(suite.ctx, otherKeys))
+
+	// restored code must match its hash
+	ctx, _ := suite.ctx.CacheContext()
+	k.SetCode(ctx, common.BigToHash(big.NewInt(1)).Bytes(), []byte{0x60, 0x00})
+	suite.Require().Error(k.VerifyRestoredState(ctx, keys))
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+
+	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// DumpOptions selects the accounts and the content of a state dump
+type DumpOptions struct {
+	// Start is the address of the first dumped account, the next cursor of the previous page
+	Start common.Address
+	// Limit is the max number of dumped accounts, 0 dumps all accounts
+	Limit int
+	// Storage includes the storage of the accounts
+	Storage bool
+}
+
+// DumpAccounts streams the EVM accounts to cb in the format of the go-ethereum state dump, ordered by
+// address. Storage values are returned as stored, so the storage of confidential contracts is
+// encrypted. The state root isn't set, since the state isn't kept in a trie. The
+// returned cursor is the address of the next account, or nil after the last one.
+func (k *Keeper) DumpAccounts(ctx sdk.Context, opts DumpOptions, cb func(account state.DumpAccount) error) (next *common.Address, err error) {
+	dumped := 0
+	k.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		ethAccount, ok := account.(evmcommontypes.EthAccountI)
+		if !ok {
+			return false
+		}
+
+		addr := ethAccount.EthAddress()
+		if bytes.Compare(addr.Bytes(), opts.Start.Bytes()) < 0 {
+			return false
+		}
+		if opts.Limit > 0 && dumped == opts.Limit {
+			next = &addr
+			return true
+		}
+
+		var dump state.DumpAccount
+		dump, err = k.dumpAccount(ctx, ethAccount, opts)
+		if err == nil {
+			err = cb(dump)
+		}
+		dumped++
+		return err != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return next, nil
+}
+
+func (k *Keeper) dumpAccount(ctx sdk.Context, account evmcommontypes.EthAccountI, opts DumpOptions) (state.DumpAccount, error) {
+	addr := account.EthAddress()
+	codeHash := account.GetCodeHash()
+
+	dump := state.DumpAccount{
+		Balance:  k.GetBalance(ctx, addr).String(),
+		Nonce:    account.GetSequence(),
+		CodeHash: codeHash.Bytes(),
+		Code:     k.GetCode(ctx, codeHash),
+		Address:  &addr,
+	}
+	if !opts.Storage {
+		return dump, nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if dump.Storage == nil {
+			dump.Storage = make(map[common.Hash]string)
+		}
+
+		dump.Storage[common.BytesToHash(iterator.Key())] = common.Bytes2Hex(iterator.Value())
+	}
+
+	return dump, nil
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/keeper"
+)
+
+func (suite *KeeperTestSuite) TestDumpAccounts() {
+	k := suite.app.EvmKeeper
+	contract := common.BigToAddress(big.NewInt(1001))
+	eoa := common.BigToAddress(big.NewInt(1002))
+	code := []byte{0x60, 0x00}
+	slot := common.BigToHash(big.NewInt(1))
+	value := []byte{0x0f, 0xf0}
+
+	suite.Require().NoError(k.SetAccountCode(suite.ctx, contract, code))
+	suite.Require().NoError(k.SetBalance(suite.ctx, contract, big.NewInt(100)))
+	k.SetState(suite.ctx, contract, slot, value)
+	suite.Require().NoError(k.SetNonce(suite.ctx, eoa, 5))
+
+	dump := func(opts keeper.DumpOptions) ([]state.DumpAccount, *common.Address) {
+		var accounts []state.DumpAccount
+		next, err := k.DumpAccounts(suite.ctx, opts, func(account state.DumpAccount) error {
+			accounts = append(accounts, account)
+			return nil
+		})
+		suite.Require().NoError(err)
+		return accounts, next
+	}
+
+	// the cursor resumes the dump at the next account
+	accounts, next := dump(keeper.DumpOptions{Start: contract, Limit: 1})
+	suite.Require().Len(accounts, 1)
+	suite.Require().Equal(contract, *accounts[0].Address)
+	suite.Require().Equal("100", accounts[0].Balance)
+	suite.Require().Equal(crypto.Keccak256(code), []byte(accounts[0].CodeHash))
+	suite.Require().Equal(code, []byte(accounts[0].Code))
+	suite.Require().Nil(accounts[0].Storage)
+	suite.Require().Equal(eoa, *next)
+
+	accounts, _ = dump(keeper.DumpOptions{Start: *next, Limit: 1})
+	suite.Require().Equal(eoa, *accounts[0].Address)
+	suite.Require().Equal(uint64(5), accounts[0].Nonce)
+	suite.Require().Empty(accounts[0].Code)
+
+	// storage of confidential contracts is dumped as stored
+	accounts, _ = dump(keeper.DumpOptions{Start: contract, Limit: 1, Storage: true})
+	suite.Require().Equal(map[common.Hash]string{slot: "0ff0"}, accounts[0].Storage)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// SetStateHistory sets the heights whose state is stored by the node and whether the node is an
+// archive node, keeping the state of every height since it started. They're node-local, as pruning
+// is configured per node.
+func (k *Keeper) SetStateHistory(history types.StateHistory, archive bool) *Keeper {
+	k.stateHistory = history
+	k.archive = archive
+	return k
+}
+
+// EarliestStateHeight returns the earliest height from which the state of every height up to the
+// latest one is stored by the node, or 0 if the state history isn't known. Older heights were pruned
+// or precede the state sync snapshot the node started from. Pruning keeps the heights of state sync
+// snapshots, so isolated older heights are skipped.
+func (k *Keeper) EarliestStateHeight() int64 {
+	if k.stateHistory == nil {
+		return 0
+	}
+	latest := k.stateHistory.LatestVersion()
+	if latest <= 0 {
+		return 0
+	}
+
+	earliest := int64(1)
+	for {
+		// the stored heights end with a contiguous range, so binary search for the start of a range
+		lo, hi := earliest, latest
+		for lo < hi {
+			mid := lo + (hi-lo)/2
+			if k.stateHistory.VersionExists(mid) {
+				hi = mid
+			} else {
+				lo = mid + 1
+			}
+		}
+
+		if lo == latest || k.stateHistory.VersionExists(lo+1) {
+			return lo
+		}
+		earliest = lo + 1
+	}
+}
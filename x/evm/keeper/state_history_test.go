@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// versions is a state history storing the listed heights
+type versions map[int64]bool
+
+func (v versions) LatestVersion() int64 {
+	var latest int64
+	for version := range v {
+		if version > latest {
+			latest = version
+		}
+	}
+	return latest
+}
+
+func (v versions) VersionExists(version int64) bool {
+	return v[version]
+}
+
+func heights(from, to int64) versions {
+	v := make(versions)
+	for height := from; height <= to; height++ {
+		v[height] = true
+	}
+	return v
+}
+
+func (suite *KeeperTestSuite) TestEarliestStateHeight() {
+	k := suite.app.EvmKeeper
+	suite.Commit()
+	suite.Require().Equal(int64(1), k.EarliestStateHeight())
+	defer k.SetStateHistory(nil, false)
+
+	snapshotHeights := heights(100, 200)
+	snapshotHeights[20] = true
+	snapshotHeights[50] = true
+
+	testCases := []struct {
+		name     string
+		history  types.StateHistory
+		expected int64
+	}{
+		{"unknown history", nil, 0},
+		{"no committed height", versions{}, 0},
+		{"archive", heights(1, 200), 1},
+		{"state sync", heights(80, 200), 80},
+		{"pruned", heights(199, 200), 199},
+		{"latest height only", heights(200, 200), 200},
+		{"snapshot heights kept by pruning", snapshotHeights, 100},
+	}
+
+	for _, tc := range testCases {
+		k.SetStateHistory(tc.history, false)
+		suite.Require().Equal(tc.expected, k.EarliestStateHeight(), tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestStateHistoryQuery() {
+	k := suite.app.EvmKeeper
+	defer k.SetStateHistory(nil, false)
+
+	k.SetStateHistory(nil, false)
+	_, err := suite.queryClient.StateHistory(suite.ctx, &types.QueryStateHistoryRequest{})
+	suite.Require().Error(err)
+
+	k.SetStateHistory(heights(80, 200), true)
+	res, err := suite.queryClient.StateHistory(suite.ctx, &types.QueryStateHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryStateHistoryResponse{Archive: true, EarliestHeight: 80, LatestHeight: 200}, res)
+}
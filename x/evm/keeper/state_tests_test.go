@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	evmkeeper "github.com/SigmaGmbH/evm-module/x/evm/keeper"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+const (
+	// stateTestsDirEnv points to the GeneralStateTests directory of ethereum/tests.
+	// State tests are skipped if it is not set.
+	stateTestsDirEnv = "STATE_TESTS_DIR"
+	// stateTestsFork defines the post-state fork of the fixtures matching the default chain config
+	stateTestsFork = "London"
+)
+
+// stateTest is a GeneralStateTests fixture. It mirrors the unexported stJSON of go-ethereum tests,
+// since the pre-state has to be set up through the keeper.
+type stateTest struct {
+	Env  stateTestEnv               `json:"env"`
+	Pre  core.GenesisAlloc          `json:"pre"`
+	Tx   stateTestTransaction       `json:"transaction"`
+	Post map[string][]stateTestPost `json:"post"`
+}
+
+type stateTestEnv struct {
+	Coinbase  common.Address        `json:"currentCoinbase"`
+	GasLimit  math.HexOrDecimal64   `json:"currentGasLimit"`
+	Number    math.HexOrDecimal64   `json:"currentNumber"`
+	Timestamp math.HexOrDecimal64   `json:"currentTimestamp"`
+	BaseFee   *math.HexOrDecimal256 `json:"currentBaseFee"`
+}
+
+type stateTestTransaction struct {
+	GasPrice             *math.HexOrDecimal256  `json:"gasPrice"`
+	MaxFeePerGas         *math.HexOrDecimal256  `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *math.HexOrDecimal256  `json:"maxPriorityFeePerGas"`
+	Nonce                math.HexOrDecimal64    `json:"nonce"`
+	To                   string                 `json:"to"`
+	Data                 []string               `json:"data"`
+	AccessLists          []*ethtypes.AccessList `json:"accessLists"`
+	GasLimit             []math.HexOrDecimal64  `json:"gasLimit"`
+	Value                []string               `json:"value"`
+	SecretKey            hexutil.Bytes          `json:"secretKey"`
+}
+
+type stateTestPost struct {
+	Root            common.Hash `json:"hash"`
+	Logs            common.Hash `json:"logs"`
+	ExpectException string      `json:"expectException"`
+	Indexes         struct {
+		Data  int `json:"data"`
+		Gas   int `json:"gas"`
+		Value int `json:"value"`
+	} `json:"indexes"`
+}
+
+// toMessage builds the message for the given post-state indexes the same way as go-ethereum state tests
+func (tx stateTestTransaction) toMessage(post stateTestPost, baseFee *big.Int) (ethtypes.Message, error) {
+	key, err := crypto.ToECDSA(tx.SecretKey)
+	if err != nil {
+		return ethtypes.Message{}, err
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	var to *common.Address
+	if tx.To != "" {
+		addr := common.HexToAddress(tx.To)
+		to = &addr
+	}
+
+	data, err := hexutil.Decode(tx.Data[post.Indexes.Data])
+	if err != nil {
+		return ethtypes.Message{}, err
+	}
+
+	value, ok := math.ParseBig256(tx.Value[post.Indexes.Value])
+	if !ok {
+		return ethtypes.Message{}, fmt.Errorf("invalid tx value %q", tx.Value[post.Indexes.Value])
+	}
+
+	var accessList ethtypes.AccessList
+	if tx.AccessLists != nil && tx.AccessLists[post.Indexes.Data] != nil {
+		accessList = *tx.AccessLists[post.Indexes.Data]
+	}
+
+	gasPrice := (*big.Int)(tx.GasPrice)
+	gasFeeCap, gasTipCap := gasPrice, gasPrice
+	if baseFee != nil && tx.MaxFeePerGas != nil {
+		gasFeeCap = (*big.Int)(tx.MaxFeePerGas)
+		gasTipCap = (*big.Int)(tx.MaxPriorityFeePerGas)
+		gasPrice = math.BigMin(new(big.Int).Add(gasTipCap, baseFee), gasFeeCap)
+	}
+	if gasPrice == nil {
+		return ethtypes.Message{}, fmt.Errorf("no gas price provided")
+	}
+
+	gasLimit := uint64(tx.GasLimit[post.Indexes.Gas])
+	return ethtypes.NewMessage(
+		from, to, uint64(tx.Nonce), value, gasLimit, gasPrice, gasFeeCap, gasTipCap, data, accessList, false,
+	), nil
+}
+
+// TestGeneralStateTests runs Ethereum GeneralStateTests fixtures through the SGXVM and compares
+// post-state root and logs hash with the expected ones.
+func (suite *KeeperTestSuite) TestGeneralStateTests() {
+	dir := os.Getenv(stateTestsDirEnv)
+	if dir == "" {
+		suite.T().Skipf("%s is not set, skipping state tests", stateTestsDirEnv)
+	}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".json") {
+			return err
+		}
+
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var tests map[string]stateTest
+		if err := json.Unmarshal(bz, &tests); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		for name, test := range tests {
+			for i, post := range test.Post[stateTestsFork] {
+				// transaction validity is checked by the ante handler, which is not part of the SGXVM path
+				if post.ExpectException != "" {
+					continue
+				}
+
+				test, post := test, post
+				suite.Run(fmt.Sprintf("%s/%d", name, i), func() {
+					suite.SetupTest()
+					suite.runStateTest(test, post)
+				})
+			}
+		}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) runStateTest(test stateTest, post stateTestPost) {
+	k := suite.app.EvmKeeper
+	ctx := suite.ctx.
+		WithBlockHeight(int64(test.Env.Number)).
+		WithBlockTime(time.Unix(int64(test.Env.Timestamp), 0))
+
+	// accounts existing before the pre-state are not part of the post-state root
+	existing := make(map[common.Address]bool)
+	suite.app.AccountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		existing[common.BytesToAddress(account.GetAddress())] = true
+		return false
+	})
+
+	touched := map[common.Address]bool{test.Env.Coinbase: true}
+	for addr, account := range test.Pre {
+		touched[addr] = true
+		suite.Require().NoError(k.SetAccount(ctx, addr, evmtypes.Account{Nonce: account.Nonce, Balance: account.Balance}))
+		if len(account.Code) > 0 {
+			suite.Require().NoError(k.SetAccountCode(ctx, addr, account.Code))
+		}
+		for key, value := range account.Storage {
+			k.SetState(ctx, addr, key, value.Bytes())
+		}
+	}
+
+	var baseFee *big.Int
+	if test.Env.BaseFee != nil {
+		baseFee = (*big.Int)(test.Env.BaseFee)
+	}
+
+	msg, err := test.Tx.toMessage(post, baseFee)
+	suite.Require().NoError(err)
+
+	// buy gas and increment nonce the same way as the ante handler does
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice())
+	sender := k.GetAccountOrEmpty(ctx, msg.From())
+	sender.Balance = new(big.Int).Sub(sender.Balance, gasCost)
+	if msg.To() != nil {
+		sender.Nonce++
+	}
+	suite.Require().NoError(k.SetAccount(ctx, msg.From(), sender))
+
+	cfg, err := k.EVMConfig(ctx, suite.consAddress, k.ChainID())
+	suite.Require().NoError(err)
+	cfg.CoinBase = test.Env.Coinbase
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	txContext, err := evmkeeper.CreateSGXVMContextFromMessage(ctx, k, msg)
+	suite.Require().NoError(err)
+	txContext.BlockCoinbase = test.Env.Coinbase.Bytes()
+	txContext.BlockNumber = uint64(test.Env.Number)
+	txContext.Timestamp = uint64(test.Env.Timestamp)
+	txContext.BlockGasLimit = uint64(test.Env.GasLimit)
+	if baseFee != nil {
+		txContext.BlockBaseFeePerGas = baseFee.Bytes()
+	}
+
+	txConfig := k.TxConfig(ctx, common.Hash{})
+	res, err := k.ApplyMessageWithConfig(ctx, msg, true, cfg, txConfig, txContext)
+	suite.Require().NoError(err)
+
+	// refund leftover gas to the sender and pay the tip to the coinbase
+	refund := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()-res.GasUsed), msg.GasPrice())
+	suite.Require().NoError(k.SetBalance(ctx, msg.From(), new(big.Int).Add(k.GetBalance(ctx, msg.From()), refund)))
+
+	tip := new(big.Int).Set(msg.GasPrice())
+	if baseFee != nil {
+		tip.Sub(tip, baseFee)
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(res.GasUsed), tip)
+	suite.Require().NoError(k.SetBalance(ctx, test.Env.Coinbase, new(big.Int).Add(k.GetBalance(ctx, test.Env.Coinbase), fee)))
+
+	suite.app.AccountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		if addr := common.BytesToAddress(account.GetAddress()); !existing[addr] {
+			touched[addr] = true
+		}
+		return false
+	})
+
+	logs, err := rlp.EncodeToBytes(evmtypes.LogsToEthereum(res.Logs))
+	suite.Require().NoError(err)
+	suite.Require().Equal(post.Logs, crypto.Keccak256Hash(logs), "logs hash mismatch")
+	suite.Require().Equal(post.Root, stateRoot(ctx, k, touched), "post-state root mismatch")
+}
+
+// stateRoot computes the Ethereum state root over the given accounts stored in the keeper
+func stateRoot(ctx sdk.Context, k *evmkeeper.Keeper, addresses map[common.Address]bool) common.Hash {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		panic(err)
+	}
+
+	for addr := range addresses {
+		account := k.GetAccount(ctx, addr)
+		if account == nil {
+			continue
+		}
+
+		statedb.SetNonce(addr, account.Nonce)
+		statedb.SetBalance(addr, account.Balance)
+		if code := k.GetCode(ctx, common.BytesToHash(account.CodeHash)); len(code) > 0 {
+			statedb.SetCode(addr, code)
+		}
+		k.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+			statedb.SetState(addr, key, value)
+			return true
+		})
+	}
+
+	return statedb.IntermediateRoot(true)
+}
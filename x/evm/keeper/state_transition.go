@@ -61,6 +61,12 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 		case ctx.BlockHeight() > h:
 			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
 			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// The BLOCKHASH ring buffer is checked first, since it doesn't depend on the staking module
+			// historical entries parameter.
+			if hash, found := k.GetBlockHash(ctx, height); found {
+				return hash
+			}
+
 			histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if !found {
 				k.Logger(ctx).Debug("historical info not found", "height", h)
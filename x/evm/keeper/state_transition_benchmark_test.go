@@ -119,16 +119,16 @@ func newEthMsgTx(
 		templateDynamicFeeTx.Nonce = nonce
 
 		if data != nil {
-			templateAccessListTx.Data = data
+			templateDynamicFeeTx.Data = data
 		} else {
-			templateAccessListTx.Data = []byte{}
+			templateDynamicFeeTx.Data = []byte{}
 		}
 
 		if value != nil {
-			templateAccessListTx.Value = value
+			templateDynamicFeeTx.Value = value
 		}
 
-		templateAccessListTx.AccessList = accessList
+		templateDynamicFeeTx.AccessList = accessList
 		ethTx = ethtypes.NewTx(templateDynamicFeeTx)
 		baseFee = big.NewInt(3)
 	default:
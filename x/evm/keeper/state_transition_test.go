@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"math"
@@ -87,6 +88,25 @@ func (suite *KeeperTestSuite) TestGetHashFn() {
 			},
 			common.BytesToHash(hash),
 		},
+		{
+			"case 2.4: height lower than current one, found in block hash ring buffer",
+			1,
+			func() {
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1, common.BytesToHash(hash))
+				suite.ctx = suite.ctx.WithBlockHeight(10)
+			},
+			common.BytesToHash(hash),
+		},
+		{
+			"case 2.5: height lower than current one, ring buffer slot overwritten",
+			1,
+			func() {
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1, common.BytesToHash(hash))
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1+types.BlockHashWindow, common.HexToHash("0x01"))
+				suite.ctx = suite.ctx.WithBlockHeight(300)
+			},
+			common.Hash{},
+		},
 		{
 			"case 3: height greater than current one",
 			200,
@@ -248,6 +268,24 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			true,
 			params.TxGas + params.TxDataNonZeroGasEIP2028*1,
 		},
+		{
+			"with 33 non zero data, no accesslist, is contract creation, is shanghai",
+			bytes.Repeat([]byte{1}, 33),
+			nil,
+			4,
+			true,
+			true,
+			params.TxGasContractCreation + params.TxDataNonZeroGasEIP2028*33 + 2*2,
+		},
+		{
+			"with 33 non zero data, no accesslist, not contract creation, is shanghai",
+			bytes.Repeat([]byte{1}, 33),
+			nil,
+			4,
+			false,
+			true,
+			params.TxGas + params.TxDataNonZeroGasEIP2028*33,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -258,6 +296,7 @@ func (suite *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			ethCfg := params.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
 			ethCfg.HomesteadBlock = big.NewInt(2)
 			ethCfg.IstanbulBlock = big.NewInt(3)
+			ethCfg.ShanghaiBlock = big.NewInt(4)
 			signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
 
 			suite.ctx = suite.ctx.WithBlockHeight(tc.height)
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// The keeper accounts the number of storage slots and their size per contract and in total,
+// so operators can reason about state growth. The usage of a contract is updated on every
+// storage write. The change of the total is collected in the transient store and applied
+// once per VM execution, so the total isn't written on every storage write. The usage is
+// the basis for state rent pricing, which is not charged yet.
+
+// GetStorageUsage returns the storage used by the contract
+func (k *Keeper) GetStorageUsage(ctx sdk.Context, addr common.Address) types.StorageUsage {
+	var usage types.StorageUsage
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStorageUsage)
+	if bz := store.Get(addr.Bytes()); len(bz) != 0 {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+
+	return usage
+}
+
+// SetStorageUsage stores the storage used by the contract, the record is removed once no
+// storage is used
+func (k *Keeper) SetStorageUsage(ctx sdk.Context, addr common.Address, usage types.StorageUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStorageUsage)
+	if usage.IsZero() {
+		store.Delete(addr.Bytes())
+		return
+	}
+
+	store.Set(addr.Bytes(), k.cdc.MustMarshal(&usage))
+}
+
+// GetTotalStorageUsage returns the storage used by all contracts
+func (k *Keeper) GetTotalStorageUsage(ctx sdk.Context) types.StorageUsage {
+	var usage types.StorageUsage
+	if bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixStorageUsageTotal); len(bz) != 0 {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+
+	return usage
+}
+
+// SetTotalStorageUsage stores the storage used by all contracts
+func (k *Keeper) SetTotalStorageUsage(ctx sdk.Context, usage types.StorageUsage) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixStorageUsageTotal, k.cdc.MustMarshal(&usage))
+}
+
+// CommitTotalStorageUsage applies the storage usage change collected since the last call to
+// the total
+func (k *Keeper) CommitTotalStorageUsage(ctx sdk.Context) {
+	added := k.getTransientStorageUsage(ctx, types.KeyPrefixTransientStorageUsageAdded)
+	removed := k.getTransientStorageUsage(ctx, types.KeyPrefixTransientStorageUsageRemoved)
+	if added.IsZero() && removed.IsZero() {
+		return
+	}
+
+	k.SetTotalStorageUsage(ctx, k.GetTotalStorageUsage(ctx).Add(added).Sub(removed))
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Delete(types.KeyPrefixTransientStorageUsageAdded)
+	store.Delete(types.KeyPrefixTransientStorageUsageRemoved)
+}
+
+// updateStorageUsage accounts the change of the storage slot value from prev to next
+func (k *Keeper) updateStorageUsage(ctx sdk.Context, addr common.Address, prev, next []byte) {
+	if len(prev) == 0 && len(next) == 0 {
+		return
+	}
+
+	k.SetStorageUsage(ctx, addr, k.GetStorageUsage(ctx, addr).Update(prev, next))
+	k.addTransientStorageUsage(ctx, types.KeyPrefixTransientStorageUsageAdded, types.StorageCellUsage(next))
+	k.addTransientStorageUsage(ctx, types.KeyPrefixTransientStorageUsageRemoved, types.StorageCellUsage(prev))
+}
+
+func (k *Keeper) getTransientStorageUsage(ctx sdk.Context, key []byte) types.StorageUsage {
+	var usage types.StorageUsage
+	if bz := ctx.TransientStore(k.transientKey).Get(key); len(bz) != 0 {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+
+	return usage
+}
+
+func (k *Keeper) addTransientStorageUsage(ctx sdk.Context, key []byte, usage types.StorageUsage) {
+	if usage.IsZero() {
+		return
+	}
+
+	usage = k.getTransientStorageUsage(ctx, key).Add(usage)
+	ctx.TransientStore(k.transientKey).Set(key, k.cdc.MustMarshal(&usage))
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestStorageUsage() {
+	k := suite.app.EvmKeeper
+	address := common.BigToAddress(big.NewInt(1006))
+	slot1 := common.BigToHash(big.NewInt(1))
+	slot2 := common.BigToHash(big.NewInt(2))
+	total := k.GetTotalStorageUsage(suite.ctx)
+
+	k.SetState(suite.ctx, address, slot1, make([]byte, 32))
+	k.SetState(suite.ctx, address, slot2, make([]byte, 64))
+	suite.Require().Equal(types.StorageUsage{Slots: 2, Bytes: 32 + 32 + 32 + 64}, k.GetStorageUsage(suite.ctx, address))
+
+	// overwriting a slot only changes its size
+	k.SetState(suite.ctx, address, slot2, make([]byte, 48))
+	suite.Require().Equal(types.StorageUsage{Slots: 2, Bytes: 32 + 32 + 32 + 48}, k.GetStorageUsage(suite.ctx, address))
+
+	// the total is only updated once the change is committed
+	suite.Require().Equal(total, k.GetTotalStorageUsage(suite.ctx))
+	k.CommitTotalStorageUsage(suite.ctx)
+	suite.Require().Equal(
+		types.StorageUsage{Slots: total.Slots + 2, Bytes: total.Bytes + 32 + 32 + 32 + 48},
+		k.GetTotalStorageUsage(suite.ctx),
+	)
+
+	// removing a missing slot is not accounted
+	k.SetState(suite.ctx, address, common.BigToHash(big.NewInt(3)), nil)
+	suite.Require().Equal(uint64(2), k.GetStorageUsage(suite.ctx, address).Slots)
+
+	k.SetState(suite.ctx, address, slot1, nil)
+	k.SetState(suite.ctx, address, slot2, nil)
+	suite.Require().True(k.GetStorageUsage(suite.ctx, address).IsZero())
+	k.CommitTotalStorageUsage(suite.ctx)
+	suite.Require().Equal(total, k.GetTotalStorageUsage(suite.ctx))
+
+	// query
+	k.SetState(suite.ctx, address, slot1, make([]byte, 32))
+	k.CommitTotalStorageUsage(suite.ctx)
+	res, err := k.StorageUsage(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageUsageRequest{Address: address.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StorageUsage{Slots: 1, Bytes: 64}, res.StorageUsage)
+
+	res, err = k.StorageUsage(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageUsageRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(k.GetTotalStorageUsage(suite.ctx), res.StorageUsage)
+
+	_, err = k.StorageUsage(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageUsageRequest{Address: "invalid"})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// The state of every contract may be viewed through eth_call by default. Restricted contracts and
+// the permissions of viewers are set in the genesis state. Queries executing the VM are run for a
+// viewer, and the Connector refuses to load the code or storage of a restricted contract the viewer
+// isn't permitted to view, so nested calls are checked as well as the called contract.
+
+// viewerKey is the context key of the viewer a query executing the VM is run for
+type viewerKey struct{}
+
+// WithViewer returns the context of a query executing the VM for the viewer. Queries which don't
+// authenticate their sender, such as eth_estimateGas and traces, are run for the zero address, which
+// can't be permitted, so they can't view restricted contracts.
+func WithViewer(ctx sdk.Context, viewer common.Address) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), viewerKey{}, viewer))
+}
+
+// checkView returns an error if the context is a query run for a viewer who may not view the state of
+// the contract. Transactions aren't run for a viewer and are never restricted.
+func (k *Keeper) checkView(ctx sdk.Context, contract common.Address) error {
+	viewer, ok := ctx.Context().Value(viewerKey{}).(common.Address)
+	if !ok || k.CanView(ctx, contract, viewer) {
+		return nil
+	}
+	return errorsmod.Wrapf(types.ErrViewNotPermitted, "%s can't view contract %s", viewer, contract)
+}
+
+// SetViewRestricted restricts or unrestricts the view of the state of the contract
+func (k *Keeper) SetViewRestricted(ctx sdk.Context, contract common.Address, restricted bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixViewRestricted)
+	if !restricted {
+		store.Delete(contract.Bytes())
+		return
+	}
+
+	store.Set(contract.Bytes(), []byte{1})
+}
+
+// IsViewRestricted returns true if the state of the contract may only be viewed by permitted viewers
+func (k *Keeper) IsViewRestricted(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixViewRestricted)
+	return store.Has(contract.Bytes())
+}
+
+// SetViewPermission permits the viewer to view the state of the contract
+func (k *Keeper) SetViewPermission(ctx sdk.Context, contract, viewer common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixViewPermission)
+	store.Set(types.ViewPermissionKey(contract, viewer), []byte{1})
+}
+
+// DeleteViewPermission revokes the permission of the viewer to view the state of the contract
+func (k *Keeper) DeleteViewPermission(ctx sdk.Context, contract, viewer common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixViewPermission)
+	store.Delete(types.ViewPermissionKey(contract, viewer))
+}
+
+// HasViewPermission returns true if the viewer was permitted to view the state of the contract
+func (k *Keeper) HasViewPermission(ctx sdk.Context, contract, viewer common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixViewPermission)
+	return store.Has(types.ViewPermissionKey(contract, viewer))
+}
+
+// CanView returns true if the viewer may view the state of the contract, which is the case if the
+// contract isn't restricted or the viewer was permitted
+func (k *Keeper) CanView(ctx sdk.Context, contract, viewer common.Address) bool {
+	return !k.IsViewRestricted(ctx, contract) || k.HasViewPermission(ctx, contract, viewer)
+}
+
+// SetViewPermissions stores the view permissions of a contract
+func (k *Keeper) SetViewPermissions(ctx sdk.Context, permissions types.ViewPermissions) {
+	contract := common.HexToAddress(permissions.Contract)
+	k.SetViewRestricted(ctx, contract, permissions.Restricted)
+	for _, viewer := range permissions.Viewers {
+		k.SetViewPermission(ctx, contract, common.HexToAddress(viewer))
+	}
+}
+
+// GetViewPermissions returns the view permissions of all restricted contracts and contracts with
+// permitted viewers, ordered by contract
+func (k *Keeper) GetViewPermissions(ctx sdk.Context) []types.ViewPermissions {
+	store := ctx.KVStore(k.storeKey)
+	permissions := make(map[common.Address]*types.ViewPermissions)
+	get := func(contract common.Address) *types.ViewPermissions {
+		if permissions[contract] == nil {
+			permissions[contract] = &types.ViewPermissions{Contract: contract.Hex()}
+		}
+		return permissions[contract]
+	}
+
+	restrictedIterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixViewRestricted)
+	defer restrictedIterator.Close()
+	for ; restrictedIterator.Valid(); restrictedIterator.Next() {
+		contract := common.BytesToAddress(restrictedIterator.Key()[len(types.KeyPrefixViewRestricted):])
+		get(contract).Restricted = true
+	}
+
+	permissionIterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixViewPermission)
+	defer permissionIterator.Close()
+	for ; permissionIterator.Valid(); permissionIterator.Next() {
+		key := permissionIterator.Key()[len(types.KeyPrefixViewPermission):]
+		contract := common.BytesToAddress(key[:common.AddressLength])
+		viewer := common.BytesToAddress(key[common.AddressLength:])
+		permission := get(contract)
+		permission.Viewers = append(permission.Viewers, viewer.Hex())
+	}
+
+	contracts := make([]common.Address, 0, len(permissions))
+	for contract := range permissions {
+		contracts = append(contracts, contract)
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return bytes.Compare(contracts[i].Bytes(), contracts[j].Bytes()) < 0
+	})
+
+	var result []types.ViewPermissions
+	for _, contract := range contracts {
+		result = append(result, *permissions[contract])
+	}
+	return result
+}
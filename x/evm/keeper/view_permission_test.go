@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/SigmaGmbH/evm-module/server/config"
+	evmkeeper "github.com/SigmaGmbH/evm-module/x/evm/keeper"
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+	"github.com/SigmaGmbH/librustgo"
+)
+
+func (suite *KeeperTestSuite) TestViewPermissions() {
+	k := suite.app.EvmKeeper
+	contract := common.BigToAddress(big.NewInt(1001))
+	viewer := common.BigToAddress(big.NewInt(1002))
+
+	// contracts are unrestricted by default
+	suite.Require().True(k.CanView(suite.ctx, contract, viewer))
+	suite.Require().Empty(k.GetViewPermissions(suite.ctx))
+
+	k.SetViewRestricted(suite.ctx, contract, true)
+	suite.Require().True(k.IsViewRestricted(suite.ctx, contract))
+	suite.Require().False(k.CanView(suite.ctx, contract, viewer))
+
+	k.SetViewPermission(suite.ctx, contract, viewer)
+	suite.Require().True(k.CanView(suite.ctx, contract, viewer))
+	suite.Require().Equal([]types.ViewPermissions{{
+		Contract:   contract.Hex(),
+		Restricted: true,
+		Viewers:    []string{viewer.Hex()},
+	}}, k.GetViewPermissions(suite.ctx))
+
+	k.DeleteViewPermission(suite.ctx, contract, viewer)
+	suite.Require().False(k.CanView(suite.ctx, contract, viewer))
+
+	k.SetViewRestricted(suite.ctx, contract, false)
+	suite.Require().True(k.CanView(suite.ctx, contract, viewer))
+	suite.Require().Empty(k.GetViewPermissions(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestConnectorViewPermissions() {
+	k := suite.app.EvmKeeper
+	contract := common.BigToAddress(big.NewInt(1001))
+	viewer := common.BigToAddress(big.NewInt(1002))
+	k.SetViewRestricted(suite.ctx, contract, true)
+	k.SetViewPermission(suite.ctx, contract, viewer)
+
+	getState := func(ctx sdk.Context) (codeErr, storageErr error) {
+		connector := evmkeeper.Connector{Context: ctx, EVMKeeper: k}
+		_, codeErr = connector.GetAccountCode(&librustgo.CosmosRequest_AccountCode{
+			AccountCode: &librustgo.QueryGetAccountCode{Address: contract.Bytes()},
+		})
+		_, storageErr = connector.GetStorageCell(&librustgo.CosmosRequest_StorageCell{
+			StorageCell: &librustgo.QueryGetAccountStorageCell{Address: contract.Bytes(), Index: common.Hash{}.Bytes()},
+		})
+		return codeErr, storageErr
+	}
+
+	// transactions aren't run for a viewer
+	codeErr, storageErr := getState(suite.ctx)
+	suite.Require().NoError(codeErr)
+	suite.Require().NoError(storageErr)
+
+	codeErr, storageErr = getState(evmkeeper.WithViewer(suite.ctx, viewer))
+	suite.Require().NoError(codeErr)
+	suite.Require().NoError(storageErr)
+
+	codeErr, storageErr = getState(evmkeeper.WithViewer(suite.ctx, common.Address{}))
+	suite.Require().ErrorIs(codeErr, types.ErrViewNotPermitted)
+	suite.Require().ErrorIs(storageErr, types.ErrViewNotPermitted)
+
+	// unrestricted contracts can be viewed by anyone
+	k.SetViewRestricted(suite.ctx, contract, false)
+	codeErr, storageErr = getState(evmkeeper.WithViewer(suite.ctx, common.Address{}))
+	suite.Require().NoError(codeErr)
+	suite.Require().NoError(storageErr)
+}
+
+func (suite *KeeperTestSuite) TestEthCallViewNotPermitted() {
+	k := suite.app.EvmKeeper
+	contract := common.BigToAddress(big.NewInt(1001))
+	k.SetViewRestricted(suite.ctx, contract, true)
+
+	// unsigned calls are sent by the zero address
+	args, err := json.Marshal(&types.CallArgs{To: &contract})
+	suite.Require().NoError(err)
+	_, err = k.EthCall(suite.ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+	suite.Require().Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasViewNotPermitted() {
+	k := suite.app.EvmKeeper
+	contract := common.BigToAddress(big.NewInt(1001))
+	viewer := common.BigToAddress(big.NewInt(1002))
+	k.SetViewRestricted(suite.ctx, contract, true)
+	k.SetViewPermission(suite.ctx, contract, viewer)
+
+	// the sender of gas estimations isn't authenticated, so even permitted viewers are refused
+	args, err := json.Marshal(&types.TransactionArgs{From: &viewer, To: &contract})
+	suite.Require().NoError(err)
+	_, err = k.EstimateGas(suite.ctx, &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+	suite.Require().ErrorIs(err, types.ErrViewNotPermitted)
+}
@@ -0,0 +1,48 @@
+package v6
+
+import (
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 5 to
+// version 6. Specifically, it walks the existing contract storage and records
+// the storage usage of every contract and of all contracts in total.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	var (
+		total  types.StorageUsage
+		usages = make(map[common.Address]types.StorageUsage)
+		order  []common.Address
+	)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixStorage)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixStorage):]
+		address := common.BytesToAddress(key[:common.AddressLength])
+
+		usage, ok := usages[address]
+		if !ok {
+			order = append(order, address)
+		}
+		usages[address] = usage.Update(nil, iterator.Value())
+		total = total.Update(nil, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, address := range order {
+		usage := usages[address]
+		store.Set(append(types.KeyPrefixStorageUsage, address.Bytes()...), cdc.MustMarshal(&usage))
+	}
+	store.Set(types.KeyPrefixStorageUsageTotal, cdc.MustMarshal(&total))
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SigmaGmbH/evm-module/app"
+	"github.com/SigmaGmbH/evm-module/encoding"
+	v6 "github.com/SigmaGmbH/evm-module/x/evm/migrations/v6"
+	"github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	contract1 := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract2 := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	// Set the storage in the store
+	kvStore.Set(types.StateKey(contract1, common.BigToHash(common.Big1).Bytes()), make([]byte, 32))
+	kvStore.Set(types.StateKey(contract1, common.BigToHash(common.Big2).Bytes()), make([]byte, 64))
+	kvStore.Set(types.StateKey(contract2, common.BigToHash(common.Big1).Bytes()), make([]byte, 48))
+
+	err := v6.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	getUsage := func(key []byte) types.StorageUsage {
+		var usage types.StorageUsage
+		cdc.MustUnmarshal(kvStore.Get(key), &usage)
+		return usage
+	}
+
+	// test that the storage usage has been recorded correctly
+	require.Equal(t, types.StorageUsage{Slots: 2, Bytes: 32 + 32 + 32 + 64},
+		getUsage(append(types.KeyPrefixStorageUsage, contract1.Bytes()...)))
+	require.Equal(t, types.StorageUsage{Slots: 1, Bytes: 32 + 48},
+		getUsage(append(types.KeyPrefixStorageUsage, contract2.Bytes()...)))
+	require.Equal(t, types.StorageUsage{Slots: 3, Bytes: 32 + 32 + 32 + 64 + 32 + 48},
+		getUsage(types.KeyPrefixStorageUsageTotal))
+}
@@ -59,7 +59,7 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 // ConsensusVersion returns the consensus state-breaking version for the module.
 func (AppModuleBasic) ConsensusVersion() uint64 {
-	return 5
+	return 7
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the evm
@@ -142,13 +142,15 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
 	m := keeper.NewMigrator(*am.keeper, am.legacySubspace)
-	err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
-	if err != nil {
-		panic(err)
-	}
-
-	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
-		panic(err)
+	migrations := m.Migrations()
+	for version := keeper.FirstMigrationVersion; version < am.ConsensusVersion(); version++ {
+		handler, ok := migrations[version]
+		if !ok {
+			panic(fmt.Errorf("missing %s store migration from consensus version %d", types.ModuleName, version))
+		}
+		if err := cfg.RegisterMigration(types.ModuleName, version, handler); err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -0,0 +1,157 @@
+// Package proof defines the account state export bundling EVM account and storage values
+// with ICS-23 proofs and the Tendermint header committing to them. It allows external
+// bridges and light clients to verify Swisstronik EVM state against the validator set
+// without running a full node.
+package proof
+
+import (
+	"bytes"
+	"fmt"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// StoreProof contains value stored under the key of the module store together with its ICS-23 proof.
+// Empty value means that the key is absent and the proof is a non-membership proof.
+type StoreProof struct {
+	StoreName string        `json:"storeName"`
+	Key       hexutil.Bytes `json:"key"`
+	Value     hexutil.Bytes `json:"value"`
+	// Proof is protobuf encoded commitmenttypes.MerkleProof
+	Proof hexutil.Bytes `json:"proof"`
+}
+
+// AccountProof contains EVM account and storage values at the given height with their proofs.
+// State at height H is committed by the app hash of the header at height H+1, which is
+// bundled as SignedHeader to be checked against the validator set.
+type AccountProof struct {
+	Address common.Address `json:"address"`
+	Height  int64          `json:"height"`
+	// SignedHeader is protobuf encoded tmproto.SignedHeader at height Height+1
+	SignedHeader hexutil.Bytes `json:"signedHeader"`
+	Account      StoreProof    `json:"account"`
+	Balance      StoreProof    `json:"balance"`
+	Storage      []StoreProof  `json:"storage"`
+}
+
+// AccountKey returns the key of the account in the auth store
+func AccountKey(address common.Address) []byte {
+	return authtypes.AddressStoreKey(address.Bytes())
+}
+
+// BalanceKey returns the key of the account balance in the bank store
+func BalanceKey(address common.Address, denom string) []byte {
+	return banktypes.CreatePrefixedAccountStoreKey(address.Bytes(), []byte(denom))
+}
+
+// StorageKey returns the key of the account storage slot in the evm store
+func StorageKey(address common.Address, slot common.Hash) []byte {
+	return evmtypes.StateKey(address, slot.Bytes())
+}
+
+// Header decodes the bundled signed header
+func (p AccountProof) Header() (*tmtypes.SignedHeader, error) {
+	pbHeader, err := p.protoHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	return tmtypes.SignedHeaderFromProto(pbHeader)
+}
+
+func (p AccountProof) protoHeader() (*tmproto.SignedHeader, error) {
+	var pbHeader tmproto.SignedHeader
+	if err := pbHeader.Unmarshal(p.SignedHeader); err != nil {
+		return nil, fmt.Errorf("failed to decode signed header: %w", err)
+	}
+	if pbHeader.Header == nil {
+		return nil, fmt.Errorf("signed header is empty")
+	}
+
+	return &pbHeader, nil
+}
+
+// Verify checks that all values are committed by the app hash of the bundled header and that
+// the proved keys belong to the account. It doesn't check the header itself, which should be
+// done with VerifyCommit or by the light client tracking the validator set.
+func (p AccountProof) Verify() error {
+	signedHeader, err := p.protoHeader()
+	if err != nil {
+		return err
+	}
+
+	header := signedHeader.Header
+	if header.Height != p.Height+1 {
+		return fmt.Errorf("header height %d doesn't commit state at height %d", header.Height, p.Height)
+	}
+
+	root := commitmenttypes.NewMerkleRoot(header.AppHash)
+
+	if p.Account.StoreName != authtypes.StoreKey || !bytes.Equal(p.Account.Key, AccountKey(p.Address)) {
+		return fmt.Errorf("account proof doesn't belong to %s", p.Address.Hex())
+	}
+	if err := p.Account.Verify(root); err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+
+	if p.Balance.StoreName != banktypes.StoreKey || !bytes.HasPrefix(p.Balance.Key, banktypes.CreateAccountBalancesPrefix(p.Address.Bytes())) {
+		return fmt.Errorf("balance proof doesn't belong to %s", p.Address.Hex())
+	}
+	if err := p.Balance.Verify(root); err != nil {
+		return fmt.Errorf("invalid balance proof: %w", err)
+	}
+
+	storagePrefix := evmtypes.AddressStoragePrefix(p.Address)
+	for _, storage := range p.Storage {
+		if storage.StoreName != evmtypes.StoreKey || !bytes.HasPrefix(storage.Key, storagePrefix) {
+			return fmt.Errorf("storage proof of key %s doesn't belong to %s", storage.Key, p.Address.Hex())
+		}
+		if err := storage.Verify(root); err != nil {
+			return fmt.Errorf("invalid storage proof of key %s: %w", storage.Key, err)
+		}
+	}
+
+	return nil
+}
+
+// VerifyCommit checks that the bundled header is signed by more than 2/3 of the given validator set
+func (p AccountProof) VerifyCommit(chainID string, valSet *tmtypes.ValidatorSet) error {
+	header, err := p.Header()
+	if err != nil {
+		return err
+	}
+
+	if err := header.ValidateBasic(chainID); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(header.ValidatorsHash, valSet.Hash()) {
+		return fmt.Errorf("validator set doesn't match header validators hash")
+	}
+
+	return valSet.VerifyCommitLight(chainID, header.Commit.BlockID, header.Height, header.Commit)
+}
+
+// Verify checks the proof of the value against the given app hash root
+func (p StoreProof) Verify(root commitmenttypes.MerkleRoot) error {
+	var merkleProof commitmenttypes.MerkleProof
+	if err := merkleProof.Unmarshal(p.Proof); err != nil {
+		return fmt.Errorf("failed to decode merkle proof: %w", err)
+	}
+
+	path := commitmenttypes.NewMerklePath(p.StoreName, string(p.Key))
+	if len(p.Value) == 0 {
+		return merkleProof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, path)
+	}
+
+	return merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, path, p.Value)
+}
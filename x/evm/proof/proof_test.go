@@ -0,0 +1,138 @@
+package proof
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+const testDenom = "aswtr"
+
+var (
+	testAddress = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testSlot    = common.HexToHash("0x01")
+	absentSlot  = common.HexToHash("0x02")
+)
+
+// newTestProof commits account state to the multistore and builds the proof of it
+func newTestProof(t *testing.T) AccountProof {
+	db := dbm.NewMemDB()
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	storeKeys := map[string]*storetypes.KVStoreKey{}
+	for _, name := range []string{authtypes.StoreKey, banktypes.StoreKey, evmtypes.StoreKey} {
+		storeKeys[name] = storetypes.NewKVStoreKey(name)
+		cms.MountStoreWithDB(storeKeys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+
+	cms.GetKVStore(storeKeys[authtypes.StoreKey]).Set(AccountKey(testAddress), []byte("account"))
+	cms.GetKVStore(storeKeys[banktypes.StoreKey]).Set(BalanceKey(testAddress, testDenom), []byte("balance"))
+	cms.GetKVStore(storeKeys[evmtypes.StoreKey]).Set(StorageKey(testAddress, testSlot), []byte("value"))
+	commitID := cms.Commit()
+
+	query := func(storeName string, key []byte) StoreProof {
+		res := cms.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", storeName),
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.Zero(t, res.Code, res.Log)
+
+		merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+		require.NoError(t, err)
+		bz, err := merkleProof.Marshal()
+		require.NoError(t, err)
+
+		return StoreProof{StoreName: storeName, Key: key, Value: res.Value, Proof: bz}
+	}
+
+	header := tmproto.SignedHeader{
+		Header: &tmproto.Header{Height: commitID.Version + 1, AppHash: commitID.Hash},
+		Commit: &tmproto.Commit{Height: commitID.Version + 1},
+	}
+	signedHeader, err := header.Marshal()
+	require.NoError(t, err)
+
+	return AccountProof{
+		Address:      testAddress,
+		Height:       commitID.Version,
+		SignedHeader: signedHeader,
+		Account:      query(authtypes.StoreKey, AccountKey(testAddress)),
+		Balance:      query(banktypes.StoreKey, BalanceKey(testAddress, testDenom)),
+		Storage: []StoreProof{
+			query(evmtypes.StoreKey, StorageKey(testAddress, testSlot)),
+			query(evmtypes.StoreKey, StorageKey(testAddress, absentSlot)),
+		},
+	}
+}
+
+func TestAccountProofVerify(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(p *AccountProof)
+		expPass  bool
+	}{
+		{
+			"valid proof",
+			func(p *AccountProof) {},
+			true,
+		},
+		{
+			"modified storage value",
+			func(p *AccountProof) {
+				p.Storage[0].Value = []byte("other")
+			},
+			false,
+		},
+		{
+			"absent slot proved as present",
+			func(p *AccountProof) {
+				p.Storage[1].Value = []byte("value")
+			},
+			false,
+		},
+		{
+			"proof of other account",
+			func(p *AccountProof) {
+				p.Address = common.HexToAddress("0x2")
+			},
+			false,
+		},
+		{
+			"header of other height",
+			func(p *AccountProof) {
+				p.Height++
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newTestProof(t)
+			tc.malleate(&p)
+
+			err := p.Verify()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
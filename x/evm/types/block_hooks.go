@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/types"
+)
+
+// MaxBlockHookGas is the maximum gas limit of a single block hook. Block hooks are
+// executed by every node outside of transactions, so their cost must stay bounded.
+const MaxBlockHookGas = 1_000_000
+
+// BlockHookSender is the msg.sender of block hook calls, i.e. the evm module account.
+// Contracts can restrict their hook entrypoints to this address.
+var BlockHookSender = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName))
+
+// Validate performs a stateless validation of the block hook
+func (h BlockHook) Validate() error {
+	if h.Name == "" {
+		return fmt.Errorf("block hook name cannot be empty")
+	}
+	if err := types.ValidateNonZeroAddress(h.Contract); err != nil {
+		return fmt.Errorf("invalid contract of block hook %s: %w", h.Name, err)
+	}
+	if h.GasLimit == 0 || h.GasLimit > MaxBlockHookGas {
+		return fmt.Errorf("gas limit of block hook %s must be between 1 and %d, got %d", h.Name, MaxBlockHookGas, h.GasLimit)
+	}
+
+	return nil
+}
+
+func validateBlockHooks(i interface{}) error {
+	hooks, ok := i.([]BlockHook)
+	if !ok {
+		return fmt.Errorf("invalid block hooks type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(hooks))
+	for _, hook := range hooks {
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+		if seen[hook.Name] {
+			return fmt.Errorf("duplicate block hook %s", hook.Name)
+		}
+		seen[hook.Name] = true
+	}
+
+	return nil
+}
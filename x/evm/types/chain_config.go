@@ -173,6 +173,24 @@ func (cc ChainConfig) Validate() error {
 	return nil
 }
 
+// ValidateChainID checks the chain config against the EIP-155 chain id parsed from the cosmos chain-id.
+// The config has no chain id of its own, the parsed one is used for replay protection. It must therefore
+// fit into the network id returned by net_version, and EIP-155 must be scheduled, since the chain id isn't
+// enforced by transaction signatures otherwise.
+func (cc ChainConfig) ValidateChainID(chainID *big.Int) error {
+	if chainID == nil || chainID.Sign() <= 0 {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "invalid EIP-155 chain id %s", chainID)
+	}
+	if !chainID.IsUint64() {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "EIP-155 chain id %s overflows the network id", chainID)
+	}
+	if getBlockValue(cc.EIP155Block) == nil {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "EIP-155 chain id %s set, but eip155Block is not", chainID)
+	}
+
+	return nil
+}
+
 func validateHash(hex string) error {
 	if hex != "" && strings.TrimSpace(hex) == "" {
 		return errorsmod.Wrap(ErrInvalidChainConfig, "hash cannot be blank")
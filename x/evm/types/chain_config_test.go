@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -379,3 +380,30 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestChainConfigValidateChainID(t *testing.T) {
+	noEIP155 := DefaultChainConfig()
+	noEIP155.EIP155Block = nil
+
+	testCases := []struct {
+		name     string
+		config   ChainConfig
+		chainID  *big.Int
+		expError bool
+	}{
+		{"default", DefaultChainConfig(), big.NewInt(1291), false},
+		{"nil chain id", DefaultChainConfig(), nil, true},
+		{"overflowing network id", DefaultChainConfig(), new(big.Int).Lsh(big.NewInt(1), 64), true},
+		{"EIP-155 not scheduled", noEIP155, big.NewInt(1291), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.config.ValidateChainID(tc.chainID)
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -38,7 +38,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName    = "ethermint/MsgUpdateParams"
+	freezeAccountName   = "ethermint/MsgFreezeAccount"
+	unfreezeAccountName = "ethermint/MsgUnfreezeAccount"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -56,6 +58,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
 		&MsgHandleTx{},
 	)
 	registry.RegisterInterface(
@@ -104,4 +108,6 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgFreezeAccount{}, freezeAccountName, nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAccount{}, unfreezeAccountName, nil)
 }
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The canonical CREATE2 deployer (github.com/Arachnid/deterministic-deployment-proxy) is deployed
+// on Ethereum with a pre-EIP-155 transaction, which can't be replayed here. It is preinstalled
+// instead, so deterministic deployments produce the same addresses as on Ethereum.
+var (
+	// Create2DeployerAddress is the address of the canonical CREATE2 deployer
+	Create2DeployerAddress = common.HexToAddress("0x4e59b44847b379578588920ca78fbf26c0b4956c")
+	// Create2DeployerCode is the runtime code of the canonical CREATE2 deployer. It deploys the
+	// calldata following the 32 bytes salt as init code and returns the created address.
+	Create2DeployerCode = common.FromHex("0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3") //nolint:lll
+)
+
+// ComputeCreate2Address computes the address of a contract created with CREATE2. The salt and
+// the init code hash are hex encoded 32 bytes values.
+func ComputeCreate2Address(deployer common.Address, salt, initCodeHash string) (common.Address, error) {
+	saltBz, err := decodeHash(salt)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid salt: %w", err)
+	}
+	initCodeHashBz, err := decodeHash(initCodeHash)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid init code hash: %w", err)
+	}
+
+	return crypto.CreateAddress2(deployer, common.BytesToHash(saltBz), initCodeHashBz), nil
+}
+
+func decodeHash(value string) ([]byte, error) {
+	bz, err := hexutil.Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != common.HashLength {
+		return nil, fmt.Errorf("expected %d bytes, got %d", common.HashLength, len(bz))
+	}
+	return bz, nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeCreate2Address(t *testing.T) {
+	zeroHash := common.Hash{}.Hex()
+	// example 0 of EIP-1014
+	emptyInitCodeHash := crypto.Keccak256Hash([]byte{0x00}).Hex()
+
+	testCases := []struct {
+		name         string
+		deployer     common.Address
+		salt         string
+		initCodeHash string
+		expAddress   string
+		expPass      bool
+	}{
+		{
+			"EIP-1014 example",
+			common.Address{},
+			zeroHash,
+			emptyInitCodeHash,
+			"0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38",
+			true,
+		},
+		{
+			"salt without prefix",
+			common.Address{},
+			zeroHash[2:],
+			emptyInitCodeHash,
+			"",
+			false,
+		},
+		{
+			"short salt",
+			common.Address{},
+			"0x00",
+			emptyInitCodeHash,
+			"",
+			false,
+		},
+		{
+			"short init code hash",
+			common.Address{},
+			zeroHash,
+			"0x00",
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		address, err := ComputeCreate2Address(tc.deployer, tc.salt, tc.initCodeHash)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expAddress, address.Hex(), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
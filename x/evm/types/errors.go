@@ -49,9 +49,21 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrConnectorInvalidRequest
+	codeErrConnectorStoreCorruption
+	codeErrInvalidParamsUpdate
+	codeErrQueryBudgetExceeded
+	codeErrDuplicateTx
+	codeErrBlockGasExceeded
+	codeErrAccountFrozen
+	codeErrMaxInitCodeSizeExceeded
+	codeErrViewNotPermitted
 )
 
-var ErrPostTxProcessing = errors.New("failed to execute post processing")
+var (
+	ErrPreTxProcessing  = errors.New("failed to execute pre processing")
+	ErrPostTxProcessing = errors.New("failed to execute post processing")
+)
 
 var (
 	// ErrInvalidState returns an error resulting from an invalid Storage State.
@@ -116,6 +128,37 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrConnectorInvalidRequest returns an error if the SGXVM sent a request the Connector cannot decode or handle
+	ErrConnectorInvalidRequest = errorsmod.Register(ModuleName, codeErrConnectorInvalidRequest, "invalid connector request")
+
+	// ErrConnectorStoreCorruption returns an error if the Connector failed to read or write the underlying state
+	ErrConnectorStoreCorruption = errorsmod.Register(ModuleName, codeErrConnectorStoreCorruption, "connector store corruption")
+
+	// ErrInvalidParamsUpdate returns an error if the proposed params can't be applied at the current height
+	ErrInvalidParamsUpdate = errorsmod.Register(ModuleName, codeErrInvalidParamsUpdate, "invalid params update")
+
+	// ErrQueryBudgetExceeded returns an error if an eth_call, eth_estimateGas or tracing execution exhausted the
+	// query budget of the node. It is fatal, the VM execution is aborted.
+	ErrQueryBudgetExceeded = errorsmod.Register(ModuleName, codeErrQueryBudgetExceeded, "query budget exceeded")
+
+	// ErrDuplicateTx returns an error if an ethereum transaction with the same hash was already processed in the block
+	ErrDuplicateTx = errorsmod.Register(ModuleName, codeErrDuplicateTx, "duplicate ethereum transaction in block")
+
+	// ErrBlockGasExceeded returns an error if the gas limit of a transaction exceeds the gas left in the
+	// block. The transaction is valid and can be retried in a later block.
+	ErrBlockGasExceeded = errorsmod.Register(ModuleName, codeErrBlockGasExceeded, "tx gas exceeds remaining block gas")
+
+	// ErrAccountFrozen returns an error if the sender of a transaction was frozen through governance
+	ErrAccountFrozen = errorsmod.Register(ModuleName, codeErrAccountFrozen, "account is frozen")
+
+	// ErrMaxInitCodeSizeExceeded returns an error if the init code of a contract creation exceeds the
+	// max init code size param
+	ErrMaxInitCodeSizeExceeded = errorsmod.Register(ModuleName, codeErrMaxInitCodeSizeExceeded, "max init code size exceeded")
+
+	// ErrViewNotPermitted returns an error if a query executing the VM loads the state of a view restricted
+	// contract for a viewer without view permission
+	ErrViewNotPermitted = errorsmod.Register(ModuleName, codeErrViewNotPermitted, "view not permitted")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
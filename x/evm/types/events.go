@@ -20,19 +20,32 @@ const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
+	EventTypeBlockHook  = "block_hook"
+	EventTypeFreeze     = "freeze_account"
+	EventTypeUnfreeze   = "unfreeze_account"
 
-	AttributeKeyContractAddress = "contract"
-	AttributeKeyRecipient       = "recipient"
-	AttributeKeyTxHash          = "txHash"
-	AttributeKeyEthereumTxHash  = "ethereumTxHash"
-	AttributeKeyTxIndex         = "txIndex"
-	AttributeKeyTxGasUsed       = "txGasUsed"
-	AttributeKeyTxType          = "txType"
-	AttributeKeyTxLog           = "txLog"
+	EventTypeContractCreated   = "contract_created"
+	EventTypeContractDestroyed = "contract_destroyed"
+
+	AttributeKeyContractAddress   = "contract"
+	AttributeKeyRecipient         = "recipient"
+	AttributeKeyTxHash            = "txHash"
+	AttributeKeyEthereumTxHash    = "ethereumTxHash"
+	AttributeKeyTxIndex           = "txIndex"
+	AttributeKeyTxGasUsed         = "txGasUsed"
+	AttributeKeyPostState         = "postState"
+	AttributeKeyEffectiveGasPrice = "effectiveGasPrice"
+	AttributeKeyTxType            = "txType"
+	AttributeKeyTxLog             = "txLog"
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyBlockHook        = "hook"
+	AttributeKeyBlockHookError   = "error"
+	AttributeKeyAddress          = "address"
+	AttributeKeyDeployer         = "deployer"
+	AttributeKeyCodeHash         = "codeHash"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
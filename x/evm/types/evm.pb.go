@@ -40,6 +40,28 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// enable_block_hooks toggles the execution of the registered block hooks
+	EnableBlockHooks bool `protobuf:"varint,7,opt,name=enable_block_hooks,json=enableBlockHooks,proto3" json:"enable_block_hooks,omitempty" yaml:"enable_block_hooks"`
+	// begin_block_hooks defines the contract calls executed at the beginning of every block
+	BeginBlockHooks []BlockHook `protobuf:"bytes,8,rep,name=begin_block_hooks,json=beginBlockHooks,proto3" json:"begin_block_hooks" yaml:"begin_block_hooks"`
+	// end_block_hooks defines the contract calls executed at the end of every block
+	EndBlockHooks []BlockHook `protobuf:"bytes,9,rep,name=end_block_hooks,json=endBlockHooks,proto3" json:"end_block_hooks" yaml:"end_block_hooks"`
+	// state_rent defines the reserved parameters of state rent pricing
+	StateRent StateRentParams `protobuf:"bytes,10,opt,name=state_rent,json=stateRent,proto3" json:"state_rent" yaml:"state_rent"`
+	// admin_authority is the bech32 address of an account allowed to execute the
+	// admin operations of the module besides governance, e.g. an x/group policy
+	// or a multisig account. It's disabled if empty.
+	AdminAuthority string `protobuf:"bytes,11,opt,name=admin_authority,json=adminAuthority,proto3" json:"admin_authority,omitempty" yaml:"admin_authority"`
+	// max_code_size is the maximum size in bytes of the code of a deployed
+	// contract (EIP-170). The EIP default of 24576 is used if it's 0.
+	MaxCodeSize uint64 `protobuf:"varint,12,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty" yaml:"max_code_size"`
+	// max_init_code_size is the maximum size in bytes of the init code of a
+	// contract creation transaction (EIP-3860). The EIP default of 49152 is used
+	// if it's 0.
+	MaxInitCodeSize uint64 `protobuf:"varint,13,opt,name=max_init_code_size,json=maxInitCodeSize,proto3" json:"max_init_code_size,omitempty" yaml:"max_init_code_size"`
+	// precompiles defines the registry of custom precompiled contracts and their
+	// activation heights. It isn't passed to the SGX VM yet.
+	Precompiles []Precompile `protobuf:"bytes,14,rep,name=precompiles,proto3" json:"precompiles" yaml:"precompiles"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +139,266 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetEnableBlockHooks() bool {
+	if m != nil {
+		return m.EnableBlockHooks
+	}
+	return false
+}
+
+func (m *Params) GetBeginBlockHooks() []BlockHook {
+	if m != nil {
+		return m.BeginBlockHooks
+	}
+	return nil
+}
+
+func (m *Params) GetEndBlockHooks() []BlockHook {
+	if m != nil {
+		return m.EndBlockHooks
+	}
+	return nil
+}
+
+func (m *Params) GetStateRent() StateRentParams {
+	if m != nil {
+		return m.StateRent
+	}
+	return StateRentParams{}
+}
+
+func (m *Params) GetAdminAuthority() string {
+	if m != nil {
+		return m.AdminAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetMaxCodeSize() uint64 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInitCodeSize() uint64 {
+	if m != nil {
+		return m.MaxInitCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompiles() []Precompile {
+	if m != nil {
+		return m.Precompiles
+	}
+	return nil
+}
+
+// StateRentParams defines the parameters reserved for pricing contract storage
+// over time. They are not charged yet.
+type StateRentParams struct {
+	// byte_price is the rent per stored byte per block, in the evm denom
+	BytePrice uint64 `protobuf:"varint,1,opt,name=byte_price,json=bytePrice,proto3" json:"byte_price,omitempty" yaml:"byte_price"`
+	// free_bytes is the storage size of a contract exempt from rent
+	FreeBytes uint64 `protobuf:"varint,2,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty" yaml:"free_bytes"`
+}
+
+func (m *StateRentParams) Reset()         { *m = StateRentParams{} }
+func (m *StateRentParams) String() string { return proto.CompactTextString(m) }
+func (*StateRentParams) ProtoMessage()    {}
+func (*StateRentParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *StateRentParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateRentParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateRentParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateRentParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateRentParams.Merge(m, src)
+}
+func (m *StateRentParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateRentParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateRentParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateRentParams proto.InternalMessageInfo
+
+func (m *StateRentParams) GetBytePrice() uint64 {
+	if m != nil {
+		return m.BytePrice
+	}
+	return 0
+}
+
+func (m *StateRentParams) GetFreeBytes() uint64 {
+	if m != nil {
+		return m.FreeBytes
+	}
+	return 0
+}
+
+// BlockHook defines a contract call executed automatically by the chain at the
+// beginning or the end of every block, e.g. oracle updates or epoch processing.
+type BlockHook struct {
+	// name identifies the hook in events and logs
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// contract is the hex address of the called contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// calldata is the input of the call
+	Calldata []byte `protobuf:"bytes,3,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// gas_limit is the maximum amount of gas the call can use
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+}
+
+func (m *BlockHook) Reset()         { *m = BlockHook{} }
+func (m *BlockHook) String() string { return proto.CompactTextString(m) }
+func (*BlockHook) ProtoMessage()    {}
+func (*BlockHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+}
+func (m *BlockHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHook.Merge(m, src)
+}
+func (m *BlockHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHook proto.InternalMessageInfo
+
+func (m *BlockHook) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BlockHook) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *BlockHook) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *BlockHook) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// Precompile defines an entry of the custom precompile registry. Its address is
+// reserved and its gas is fixed from its activation height on.
+type Precompile struct {
+	// address is the hex address of the precompile
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// base_gas is the gas charged for every call of the precompile
+	BaseGas uint64 `protobuf:"varint,2,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty" yaml:"base_gas"`
+	// word_gas is the gas charged for every 32 byte word of the call input
+	WordGas uint64 `protobuf:"varint,3,opt,name=word_gas,json=wordGas,proto3" json:"word_gas,omitempty" yaml:"word_gas"`
+	// activation_height is the block height the precompile is active from
+	ActivationHeight int64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty" yaml:"activation_height"`
+}
+
+func (m *Precompile) Reset()         { *m = Precompile{} }
+func (m *Precompile) String() string { return proto.CompactTextString(m) }
+func (*Precompile) ProtoMessage()    {}
+func (*Precompile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+}
+func (m *Precompile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Precompile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Precompile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Precompile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Precompile.Merge(m, src)
+}
+func (m *Precompile) XXX_Size() int {
+	return m.Size()
+}
+func (m *Precompile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Precompile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Precompile proto.InternalMessageInfo
+
+func (m *Precompile) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Precompile) GetBaseGas() uint64 {
+	if m != nil {
+		return m.BaseGas
+	}
+	return 0
+}
+
+func (m *Precompile) GetWordGas() uint64 {
+	if m != nil {
+		return m.WordGas
+	}
+	return 0
+}
+
+func (m *Precompile) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -174,7 +456,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +511,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +568,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +644,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -477,7 +759,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +800,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +860,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,8 +966,124 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// KeyEpoch defines a period of use of the state encryption key. The epoch of
+// storage written since start_height is recorded, so it can be decrypted with
+// the key of its epoch once the enclave derives a key per epoch.
+type KeyEpoch struct {
+	// epoch is the sequence number of the state encryption key
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// start_height is the first block height using the key of the epoch
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+}
+
+func (m *KeyEpoch) Reset()         { *m = KeyEpoch{} }
+func (m *KeyEpoch) String() string { return proto.CompactTextString(m) }
+func (*KeyEpoch) ProtoMessage()    {}
+func (*KeyEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{11}
+}
+func (m *KeyEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyEpoch.Merge(m, src)
+}
+func (m *KeyEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyEpoch proto.InternalMessageInfo
+
+func (m *KeyEpoch) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *KeyEpoch) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// StorageUsage defines the storage used by a contract. Every slot is accounted
+// with the size of its key and its (possibly encrypted) value.
+type StorageUsage struct {
+	// slots is the number of non-empty storage slots
+	Slots uint64 `protobuf:"varint,1,opt,name=slots,proto3" json:"slots,omitempty"`
+	// bytes is the total size of the storage slots
+	Bytes uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (m *StorageUsage) Reset()         { *m = StorageUsage{} }
+func (m *StorageUsage) String() string { return proto.CompactTextString(m) }
+func (*StorageUsage) ProtoMessage()    {}
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{12}
+}
+func (m *StorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUsage.Merge(m, src)
+}
+func (m *StorageUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUsage proto.InternalMessageInfo
+
+func (m *StorageUsage) GetSlots() uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*StateRentParams)(nil), "ethermint.evm.v1.StateRentParams")
+	proto.RegisterType((*BlockHook)(nil), "ethermint.evm.v1.BlockHook")
+	proto.RegisterType((*Precompile)(nil), "ethermint.evm.v1.Precompile")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -693,113 +1091,145 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+	proto.RegisterType((*KeyEpoch)(nil), "ethermint.evm.v1.KeyEpoch")
+	proto.RegisterType((*StorageUsage)(nil), "ethermint.evm.v1.StorageUsage")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0xe3, 0xc6,
-	0x15, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0xb2, 0x44, 0x8f, 0xb5, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x2f,
-	0x0a, 0x17, 0x48, 0xec, 0xd8, 0x81, 0xd1, 0x45, 0x82, 0x16, 0xb5, 0x76, 0x9d, 0xc4, 0xee, 0x36,
-	0x35, 0xc6, 0x0e, 0x0a, 0x14, 0x28, 0x88, 0x11, 0x39, 0xa1, 0x18, 0x93, 0x1c, 0x61, 0x66, 0xa8,
-	0x95, 0xda, 0x3e, 0x40, 0x8b, 0xde, 0xf4, 0x09, 0x8a, 0x3c, 0x4e, 0xd0, 0xab, 0xbd, 0x2c, 0x7a,
-	0x41, 0x14, 0xde, 0x3b, 0x5f, 0xea, 0x09, 0x8a, 0xf9, 0x11, 0xf5, 0x63, 0xa3, 0xad, 0x75, 0xe5,
-	0xf9, 0xce, 0x39, 0xf3, 0x7d, 0x33, 0x67, 0xce, 0x68, 0x0e, 0x0d, 0x9e, 0x13, 0xd1, 0x23, 0x2c,
-	0x8d, 0x33, 0x71, 0x44, 0x06, 0xe9, 0xd1, 0xe0, 0x58, 0xfe, 0x39, 0xec, 0x33, 0x2a, 0x28, 0x74,
-	0x4a, 0xdf, 0xa1, 0x34, 0x0e, 0x8e, 0x9f, 0xb7, 0x22, 0x1a, 0x51, 0xe5, 0x3c, 0x92, 0x23, 0x1d,
-	0xe7, 0xfd, 0xa5, 0x02, 0x36, 0xae, 0x30, 0xc3, 0x29, 0x87, 0xc7, 0xa0, 0x4a, 0x06, 0xa9, 0x1f,
-	0x92, 0x8c, 0xa6, 0xed, 0xd5, 0xfd, 0xd5, 0x83, 0x6a, 0xa7, 0x35, 0x2e, 0x5c, 0x67, 0x84, 0xd3,
-	0xe4, 0x33, 0xaf, 0x74, 0x79, 0xc8, 0x26, 0x83, 0xf4, 0xb5, 0x1c, 0xc2, 0x9f, 0x83, 0x2d, 0x92,
-	0xe1, 0x6e, 0x42, 0xfc, 0x80, 0x11, 0x2c, 0x48, 0x7b, 0x6d, 0x7f, 0xf5, 0xc0, 0xee, 0xb4, 0xc7,
-	0x85, 0xdb, 0x32, 0xd3, 0x66, 0xdd, 0x1e, 0xaa, 0x6b, 0xfc, 0x4a, 0x41, 0xf8, 0x33, 0x50, 0x9b,
-	0xf8, 0x71, 0x92, 0xb4, 0x2b, 0x6a, 0xf2, 0xee, 0xb8, 0x70, 0xe1, 0xfc, 0x64, 0x9c, 0x24, 0x1e,
-	0x02, 0x66, 0x2a, 0x4e, 0x12, 0x78, 0x06, 0x00, 0x19, 0x0a, 0x86, 0x7d, 0x12, 0xf7, 0x79, 0xdb,
-	0xda, 0xaf, 0x1c, 0x54, 0x3a, 0xde, 0x5d, 0xe1, 0x56, 0xcf, 0xa5, 0xf5, 0xfc, 0xe2, 0x8a, 0x8f,
-	0x0b, 0x77, 0xdb, 0x90, 0x94, 0x81, 0x1e, 0xaa, 0x2a, 0x70, 0x1e, 0xf7, 0x39, 0xfc, 0x3d, 0xa8,
-	0x07, 0x3d, 0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe3, 0xa8, 0xbd, 0xbe, 0xbf, 0x7a, 0x50, 0x3b,
-	0xf9, 0xd1, 0xe1, 0x62, 0xde, 0x0e, 0x5f, 0xc9, 0xa8, 0x57, 0x2a, 0xa8, 0xf3, 0xe2, 0x87, 0xc2,
-	0x5d, 0x19, 0x17, 0xee, 0x8e, 0xa6, 0x9e, 0x25, 0xf0, 0x50, 0x2d, 0x98, 0x46, 0xc2, 0x13, 0xf0,
-	0x0c, 0x27, 0x09, 0x7d, 0xeb, 0xe7, 0x99, 0x4c, 0x34, 0x09, 0x04, 0x09, 0x7d, 0x31, 0xe4, 0xed,
-	0x0d, 0xb9, 0x49, 0xb4, 0xa3, 0x9c, 0xdf, 0x4c, 0x7d, 0x37, 0x43, 0xee, 0xfd, 0x7d, 0x1b, 0xd4,
-	0x66, 0xd4, 0x60, 0x0a, 0x9a, 0x3d, 0x9a, 0x12, 0x2e, 0x08, 0x0e, 0xfd, 0x6e, 0x42, 0x83, 0x5b,
-	0x73, 0x2c, 0xaf, 0xff, 0x55, 0xb8, 0x3f, 0x89, 0x62, 0xd1, 0xcb, 0xbb, 0x87, 0x01, 0x4d, 0x8f,
-	0x02, 0xca, 0x53, 0xca, 0xcd, 0x9f, 0x8f, 0x79, 0x78, 0x7b, 0x24, 0x46, 0x7d, 0xc2, 0x0f, 0x2f,
-	0x32, 0x31, 0x2e, 0xdc, 0x5d, 0xbd, 0xd8, 0x05, 0x2a, 0x0f, 0x35, 0x4a, 0x4b, 0x47, 0x1a, 0xe0,
-	0x08, 0x34, 0x42, 0x4c, 0xfd, 0x6f, 0x29, 0xbb, 0x35, 0x6a, 0x6b, 0x4a, 0xed, 0xfa, 0xff, 0x57,
-	0xbb, 0x2b, 0xdc, 0xfa, 0xeb, 0xb3, 0xdf, 0x7c, 0x41, 0xd9, 0xad, 0xe2, 0x1c, 0x17, 0xee, 0x33,
-	0xad, 0x3e, 0xcf, 0xec, 0xa1, 0x7a, 0x88, 0x69, 0x19, 0x06, 0x7f, 0x0b, 0x9c, 0x32, 0x80, 0xe7,
-	0xfd, 0x3e, 0x65, 0xc2, 0x54, 0xc3, 0xc7, 0x77, 0x85, 0xdb, 0x30, 0x94, 0xd7, 0xda, 0x33, 0x2e,
-	0xdc, 0x0f, 0x16, 0x48, 0xcd, 0x1c, 0x0f, 0x35, 0x0c, 0xad, 0x09, 0x85, 0x1c, 0xd4, 0x49, 0xdc,
-	0x3f, 0x3e, 0xfd, 0xc4, 0xec, 0xc8, 0x52, 0x3b, 0xba, 0x7a, 0xd2, 0x8e, 0x6a, 0xe7, 0x17, 0x57,
-	0xc7, 0xa7, 0x9f, 0x4c, 0x36, 0x64, 0xce, 0x7e, 0x96, 0xd6, 0x43, 0x35, 0x0d, 0xf5, 0x6e, 0x2e,
-	0x80, 0x81, 0x7e, 0x0f, 0xf3, 0x9e, 0xaa, 0xac, 0x6a, 0xe7, 0xe0, 0xae, 0x70, 0x81, 0x66, 0xfa,
-	0x0a, 0xf3, 0xde, 0xf4, 0x5c, 0xba, 0xa3, 0x3f, 0xe0, 0x4c, 0xc4, 0x79, 0x3a, 0xe1, 0x02, 0x7a,
-	0xb2, 0x8c, 0x2a, 0xd7, 0x7f, 0x6a, 0xd6, 0xbf, 0xb1, 0xf4, 0xfa, 0x4f, 0x1f, 0x5b, 0xff, 0xe9,
-	0xfc, 0xfa, 0x75, 0x4c, 0x29, 0xfa, 0xd2, 0x88, 0x6e, 0x2e, 0x2d, 0xfa, 0xf2, 0x31, 0xd1, 0x97,
-	0xf3, 0xa2, 0x3a, 0x46, 0x16, 0xfb, 0x42, 0x26, 0xda, 0xf6, 0xf2, 0xc5, 0xfe, 0x20, 0xa9, 0x8d,
-	0xd2, 0xa2, 0xe5, 0xfe, 0x04, 0x5a, 0x01, 0xcd, 0xb8, 0x90, 0xb6, 0x8c, 0xf6, 0x13, 0x62, 0x34,
-	0xab, 0x4a, 0xf3, 0xe2, 0x49, 0x9a, 0x2f, 0xcc, 0xaf, 0xc1, 0x23, 0x7c, 0x1e, 0xda, 0x99, 0x37,
-	0x6b, 0xf5, 0x3e, 0x70, 0xfa, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x8c, 0x32, 0x50, 0xca, 0xe7,
-	0x4f, 0x52, 0x36, 0xf7, 0x60, 0x91, 0xcb, 0x43, 0xcd, 0xa9, 0x49, 0x2b, 0x7e, 0x07, 0x1a, 0xb1,
-	0x5c, 0x46, 0x37, 0x4f, 0x8c, 0x5e, 0x4d, 0xe9, 0xbd, 0x7a, 0x92, 0x9e, 0xb9, 0xcc, 0xf3, 0x4c,
-	0x1e, 0xda, 0x9a, 0x18, 0xb4, 0x56, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f, 0x12, 0x1c, 0xc4, 0x84,
-	0x19, 0xbd, 0xba, 0xd2, 0xfb, 0xf2, 0x49, 0x7a, 0x1f, 0x6a, 0xbd, 0x87, 0x6c, 0x1e, 0x72, 0xa4,
-	0xf1, 0x4b, 0x6d, 0xd3, 0xb2, 0x21, 0xa8, 0x77, 0x09, 0x4b, 0xe2, 0xcc, 0x08, 0x6e, 0x29, 0xc1,
-	0xb3, 0x27, 0x09, 0x9a, 0x3a, 0x9d, 0xe5, 0xf1, 0x50, 0x4d, 0xc3, 0x52, 0x25, 0xa1, 0x59, 0x48,
-	0x27, 0x2a, 0xdb, 0xcb, 0xab, 0xcc, 0xf2, 0x78, 0xa8, 0xa6, 0xa1, 0x56, 0x19, 0x82, 0x1d, 0xcc,
-	0x18, 0x7d, 0xbb, 0x90, 0x43, 0xa8, 0xc4, 0xbe, 0x7a, 0x92, 0xd8, 0x73, 0x2d, 0xf6, 0x08, 0x9d,
-	0x87, 0xb6, 0x95, 0x75, 0x2e, 0x8b, 0x39, 0x80, 0x11, 0xc3, 0xa3, 0x05, 0xe1, 0xd6, 0xf2, 0x87,
-	0xf7, 0x90, 0xcd, 0x43, 0x8e, 0x34, 0xce, 0xc9, 0xfe, 0x11, 0xb4, 0x52, 0xc2, 0x22, 0xe2, 0x67,
-	0x44, 0xf0, 0x7e, 0x12, 0x0b, 0x23, 0xfc, 0x6c, 0xf9, 0xfb, 0xf8, 0x18, 0x9f, 0x87, 0xa0, 0x32,
-	0x7f, 0x6d, 0xac, 0xe5, 0xe5, 0xe0, 0x3d, 0x9c, 0x45, 0x3d, 0x1c, 0x1b, 0xd9, 0xdd, 0xe5, 0x2f,
-	0xc7, 0x3c, 0x93, 0x87, 0xb6, 0x26, 0x86, 0xb2, 0x7e, 0x02, 0x9c, 0x05, 0xf9, 0xa4, 0x7e, 0x3e,
-	0x58, 0xbe, 0x7e, 0x66, 0x79, 0x64, 0xfb, 0xa1, 0xa0, 0x52, 0xb9, 0xb4, 0xec, 0x86, 0xd3, 0xbc,
-	0xb4, 0xec, 0xa6, 0xe3, 0x5c, 0x5a, 0xb6, 0xe3, 0x6c, 0x5f, 0x5a, 0xf6, 0x8e, 0xd3, 0x42, 0x5b,
-	0x23, 0x9a, 0x50, 0x7f, 0xf0, 0xa9, 0x9e, 0x84, 0x6a, 0xe4, 0x2d, 0xe6, 0xe6, 0x37, 0x12, 0x35,
-	0x02, 0x2c, 0x70, 0x32, 0xe2, 0x26, 0x55, 0xc8, 0xd1, 0x09, 0x9c, 0x79, 0xb5, 0x8f, 0xc0, 0xfa,
-	0xb5, 0x90, 0x8d, 0x9b, 0x03, 0x2a, 0xb7, 0x64, 0xa4, 0xbb, 0x11, 0x24, 0x87, 0xb0, 0x05, 0xd6,
-	0x07, 0x38, 0xc9, 0x75, 0x07, 0x58, 0x45, 0x1a, 0x78, 0x57, 0xa0, 0x79, 0xc3, 0x70, 0xc6, 0x71,
-	0x20, 0x62, 0x9a, 0xbd, 0xa1, 0x11, 0x87, 0x10, 0x58, 0xea, 0x55, 0xd4, 0x73, 0xd5, 0x18, 0xfe,
-	0x14, 0x58, 0x09, 0x8d, 0x78, 0x7b, 0x6d, 0xbf, 0x72, 0x50, 0x3b, 0x79, 0xf6, 0xb0, 0x07, 0x7b,
-	0x43, 0x23, 0xa4, 0x42, 0xbc, 0x7f, 0xac, 0x81, 0xca, 0x1b, 0x1a, 0xc1, 0x36, 0xd8, 0xc4, 0x61,
-	0xc8, 0x08, 0xe7, 0x86, 0x69, 0x02, 0xe1, 0x2e, 0xd8, 0x10, 0xb4, 0x1f, 0x07, 0x9a, 0xae, 0x8a,
-	0x0c, 0x92, 0xc2, 0x21, 0x16, 0x58, 0xf5, 0x15, 0x75, 0xa4, 0xc6, 0xf0, 0x04, 0xd4, 0xd5, 0xce,
-	0xfc, 0x2c, 0x4f, 0xbb, 0x84, 0xa9, 0xf6, 0xc0, 0xea, 0x34, 0xef, 0x0b, 0xb7, 0xa6, 0xec, 0x5f,
-	0x2b, 0x33, 0x9a, 0x05, 0xf0, 0x23, 0xb0, 0x29, 0x86, 0xb3, 0x2f, 0xfb, 0xce, 0x7d, 0xe1, 0x36,
-	0xc5, 0x74, 0x9b, 0xf2, 0xe1, 0x46, 0x1b, 0x62, 0xa8, 0x1e, 0xf0, 0x23, 0x60, 0x8b, 0xa1, 0x1f,
-	0x67, 0x21, 0x19, 0xaa, 0xc7, 0xdb, 0xea, 0xb4, 0xee, 0x0b, 0xd7, 0x99, 0x09, 0xbf, 0x90, 0x3e,
-	0xb4, 0x29, 0x86, 0x6a, 0x00, 0x3f, 0x02, 0x40, 0x2f, 0x49, 0x29, 0xe8, 0xa7, 0x77, 0xeb, 0xbe,
-	0x70, 0xab, 0xca, 0xaa, 0xb8, 0xa7, 0x43, 0xe8, 0x81, 0x75, 0xcd, 0x6d, 0x2b, 0xee, 0xfa, 0x7d,
-	0xe1, 0xda, 0x09, 0x8d, 0x34, 0xa7, 0x76, 0xc9, 0x54, 0x31, 0x92, 0xd2, 0x01, 0x09, 0xd5, 0xeb,
-	0x66, 0xa3, 0x09, 0xf4, 0xfe, 0xba, 0x06, 0xec, 0x9b, 0x21, 0x22, 0x3c, 0x4f, 0x04, 0xfc, 0x02,
-	0x38, 0x01, 0xcd, 0x04, 0xc3, 0x81, 0xf0, 0xe7, 0x52, 0xdb, 0x79, 0x31, 0x7d, 0x69, 0x16, 0x23,
-	0x3c, 0xd4, 0x9c, 0x98, 0xce, 0x4c, 0xfe, 0x5b, 0x60, 0xbd, 0x9b, 0x50, 0x9a, 0xaa, 0x4a, 0xa8,
-	0x23, 0x0d, 0x20, 0x52, 0x59, 0x53, 0xa7, 0x5c, 0x51, 0x9d, 0xf6, 0x8f, 0x1f, 0x9e, 0xf2, 0x42,
-	0xa9, 0x74, 0x76, 0x4d, 0xb7, 0xdd, 0xd0, 0xda, 0x66, 0xbe, 0x27, 0x73, 0xab, 0x4a, 0xc9, 0x01,
-	0x15, 0x46, 0x84, 0x3a, 0xb4, 0x3a, 0x92, 0x43, 0xf8, 0x1c, 0xd8, 0x8c, 0x0c, 0x08, 0x13, 0x24,
-	0x54, 0x87, 0x63, 0xa3, 0x12, 0xc3, 0x0f, 0x81, 0x1d, 0x61, 0xee, 0xe7, 0x9c, 0x84, 0xfa, 0x24,
-	0xd0, 0x66, 0x84, 0xf9, 0x37, 0x9c, 0x84, 0x9f, 0x59, 0x7f, 0xfe, 0xde, 0x5d, 0xf1, 0x30, 0xa8,
-	0x9d, 0x05, 0x01, 0xe1, 0xfc, 0x26, 0xef, 0x27, 0xe4, 0xbf, 0x54, 0xd8, 0x09, 0xa8, 0x73, 0x41,
-	0x19, 0x8e, 0x88, 0x7f, 0x4b, 0x46, 0xa6, 0xce, 0x74, 0xd5, 0x18, 0xfb, 0xaf, 0xc8, 0x88, 0xa3,
-	0x59, 0x60, 0x24, 0xbe, 0xb7, 0x40, 0xed, 0x86, 0xe1, 0x80, 0x98, 0x0e, 0x5f, 0xd6, 0xaa, 0x84,
-	0xcc, 0x48, 0x18, 0x24, 0xb5, 0x45, 0x9c, 0x12, 0x9a, 0x0b, 0x73, 0x9f, 0x26, 0x50, 0xce, 0x60,
-	0x84, 0x0c, 0x49, 0xa0, 0xd2, 0x68, 0x21, 0x83, 0xe0, 0x29, 0xd8, 0x0a, 0x63, 0xae, 0x3e, 0x97,
-	0xb8, 0xc0, 0xc1, 0xad, 0xde, 0x7e, 0xc7, 0xb9, 0x2f, 0xdc, 0xba, 0x71, 0x5c, 0x4b, 0x3b, 0x9a,
-	0x43, 0xf0, 0x73, 0xd0, 0x9c, 0x4e, 0x53, 0xab, 0xd5, 0x1f, 0x28, 0x1d, 0x78, 0x5f, 0xb8, 0x8d,
-	0x32, 0x54, 0x79, 0xd0, 0x02, 0x96, 0x27, 0x1d, 0x92, 0x6e, 0x1e, 0xa9, 0xe2, 0xb3, 0x91, 0x06,
-	0xd2, 0x9a, 0xc4, 0x69, 0x2c, 0x54, 0xb1, 0xad, 0x23, 0x0d, 0xe0, 0xe7, 0xa0, 0x4a, 0x07, 0x84,
-	0xb1, 0x38, 0x24, 0x5c, 0xb5, 0x3a, 0xff, 0xeb, 0x5b, 0x0b, 0x4d, 0xe3, 0xe5, 0xe6, 0xcc, 0xa7,
-	0x60, 0x4a, 0x52, 0xca, 0x46, 0xaa, 0x77, 0x31, 0x9b, 0xd3, 0x8e, 0x5f, 0x2b, 0x3b, 0x9a, 0x43,
-	0xb0, 0x03, 0xa0, 0x99, 0xc6, 0x88, 0xc8, 0x59, 0xe6, 0xab, 0xfb, 0x5f, 0x57, 0x73, 0xd5, 0x2d,
-	0xd4, 0x5e, 0xa4, 0x9c, 0xaf, 0xb1, 0xc0, 0xe8, 0x81, 0x05, 0xfe, 0x02, 0x40, 0x7d, 0x26, 0xfe,
-	0x77, 0x9c, 0x96, 0x1f, 0x8b, 0xba, 0xb5, 0x50, 0xfa, 0xda, 0x6b, 0xd6, 0xec, 0x68, 0x74, 0xc9,
-	0xa9, 0xd9, 0xc5, 0xa5, 0x65, 0x5b, 0xce, 0xfa, 0xa5, 0x65, 0x6f, 0x3a, 0x76, 0x99, 0x3f, 0xb3,
-	0x0b, 0xb4, 0x33, 0xc1, 0x33, 0xcb, 0xeb, 0xfc, 0xf2, 0x87, 0xbb, 0xbd, 0xd5, 0x77, 0x77, 0x7b,
-	0xab, 0xff, 0xbe, 0xdb, 0x5b, 0xfd, 0xdb, 0xfb, 0xbd, 0x95, 0x77, 0xef, 0xf7, 0x56, 0xfe, 0xf9,
-	0x7e, 0x6f, 0xe5, 0x77, 0xb3, 0xef, 0x03, 0x19, 0xc8, 0xe7, 0x61, 0xfa, 0xfd, 0x3f, 0x54, 0xff,
-	0x01, 0x50, 0x6f, 0x44, 0x77, 0x43, 0x7d, 0xd9, 0x7f, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xe1, 0x18, 0x7a, 0x1f, 0x10, 0x00, 0x00,
+	// 2093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x6f, 0x1b, 0xb9,
+	0x19, 0x8e, 0x63, 0xd9, 0x1e, 0x51, 0xb2, 0x34, 0xa6, 0x9d, 0x44, 0x71, 0x76, 0x3d, 0x2e, 0x0f,
+	0x85, 0x0b, 0xec, 0xda, 0x9b, 0x6c, 0x8d, 0x06, 0xd9, 0xb6, 0xa8, 0xe5, 0x78, 0x13, 0x3b, 0xe9,
+	0xd6, 0x60, 0x12, 0x14, 0xd8, 0xb6, 0x18, 0x50, 0x33, 0xcc, 0x68, 0xd6, 0x33, 0x43, 0x61, 0x48,
+	0x29, 0x52, 0xda, 0xde, 0x8b, 0xf6, 0xd2, 0x5f, 0x50, 0xec, 0x2f, 0xe8, 0xef, 0x58, 0xf4, 0xb4,
+	0xbd, 0x15, 0x3d, 0x0c, 0x0a, 0xe7, 0xe6, 0xa3, 0x7f, 0x41, 0xc1, 0x8f, 0xf9, 0x90, 0x94, 0x6e,
+	0xd7, 0x3e, 0x0d, 0xdf, 0x0f, 0x3e, 0x0f, 0xf9, 0x92, 0x9c, 0xf7, 0x25, 0xc1, 0x26, 0x15, 0x7d,
+	0x9a, 0xc6, 0x61, 0x22, 0xf6, 0xe8, 0x28, 0xde, 0x1b, 0xdd, 0x97, 0x9f, 0xdd, 0x41, 0xca, 0x04,
+	0x83, 0x76, 0x61, 0xdb, 0x95, 0xca, 0xd1, 0xfd, 0xcd, 0x8d, 0x80, 0x05, 0x4c, 0x19, 0xf7, 0x64,
+	0x4b, 0xfb, 0xa1, 0xbf, 0x5b, 0x60, 0xf9, 0x94, 0xa4, 0x24, 0xe6, 0xf0, 0x3e, 0xa8, 0xd3, 0x51,
+	0xec, 0xfa, 0x34, 0x61, 0x71, 0x67, 0x61, 0x7b, 0x61, 0xa7, 0xde, 0xdd, 0xb8, 0xcc, 0x1c, 0x7b,
+	0x42, 0xe2, 0xe8, 0x11, 0x2a, 0x4c, 0x08, 0x5b, 0x74, 0x14, 0x3f, 0x96, 0x4d, 0xf8, 0x33, 0xb0,
+	0x4a, 0x13, 0xd2, 0x8b, 0xa8, 0xeb, 0xa5, 0x94, 0x08, 0xda, 0xb9, 0xb9, 0xbd, 0xb0, 0x63, 0x75,
+	0x3b, 0x97, 0x99, 0xb3, 0x61, 0xba, 0x55, 0xcd, 0x08, 0x37, 0xb5, 0x7c, 0xa8, 0x44, 0xf8, 0x13,
+	0xd0, 0xc8, 0xed, 0x24, 0x8a, 0x3a, 0x8b, 0xaa, 0xf3, 0xed, 0xcb, 0xcc, 0x81, 0xd3, 0x9d, 0x49,
+	0x14, 0x21, 0x0c, 0x4c, 0x57, 0x12, 0x45, 0xf0, 0x00, 0x00, 0x3a, 0x16, 0x29, 0x71, 0x69, 0x38,
+	0xe0, 0x9d, 0xda, 0xf6, 0xe2, 0xce, 0x62, 0x17, 0x9d, 0x67, 0x4e, 0xfd, 0x48, 0x6a, 0x8f, 0x8e,
+	0x4f, 0xf9, 0x65, 0xe6, 0xac, 0x19, 0x90, 0xc2, 0x11, 0xe1, 0xba, 0x12, 0x8e, 0xc2, 0x01, 0x87,
+	0xbf, 0x03, 0x4d, 0xaf, 0x4f, 0xc2, 0xc4, 0xf5, 0x58, 0xf2, 0x3a, 0x0c, 0x3a, 0x4b, 0xdb, 0x0b,
+	0x3b, 0x8d, 0x07, 0x1f, 0xee, 0xce, 0xc6, 0x6d, 0xf7, 0x50, 0x7a, 0x1d, 0x2a, 0xa7, 0xee, 0xbd,
+	0x6f, 0x32, 0xe7, 0xc6, 0x65, 0xe6, 0xac, 0x6b, 0xe8, 0x2a, 0x00, 0xc2, 0x0d, 0xaf, 0xf4, 0x84,
+	0x0f, 0xc0, 0x2d, 0x12, 0x45, 0xec, 0x8d, 0x3b, 0x4c, 0x64, 0xa0, 0xa9, 0x27, 0xa8, 0xef, 0x8a,
+	0x31, 0xef, 0x2c, 0xcb, 0x49, 0xe2, 0x75, 0x65, 0x7c, 0x55, 0xda, 0x5e, 0x8e, 0x39, 0x7c, 0x06,
+	0xa0, 0x99, 0x71, 0x2f, 0x62, 0xde, 0x99, 0xdb, 0x67, 0xec, 0x8c, 0x77, 0x56, 0x54, 0x54, 0x3e,
+	0xbc, 0xcc, 0x9c, 0xbb, 0x53, 0x51, 0xa9, 0xf8, 0x20, 0x6c, 0x6b, 0x65, 0x57, 0xea, 0x9e, 0x4a,
+	0x15, 0x0c, 0xc1, 0x5a, 0x8f, 0x06, 0x61, 0x32, 0x85, 0x65, 0x6d, 0x2f, 0xee, 0x34, 0x1e, 0xdc,
+	0x9b, 0x9f, 0x64, 0xd1, 0xb1, 0xbb, 0x6d, 0xa6, 0xd8, 0xd1, 0x64, 0x73, 0x18, 0x08, 0xb7, 0x95,
+	0xae, 0x42, 0xe5, 0x81, 0x36, 0x4d, 0xfc, 0x29, 0xa2, 0xfa, 0xff, 0x27, 0xda, 0x32, 0x44, 0xb7,
+	0xf3, 0x59, 0xf9, 0xd3, 0x34, 0xab, 0x34, 0xf1, 0x2b, 0x24, 0xbf, 0x01, 0x80, 0x0b, 0x22, 0xa8,
+	0x9b, 0xd2, 0x44, 0x74, 0x80, 0x5a, 0xad, 0x1f, 0xcc, 0xe3, 0xbf, 0x90, 0x3e, 0x98, 0x26, 0x42,
+	0x6f, 0xea, 0xee, 0x5d, 0xc3, 0x62, 0x36, 0x43, 0x09, 0x81, 0x70, 0x9d, 0xe7, 0xbe, 0xf0, 0x10,
+	0xb4, 0x89, 0x1f, 0x87, 0x89, 0x4b, 0x86, 0xa2, 0xcf, 0xd2, 0x50, 0x4c, 0x3a, 0x0d, 0x75, 0x00,
+	0x36, 0xcb, 0x01, 0xce, 0x38, 0x20, 0xdc, 0x52, 0x9a, 0x83, 0x5c, 0x01, 0x7f, 0x0a, 0x56, 0x63,
+	0x32, 0x76, 0x3d, 0xe6, 0x53, 0x97, 0x87, 0x6f, 0x69, 0xa7, 0xb9, 0xbd, 0xb0, 0x53, 0xab, 0x1e,
+	0x86, 0x29, 0x33, 0xc2, 0x8d, 0x98, 0x8c, 0x0f, 0x99, 0x4f, 0x5f, 0x84, 0x6f, 0x29, 0x3c, 0x01,
+	0x50, 0x9a, 0xc3, 0x24, 0x14, 0x15, 0x88, 0x55, 0x05, 0x51, 0x59, 0xfc, 0x79, 0x1f, 0x84, 0xdb,
+	0x31, 0x19, 0x1f, 0x27, 0xa1, 0x28, 0xb0, 0xbe, 0x04, 0x8d, 0x41, 0x4a, 0x3d, 0x16, 0x0f, 0xc2,
+	0x88, 0xf2, 0x4e, 0x4b, 0x2d, 0xc6, 0x07, 0xf3, 0xc1, 0x3a, 0x2d, 0x9c, 0xba, 0x9b, 0x26, 0x4e,
+	0xe6, 0xe4, 0x55, 0xba, 0x23, 0x5c, 0x05, 0x43, 0x7f, 0x04, 0xed, 0x99, 0x18, 0xc3, 0x1f, 0x03,
+	0xd0, 0x9b, 0x08, 0xea, 0x0e, 0xd2, 0xd0, 0xa3, 0xea, 0xcf, 0x51, 0xeb, 0xde, 0x2a, 0x63, 0x5e,
+	0xda, 0x10, 0xae, 0x4b, 0xe1, 0x54, 0xb6, 0x65, 0xaf, 0xd7, 0x29, 0xa5, 0xae, 0xd4, 0xf0, 0xce,
+	0xcd, 0xd9, 0x5e, 0xa5, 0x0d, 0xe1, 0xba, 0x14, 0xba, 0xaa, 0xfd, 0xe7, 0x05, 0x50, 0x2f, 0x76,
+	0x05, 0x84, 0xa0, 0x96, 0x90, 0x58, 0x73, 0xd6, 0xb1, 0x6a, 0xc3, 0x4d, 0x60, 0x79, 0x2c, 0x11,
+	0x29, 0xf1, 0x84, 0x42, 0xad, 0xe3, 0x42, 0x56, 0x36, 0x12, 0x45, 0x3e, 0x11, 0x44, 0xfd, 0x6d,
+	0x9a, 0xb8, 0x90, 0xe5, 0xef, 0x2f, 0x20, 0xdc, 0x8d, 0xc2, 0x38, 0x14, 0x9d, 0x9a, 0x1a, 0x4e,
+	0xe5, 0xf7, 0x57, 0x98, 0x10, 0xb6, 0x02, 0xc2, 0x9f, 0xab, 0xe6, 0x3f, 0x17, 0x00, 0x28, 0x63,
+	0x08, 0x3b, 0x60, 0x85, 0xf8, 0x7e, 0x4a, 0x39, 0x37, 0x03, 0xca, 0x45, 0xb8, 0x0b, 0xac, 0x1e,
+	0xe1, 0xd4, 0x0d, 0x48, 0x3e, 0xd3, 0xf5, 0xcb, 0xcc, 0x69, 0x9b, 0xf8, 0x18, 0x0b, 0xc2, 0x2b,
+	0xb2, 0xf9, 0x84, 0x28, 0xff, 0x37, 0x2c, 0xf5, 0x95, 0xff, 0xe2, 0xac, 0x7f, 0x6e, 0x41, 0x78,
+	0x45, 0x36, 0xa5, 0xff, 0x31, 0x58, 0x23, 0x9e, 0x08, 0x47, 0x44, 0x84, 0x2c, 0x71, 0xfb, 0x34,
+	0x0c, 0xfa, 0x7a, 0x0e, 0x8b, 0xdd, 0x0f, 0xca, 0xb3, 0x3c, 0xe7, 0x82, 0xb0, 0x5d, 0xea, 0x9e,
+	0x6a, 0xd5, 0xdf, 0xd6, 0x40, 0xa3, 0xf2, 0xcb, 0x83, 0x31, 0x68, 0xf7, 0x59, 0x4c, 0xb9, 0xa0,
+	0xc4, 0x1c, 0x50, 0x93, 0x1b, 0x1e, 0xff, 0x3b, 0x73, 0x7e, 0x18, 0x84, 0xa2, 0x3f, 0xec, 0xed,
+	0x7a, 0x2c, 0xde, 0xf3, 0x18, 0x8f, 0x19, 0x37, 0x9f, 0x8f, 0xb9, 0x7f, 0xb6, 0x27, 0x26, 0x03,
+	0xca, 0x77, 0x8f, 0x13, 0x51, 0x1e, 0xa2, 0x19, 0x28, 0x84, 0x5b, 0x85, 0x46, 0xad, 0x2a, 0x9c,
+	0x80, 0x96, 0x4f, 0x98, 0xfb, 0x9a, 0xa5, 0x67, 0x86, 0x4d, 0xad, 0x61, 0xf7, 0xc5, 0xf7, 0x67,
+	0x3b, 0xcf, 0x9c, 0xe6, 0xe3, 0x83, 0x5f, 0x7d, 0xce, 0xd2, 0x33, 0x85, 0x79, 0x99, 0x39, 0xb7,
+	0x34, 0xfb, 0x34, 0x32, 0xc2, 0x4d, 0x9f, 0xb0, 0xc2, 0x0d, 0xfe, 0x1a, 0xd8, 0x85, 0x03, 0x1f,
+	0x0e, 0x06, 0x2c, 0x15, 0x26, 0x25, 0x7d, 0x7c, 0x9e, 0x39, 0x2d, 0x03, 0xf9, 0x42, 0x5b, 0x2e,
+	0x33, 0xe7, 0xce, 0x0c, 0xa8, 0xe9, 0x83, 0x70, 0xcb, 0xc0, 0x1a, 0x57, 0xc8, 0x41, 0x93, 0x86,
+	0x83, 0xfb, 0xfb, 0x9f, 0x98, 0x19, 0xd5, 0xd4, 0x8c, 0x4e, 0xaf, 0x34, 0xa3, 0xc6, 0xd1, 0xf1,
+	0xe9, 0xfd, 0xfd, 0x4f, 0xf2, 0x09, 0x99, 0x04, 0x54, 0x85, 0x45, 0xb8, 0xa1, 0x45, 0x3d, 0x9b,
+	0x63, 0x60, 0x44, 0xb7, 0x4f, 0x78, 0x5f, 0xa5, 0xb7, 0x7a, 0x77, 0xe7, 0x3c, 0x73, 0x80, 0x46,
+	0x7a, 0x4a, 0x78, 0xbf, 0x5c, 0x97, 0xde, 0xe4, 0x2d, 0x49, 0x44, 0x38, 0x8c, 0x73, 0x2c, 0xa0,
+	0x3b, 0x4b, 0xaf, 0x62, 0xfc, 0xfb, 0x66, 0xfc, 0xcb, 0xd7, 0x1e, 0xff, 0xfe, 0xfb, 0xc6, 0xbf,
+	0x3f, 0x3d, 0x7e, 0xed, 0x53, 0x90, 0x3e, 0x34, 0xa4, 0x2b, 0xd7, 0x26, 0x7d, 0xf8, 0x3e, 0xd2,
+	0x87, 0xd3, 0xa4, 0xda, 0x47, 0x6e, 0xf6, 0x99, 0x48, 0x74, 0xac, 0xeb, 0x6f, 0xf6, 0xb9, 0xa0,
+	0xb6, 0x0a, 0x8d, 0xa6, 0xfb, 0x03, 0xd8, 0xf0, 0x58, 0xc2, 0x85, 0xd4, 0x25, 0x6c, 0x90, 0x27,
+	0xf5, 0x4e, 0x5d, 0x71, 0x1e, 0x5f, 0x89, 0xf3, 0x9e, 0xe6, 0x7c, 0x1f, 0x1e, 0xc2, 0xeb, 0xd3,
+	0x6a, 0xcd, 0x3e, 0x00, 0xf6, 0x80, 0x0a, 0x9a, 0xf2, 0xde, 0x30, 0x0d, 0x0c, 0x33, 0x50, 0xcc,
+	0x47, 0x57, 0x62, 0x36, 0xe7, 0x60, 0x16, 0x0b, 0xe1, 0x76, 0xa9, 0xd2, 0x8c, 0x5f, 0x81, 0x56,
+	0x28, 0x87, 0xd1, 0x1b, 0x46, 0x86, 0x4f, 0x67, 0xd9, 0xc3, 0x2b, 0xf1, 0x99, 0xc3, 0x3c, 0x8d,
+	0x84, 0xf0, 0x6a, 0xae, 0xd0, 0x5c, 0x43, 0x00, 0xe3, 0x61, 0x98, 0xba, 0x41, 0x44, 0xbc, 0x90,
+	0xa6, 0x86, 0xaf, 0xa9, 0xf8, 0x9e, 0x5c, 0x89, 0x2f, 0xcf, 0xbc, 0x73, 0x68, 0x08, 0xdb, 0x52,
+	0xf9, 0x44, 0xeb, 0x34, 0xad, 0x0f, 0x9a, 0x3d, 0x9a, 0x46, 0x79, 0xcd, 0xa4, 0x12, 0x78, 0xbd,
+	0x7b, 0x70, 0x25, 0xc2, 0xf5, 0xbc, 0xf4, 0x2a, 0x71, 0x10, 0x6e, 0x68, 0xb1, 0x60, 0x89, 0x58,
+	0xe2, 0xb3, 0x9c, 0x65, 0xed, 0xfa, 0x2c, 0x55, 0x1c, 0x84, 0x1b, 0x5a, 0xd4, 0x2c, 0x63, 0xb0,
+	0x4e, 0xd2, 0x94, 0xbd, 0x99, 0x89, 0x21, 0x54, 0x64, 0x4f, 0xaf, 0x44, 0xb6, 0x69, 0x32, 0xd0,
+	0x3c, 0x1c, 0xc2, 0x6b, 0x4a, 0x3b, 0x15, 0xc5, 0x21, 0x80, 0x41, 0x4a, 0x26, 0x33, 0xc4, 0x1b,
+	0xd7, 0x5f, 0xbc, 0x79, 0x34, 0x84, 0x6d, 0xa9, 0x9c, 0xa2, 0xfd, 0x3d, 0xd8, 0x88, 0x69, 0x1a,
+	0x50, 0x37, 0xa1, 0x82, 0x0f, 0xa2, 0x50, 0x18, 0xe2, 0x5b, 0xd7, 0x3f, 0x8f, 0xef, 0xc3, 0x43,
+	0x18, 0x2a, 0xf5, 0x17, 0x46, 0x5b, 0x1c, 0x0e, 0xde, 0x27, 0x49, 0xd0, 0x27, 0xa1, 0xa1, 0xbd,
+	0x7d, 0xfd, 0xc3, 0x31, 0x8d, 0x84, 0xf0, 0x6a, 0xae, 0x28, 0xf6, 0x8f, 0x47, 0x12, 0x6f, 0x98,
+	0xef, 0x9f, 0x3b, 0xd7, 0xdf, 0x3f, 0x55, 0x1c, 0x79, 0x07, 0x52, 0xa2, 0x62, 0x39, 0xa9, 0x59,
+	0x2d, 0xbb, 0x7d, 0x52, 0xb3, 0xda, 0xb6, 0x7d, 0x52, 0xb3, 0x6c, 0x7b, 0xed, 0xa4, 0x66, 0xad,
+	0xdb, 0x1b, 0x78, 0x75, 0xc2, 0x22, 0xe6, 0x8e, 0x3e, 0xd5, 0x9d, 0x70, 0x83, 0xbe, 0x21, 0xdc,
+	0xfc, 0x23, 0x71, 0xcb, 0x23, 0x82, 0x44, 0x13, 0x6e, 0x42, 0x85, 0x6d, 0x1d, 0xc0, 0x4a, 0xd6,
+	0xde, 0x03, 0x4b, 0xaa, 0x00, 0x85, 0x36, 0x58, 0x3c, 0xa3, 0x13, 0x53, 0x6a, 0xc9, 0x26, 0xdc,
+	0x00, 0x4b, 0x23, 0x12, 0x0d, 0xa9, 0xa9, 0xfb, 0xb4, 0x80, 0x4e, 0x41, 0xfb, 0x65, 0x4a, 0x12,
+	0x2e, 0x4b, 0x1d, 0x96, 0x3c, 0x67, 0x01, 0x97, 0x75, 0xa3, 0xca, 0x8a, 0xa6, 0x6e, 0x94, 0x6d,
+	0xf8, 0x23, 0x50, 0x8b, 0x58, 0x20, 0xeb, 0x33, 0x59, 0x2d, 0xdf, 0x9a, 0xaf, 0x96, 0x9f, 0xb3,
+	0x00, 0x2b, 0x17, 0xf4, 0x8f, 0x9b, 0x60, 0xf1, 0x39, 0x0b, 0xbe, 0xa3, 0xe0, 0xbb, 0x0d, 0x96,
+	0x05, 0x1b, 0x84, 0x9e, 0x86, 0xab, 0x63, 0x23, 0x49, 0xe2, 0x4a, 0xf1, 0xa9, 0xda, 0xf0, 0x01,
+	0x68, 0xea, 0x8b, 0x4f, 0x32, 0x8c, 0x7b, 0x34, 0x35, 0xb5, 0x67, 0xfb, 0x22, 0x73, 0x1a, 0x4a,
+	0xff, 0x85, 0x52, 0xe3, 0xaa, 0x00, 0x3f, 0x02, 0x2b, 0x62, 0x5c, 0xcd, 0xec, 0xeb, 0x17, 0x99,
+	0xd3, 0x16, 0xe5, 0x34, 0x65, 0xe2, 0xc6, 0xcb, 0x62, 0x2c, 0xbf, 0x70, 0x0f, 0x58, 0x42, 0x5e,
+	0x1b, 0x7c, 0x3a, 0x56, 0xc9, 0xbb, 0xd6, 0xdd, 0xb8, 0xc8, 0x1c, 0xbb, 0xe2, 0x7e, 0x2c, 0x6d,
+	0x78, 0x45, 0x8c, 0x55, 0x03, 0x7e, 0x04, 0x80, 0xb9, 0x8b, 0x49, 0x06, 0x9d, 0x7a, 0x57, 0x2f,
+	0x32, 0xa7, 0xae, 0xb4, 0x0a, 0xbb, 0x6c, 0x42, 0x04, 0x96, 0x34, 0xb6, 0xa5, 0xb0, 0x9b, 0x17,
+	0x99, 0x63, 0x45, 0x2c, 0xd0, 0x98, 0xda, 0x24, 0x43, 0x95, 0xd2, 0x98, 0x8d, 0xa8, 0xaf, 0xb2,
+	0x9b, 0x85, 0x73, 0x11, 0xfd, 0xe5, 0x26, 0xb0, 0x5e, 0x8e, 0x31, 0xe5, 0xc3, 0x48, 0xc0, 0xcf,
+	0x81, 0x9d, 0x17, 0xeb, 0xee, 0x54, 0x68, 0xbb, 0xf7, 0xca, 0x4c, 0x33, 0xeb, 0x81, 0x70, 0x3b,
+	0x57, 0x1d, 0x98, 0xf8, 0x6f, 0x80, 0xa5, 0x5e, 0xc4, 0x58, 0xac, 0x76, 0x42, 0x13, 0x6b, 0x01,
+	0x62, 0x15, 0x35, 0xb5, 0xca, 0x8b, 0xff, 0xeb, 0x02, 0x39, 0xb3, 0x55, 0xba, 0xb7, 0xcd, 0xc5,
+	0xa8, 0xa5, 0xb9, 0x4d, 0x7f, 0x24, 0x63, 0xab, 0xb6, 0x92, 0x0d, 0x16, 0x53, 0xaa, 0x8b, 0xed,
+	0x26, 0x96, 0x4d, 0x79, 0xc9, 0x48, 0xe9, 0x88, 0xa6, 0x82, 0xfa, 0x6a, 0x71, 0x2c, 0x5c, 0xc8,
+	0xf0, 0x2e, 0x90, 0xb7, 0x07, 0x77, 0xc8, 0xa9, 0xaf, 0x57, 0x02, 0xaf, 0x04, 0x84, 0xbf, 0xe2,
+	0xd4, 0x7f, 0x54, 0xfb, 0xd3, 0xd7, 0xce, 0x0d, 0x44, 0x40, 0xe3, 0xc0, 0xf3, 0x28, 0xe7, 0x2f,
+	0x87, 0x83, 0xef, 0xbc, 0x52, 0x3c, 0x00, 0x4d, 0x2e, 0x58, 0x4a, 0x02, 0xea, 0x9e, 0xd1, 0x89,
+	0xd9, 0x67, 0x7a, 0xd7, 0x18, 0xfd, 0x33, 0x3a, 0xe1, 0xb8, 0x2a, 0x18, 0x8a, 0xaf, 0x6b, 0xa0,
+	0xf1, 0x32, 0x25, 0x1e, 0x35, 0x15, 0xbe, 0xdc, 0xab, 0x52, 0x4c, 0x0d, 0x85, 0x91, 0x24, 0xb7,
+	0x08, 0x63, 0xca, 0x86, 0xf9, 0x3d, 0x2a, 0x17, 0x65, 0x8f, 0x94, 0xd2, 0x31, 0xf5, 0xf4, 0xe5,
+	0x04, 0x1b, 0x09, 0xee, 0x83, 0x55, 0x3f, 0xe4, 0xea, 0x75, 0x82, 0x0b, 0xe2, 0x9d, 0xe9, 0xe9,
+	0x77, 0xed, 0x8b, 0xcc, 0x69, 0x1a, 0xc3, 0x0b, 0xa9, 0xc7, 0x53, 0x12, 0xfc, 0x0c, 0xb4, 0xcb,
+	0x6e, 0x6a, 0xb4, 0xfa, 0x95, 0xa4, 0x0b, 0x2f, 0x32, 0xa7, 0x55, 0xb8, 0x2a, 0x0b, 0x9e, 0x91,
+	0xe5, 0x4a, 0xfb, 0xb4, 0x37, 0x0c, 0xd4, 0xe6, 0xb3, 0xb0, 0x16, 0xa4, 0x56, 0x5f, 0xe4, 0xe4,
+	0x66, 0x5b, 0xc2, 0x5a, 0x80, 0x9f, 0x81, 0x3a, 0x1b, 0xd1, 0x34, 0x0d, 0x7d, 0xca, 0x3b, 0xe0,
+	0x7b, 0x3c, 0xf8, 0xe0, 0xd2, 0x5f, 0x4e, 0xce, 0xbc, 0xbc, 0xc4, 0x34, 0x66, 0xa9, 0x7e, 0x21,
+	0x30, 0x93, 0xd3, 0x86, 0x5f, 0x2a, 0x3d, 0x9e, 0x92, 0x60, 0xb7, 0x78, 0xd4, 0x49, 0xa9, 0x18,
+	0xa6, 0x89, 0xab, 0xce, 0x7f, 0x53, 0xf5, 0x55, 0xa7, 0x50, 0x5b, 0xb1, 0x32, 0x3e, 0x26, 0x82,
+	0xe0, 0x39, 0x0d, 0xfc, 0x39, 0x80, 0x7a, 0x4d, 0xdc, 0xaf, 0x38, 0x2b, 0x5e, 0xac, 0x74, 0x69,
+	0xa1, 0xf8, 0xb5, 0xd5, 0x8c, 0xd9, 0xd6, 0xd2, 0x09, 0x67, 0x66, 0x16, 0x27, 0x35, 0xab, 0x66,
+	0x2f, 0x9d, 0xd4, 0xac, 0x15, 0xdb, 0x2a, 0xe2, 0x67, 0x66, 0x81, 0xd7, 0x73, 0xb9, 0x32, 0x3c,
+	0xf4, 0x5b, 0x60, 0x3d, 0xa3, 0x93, 0xa3, 0x01, 0xf3, 0xfa, 0x32, 0x94, 0x54, 0x36, 0xf4, 0xc5,
+	0x1e, 0x6b, 0x01, 0x3e, 0x92, 0xdb, 0x8f, 0xa4, 0x22, 0xbf, 0x6c, 0xde, 0x54, 0x97, 0xcd, 0x3b,
+	0x65, 0x5e, 0xa8, 0x5a, 0x91, 0xdc, 0x86, 0x24, 0x15, 0xe6, 0x8a, 0xf9, 0x08, 0x34, 0xcd, 0xea,
+	0xbd, 0xe2, 0x66, 0x09, 0x79, 0xc4, 0x04, 0xcf, 0x19, 0x94, 0x20, 0xb5, 0x95, 0xa7, 0x01, 0xac,
+	0x85, 0xee, 0x2f, 0xbe, 0x39, 0xdf, 0x5a, 0xf8, 0xf6, 0x7c, 0x6b, 0xe1, 0x3f, 0xe7, 0x5b, 0x0b,
+	0x7f, 0x7d, 0xb7, 0x75, 0xe3, 0xdb, 0x77, 0x5b, 0x37, 0xfe, 0xf5, 0x6e, 0xeb, 0xc6, 0x97, 0xd5,
+	0xcc, 0x45, 0x47, 0x32, 0x71, 0x95, 0xcf, 0xa3, 0x63, 0xa9, 0xd1, 0xd9, 0xab, 0xb7, 0xac, 0x1e,
+	0x3e, 0x3f, 0xfd, 0xef, 0x00, 0xcb, 0xd4, 0xf7, 0xcf, 0x3e, 0x15, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -822,18 +1252,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.AllowUnprotectedTxs {
-		i--
-		if m.AllowUnprotectedTxs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Precompiles) > 0 {
+		for iNdEx := len(m.Precompiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Precompiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
 		}
+	}
+	if m.MaxInitCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxInitCodeSize))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x68
+	}
+	if m.MaxCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCodeSize))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.AdminAuthority) > 0 {
+		i -= len(m.AdminAuthority)
+		copy(dAtA[i:], m.AdminAuthority)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.AdminAuthority)))
+		i--
+		dAtA[i] = 0x5a
 	}
 	{
-		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.StateRent.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -841,23 +1292,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
-		for _, num1 := range m.ExtraEIPs {
+	dAtA[i] = 0x52
+	if len(m.EndBlockHooks) > 0 {
+		for iNdEx := len(m.EndBlockHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndBlockHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BeginBlockHooks) > 0 {
+		for iNdEx := len(m.BeginBlockHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeginBlockHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.EnableBlockHooks {
+		i--
+		if m.EnableBlockHooks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AllowUnprotectedTxs {
+		i--
+		if m.AllowUnprotectedTxs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ExtraEIPs) > 0 {
+		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
+		var j3 int
+		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvm(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -891,6 +1400,133 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StateRentParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateRentParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateRentParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FreeBytes != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.FreeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BytePrice != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BytePrice))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Precompile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Precompile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Precompile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WordGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.WordGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1537,6 +2173,72 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeyEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slots != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Slots))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -1576,6 +2278,100 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if m.EnableBlockHooks {
+		n += 2
+	}
+	if len(m.BeginBlockHooks) > 0 {
+		for _, e := range m.BeginBlockHooks {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.EndBlockHooks) > 0 {
+		for _, e := range m.EndBlockHooks {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = m.StateRent.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = len(m.AdminAuthority)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.MaxCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxCodeSize))
+	}
+	if m.MaxInitCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxInitCodeSize))
+	}
+	if len(m.Precompiles) > 0 {
+		for _, e := range m.Precompiles {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StateRentParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BytePrice != 0 {
+		n += 1 + sovEvm(uint64(m.BytePrice))
+	}
+	if m.FreeBytes != 0 {
+		n += 1 + sovEvm(uint64(m.FreeBytes))
+	}
+	return n
+}
+
+func (m *BlockHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *Precompile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.BaseGas != 0 {
+		n += 1 + sovEvm(uint64(m.BaseGas))
+	}
+	if m.WordGas != 0 {
+		n += 1 + sovEvm(uint64(m.WordGas))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvm(uint64(m.ActivationHeight))
+	}
 	return n
 }
 
@@ -1836,7 +2632,37 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
-func sovEvm(x uint64) (n int) {
+func (m *KeyEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovEvm(uint64(m.Epoch))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovEvm(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *StorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slots != 0 {
+		n += 1 + sovEvm(uint64(m.Slots))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovEvm(uint64(m.Bytes))
+	}
+	return n
+}
+
+func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozEvm(x uint64) (n int) {
@@ -2019,11 +2845,594 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
 			}
-		case 5:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableBlockHooks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableBlockHooks = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlockHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeginBlockHooks = append(m.BeginBlockHooks, BlockHook{})
+			if err := m.BeginBlockHooks[len(m.BeginBlockHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBlockHooks = append(m.EndBlockHooks, BlockHook{})
+			if err := m.EndBlockHooks[len(m.EndBlockHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateRent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+			}
+			m.MaxCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInitCodeSize", wireType)
+			}
+			m.MaxInitCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInitCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Precompiles = append(m.Precompiles, Precompile{})
+			if err := m.Precompiles[len(m.Precompiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRentParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateRentParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateRentParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytePrice", wireType)
+			}
+			m.BytePrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytePrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeBytes", wireType)
+			}
+			m.FreeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Precompile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Precompile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Precompile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2033,30 +3442,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
 			}
-			var v int
+			m.BaseGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2066,12 +3474,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WordGas", wireType)
+			}
+			m.WordGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WordGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowUnprotectedTxs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3982,6 +5427,182 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *KeyEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			m.Slots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
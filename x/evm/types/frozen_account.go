@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
+)
+
+// ValidateFrozenAccounts checks that frozen accounts are valid addresses and frozen at most once.
+func ValidateFrozenAccounts(addresses []string) error {
+	seen := make(map[common.Address]bool)
+	for _, address := range addresses {
+		if err := evmcommontypes.ValidateNonZeroAddress(address); err != nil {
+			return fmt.Errorf("invalid frozen account %s: %w", address, err)
+		}
+		addr := common.HexToAddress(address)
+		if seen[addr] {
+			return fmt.Errorf("duplicated frozen account %s", address)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
@@ -16,8 +16,15 @@
 package types
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
 	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
 )
 
@@ -26,9 +33,65 @@ func (ga GenesisAccount) Validate() error {
 	if err := evmcommontypes.ValidateAddress(ga.Address); err != nil {
 		return err
 	}
+	if _, err := hex.DecodeString(ga.Code); err != nil {
+		return fmt.Errorf("code must be hex encoded without 0x prefix: %w", err)
+	}
 	return ga.Storage.Validate()
 }
 
+// ValidateAccounts checks the genesis accounts against the accounts of the auth genesis, which
+// InitGenesis otherwise rejects with a panic when the chain starts. Every genesis account must be an
+// EthAccount whose code hash is the hash of its code, and every EthAccount with a code hash must have
+// its code in the genesis accounts.
+func (gs GenesisState) ValidateAccounts(accounts []authtypes.AccountI) error {
+	ethAccounts := make(map[common.Address]evmcommontypes.EthAccountI, len(accounts))
+	for _, account := range accounts {
+		if ethAccount, ok := account.(evmcommontypes.EthAccountI); ok {
+			ethAccounts[ethAccount.EthAddress()] = ethAccount
+		}
+	}
+
+	withCode := make(map[common.Address]bool, len(gs.Accounts))
+	for _, acc := range gs.Accounts {
+		address := common.HexToAddress(acc.Address)
+		ethAccount, ok := ethAccounts[address]
+		if !ok {
+			return fmt.Errorf(
+				"genesis account %s has no EthAccount in the auth genesis, add it with the code hash %s",
+				acc.Address, crypto.Keccak256Hash(common.Hex2Bytes(acc.Code)),
+			)
+		}
+
+		// accounts without code keep the code hash they have, as by InitGenesis
+		if acc.Code == "" {
+			continue
+		}
+		withCode[address] = true
+		codeHash := crypto.Keccak256Hash(common.Hex2Bytes(acc.Code))
+		if !bytes.Equal(ethAccount.GetCodeHash().Bytes(), codeHash.Bytes()) {
+			return fmt.Errorf(
+				"code hash %s of EthAccount %s doesn't match its code, set the code hash to %s or fix the code",
+				ethAccount.GetCodeHash(), acc.Address, codeHash,
+			)
+		}
+	}
+
+	for _, account := range accounts {
+		ethAccount, ok := account.(evmcommontypes.EthAccountI)
+		if !ok || withCode[ethAccount.EthAddress()] {
+			continue
+		}
+		if ethAccount.Type() == evmcommontypes.AccountTypeContract && ethAccount.GetCodeHash() != (common.Hash{}) {
+			return fmt.Errorf(
+				"EthAccount %s has the code hash %s but its code is missing, add the code to its genesis account or reset the code hash",
+				ethAccount.EthAddress(), ethAccount.GetCodeHash(),
+			)
+		}
+	}
+
+	return nil
+}
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
@@ -60,5 +123,77 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	if err := ValidateKeyEpochs(gs.KeyEpochs); err != nil {
+		return err
+	}
+
+	if err := ValidateEpochPublicKeys(gs.EpochPublicKeys, gs.KeyEpochs); err != nil {
+		return err
+	}
+
+	if err := ValidateFrozenAccounts(gs.FrozenAccounts); err != nil {
+		return err
+	}
+
+	if err := ValidateViewPermissions(gs.ViewPermissions); err != nil {
+		return err
+	}
+
+	if err := ValidatePreinstalls(gs.Preinstalls); err != nil {
+		return err
+	}
+	accountAddresses := make(map[common.Address]bool, len(gs.Accounts))
+	for _, acc := range gs.Accounts {
+		accountAddresses[common.HexToAddress(acc.Address)] = true
+	}
+	for _, preinstall := range gs.Preinstalls {
+		if accountAddresses[common.HexToAddress(preinstall.Address)] {
+			return fmt.Errorf("preinstall %s collides with genesis account %s", preinstall.Name, preinstall.Address)
+		}
+	}
+
 	return gs.Params.Validate()
 }
+
+// ValidateEpochPublicKeys checks that exported epoch public keys are set for epoch 0 and every key
+// epoch in order. They are optional, since storage of a new chain isn't encrypted yet.
+func ValidateEpochPublicKeys(keys []EpochPublicKey, epochs []KeyEpoch) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if len(keys) != len(epochs)+1 {
+		return fmt.Errorf("expected %d epoch public keys, got %d", len(epochs)+1, len(keys))
+	}
+
+	for i, key := range keys {
+		if key.Epoch != uint64(i) {
+			return fmt.Errorf("invalid epoch public key epoch %d, expected %d", key.Epoch, i)
+		}
+		publicKey, err := hexutil.Decode(key.PublicKey)
+		if err != nil {
+			return fmt.Errorf("invalid public key of epoch %d: %w", key.Epoch, err)
+		}
+		if len(publicKey) != common.HashLength {
+			return fmt.Errorf("invalid public key length %d of epoch %d", len(publicKey), key.Epoch)
+		}
+	}
+
+	return nil
+}
+
+// ValidateKeyEpochs checks that key epochs are numbered sequentially starting from 1
+// and start at increasing heights. Epoch 0 is implicit and starts at genesis.
+func ValidateKeyEpochs(epochs []KeyEpoch) error {
+	var lastHeight int64
+	for i, epoch := range epochs {
+		if epoch.Epoch != uint64(i+1) {
+			return fmt.Errorf("invalid key epoch %d, expected %d", epoch.Epoch, i+1)
+		}
+		if epoch.StartHeight <= lastHeight {
+			return fmt.Errorf("key epoch %d start height %d must be greater than %d", epoch.Epoch, epoch.StartHeight, lastHeight)
+		}
+		lastHeight = epoch.StartHeight
+	}
+
+	return nil
+}
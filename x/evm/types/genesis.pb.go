@@ -29,6 +29,21 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// key_epochs defines the state encryption key epochs after epoch 0
+	KeyEpochs []KeyEpoch `protobuf:"bytes,3,rep,name=key_epochs,json=keyEpochs,proto3" json:"key_epochs"`
+	// frozen_accounts defines the ethereum hex addresses of the frozen accounts
+	FrozenAccounts []string `protobuf:"bytes,5,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty"`
+	// preinstalls defines the infrastructure contracts installed at genesis,
+	// such as the singleton factory, Multicall3 and CreateX
+	Preinstalls []Preinstall `protobuf:"bytes,6,rep,name=preinstalls,proto3" json:"preinstalls"`
+	// view_permissions defines the contracts with restricted or granted view
+	// permissions
+	ViewPermissions []ViewPermissions `protobuf:"bytes,7,rep,name=view_permissions,json=viewPermissions,proto3" json:"view_permissions"`
+	// epoch_public_keys defines the public keys the enclave of the exporting
+	// chain derived for every key epoch. The encrypted storage can only be
+	// decrypted by enclaves holding the same master seed, which is checked
+	// against these keys at genesis.
+	EpochPublicKeys []EpochPublicKey `protobuf:"bytes,8,rep,name=epoch_public_keys,json=epochPublicKeys,proto3" json:"epoch_public_keys"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +93,227 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetKeyEpochs() []KeyEpoch {
+	if m != nil {
+		return m.KeyEpochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAccounts() []string {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreinstalls() []Preinstall {
+	if m != nil {
+		return m.Preinstalls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetViewPermissions() []ViewPermissions {
+	if m != nil {
+		return m.ViewPermissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochPublicKeys() []EpochPublicKey {
+	if m != nil {
+		return m.EpochPublicKeys
+	}
+	return nil
+}
+
+// EpochPublicKey defines the public key of the enclave for a key epoch.
+type EpochPublicKey struct {
+	// epoch is the sequence number of the state encryption key
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// public_key is the hex encoded x25519 public key of the enclave for the
+	// epoch
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (m *EpochPublicKey) Reset()         { *m = EpochPublicKey{} }
+func (m *EpochPublicKey) String() string { return proto.CompactTextString(m) }
+func (*EpochPublicKey) ProtoMessage()    {}
+func (*EpochPublicKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bcdec50cc9d156d, []int{1}
+}
+func (m *EpochPublicKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochPublicKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochPublicKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochPublicKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochPublicKey.Merge(m, src)
+}
+func (m *EpochPublicKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochPublicKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochPublicKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochPublicKey proto.InternalMessageInfo
+
+func (m *EpochPublicKey) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochPublicKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+// ViewPermissions defines who may view the confidential state of a contract
+// through eth_call.
+type ViewPermissions struct {
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// restricted defines if only the viewers may view the state of the contract
+	Restricted bool `protobuf:"varint,2,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// viewers are the hex addresses of the accounts permitted to view the state
+	// of the contract
+	Viewers []string `protobuf:"bytes,3,rep,name=viewers,proto3" json:"viewers,omitempty"`
+}
+
+func (m *ViewPermissions) Reset()         { *m = ViewPermissions{} }
+func (m *ViewPermissions) String() string { return proto.CompactTextString(m) }
+func (*ViewPermissions) ProtoMessage()    {}
+func (*ViewPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bcdec50cc9d156d, []int{2}
+}
+func (m *ViewPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ViewPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ViewPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ViewPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewPermissions.Merge(m, src)
+}
+func (m *ViewPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ViewPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewPermissions proto.InternalMessageInfo
+
+func (m *ViewPermissions) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ViewPermissions) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func (m *ViewPermissions) GetViewers() []string {
+	if m != nil {
+		return m.Viewers
+	}
+	return nil
+}
+
+// Preinstall defines a contract installed at genesis at its standard address.
+type Preinstall struct {
+	// name of the contract
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address defines the ethereum hex formated address of the contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// code defines the hex bytes of the runtime code of the contract
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *Preinstall) Reset()         { *m = Preinstall{} }
+func (m *Preinstall) String() string { return proto.CompactTextString(m) }
+func (*Preinstall) ProtoMessage()    {}
+func (*Preinstall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bcdec50cc9d156d, []int{3}
+}
+func (m *Preinstall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Preinstall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Preinstall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Preinstall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Preinstall.Merge(m, src)
+}
+func (m *Preinstall) XXX_Size() int {
+	return m.Size()
+}
+func (m *Preinstall) XXX_DiscardUnknown() {
+	xxx_messageInfo_Preinstall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Preinstall proto.InternalMessageInfo
+
+func (m *Preinstall) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Preinstall) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Preinstall) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -94,7 +330,7 @@ func (m *GenesisAccount) Reset()         { *m = GenesisAccount{} }
 func (m *GenesisAccount) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccount) ProtoMessage()    {}
 func (*GenesisAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bcdec50cc9d156d, []int{1}
+	return fileDescriptor_9bcdec50cc9d156d, []int{4}
 }
 func (m *GenesisAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,32 +382,50 @@ func (m *GenesisAccount) GetStorage() Storage {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.evm.v1.GenesisState")
+	proto.RegisterType((*EpochPublicKey)(nil), "ethermint.evm.v1.EpochPublicKey")
+	proto.RegisterType((*ViewPermissions)(nil), "ethermint.evm.v1.ViewPermissions")
+	proto.RegisterType((*Preinstall)(nil), "ethermint.evm.v1.Preinstall")
 	proto.RegisterType((*GenesisAccount)(nil), "ethermint.evm.v1.GenesisAccount")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4e, 0x83, 0x40,
-	0x1c, 0xe6, 0x6c, 0x53, 0xec, 0xd5, 0xa8, 0xb9, 0x98, 0x48, 0x18, 0xae, 0xa4, 0x83, 0x61, 0x3a,
-	0xd2, 0x9a, 0x38, 0x2b, 0x8b, 0xab, 0xa1, 0x9b, 0xdb, 0x15, 0x7e, 0xa1, 0x0c, 0x70, 0x84, 0xbb,
-	0x12, 0x5d, 0x1d, 0x9d, 0x7c, 0x0e, 0x9f, 0xa4, 0x63, 0x47, 0x27, 0x35, 0xf0, 0x22, 0x86, 0x83,
-	0xd6, 0x28, 0xdb, 0x07, 0xdf, 0xbf, 0xdf, 0x7d, 0x98, 0x82, 0x5a, 0x43, 0x91, 0x26, 0x99, 0xf2,
-	0xa0, 0x4c, 0xbd, 0x72, 0xee, 0xc5, 0x90, 0x81, 0x4c, 0x24, 0xcb, 0x0b, 0xa1, 0x04, 0x39, 0x3f,
-	0xf0, 0x0c, 0xca, 0x94, 0x95, 0x73, 0xdb, 0xee, 0x39, 0x1a, 0x42, 0xab, 0xed, 0x8b, 0x58, 0xc4,
-	0x42, 0x43, 0xaf, 0x41, 0xed, 0xdf, 0xd9, 0x2b, 0xc2, 0x27, 0xf7, 0x6d, 0xea, 0x52, 0x71, 0x05,
-	0xc4, 0xc7, 0xc7, 0x3c, 0x0c, 0xc5, 0x26, 0x53, 0xd2, 0x42, 0xce, 0xc0, 0x9d, 0x2c, 0x1c, 0xf6,
-	0xbf, 0x87, 0x75, 0x8e, 0xbb, 0x56, 0xe8, 0x0f, 0xb7, 0x9f, 0x53, 0x23, 0x38, 0xf8, 0xc8, 0x0d,
-	0x1e, 0xe5, 0xbc, 0xe0, 0xa9, 0xb4, 0x8e, 0x1c, 0xe4, 0x4e, 0x16, 0x56, 0x3f, 0xe1, 0x41, 0xf3,
-	0x9d, 0xb3, 0x53, 0xcf, 0x5e, 0x10, 0x3e, 0xfd, 0x1b, 0x4d, 0x2c, 0x6c, 0xf2, 0x28, 0x2a, 0x40,
-	0x36, 0xd7, 0x20, 0x77, 0x1c, 0xec, 0x3f, 0x09, 0xc1, 0xc3, 0x50, 0x44, 0xa0, 0x2b, 0xc6, 0x81,
-	0xc6, 0xc4, 0xc7, 0xa6, 0x54, 0xa2, 0xe0, 0x31, 0x58, 0x03, 0x7d, 0xfb, 0x65, 0xbf, 0x59, 0x3f,
-	0xd3, 0x3f, 0x6b, 0x8a, 0xdf, 0xbf, 0xa6, 0xe6, 0xb2, 0xd5, 0x07, 0x7b, 0xa3, 0x7f, 0xbb, 0xad,
-	0x28, 0xda, 0x55, 0x14, 0x7d, 0x57, 0x14, 0xbd, 0xd5, 0xd4, 0xd8, 0xd5, 0xd4, 0xf8, 0xa8, 0xa9,
-	0xf1, 0x78, 0x15, 0x27, 0x6a, 0xbd, 0x59, 0xb1, 0x50, 0xa4, 0xcd, 0xae, 0x42, 0x7a, 0xbf, 0x73,
-	0x3f, 0xe9, 0xc1, 0xd5, 0x73, 0x0e, 0x72, 0x35, 0xd2, 0xd3, 0x5e, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe3, 0x61, 0xf0, 0x9f, 0xc0, 0x01, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xcf, 0x6e, 0xda, 0x4e,
+	0x10, 0xc6, 0x81, 0x00, 0x1e, 0x7e, 0x82, 0xfc, 0x56, 0x91, 0x6a, 0xa1, 0xd6, 0xa1, 0x1c, 0x5a,
+	0x4e, 0x46, 0x49, 0xa5, 0x5e, 0xdb, 0xa0, 0x46, 0x3d, 0x44, 0xaa, 0xd0, 0x46, 0xea, 0xa1, 0x17,
+	0x64, 0xcc, 0xd4, 0x58, 0x60, 0xaf, 0xb5, 0xbb, 0x38, 0xa5, 0xc7, 0x3e, 0x41, 0x9f, 0xa3, 0x4f,
+	0x92, 0x63, 0x8e, 0x3d, 0xb5, 0x15, 0xbc, 0x48, 0xb5, 0xeb, 0x35, 0x7f, 0x42, 0x7a, 0x9b, 0x3f,
+	0xdf, 0xf7, 0xcd, 0xcc, 0xce, 0x0e, 0xb8, 0x28, 0xa7, 0xc8, 0xe3, 0x28, 0x91, 0x7d, 0xcc, 0xe2,
+	0x7e, 0x76, 0xde, 0x0f, 0x31, 0x41, 0x11, 0x09, 0x2f, 0xe5, 0x4c, 0x32, 0x72, 0xb2, 0xc9, 0x7b,
+	0x98, 0xc5, 0x5e, 0x76, 0xde, 0x6e, 0x1f, 0x30, 0x54, 0x42, 0xa3, 0xdb, 0xa7, 0x21, 0x0b, 0x99,
+	0x36, 0xfb, 0xca, 0xca, 0xa3, 0xdd, 0x55, 0x19, 0xfe, 0x7b, 0x9f, 0xab, 0xde, 0x48, 0x5f, 0x22,
+	0x19, 0x40, 0xdd, 0x0f, 0x02, 0xb6, 0x48, 0xa4, 0x70, 0xac, 0x4e, 0xb9, 0xd7, 0xb8, 0xe8, 0x78,
+	0x0f, 0xeb, 0x78, 0x86, 0x71, 0x99, 0x03, 0x07, 0x95, 0xbb, 0x5f, 0x67, 0x25, 0xba, 0xe1, 0x91,
+	0xd7, 0x50, 0x4d, 0x7d, 0xee, 0xc7, 0xc2, 0x39, 0xea, 0x58, 0xbd, 0xc6, 0x85, 0x73, 0xa8, 0x30,
+	0xd4, 0x79, 0xc3, 0x34, 0x68, 0xf2, 0x06, 0x60, 0x86, 0xcb, 0x11, 0xa6, 0x2c, 0x98, 0x0a, 0xa7,
+	0xac, 0xab, 0xb7, 0x0f, 0xb9, 0xd7, 0xb8, 0xbc, 0x52, 0x10, 0xc3, 0xb6, 0x67, 0xc6, 0x17, 0xe4,
+	0x25, 0xb4, 0x3e, 0x73, 0xf6, 0x15, 0x93, 0xd1, 0x66, 0x86, 0xe3, 0x4e, 0xb9, 0x67, 0xd3, 0x66,
+	0x1e, 0xbe, 0x2c, 0x3a, 0x7c, 0x07, 0x8d, 0x94, 0x63, 0x94, 0x08, 0xe9, 0xcf, 0xe7, 0xc2, 0xa9,
+	0xea, 0x52, 0x4f, 0x1f, 0x69, 0x73, 0x03, 0x32, 0xc5, 0x76, 0x69, 0x84, 0xc2, 0x49, 0x16, 0xe1,
+	0xed, 0x28, 0x55, 0x24, 0x21, 0x22, 0x96, 0x08, 0xa7, 0xa6, 0xa5, 0x9e, 0x1f, 0x4a, 0x7d, 0x8c,
+	0xf0, 0x76, 0xb8, 0x05, 0x1a, 0xbd, 0x56, 0xb6, 0x1f, 0x26, 0x14, 0xfe, 0xd7, 0xf3, 0x8f, 0xd2,
+	0xc5, 0x78, 0x1e, 0x05, 0xa3, 0x19, 0x2e, 0x85, 0x53, 0xff, 0xd7, 0x22, 0xf4, 0xdc, 0x43, 0x8d,
+	0xbc, 0xc6, 0x65, 0xa1, 0x89, 0x7b, 0x51, 0xd1, 0xbd, 0x82, 0xe6, 0x3e, 0x90, 0x9c, 0xc2, 0xb1,
+	0x06, 0x39, 0x56, 0xc7, 0xea, 0x55, 0x68, 0xee, 0x90, 0x67, 0x00, 0xdb, 0xaa, 0x7a, 0x77, 0x36,
+	0xb5, 0xd3, 0x82, 0xd4, 0x0d, 0xa1, 0xf5, 0x60, 0x08, 0xd2, 0x86, 0x7a, 0xc0, 0x12, 0xc9, 0xfd,
+	0x40, 0x6a, 0x29, 0x9b, 0x6e, 0x7c, 0xe2, 0x02, 0x70, 0x14, 0x92, 0x47, 0x81, 0xc4, 0x89, 0x56,
+	0xab, 0xd3, 0x9d, 0x08, 0x71, 0xa0, 0xa6, 0x86, 0x47, 0x9e, 0xaf, 0xda, 0xa6, 0x85, 0xdb, 0xfd,
+	0x00, 0xb0, 0x7d, 0x78, 0x42, 0xa0, 0x92, 0xf8, 0x31, 0x1a, 0x7d, 0x6d, 0x2b, 0xae, 0x3f, 0x99,
+	0x70, 0x14, 0xc2, 0xb4, 0x59, 0xb8, 0x0a, 0x1d, 0xb0, 0x09, 0x3a, 0xe5, 0x1c, 0xad, 0xec, 0xee,
+	0x37, 0x0b, 0x9a, 0xfb, 0x5f, 0x76, 0x57, 0xc0, 0x7a, 0x5c, 0xe0, 0x68, 0x2b, 0x40, 0x06, 0x50,
+	0x13, 0x92, 0x71, 0x3f, 0x44, 0xf3, 0x2b, 0x9f, 0x1c, 0xae, 0x42, 0x9f, 0xcf, 0xa0, 0xa5, 0x36,
+	0xf0, 0xe3, 0xf7, 0x59, 0xed, 0x26, 0xc7, 0xd3, 0x82, 0x38, 0x78, 0x7b, 0xb7, 0x72, 0xad, 0xfb,
+	0x95, 0x6b, 0xfd, 0x59, 0xb9, 0xd6, 0xf7, 0xb5, 0x5b, 0xba, 0x5f, 0xbb, 0xa5, 0x9f, 0x6b, 0xb7,
+	0xf4, 0xe9, 0x45, 0x18, 0xc9, 0xe9, 0x62, 0xec, 0x05, 0x2c, 0x56, 0xf7, 0xca, 0x44, 0x7f, 0x7b,
+	0xc6, 0x5f, 0xf4, 0x21, 0xcb, 0x65, 0x8a, 0x62, 0x5c, 0xd5, 0x27, 0xfb, 0xea, 0xef, 0x00, 0xce,
+	0x22, 0xfb, 0x8c, 0x18, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +448,71 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EpochPublicKeys) > 0 {
+		for iNdEx := len(m.EpochPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochPublicKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ViewPermissions) > 0 {
+		for iNdEx := len(m.ViewPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ViewPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Preinstalls) > 0 {
+		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preinstalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAccounts[iNdEx])
+			copy(dAtA[i:], m.FrozenAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.KeyEpochs) > 0 {
+		for iNdEx := len(m.KeyEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -221,7 +540,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisAccount) Marshal() (dAtA []byte, err error) {
+func (m *EpochPublicKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -231,54 +550,182 @@ func (m *GenesisAccount) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisAccount) MarshalTo(dAtA []byte) (int, error) {
+func (m *EpochPublicKey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EpochPublicKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Storage) > 0 {
-		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ViewPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ViewPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ViewPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Viewers) > 0 {
+		for iNdEx := len(m.Viewers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Viewers[iNdEx])
+			copy(dAtA[i:], m.Viewers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Viewers[iNdEx])))
 			i--
 			dAtA[i] = 0x1a
 		}
 	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Preinstall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Preinstall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Preinstall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Code) > 0 {
 		i -= len(m.Code)
 		copy(dAtA[i:], m.Code)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Code)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenesisAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -297,6 +744,95 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.KeyEpochs) > 0 {
+		for _, e := range m.KeyEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, s := range m.FrozenAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Preinstalls) > 0 {
+		for _, e := range m.Preinstalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ViewPermissions) > 0 {
+		for _, e := range m.ViewPermissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochPublicKeys) > 0 {
+		for _, e := range m.EpochPublicKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochPublicKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ViewPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	if len(m.Viewers) > 0 {
+		for _, s := range m.Viewers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Preinstall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -425,6 +961,555 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyEpochs = append(m.KeyEpochs, KeyEpoch{})
+			if err := m.KeyEpochs[len(m.KeyEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preinstalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preinstalls = append(m.Preinstalls, Preinstall{})
+			if err := m.Preinstalls[len(m.Preinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewPermissions = append(m.ViewPermissions, ViewPermissions{})
+			if err := m.ViewPermissions[len(m.ViewPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPublicKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochPublicKeys = append(m.EpochPublicKeys, EpochPublicKey{})
+			if err := m.EpochPublicKeys[len(m.EpochPublicKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochPublicKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochPublicKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ViewPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ViewPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ViewPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Viewers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Viewers = append(m.Viewers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Preinstall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
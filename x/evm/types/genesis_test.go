@@ -3,10 +3,13 @@ package types
 import (
 	"testing"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/SigmaGmbH/evm-module/crypto/ethsecp256k1"
+	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
 )
 
 type GenesisTestSuite struct {
@@ -69,6 +72,14 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 			},
 			true,
 		},
+		{
+			"0x prefixed code",
+			GenesisAccount{
+				Address: suite.address,
+				Code:    "0x" + suite.code,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -223,3 +234,189 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *GenesisTestSuite) TestValidateKeyEpochs() {
+	testCases := []struct {
+		name    string
+		epochs  []KeyEpoch
+		expPass bool
+	}{
+		{"no rotations", nil, true},
+		{"sequential epochs", []KeyEpoch{{Epoch: 1, StartHeight: 10}, {Epoch: 2, StartHeight: 20}}, true},
+		{"epoch numbers gap", []KeyEpoch{{Epoch: 1, StartHeight: 10}, {Epoch: 3, StartHeight: 20}}, false},
+		{"epoch 0 is implicit", []KeyEpoch{{Epoch: 0, StartHeight: 10}}, false},
+		{"same start height", []KeyEpoch{{Epoch: 1, StartHeight: 10}, {Epoch: 2, StartHeight: 10}}, false},
+		{"zero start height", []KeyEpoch{{Epoch: 1, StartHeight: 0}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateKeyEpochs(tc.epochs)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *GenesisTestSuite) TestValidateFrozenAccounts() {
+	address := "0x1000000000000000000000000000000000000001"
+
+	testCases := []struct {
+		name      string
+		addresses []string
+		expPass   bool
+	}{
+		{"no frozen accounts", nil, true},
+		{"frozen account", []string{address}, true},
+		{"invalid address", []string{"0x1234"}, false},
+		{"zero address", []string{common.Address{}.Hex()}, false},
+		{"duplicated account", []string{address, address}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateFrozenAccounts(tc.addresses)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *GenesisTestSuite) TestValidateViewPermissions() {
+	contract := "0x1000000000000000000000000000000000000001"
+	viewer := "0x1000000000000000000000000000000000000002"
+
+	testCases := []struct {
+		name        string
+		permissions []ViewPermissions
+		expPass     bool
+	}{
+		{"no view permissions", nil, true},
+		{"restricted contract", []ViewPermissions{{Contract: contract, Restricted: true}}, true},
+		{"restricted contract with viewer", []ViewPermissions{{Contract: contract, Restricted: true, Viewers: []string{viewer}}}, true},
+		{"unrestricted contract with viewer", []ViewPermissions{{Contract: contract, Viewers: []string{viewer}}}, true},
+		{"default permissions", []ViewPermissions{{Contract: contract}}, false},
+		{"zero contract address", []ViewPermissions{{Contract: common.Address{}.Hex(), Restricted: true}}, false},
+		{"invalid viewer", []ViewPermissions{{Contract: contract, Viewers: []string{"0x1234"}}}, false},
+		{"duplicated viewer", []ViewPermissions{{Contract: contract, Viewers: []string{viewer, viewer}}}, false},
+		{"duplicated contract", []ViewPermissions{{Contract: contract, Restricted: true}, {Contract: contract, Viewers: []string{viewer}}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateViewPermissions(tc.permissions)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+
+	genesis := DefaultGenesisState()
+	genesis.ViewPermissions = []ViewPermissions{{Contract: contract}}
+	suite.Require().Error(genesis.Validate())
+}
+
+func (suite *GenesisTestSuite) TestValidatePreinstalls() {
+	multicall := Preinstall{Name: PreinstallMulticall3, Address: PreinstallAddresses[PreinstallMulticall3].Hex(), Code: "0x6000"}
+	custom := Preinstall{Name: "custom", Address: "0x1000000000000000000000000000000000000001", Code: "6000"}
+
+	testCases := []struct {
+		name        string
+		preinstalls []Preinstall
+		expPass     bool
+	}{
+		{"no preinstalls", nil, true},
+		{"standard and custom preinstalls", []Preinstall{multicall, custom}, true},
+		{"blank name", []Preinstall{{Address: custom.Address, Code: custom.Code}}, false},
+		{"zero address", []Preinstall{{Name: "custom", Address: common.Address{}.Hex(), Code: custom.Code}}, false},
+		{"non-standard address", []Preinstall{{Name: PreinstallMulticall3, Address: custom.Address, Code: custom.Code}}, false},
+		{"CREATE2 deployer address", []Preinstall{{Name: "custom", Address: Create2DeployerAddress.Hex(), Code: custom.Code}}, false},
+		{"invalid code", []Preinstall{{Name: "custom", Address: custom.Address, Code: "0xzz"}}, false},
+		{"empty code", []Preinstall{{Name: "custom", Address: custom.Address}}, false},
+		{"duplicated name", []Preinstall{custom, {Name: "custom", Address: "0x1000000000000000000000000000000000000002", Code: custom.Code}}, false},
+		{"duplicated address", []Preinstall{custom, {Name: "other", Address: custom.Address, Code: custom.Code}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidatePreinstalls(tc.preinstalls)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+
+	genesis := DefaultGenesisState()
+	genesis.Accounts = []GenesisAccount{{Address: custom.Address}}
+	genesis.Preinstalls = []Preinstall{custom}
+	suite.Require().Error(genesis.Validate())
+}
+
+func (suite *GenesisTestSuite) TestValidateEpochPublicKeys() {
+	publicKey := common.BytesToHash([]byte{1}).Hex()
+	epochs := []KeyEpoch{{Epoch: 1, StartHeight: 10}}
+
+	testCases := []struct {
+		name    string
+		keys    []EpochPublicKey
+		expPass bool
+	}{
+		{"no epoch public keys", nil, true},
+		{"all epochs", []EpochPublicKey{{Epoch: 0, PublicKey: publicKey}, {Epoch: 1, PublicKey: publicKey}}, true},
+		{"missing epoch", []EpochPublicKey{{Epoch: 0, PublicKey: publicKey}}, false},
+		{"unordered epochs", []EpochPublicKey{{Epoch: 1, PublicKey: publicKey}, {Epoch: 0, PublicKey: publicKey}}, false},
+		{"invalid public key", []EpochPublicKey{{Epoch: 0, PublicKey: "0x1234"}, {Epoch: 1, PublicKey: publicKey}}, false},
+		{"non hex public key", []EpochPublicKey{{Epoch: 0, PublicKey: "key"}, {Epoch: 1, PublicKey: publicKey}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateEpochPublicKeys(tc.keys, epochs)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *GenesisTestSuite) TestValidateAccounts() {
+	address := common.HexToAddress(suite.address)
+	ethAccount := func(codeHash common.Hash) authtypes.AccountI {
+		return &evmcommontypes.EthAccount{
+			BaseAccount: authtypes.NewBaseAccountWithAddress(address.Bytes()),
+			CodeHash:    codeHash.Hex(),
+		}
+	}
+	codeHash := crypto.Keccak256Hash(common.Hex2Bytes(suite.code))
+	emptyCodeHash := common.BytesToHash(EmptyCodeHash)
+
+	testCases := []struct {
+		name     string
+		accounts []authtypes.AccountI
+		genesis  []GenesisAccount
+		expPass  bool
+	}{
+		{"no accounts", nil, nil, true},
+		{"contract", []authtypes.AccountI{ethAccount(codeHash)}, []GenesisAccount{{Address: suite.address, Code: suite.code}}, true},
+		{"account without code", []authtypes.AccountI{ethAccount(emptyCodeHash)}, []GenesisAccount{{Address: suite.address}}, true},
+		{"account only in auth", []authtypes.AccountI{ethAccount(emptyCodeHash)}, nil, true},
+		{"missing auth account", nil, []GenesisAccount{{Address: suite.address, Code: suite.code}}, false},
+		{"base account", []authtypes.AccountI{authtypes.NewBaseAccountWithAddress(address.Bytes())}, []GenesisAccount{{Address: suite.address}}, false},
+		{"code hash mismatch", []authtypes.AccountI{ethAccount(emptyCodeHash)}, []GenesisAccount{{Address: suite.address, Code: suite.code}}, false},
+		{"missing code", []authtypes.AccountI{ethAccount(codeHash)}, []GenesisAccount{{Address: suite.address}}, false},
+		{"missing genesis account", []authtypes.AccountI{ethAccount(codeHash)}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		genesis := DefaultGenesisState()
+		genesis.Accounts = tc.genesis
+		err := genesis.ValidateAccounts(tc.accounts)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
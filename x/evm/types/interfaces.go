@@ -62,6 +62,7 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) *big.Int
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+	AddTransientEvmGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, error)
 }
 
 // Event Hooks
@@ -73,6 +74,29 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// EvmPreHooks event hooks called before an evm tx is executed, e.g. to enforce rate limits or
+// compliance policies
+type EvmPreHooks interface {
+	// Called before the tx is executed, if it returns an error the tx is reverted without being executed.
+	PreTxProcessing(ctx sdk.Context, msg core.Message, cfg *EVMConfig) error
+}
+
+// ParamsListener is notified when the EVM module params change. Listeners are node-local
+// services (gas oracle, RPC limits, indexer) and must not modify state: they are called at the
+// end of the block in which the change was committed, with the previously announced params.
+type ParamsListener interface {
+	OnParamsChanged(height int64, prev, next Params)
+}
+
+// StateHistory reports the heights whose state is stored by the node. It is implemented by the
+// multistore of the app, since the state of other heights isn't reachable from the module.
+type StateHistory interface {
+	// LatestVersion returns the latest height whose state is stored
+	LatestVersion() int64
+	// VersionExists returns true if the state of the height is stored
+	VersionExists(version int64) bool
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
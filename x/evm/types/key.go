@@ -16,6 +16,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -34,6 +35,18 @@ const (
 
 	// RouterKey uses module name for routing
 	RouterKey = ModuleName
+
+	// BlockHashWindow is the number of recent block hashes kept in the persistent
+	// ring buffer. It matches the range accessible through the BLOCKHASH opcode.
+	BlockHashWindow = 256
+
+	// BlockLogsRetention is the number of recent blocks whose bloom filter and logs are
+	// kept in the persistent store for log queries. Older blocks are pruned at end block.
+	BlockLogsRetention = 10000
+
+	// MaxStorageRangeLimit is the maximum number of storage slots returned by a page of the
+	// StorageRange query.
+	MaxStorageRangeLimit = 1000
 )
 
 // prefix bytes for the EVM persistent store
@@ -41,6 +54,15 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixBlockHash
+	prefixKeyEpoch
+	prefixStorageUsage
+	prefixStorageUsageTotal
+	prefixFrozenAccount
+	prefixBlockBloom
+	prefixBlockLogs
+	prefixViewRestricted
+	prefixViewPermission
 )
 
 // prefix bytes for the EVM transient store
@@ -49,13 +71,37 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientBoundaryBytes
+	prefixTransientTxHash
+	prefixTransientPostState
+	prefixTransientTxLogs
+	prefixTransientPendingTx
+	prefixTransientStorageUsageAdded
+	prefixTransientStorageUsageRemoved
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode    = []byte{prefixCode}
-	KeyPrefixStorage = []byte{prefixStorage}
-	KeyPrefixParams  = []byte{prefixParams}
+	KeyPrefixCode      = []byte{prefixCode}
+	KeyPrefixStorage   = []byte{prefixStorage}
+	KeyPrefixParams    = []byte{prefixParams}
+	KeyPrefixBlockHash = []byte{prefixBlockHash}
+	// KeyPrefixKeyEpoch stores state encryption key epochs by epoch number
+	KeyPrefixKeyEpoch = []byte{prefixKeyEpoch}
+	// KeyPrefixStorageUsage stores the storage usage of contracts
+	KeyPrefixStorageUsage = []byte{prefixStorageUsage}
+	// KeyPrefixStorageUsageTotal stores the storage usage of all contracts
+	KeyPrefixStorageUsageTotal = []byte{prefixStorageUsageTotal}
+	// KeyPrefixFrozenAccount stores the accounts frozen through governance
+	KeyPrefixFrozenAccount = []byte{prefixFrozenAccount}
+	// KeyPrefixBlockBloom stores the bloom filter of the recent blocks by height
+	KeyPrefixBlockBloom = []byte{prefixBlockBloom}
+	// KeyPrefixBlockLogs stores the logs of the ethereum transactions of the recent blocks
+	KeyPrefixBlockLogs = []byte{prefixBlockLogs}
+	// KeyPrefixViewRestricted stores the contracts whose state can only be viewed by permitted viewers
+	KeyPrefixViewRestricted = []byte{prefixViewRestricted}
+	// KeyPrefixViewPermission stores the viewers permitted to view the state of contracts
+	KeyPrefixViewPermission = []byte{prefixViewPermission}
 )
 
 // Transient Store key prefixes
@@ -64,8 +110,28 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	// KeyPrefixTransientBoundaryBytes stores the number of bytes passed between Connector and SGXVM in current block
+	KeyPrefixTransientBoundaryBytes = []byte{prefixTransientBoundaryBytes}
+	// KeyPrefixTransientTxHash stores the hashes of the ethereum transactions processed in current block
+	KeyPrefixTransientTxHash = []byte{prefixTransientTxHash}
+	// KeyPrefixTransientPostState stores the commitment of the state writes of the VM in current block
+	KeyPrefixTransientPostState = []byte{prefixTransientPostState}
+	// KeyPrefixTransientTxLogs stores the logs of the ethereum transactions processed in current block
+	KeyPrefixTransientTxLogs = []byte{prefixTransientTxLogs}
+	// KeyPrefixTransientPendingTx stores the mempool priority and the effective gas price of the pending
+	// ethereum transactions by sender and nonce
+	KeyPrefixTransientPendingTx = []byte{prefixTransientPendingTx}
+	// KeyPrefixTransientStorageUsageAdded stores the storage usage added since the total was last updated
+	KeyPrefixTransientStorageUsageAdded = []byte{prefixTransientStorageUsageAdded}
+	// KeyPrefixTransientStorageUsageRemoved stores the storage usage removed since the total was last updated
+	KeyPrefixTransientStorageUsageRemoved = []byte{prefixTransientStorageUsageRemoved}
 )
 
+// PendingTxKey returns the key of the pending ethereum transaction of the sender with the given nonce.
+func PendingTxKey(sender common.Address, nonce uint64) []byte {
+	return append(sender.Bytes(), sdk.Uint64ToBigEndian(nonce)...)
+}
+
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
 func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
@@ -75,3 +141,26 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// BlockHashKey returns the ring buffer slot key under which the hash of the block
+// at the given height is stored.
+func BlockHashKey(height uint64) []byte {
+	return sdk.Uint64ToBigEndian(height % BlockHashWindow)
+}
+
+// BlockLogsKey returns the key under which the logs of the ethereum transaction with the
+// given index in the block at the given height are stored.
+func BlockLogsKey(height int64, txIndex uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), sdk.Uint64ToBigEndian(txIndex)...)
+}
+
+// KeyEpochKey returns the key under which the state encryption key epoch is stored.
+func KeyEpochKey(epoch uint64) []byte {
+	return sdk.Uint64ToBigEndian(epoch)
+}
+
+// ViewPermissionKey returns the key under which the permission of the viewer to view the state of the
+// contract is stored.
+func ViewPermissionKey(contract, viewer common.Address) []byte {
+	return append(contract.Bytes(), viewer.Bytes()...)
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
 )
 
 var (
@@ -31,6 +33,8 @@ var (
 	_ sdk.Tx     = &MsgHandleTx{}
 	_ ante.GasTx = &MsgHandleTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgFreezeAccount{}
+	_ sdk.Msg    = &MsgUnfreezeAccount{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgHandleTx{}
 )
@@ -84,3 +88,45 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgFreezeAccount message.
+func (m MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgFreezeAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errortypes.Wrap(err, "invalid authority address")
+	}
+
+	return evmcommontypes.ValidateNonZeroAddress(m.Address)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUnfreezeAccount message.
+func (m MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUnfreezeAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errortypes.Wrap(err, "invalid authority address")
+	}
+
+	return evmcommontypes.ValidateNonZeroAddress(m.Address)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUnfreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
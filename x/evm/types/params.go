@@ -35,6 +35,10 @@ var (
 	DefaultEnableCreate = true
 	// DefaultEnableCall enables contract calls (i.e true)
 	DefaultEnableCall = true
+	// DefaultMaxCodeSize is the max contract code size defined by EIP-170
+	DefaultMaxCodeSize uint64 = params.MaxCodeSize
+	// DefaultMaxInitCodeSize is the max init code size defined by EIP-3860, twice the max code size
+	DefaultMaxInitCodeSize = 2 * DefaultMaxCodeSize
 )
 
 // AvailableExtraEIPs define the list of all EIPs that can be enabled by the
@@ -53,6 +57,8 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 		EnableCall:          enableCall,
 		ExtraEIPs:           extraEIPs,
 		ChainConfig:         config,
+		MaxCodeSize:         DefaultMaxCodeSize,
+		MaxInitCodeSize:     DefaultMaxInitCodeSize,
 	}
 }
 
@@ -66,6 +72,8 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           nil,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		MaxCodeSize:         DefaultMaxCodeSize,
+		MaxInitCodeSize:     DefaultMaxInitCodeSize,
 	}
 }
 
@@ -91,6 +99,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBlockHooks(p.BeginBlockHooks); err != nil {
+		return err
+	}
+
+	if err := validateBlockHooks(p.EndBlockHooks); err != nil {
+		return err
+	}
+
+	if err := validateAdminAuthority(p.AdminAuthority); err != nil {
+		return err
+	}
+
+	if err := validateCodeSizes(p.CodeSizeLimit(), p.InitCodeSizeLimit()); err != nil {
+		return err
+	}
+
+	if err := validatePrecompiles(p.Precompiles); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -103,6 +131,22 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// CodeSizeLimit returns the max contract code size, or the EIP-170 default if the param is unset
+func (p Params) CodeSizeLimit() uint64 {
+	if p.MaxCodeSize == 0 {
+		return DefaultMaxCodeSize
+	}
+	return p.MaxCodeSize
+}
+
+// InitCodeSizeLimit returns the max init code size, or the EIP-3860 default if the param is unset
+func (p Params) InitCodeSizeLimit() uint64 {
+	if p.MaxInitCodeSize == 0 {
+		return DefaultMaxInitCodeSize
+	}
+	return p.MaxInitCodeSize
+}
+
 func validateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
@@ -135,6 +179,24 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validateAdminAuthority(authority string) error {
+	if authority == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid admin authority %s: %w", authority, err)
+	}
+	return nil
+}
+
+func validateCodeSizes(maxCodeSize, maxInitCodeSize uint64) error {
+	if maxInitCodeSize < maxCodeSize {
+		return fmt.Errorf("max init code size %d must not be less than max code size %d", maxInitCodeSize, maxCodeSize)
+	}
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
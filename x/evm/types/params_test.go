@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,93 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid block hooks",
+			func() Params {
+				p := DefaultParams()
+				p.EnableBlockHooks = true
+				p.BeginBlockHooks = []BlockHook{{Name: "oracle", Contract: "0x1000000000000000000000000000000000000001", GasLimit: 100_000}}
+				p.EndBlockHooks = []BlockHook{{Name: "epoch", Contract: "0x1000000000000000000000000000000000000001", GasLimit: MaxBlockHookGas}}
+				return p
+			}(),
+			false,
+		},
+		{
+			"block hook gas limit exceeds max",
+			func() Params {
+				p := DefaultParams()
+				p.EndBlockHooks = []BlockHook{{Name: "epoch", Contract: "0x1000000000000000000000000000000000000001", GasLimit: MaxBlockHookGas + 1}}
+				return p
+			}(),
+			true,
+		},
+		{
+			"duplicate block hook",
+			func() Params {
+				p := DefaultParams()
+				hook := BlockHook{Name: "oracle", Contract: "0x1000000000000000000000000000000000000001", GasLimit: 100_000}
+				p.BeginBlockHooks = []BlockHook{hook, hook}
+				return p
+			}(),
+			true,
+		},
+		{
+			"block hook without contract",
+			func() Params {
+				p := DefaultParams()
+				p.BeginBlockHooks = []BlockHook{{Name: "oracle", GasLimit: 100_000}}
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid admin authority",
+			func() Params {
+				p := DefaultParams()
+				p.AdminAuthority = sdk.AccAddress([]byte("admin_authority_____")).String()
+				return p
+			}(),
+			false,
+		},
+		{
+			"valid code sizes",
+			func() Params {
+				p := DefaultParams()
+				p.MaxCodeSize = 2 * DefaultMaxCodeSize
+				p.MaxInitCodeSize = 2 * p.MaxCodeSize
+				return p
+			}(),
+			false,
+		},
+		{
+			"unset code sizes use the defaults",
+			func() Params {
+				p := DefaultParams()
+				p.MaxCodeSize = 0
+				p.MaxInitCodeSize = 0
+				return p
+			}(),
+			false,
+		},
+		{
+			"init code size less than code size",
+			func() Params {
+				p := DefaultParams()
+				p.MaxCodeSize = DefaultMaxCodeSize
+				p.MaxInitCodeSize = DefaultMaxCodeSize - 1
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid admin authority",
+			func() Params {
+				p := DefaultParams()
+				p.AdminAuthority = "foobar"
+				return p
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -54,6 +142,54 @@ func TestParamsValidate(t *testing.T) {
 	}
 }
 
+func TestParamsCodeSizeLimits(t *testing.T) {
+	var params Params
+	require.Equal(t, DefaultMaxCodeSize, params.CodeSizeLimit())
+	require.Equal(t, DefaultMaxInitCodeSize, params.InitCodeSizeLimit())
+
+	params.MaxCodeSize, params.MaxInitCodeSize = 1, 2
+	require.Equal(t, uint64(1), params.CodeSizeLimit())
+	require.Equal(t, uint64(2), params.InitCodeSizeLimit())
+}
+
+func TestParamsPrecompiles(t *testing.T) {
+	precompile := Precompile{Address: "0x0000000000000000000000000000000000000400", BaseGas: 100, WordGas: 3, ActivationHeight: 10}
+
+	testCases := []struct {
+		name        string
+		precompiles []Precompile
+		expError    bool
+	}{
+		{"empty", nil, false},
+		{"valid", []Precompile{precompile}, false},
+		{"zero address", []Precompile{{Address: "0x0000000000000000000000000000000000000000", BaseGas: 1}}, true},
+		{"invalid address", []Precompile{{Address: "0x1", BaseGas: 1}}, true},
+		{"ethereum precompile", []Precompile{{Address: "0x0000000000000000000000000000000000000001", BaseGas: 1}}, true},
+		{"zero base gas", []Precompile{{Address: precompile.Address}}, true},
+		{"negative activation height", []Precompile{{Address: precompile.Address, BaseGas: 1, ActivationHeight: -1}}, true},
+		{"duplicate", []Precompile{precompile, precompile}, true},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.Precompiles = tc.precompiles
+		err := params.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	params := DefaultParams()
+	params.Precompiles = []Precompile{precompile}
+	require.Empty(t, params.ActivePrecompiles(9))
+	require.Equal(t, []Precompile{precompile}, params.ActivePrecompiles(10))
+
+	require.Equal(t, uint64(100), precompile.RequiredGas(nil))
+	require.Equal(t, uint64(106), precompile.RequiredGas(make([]byte, 33)))
+}
+
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []int64{2929, 1884, 1344}
 	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips)
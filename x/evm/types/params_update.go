@@ -0,0 +1,183 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// chainConfigFork is a fork of the chain config scheduled at a block
+type chainConfigFork struct {
+	name  string
+	block *sdkmath.Int
+}
+
+// forks returns the forks of the chain config in activation order
+func (cc ChainConfig) forks() []chainConfigFork {
+	return []chainConfigFork{
+		{"homestead_block", cc.HomesteadBlock},
+		{"dao_fork_block", cc.DAOForkBlock},
+		{"eip150_block", cc.EIP150Block},
+		{"eip155_block", cc.EIP155Block},
+		{"eip158_block", cc.EIP158Block},
+		{"byzantium_block", cc.ByzantiumBlock},
+		{"constantinople_block", cc.ConstantinopleBlock},
+		{"petersburg_block", cc.PetersburgBlock},
+		{"istanbul_block", cc.IstanbulBlock},
+		{"muir_glacier_block", cc.MuirGlacierBlock},
+		{"berlin_block", cc.BerlinBlock},
+		{"london_block", cc.LondonBlock},
+		{"arrow_glacier_block", cc.ArrowGlacierBlock},
+		{"gray_glacier_block", cc.GrayGlacierBlock},
+		{"merge_netsplit_block", cc.MergeNetsplitBlock},
+		{"shanghai_block", cc.ShanghaiBlock},
+		{"cancun_block", cc.CancunBlock},
+	}
+}
+
+// ValidateParamsUpdate validates the update of the current params to the proposed params at the
+// given height. Besides the basic validation of the proposed params, it checks that the EVM denom
+// is kept once the chain has started, that forks and precompiles activated up to the height are
+// not rescheduled or changed and that newly scheduled ones activate after the height, so an update
+// can't change the rules of executed blocks.
+func ValidateParamsUpdate(current, proposed Params, height int64) error {
+	if err := proposed.Validate(); err != nil {
+		return err
+	}
+
+	if height > 0 && proposed.EvmDenom != current.EvmDenom {
+		return errorsmod.Wrapf(
+			ErrInvalidParamsUpdate, "evm denom cannot be changed after genesis, current %s, proposed %s",
+			current.EvmDenom, proposed.EvmDenom,
+		)
+	}
+
+	proposedForks := proposed.ChainConfig.forks()
+	for i, fork := range current.ChainConfig.forks() {
+		next := proposedForks[i].block
+		if blockString(fork.block) == blockString(next) {
+			continue
+		}
+		if isActivated(fork.block, height) {
+			return errorsmod.Wrapf(
+				ErrInvalidParamsUpdate, "%s is activated at block %s and cannot be rescheduled",
+				fork.name, fork.block,
+			)
+		}
+		if isActivated(next, height) {
+			return errorsmod.Wrapf(
+				ErrInvalidParamsUpdate, "%s must be scheduled after the current block %d, got %s",
+				fork.name, height, next,
+			)
+		}
+	}
+
+	return validatePrecompilesUpdate(current.Precompiles, proposed.Precompiles, height)
+}
+
+// validatePrecompilesUpdate checks that the precompiles active at the height are kept unchanged and
+// that added or changed precompiles activate after the height
+func validatePrecompilesUpdate(current, proposed []Precompile, height int64) error {
+	unchanged := make(map[Precompile]bool, len(current))
+	for _, precompile := range current {
+		unchanged[precompile] = false
+	}
+	for _, precompile := range proposed {
+		if _, ok := unchanged[precompile]; ok {
+			unchanged[precompile] = true
+			continue
+		}
+		if precompile.IsActive(height) {
+			return errorsmod.Wrapf(
+				ErrInvalidParamsUpdate, "precompile %s must be scheduled after the current block %d, got %d",
+				precompile.Address, height, precompile.ActivationHeight,
+			)
+		}
+	}
+
+	for _, precompile := range current {
+		if precompile.IsActive(height) && !unchanged[precompile] {
+			return errorsmod.Wrapf(
+				ErrInvalidParamsUpdate, "precompile %s is activated at block %d and cannot be changed or removed",
+				precompile.Address, precompile.ActivationHeight,
+			)
+		}
+	}
+
+	return nil
+}
+
+// DiffParams returns the parameters changed from the current to the proposed params
+func DiffParams(current, proposed Params) []ParamChange {
+	var changes []ParamChange
+	add := func(key, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, ParamChange{Key: key, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+
+	add("evm_denom", current.EvmDenom, proposed.EvmDenom)
+	add("enable_create", strconv.FormatBool(current.EnableCreate), strconv.FormatBool(proposed.EnableCreate))
+	add("enable_call", strconv.FormatBool(current.EnableCall), strconv.FormatBool(proposed.EnableCall))
+	add("extra_eips", jsonString(current.ExtraEIPs), jsonString(proposed.ExtraEIPs))
+	add(
+		"allow_unprotected_txs",
+		strconv.FormatBool(current.AllowUnprotectedTxs), strconv.FormatBool(proposed.AllowUnprotectedTxs),
+	)
+	add(
+		"enable_block_hooks",
+		strconv.FormatBool(current.EnableBlockHooks), strconv.FormatBool(proposed.EnableBlockHooks),
+	)
+	add("begin_block_hooks", jsonString(current.BeginBlockHooks), jsonString(proposed.BeginBlockHooks))
+	add("end_block_hooks", jsonString(current.EndBlockHooks), jsonString(proposed.EndBlockHooks))
+	add("state_rent", jsonString(current.StateRent), jsonString(proposed.StateRent))
+	add("admin_authority", current.AdminAuthority, proposed.AdminAuthority)
+	add(
+		"max_code_size",
+		strconv.FormatUint(current.MaxCodeSize, 10), strconv.FormatUint(proposed.MaxCodeSize, 10),
+	)
+	add(
+		"max_init_code_size",
+		strconv.FormatUint(current.MaxInitCodeSize, 10), strconv.FormatUint(proposed.MaxInitCodeSize, 10),
+	)
+
+	add("precompiles", jsonString(current.Precompiles), jsonString(proposed.Precompiles))
+
+	add(
+		"chain_config.dao_fork_support",
+		strconv.FormatBool(current.ChainConfig.DAOForkSupport), strconv.FormatBool(proposed.ChainConfig.DAOForkSupport),
+	)
+	add("chain_config.eip150_hash", current.ChainConfig.EIP150Hash, proposed.ChainConfig.EIP150Hash)
+	proposedForks := proposed.ChainConfig.forks()
+	for i, fork := range current.ChainConfig.forks() {
+		add("chain_config."+fork.name, blockString(fork.block), blockString(proposedForks[i].block))
+	}
+
+	return changes
+}
+
+// isActivated returns true if the fork block is set and not after the height
+func isActivated(block *sdkmath.Int, height int64) bool {
+	value := getBlockValue(block)
+	return value != nil && value.IsInt64() && value.Int64() <= height
+}
+
+// blockString formats a fork block, unset and negative blocks are formatted as empty strings
+func blockString(block *sdkmath.Int) string {
+	value := getBlockValue(block)
+	if value == nil {
+		return ""
+	}
+	return value.String()
+}
+
+func jsonString(value interface{}) string {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return err.Error()
+	}
+	return string(bz)
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParamsUpdate(t *testing.T) {
+	newInt := func(i int64) *sdkmath.Int {
+		v := sdkmath.NewInt(i)
+		return &v
+	}
+	current := DefaultParams()
+	current.ChainConfig.ShanghaiBlock = newInt(100)
+	current.ChainConfig.CancunBlock = nil
+	precompile := Precompile{Address: "0x0000000000000000000000000000000000000400", BaseGas: 100, ActivationHeight: 100}
+	current.Precompiles = []Precompile{precompile}
+
+	testCases := []struct {
+		name     string
+		malleate func(p *Params)
+		height   int64
+		expPass  bool
+	}{
+		{"unchanged", func(p *Params) {}, 10, true},
+		{"toggle enable flags", func(p *Params) { p.EnableCall = false; p.EnableCreate = false }, 10, true},
+		{"invalid params", func(p *Params) { p.EvmDenom = "" }, 10, false},
+		{"change denom after genesis", func(p *Params) { p.EvmDenom = "aevmos" }, 10, false},
+		{"change denom at genesis", func(p *Params) { p.EvmDenom = "aevmos" }, 0, true},
+		{"reschedule pending fork", func(p *Params) { p.ChainConfig.ShanghaiBlock = newInt(200) }, 10, true},
+		{"reschedule activated fork", func(p *Params) { p.ChainConfig.ShanghaiBlock = newInt(200) }, 100, false},
+		{"unset activated fork", func(p *Params) { p.ChainConfig.LondonBlock = nil }, 10, false},
+		{"schedule fork", func(p *Params) { p.ChainConfig.CancunBlock = newInt(200) }, 10, true},
+		{"schedule fork in the past", func(p *Params) { p.ChainConfig.CancunBlock = newInt(150) }, 150, false},
+		{"invalid fork order", func(p *Params) { p.ChainConfig.CancunBlock = newInt(50) }, 10, false},
+		{"reschedule pending precompile", func(p *Params) { p.Precompiles[0].ActivationHeight = 200 }, 10, true},
+		{"change active precompile", func(p *Params) { p.Precompiles[0].BaseGas = 200 }, 100, false},
+		{"remove active precompile", func(p *Params) { p.Precompiles = nil }, 100, false},
+		{"remove pending precompile", func(p *Params) { p.Precompiles = nil }, 10, true},
+		{"add precompile", func(p *Params) {
+			p.Precompiles = append(p.Precompiles, Precompile{Address: "0x0000000000000000000000000000000000000401", BaseGas: 1, ActivationHeight: 11})
+		}, 10, true},
+		{"add precompile in the past", func(p *Params) {
+			p.Precompiles = append(p.Precompiles, Precompile{Address: "0x0000000000000000000000000000000000000401", BaseGas: 1, ActivationHeight: 10})
+		}, 10, false},
+	}
+
+	for _, tc := range testCases {
+		proposed := current
+		proposed.ChainConfig.ShanghaiBlock = newInt(100)
+		proposed.Precompiles = []Precompile{precompile}
+		tc.malleate(&proposed)
+
+		err := ValidateParamsUpdate(current, proposed, tc.height)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestDiffParams(t *testing.T) {
+	current := DefaultParams()
+	require.Empty(t, DiffParams(current, current))
+
+	proposed := current
+	proposed.EnableCreate = false
+	proposed.ExtraEIPs = []int64{3855}
+	proposed.MaxInitCodeSize = 2 * DefaultMaxInitCodeSize
+	londonBlock := sdkmath.NewInt(50)
+	proposed.ChainConfig.LondonBlock = &londonBlock
+	proposed.ChainConfig.CancunBlock = nil
+
+	require.Equal(t, []ParamChange{
+		{Key: "enable_create", OldValue: "true", NewValue: "false"},
+		{Key: "extra_eips", OldValue: "null", NewValue: "[3855]"},
+		{Key: "max_init_code_size", OldValue: "49152", NewValue: "98304"},
+		{Key: "chain_config.london_block", OldValue: "0", NewValue: "50"},
+		{Key: "chain_config.cancun_block", OldValue: "0", NewValue: ""},
+	}, DiffParams(current, proposed))
+}
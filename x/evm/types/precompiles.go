@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/SigmaGmbH/evm-module/types"
+)
+
+// Validate performs a stateless validation of the precompile
+func (p Precompile) Validate() error {
+	if err := types.ValidateNonZeroAddress(p.Address); err != nil {
+		return fmt.Errorf("invalid address of precompile: %w", err)
+	}
+	address := common.HexToAddress(p.Address)
+	if _, ok := vm.PrecompiledContractsBerlin[address]; ok {
+		return fmt.Errorf("precompile %s collides with an Ethereum precompile", address)
+	}
+	if p.BaseGas == 0 {
+		return fmt.Errorf("base gas of precompile %s cannot be 0", address)
+	}
+	if p.ActivationHeight < 0 {
+		return fmt.Errorf("activation height of precompile %s cannot be negative, got %d", address, p.ActivationHeight)
+	}
+
+	return nil
+}
+
+// IsActive returns true if the precompile is active at the height
+func (p Precompile) IsActive(height int64) bool {
+	return p.ActivationHeight <= height
+}
+
+// RequiredGas returns the gas of a call of the precompile with the input
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	return p.BaseGas + uint64(len(input)+31)/32*p.WordGas
+}
+
+// ActivePrecompiles returns the custom precompiles active at the height
+func (p Params) ActivePrecompiles(height int64) []Precompile {
+	var active []Precompile
+	for _, precompile := range p.Precompiles {
+		if precompile.IsActive(height) {
+			active = append(active, precompile)
+		}
+	}
+	return active
+}
+
+func validatePrecompiles(i interface{}) error {
+	precompiles, ok := i.([]Precompile)
+	if !ok {
+		return fmt.Errorf("invalid precompiles type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(precompiles))
+	for _, precompile := range precompiles {
+		if err := precompile.Validate(); err != nil {
+			return err
+		}
+		address := common.HexToAddress(precompile.Address)
+		if seen[address] {
+			return fmt.Errorf("duplicate precompile %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
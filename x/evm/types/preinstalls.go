@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/types"
+)
+
+// Names of the infrastructure contracts deployment frameworks expect at their standard addresses
+const (
+	PreinstallSingletonFactory = "singleton_factory"
+	PreinstallMulticall3       = "multicall3"
+	PreinstallCreateX          = "createx"
+)
+
+// PreinstallAddresses are the standard addresses of the infrastructure contracts. They are deployed
+// on Ethereum with keyless or chain specific transactions which can't be replayed here, so they are
+// preinstalled at genesis instead.
+var PreinstallAddresses = map[string]common.Address{
+	// EIP-2470 singleton factory
+	PreinstallSingletonFactory: common.HexToAddress("0xce0042B868300000d44A59004Da54A005ffdcf9f"),
+	// github.com/mds1/multicall
+	PreinstallMulticall3: common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
+	// github.com/pcaversaccio/createx
+	PreinstallCreateX: common.HexToAddress("0xba5Ed099633D3B313e4D5F7bdc1305d3c28ba5Ed"),
+}
+
+// Validate performs a stateless validation of the preinstall. The known infrastructure contracts
+// must be installed at their standard address.
+func (p Preinstall) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("preinstall name cannot be blank")
+	}
+	if err := types.ValidateNonZeroAddress(p.Address); err != nil {
+		return fmt.Errorf("invalid address of preinstall %s: %w", p.Name, err)
+	}
+	address := common.HexToAddress(p.Address)
+	if standard, ok := PreinstallAddresses[p.Name]; ok && standard != address {
+		return fmt.Errorf("preinstall %s must be installed at %s, got %s", p.Name, standard, address)
+	}
+	if address == Create2DeployerAddress {
+		return fmt.Errorf("preinstall %s collides with the CREATE2 deployer", p.Name)
+	}
+
+	// like the code of genesis accounts, the 0x prefix is optional
+	code, err := hex.DecodeString(strings.TrimPrefix(p.Code, "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid code of preinstall %s: %w", p.Name, err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("code of preinstall %s cannot be empty", p.Name)
+	}
+
+	return nil
+}
+
+// ValidatePreinstalls validates the preinstalls and checks that names and addresses are unique
+func ValidatePreinstalls(preinstalls []Preinstall) error {
+	seenNames := make(map[string]bool, len(preinstalls))
+	seenAddresses := make(map[common.Address]bool, len(preinstalls))
+	for _, preinstall := range preinstalls {
+		if err := preinstall.Validate(); err != nil {
+			return err
+		}
+		if seenNames[preinstall.Name] {
+			return fmt.Errorf("duplicated preinstall %s", preinstall.Name)
+		}
+		address := common.HexToAddress(preinstall.Address)
+		if seenAddresses[address] {
+			return fmt.Errorf("duplicated preinstall address %s", address)
+		}
+		seenNames[preinstall.Name] = true
+		seenAddresses[address] = true
+	}
+
+	return nil
+}
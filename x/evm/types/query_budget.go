@@ -0,0 +1,14 @@
+package types
+
+import "time"
+
+// QueryBudget bounds the work of a single eth_call, eth_estimateGas or tracing query, so public nodes can't be
+// pinned by view functions looping until the gas cap is exhausted. The enclave doesn't expose an
+// instruction counter, so the budget is enforced on the Connector requests of the VM. Zero values
+// disable the corresponding limit.
+type QueryBudget struct {
+	// MaxHostCalls is the max number of Connector requests of a single execution
+	MaxHostCalls uint64
+	// Timeout is the max duration of a query
+	Timeout time.Duration
+}
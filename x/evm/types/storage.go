@@ -21,6 +21,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // Storage represents the account Storage map as a slice of single key value
@@ -69,9 +70,37 @@ func (s State) Validate() error {
 		return errorsmod.Wrap(ErrInvalidState, "state key hash cannot be blank")
 	}
 
+	// keys are stored as hashes, so other encodings of a key would silently address another slot
+	key, err := hexutil.Decode(s.Key)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidState, "state key %s must be a 0x prefixed hex hash: %s", s.Key, err)
+	}
+	if len(key) != common.HashLength {
+		return errorsmod.Wrapf(ErrInvalidState, "state key %s must be a %d byte hash, got %d bytes", s.Key, common.HashLength, len(key))
+	}
+	if s.Key != common.BytesToHash(key).Hex() {
+		return errorsmod.Wrapf(ErrInvalidState, "state key %s must be lower case, use %s", s.Key, common.BytesToHash(key).Hex())
+	}
+
+	if s.Value != "" {
+		if _, err := hexutil.Decode(s.Value); err != nil {
+			return errorsmod.Wrapf(ErrInvalidState, "value of state key %s must be 0x prefixed hex: %s", s.Key, err)
+		}
+	}
+
 	return nil
 }
 
+// StoredValue returns the value as stored. Values of plaintext storage are hashes, shorter values
+// are left padded as by the hash form, while encrypted values are kept whole.
+func (s State) StoredValue() []byte {
+	value := common.FromHex(s.Value)
+	if len(value) < common.HashLength {
+		return common.LeftPadBytes(value, common.HashLength)
+	}
+	return value
+}
+
 // NewState creates a new State instance
 func NewState(key, value common.Hash) State {
 	return State{
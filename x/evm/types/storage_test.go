@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -27,6 +28,34 @@ func TestStorageValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"short storage key",
+			Storage{
+				{Key: "0x01"},
+			},
+			false,
+		},
+		{
+			"storage key without 0x prefix",
+			Storage{
+				{Key: common.Bytes2Hex(common.BytesToHash([]byte{1, 2, 3}).Bytes())},
+			},
+			false,
+		},
+		{
+			"upper case storage key",
+			Storage{
+				{Key: "0x" + strings.ToUpper(common.Bytes2Hex(common.BytesToHash([]byte{0xab}).Bytes()))},
+			},
+			false,
+		},
+		{
+			"non hex storage value",
+			Storage{
+				{Key: common.BytesToHash([]byte{1, 2, 3}).String(), Value: "value"},
+			},
+			false,
+		},
 		{
 			"duplicated storage key",
 			Storage{
@@ -82,3 +111,35 @@ func TestStorageString(t *testing.T) {
 	str := "key:\"0x00000000000000000000000000000000000000000000000000000000006b6579\" value:\"0x00000000000000000000000000000000000000000000000000000076616c7565\" \n"
 	require.Equal(t, str, storage.String())
 }
+
+func TestStateStoredValue(t *testing.T) {
+	// plaintext values are hashes
+	require.Equal(t, common.BytesToHash([]byte{1}).Bytes(), State{Value: "0x01"}.StoredValue())
+	require.Equal(t, make([]byte, common.HashLength), State{}.StoredValue())
+
+	// encrypted values are kept whole
+	encrypted := make([]byte, 2*common.HashLength)
+	encrypted[0] = 1
+	require.Equal(t, encrypted, State{Value: common.Bytes2Hex(encrypted)}.StoredValue())
+}
+
+func TestStorageUsageUpdate(t *testing.T) {
+	var usage StorageUsage
+	require.True(t, usage.IsZero())
+
+	usage = usage.Update(nil, make([]byte, 32))
+	require.Equal(t, StorageUsage{Slots: 1, Bytes: 64}, usage)
+
+	usage = usage.Update(make([]byte, 32), make([]byte, 48))
+	require.Equal(t, StorageUsage{Slots: 1, Bytes: 80}, usage)
+
+	usage = usage.Update(make([]byte, 48), nil)
+	require.True(t, usage.IsZero())
+
+	// storage missing in the usage is clamped at zero instead of underflowing
+	usage = usage.Update(make([]byte, 32), nil)
+	require.True(t, usage.IsZero())
+
+	usage = StorageUsage{Slots: 2, Bytes: 40}.Update(make([]byte, 32), make([]byte, 16))
+	require.Equal(t, StorageUsage{Slots: 2, Bytes: 48}, usage)
+}
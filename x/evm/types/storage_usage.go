@@ -0,0 +1,49 @@
+package types
+
+import "github.com/ethereum/go-ethereum/common"
+
+// StorageCellSize returns the accounted size of a storage slot holding the value.
+func StorageCellSize(value []byte) uint64 {
+	return uint64(common.HashLength + len(value))
+}
+
+// StorageCellUsage returns the usage of a storage slot holding the value. An empty value
+// means the slot is not set.
+func StorageCellUsage(value []byte) StorageUsage {
+	if len(value) == 0 {
+		return StorageUsage{}
+	}
+	return StorageUsage{Slots: 1, Bytes: StorageCellSize(value)}
+}
+
+// Update returns the usage after the slot value changed from prev to next. An empty
+// value means the slot is not set.
+func (u StorageUsage) Update(prev, next []byte) StorageUsage {
+	return u.Sub(StorageCellUsage(prev)).Add(StorageCellUsage(next))
+}
+
+// Add returns the usage with other added.
+func (u StorageUsage) Add(other StorageUsage) StorageUsage {
+	u.Slots += other.Slots
+	u.Bytes += other.Bytes
+	return u
+}
+
+// Sub returns the usage with other removed. It is clamped at zero, so storage written
+// before the usage was recorded can't underflow it.
+func (u StorageUsage) Sub(other StorageUsage) StorageUsage {
+	if other.Slots > u.Slots {
+		other.Slots = u.Slots
+	}
+	if other.Bytes > u.Bytes {
+		other.Bytes = u.Bytes
+	}
+	u.Slots -= other.Slots
+	u.Bytes -= other.Bytes
+	return u
+}
+
+// IsZero returns true if no storage is used.
+func (u StorageUsage) IsZero() bool {
+	return u.Slots == 0 && u.Bytes == 0
+}
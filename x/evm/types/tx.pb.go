@@ -308,6 +308,11 @@ type MsgEthereumTxResponse struct {
 	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
 	// gas_used specifies how much gas was consumed by the transaction
 	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// effective_gas_price is the price per gas paid by the transaction. For
+	// dynamic fee transactions it's the base fee plus the effective priority fee.
+	EffectiveGasPrice *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=effective_gas_price,json=effectiveGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"effective_gas_price,omitempty"`
+	// tx_type is the EIP-2718 type of the ethereum transaction
+	TxType uint32 `protobuf:"varint,7,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
 }
 
 func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
@@ -437,6 +442,194 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgFreezeAccount defines a Msg for freezing an externally owned account.
+// Transactions sent from a frozen account are rejected, while it can still
+// receive funds.
+type MsgFreezeAccount struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the ethereum hex address of the account to freeze.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgFreezeAccount) Reset()         { *m = MsgFreezeAccount{} }
+func (m *MsgFreezeAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeAccount) ProtoMessage()    {}
+func (*MsgFreezeAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgFreezeAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeAccount.Merge(m, src)
+}
+func (m *MsgFreezeAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeAccount proto.InternalMessageInfo
+
+func (m *MsgFreezeAccount) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgFreezeAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgFreezeAccountResponse defines the response structure for executing a
+// MsgFreezeAccount message.
+type MsgFreezeAccountResponse struct {
+}
+
+func (m *MsgFreezeAccountResponse) Reset()         { *m = MsgFreezeAccountResponse{} }
+func (m *MsgFreezeAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeAccountResponse) ProtoMessage()    {}
+func (*MsgFreezeAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgFreezeAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeAccountResponse.Merge(m, src)
+}
+func (m *MsgFreezeAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeAccountResponse proto.InternalMessageInfo
+
+// MsgUnfreezeAccount defines a Msg for unfreezing a frozen account.
+type MsgUnfreezeAccount struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the ethereum hex address of the account to unfreeze.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgUnfreezeAccount) Reset()         { *m = MsgUnfreezeAccount{} }
+func (m *MsgUnfreezeAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeAccount) ProtoMessage()    {}
+func (*MsgUnfreezeAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
+}
+func (m *MsgUnfreezeAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeAccount.Merge(m, src)
+}
+func (m *MsgUnfreezeAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeAccount proto.InternalMessageInfo
+
+func (m *MsgUnfreezeAccount) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUnfreezeAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgUnfreezeAccountResponse defines the response structure for executing a
+// MsgUnfreezeAccount message.
+type MsgUnfreezeAccountResponse struct {
+}
+
+func (m *MsgUnfreezeAccountResponse) Reset()         { *m = MsgUnfreezeAccountResponse{} }
+func (m *MsgUnfreezeAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeAccountResponse) ProtoMessage()    {}
+func (*MsgUnfreezeAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgUnfreezeAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeAccountResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeAccountResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgHandleTx)(nil), "ethermint.evm.v1.MsgHandleTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -446,72 +639,84 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgFreezeAccount)(nil), "ethermint.evm.v1.MsgFreezeAccount")
+	proto.RegisterType((*MsgFreezeAccountResponse)(nil), "ethermint.evm.v1.MsgFreezeAccountResponse")
+	proto.RegisterType((*MsgUnfreezeAccount)(nil), "ethermint.evm.v1.MsgUnfreezeAccount")
+	proto.RegisterType((*MsgUnfreezeAccountResponse)(nil), "ethermint.evm.v1.MsgUnfreezeAccountResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 959 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x13, 0x27, 0x71, 0x26, 0xd1, 0x52, 0x59, 0x5b, 0xd5, 0x09, 0x34, 0x0e, 0x46, 0x82,
-	0xb4, 0xd2, 0xda, 0xea, 0x82, 0x7a, 0xd8, 0x53, 0x37, 0xdd, 0x6d, 0x69, 0xb5, 0x2b, 0x2a, 0x93,
-	0x5e, 0x28, 0x52, 0x34, 0x6b, 0xcf, 0x4e, 0x2c, 0x62, 0x8f, 0xe5, 0x99, 0x18, 0xe7, 0xda, 0x13,
-	0x47, 0x10, 0x7f, 0x80, 0x03, 0x27, 0x4e, 0x48, 0xf4, 0x07, 0x70, 0xac, 0x38, 0x55, 0x70, 0x41,
-	0x08, 0x05, 0x94, 0x45, 0x42, 0xea, 0x0d, 0x7e, 0x01, 0x9a, 0x19, 0x67, 0xb3, 0x69, 0x76, 0x0b,
-	0x94, 0x45, 0x3d, 0x79, 0xde, 0xbc, 0x37, 0xdf, 0x7b, 0xf3, 0xbe, 0x6f, 0x66, 0x0c, 0x9a, 0x88,
-	0x0d, 0x51, 0x12, 0x06, 0x11, 0x73, 0x50, 0x1a, 0x3a, 0xe9, 0x35, 0x87, 0x65, 0x76, 0x9c, 0x10,
-	0x46, 0xf4, 0x0b, 0xc7, 0x2e, 0x1b, 0xa5, 0xa1, 0x9d, 0x5e, 0x6b, 0x5d, 0xf2, 0x08, 0x0d, 0x09,
-	0x75, 0x42, 0x8a, 0x79, 0x64, 0x48, 0xb1, 0x0c, 0x6d, 0x35, 0xa5, 0x63, 0x20, 0x2c, 0x47, 0x1a,
-	0xb9, 0xab, 0xb5, 0x92, 0x80, 0x83, 0x49, 0xdf, 0x3a, 0x26, 0x98, 0xc8, 0x35, 0x7c, 0x94, 0xcf,
-	0xbe, 0x86, 0x09, 0xc1, 0x23, 0xe4, 0xc0, 0x38, 0x70, 0x60, 0x14, 0x11, 0x06, 0x59, 0x40, 0xa2,
-	0x39, 0x5e, 0x33, 0xf7, 0x0a, 0xeb, 0x60, 0x7c, 0xe8, 0xc0, 0x68, 0x22, 0x5d, 0x56, 0x02, 0xea,
-	0xfb, 0x14, 0xbf, 0x0b, 0x23, 0x7f, 0x84, 0xfa, 0x99, 0xde, 0x05, 0xaa, 0x0f, 0x19, 0x34, 0x94,
-	0x8e, 0xd2, 0xad, 0x6f, 0xae, 0xdb, 0x72, 0xa1, 0x3d, 0x5f, 0x68, 0x6f, 0x47, 0x13, 0x57, 0x44,
-	0xe8, 0x26, 0x50, 0x87, 0x90, 0x0e, 0x8d, 0x52, 0x47, 0xe9, 0xd6, 0x7a, 0xf5, 0x3f, 0xa7, 0x66,
-	0x35, 0x19, 0xc5, 0x5b, 0xd6, 0x86, 0xe5, 0x0a, 0x87, 0xae, 0x03, 0xf5, 0x30, 0x21, 0xa1, 0xa1,
-	0xf2, 0x00, 0x57, 0x8c, 0xb7, 0xd4, 0x4f, 0xbe, 0x30, 0x0b, 0xd6, 0x37, 0x45, 0xa0, 0xed, 0x21,
-	0x0c, 0xbd, 0x49, 0x3f, 0xd3, 0xd7, 0x41, 0x39, 0x22, 0x91, 0x87, 0x44, 0x4a, 0xd5, 0x95, 0x86,
-	0x7e, 0x1b, 0xd4, 0x30, 0xe4, 0xbd, 0x09, 0x3c, 0x64, 0x14, 0x45, 0x8a, 0xab, 0x3f, 0x4d, 0xcd,
-	0x37, 0x71, 0xc0, 0x86, 0xe3, 0x03, 0xdb, 0x23, 0x61, 0xde, 0xb1, 0xfc, 0xb3, 0x41, 0xfd, 0x8f,
-	0x1c, 0x36, 0x89, 0x11, 0xb5, 0xef, 0x44, 0xcc, 0xd5, 0x30, 0xa4, 0xf7, 0xf8, 0x5a, 0xbd, 0x0d,
-	0x4a, 0x18, 0x52, 0x51, 0xa5, 0xda, 0x6b, 0xcc, 0xa6, 0xa6, 0x76, 0x1b, 0xd2, 0xbd, 0x20, 0x0c,
-	0x98, 0xcb, 0x1d, 0xfa, 0x1a, 0x28, 0x32, 0x92, 0xd7, 0x58, 0x64, 0x44, 0xbf, 0x0b, 0xca, 0x29,
-	0x1c, 0x8d, 0x91, 0x51, 0x16, 0x49, 0xdf, 0xf9, 0xe7, 0x49, 0x67, 0x53, 0xb3, 0xb2, 0x1d, 0x92,
-	0x71, 0xc4, 0x5c, 0x09, 0xc1, 0x3b, 0x20, 0x9a, 0x59, 0xe9, 0x28, 0xdd, 0x46, 0xde, 0xb6, 0x06,
-	0x50, 0x52, 0xa3, 0x2a, 0x26, 0x94, 0x94, 0x5b, 0x89, 0xa1, 0x49, 0x2b, 0xe1, 0x16, 0x35, 0x6a,
-	0xd2, 0xa2, 0x5b, 0x6b, 0xbc, 0x57, 0xdf, 0x3d, 0xda, 0xa8, 0xf4, 0xb3, 0x1d, 0xc8, 0xa0, 0xf5,
-	0x47, 0x09, 0x34, 0xb6, 0x3d, 0x0f, 0x51, 0xba, 0x17, 0x50, 0xd6, 0xcf, 0xf4, 0x07, 0x40, 0xf3,
-	0x86, 0x30, 0x88, 0x06, 0x81, 0x2f, 0x9a, 0x57, 0xeb, 0xdd, 0xf8, 0x57, 0xd5, 0x56, 0x6f, 0xf2,
-	0xd5, 0x77, 0x76, 0x9e, 0x4e, 0xcd, 0xaa, 0x27, 0x87, 0x6e, 0x3e, 0xf0, 0x17, 0xb4, 0x14, 0xcf,
-	0xa4, 0xa5, 0xf4, 0xdf, 0x69, 0x51, 0x9f, 0x4f, 0x4b, 0x79, 0x95, 0x96, 0xca, 0xf9, 0xd1, 0x52,
-	0x3d, 0x41, 0xcb, 0x03, 0xa0, 0x41, 0xd1, 0x5b, 0x44, 0x0d, 0xad, 0x53, 0xea, 0xd6, 0x37, 0x2f,
-	0xdb, 0xcf, 0x1e, 0x65, 0x5b, 0x76, 0xbf, 0x3f, 0x8e, 0x47, 0xa8, 0xd7, 0x79, 0x3c, 0x35, 0x0b,
-	0x4f, 0xa7, 0x26, 0x80, 0xc7, 0x94, 0x7c, 0xf5, 0x8b, 0x09, 0x16, 0x04, 0xb9, 0xc7, 0x80, 0x92,
-	0xf3, 0xda, 0x12, 0xe7, 0x60, 0x89, 0xf3, 0xfa, 0x59, 0x9c, 0x7f, 0xab, 0x82, 0xc6, 0xce, 0x24,
-	0x82, 0x61, 0xe0, 0xdd, 0x42, 0xe8, 0xe5, 0x70, 0x7e, 0x17, 0xd4, 0x39, 0xe7, 0x2c, 0x88, 0x07,
-	0x1e, 0x8c, 0x5f, 0x80, 0x75, 0x2e, 0x99, 0x7e, 0x10, 0xdf, 0x84, 0xf1, 0x1c, 0xeb, 0x10, 0x21,
-	0x81, 0xa5, 0xbe, 0x10, 0xd6, 0x2d, 0x84, 0x38, 0x56, 0x2e, 0xa1, 0xf2, 0xf3, 0x25, 0x54, 0x59,
-	0x95, 0x50, 0xf5, 0xfc, 0x24, 0xa4, 0x9d, 0x21, 0xa1, 0xda, 0xff, 0x22, 0x21, 0xb0, 0x24, 0xa1,
-	0xfa, 0x92, 0x84, 0x1a, 0x67, 0x49, 0xc8, 0x02, 0xad, 0xdd, 0x8c, 0xa1, 0x88, 0x06, 0x24, 0x7a,
-	0x2f, 0x16, 0xaf, 0xc2, 0x2e, 0xaf, 0x0a, 0x8d, 0xc3, 0x7e, 0x96, 0x5f, 0xc8, 0x5f, 0x2a, 0xe0,
-	0xe2, 0x3e, 0xc5, 0x8b, 0x79, 0x17, 0xd1, 0x98, 0x44, 0x54, 0x6c, 0x54, 0xdc, 0xf2, 0x8a, 0xbc,
-	0xc4, 0xc5, 0xc5, 0x7e, 0x05, 0xa8, 0x23, 0x82, 0xa9, 0x51, 0x14, 0x9b, 0xbc, 0xb8, 0xba, 0xc9,
-	0x3d, 0x82, 0x5d, 0x11, 0xa2, 0x5f, 0x00, 0xa5, 0x04, 0x31, 0xa1, 0x99, 0x86, 0xcb, 0x87, 0x7a,
-	0x13, 0x68, 0x69, 0x38, 0x40, 0x49, 0x42, 0x92, 0xfc, 0xd6, 0xad, 0xa6, 0xe1, 0x2e, 0x37, 0xb9,
-	0x8b, 0x8b, 0x63, 0x4c, 0x91, 0x2f, 0x59, 0x75, 0xab, 0x18, 0xd2, 0xfb, 0x14, 0xf9, 0x79, 0x99,
-	0x9f, 0x29, 0xe0, 0x95, 0x7d, 0x8a, 0xef, 0xc7, 0x3e, 0x64, 0xe8, 0x1e, 0x4c, 0x60, 0x48, 0xf5,
-	0xeb, 0xa0, 0x06, 0xc7, 0x6c, 0x48, 0x92, 0x80, 0x4d, 0xf2, 0x13, 0x61, 0x7c, 0xff, 0x68, 0x63,
-	0x3d, 0x7f, 0x4f, 0xb7, 0x7d, 0x3f, 0x41, 0x94, 0xbe, 0xcf, 0x92, 0x20, 0xc2, 0xee, 0x22, 0x54,
-	0xbf, 0x0e, 0x2a, 0xb1, 0x40, 0x10, 0x62, 0xaf, 0x6f, 0x1a, 0xab, 0xdb, 0x90, 0x19, 0x7a, 0x2a,
-	0xa7, 0xc9, 0xcd, 0xa3, 0xb7, 0xd6, 0x1e, 0xfe, 0xfe, 0xf5, 0xd5, 0x05, 0x8e, 0xd5, 0x04, 0x97,
-	0x9e, 0x29, 0x69, 0xde, 0xbb, 0xcd, 0x9f, 0x15, 0x50, 0xda, 0xa7, 0x58, 0xff, 0x18, 0x68, 0xc7,
-	0xef, 0xeb, 0x29, 0x92, 0x38, 0xf1, 0xfc, 0xb6, 0xde, 0x3a, 0xd5, 0xbd, 0xca, 0x8b, 0xf5, 0xc6,
-	0xc3, 0x1f, 0x7e, 0xfb, 0xbc, 0x78, 0xd9, 0x7a, 0xd5, 0x59, 0xf9, 0x55, 0x18, 0x0a, 0xb0, 0x01,
-	0xcb, 0xf4, 0x0f, 0x41, 0x63, 0xa9, 0x57, 0xaf, 0x9f, 0x8a, 0x7e, 0x32, 0xa4, 0x75, 0xe5, 0x6f,
-	0x43, 0xe6, 0x25, 0xf4, 0x6e, 0x3c, 0x9e, 0xb5, 0x95, 0x27, 0xb3, 0xb6, 0xf2, 0xeb, 0xac, 0xad,
-	0x7c, 0x7a, 0xd4, 0x2e, 0x3c, 0x39, 0x6a, 0x17, 0x7e, 0x3c, 0x6a, 0x17, 0x3e, 0x38, 0x79, 0xac,
-	0x50, 0xca, 0x4f, 0xd5, 0xa2, 0xc8, 0x4c, 0x94, 0x29, 0x8e, 0xd6, 0x41, 0x45, 0xfc, 0x56, 0xbc,
-	0xfd, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0x38, 0x66, 0xae, 0x50, 0x09, 0x00, 0x00,
+	// 1086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x1b, 0xff, 0x78, 0x76, 0xd3, 0x7c, 0xe7, 0x9b, 0x2a, 0x1b, 0xd3, 0xda, 0x61,
+	0x41, 0x90, 0x46, 0xc4, 0x56, 0x03, 0xea, 0x21, 0xa7, 0xc6, 0xcd, 0x0f, 0x5a, 0x25, 0xa2, 0x5a,
+	0xdc, 0x4b, 0x8b, 0x64, 0x4d, 0xd6, 0xe3, 0xf1, 0x0a, 0xef, 0xce, 0x6a, 0x67, 0xbc, 0xac, 0x39,
+	0xf6, 0xc4, 0x11, 0xc4, 0x89, 0x1b, 0x07, 0x4e, 0x9c, 0x90, 0xe8, 0x1f, 0xc0, 0xb1, 0xe2, 0x54,
+	0xc1, 0x05, 0x71, 0x30, 0x28, 0x41, 0x42, 0xea, 0x0d, 0xfe, 0x02, 0x34, 0xb3, 0x6b, 0x3b, 0x8e,
+	0x93, 0xfe, 0x08, 0x05, 0x4e, 0x3b, 0x6f, 0xdf, 0x67, 0xde, 0x7b, 0xfb, 0x3e, 0x9f, 0x37, 0x3b,
+	0xb0, 0x44, 0x44, 0x87, 0x04, 0xae, 0xe3, 0x89, 0x1a, 0x09, 0xdd, 0x5a, 0x78, 0xad, 0x26, 0xa2,
+	0xaa, 0x1f, 0x30, 0xc1, 0xd0, 0xfc, 0xc8, 0x55, 0x25, 0xa1, 0x5b, 0x0d, 0xaf, 0x95, 0x16, 0x6d,
+	0xc6, 0x5d, 0xc6, 0x6b, 0x2e, 0xa7, 0x12, 0xe9, 0x72, 0x1a, 0x43, 0x4b, 0x4b, 0xb1, 0xa3, 0xa9,
+	0xac, 0x5a, 0x6c, 0x24, 0xae, 0xd2, 0x54, 0x02, 0x19, 0x2c, 0xf6, 0x2d, 0x50, 0x46, 0x59, 0xbc,
+	0x47, 0xae, 0x92, 0xb7, 0x97, 0x29, 0x63, 0xb4, 0x4b, 0x6a, 0xd8, 0x77, 0x6a, 0xd8, 0xf3, 0x98,
+	0xc0, 0xc2, 0x61, 0xde, 0x30, 0xde, 0x52, 0xe2, 0x55, 0xd6, 0x41, 0xaf, 0x5d, 0xc3, 0x5e, 0x3f,
+	0x76, 0x99, 0x01, 0x14, 0xf6, 0x39, 0x7d, 0x17, 0x7b, 0xad, 0x2e, 0x69, 0x44, 0x68, 0x05, 0xf4,
+	0x16, 0x16, 0xd8, 0xd0, 0x96, 0xb5, 0x95, 0xc2, 0xfa, 0x42, 0x35, 0xde, 0x58, 0x1d, 0x6e, 0xac,
+	0x6e, 0x7a, 0x7d, 0x4b, 0x21, 0x50, 0x05, 0xf4, 0x0e, 0xe6, 0x1d, 0x23, 0xbd, 0xac, 0xad, 0xe4,
+	0xeb, 0x85, 0x3f, 0x07, 0x95, 0x6c, 0xd0, 0xf5, 0x37, 0xcc, 0x35, 0xd3, 0x52, 0x0e, 0x84, 0x40,
+	0x6f, 0x07, 0xcc, 0x35, 0x74, 0x09, 0xb0, 0xd4, 0x7a, 0x43, 0xff, 0xe4, 0xcb, 0xca, 0x8c, 0xf9,
+	0x6d, 0x0a, 0x72, 0x7b, 0x84, 0x62, 0xbb, 0xdf, 0x88, 0xd0, 0x02, 0xcc, 0x7a, 0xcc, 0xb3, 0x89,
+	0x4a, 0xa9, 0x5b, 0xb1, 0x81, 0x76, 0x21, 0x4f, 0xb1, 0xec, 0x8d, 0x63, 0x13, 0x23, 0xa5, 0x52,
+	0xac, 0xfe, 0x3c, 0xa8, 0xbc, 0x41, 0x1d, 0xd1, 0xe9, 0x1d, 0x54, 0x6d, 0xe6, 0x26, 0x1d, 0x4b,
+	0x1e, 0x6b, 0xbc, 0xf5, 0x61, 0x4d, 0xf4, 0x7d, 0xc2, 0xab, 0xb7, 0x3c, 0x61, 0xe5, 0x28, 0xe6,
+	0x77, 0xe4, 0x5e, 0x54, 0x86, 0x34, 0xc5, 0x5c, 0x55, 0xa9, 0xd7, 0x8b, 0x87, 0x83, 0x4a, 0x6e,
+	0x17, 0xf3, 0x3d, 0xc7, 0x75, 0x84, 0x25, 0x1d, 0x68, 0x0e, 0x52, 0x82, 0x25, 0x35, 0xa6, 0x04,
+	0x43, 0xb7, 0x61, 0x36, 0xc4, 0xdd, 0x1e, 0x31, 0x66, 0x55, 0xd2, 0x77, 0x9e, 0x3f, 0xe9, 0xe1,
+	0xa0, 0x92, 0xd9, 0x74, 0x59, 0xcf, 0x13, 0x56, 0x1c, 0x42, 0x76, 0x40, 0x35, 0x33, 0xb3, 0xac,
+	0xad, 0x14, 0x93, 0xb6, 0x15, 0x41, 0x0b, 0x8d, 0xac, 0x7a, 0xa1, 0x85, 0xd2, 0x0a, 0x8c, 0x5c,
+	0x6c, 0x05, 0xd2, 0xe2, 0x46, 0x3e, 0xb6, 0xf8, 0xc6, 0x9c, 0xec, 0xd5, 0xf7, 0x0f, 0xd7, 0x32,
+	0x8d, 0x68, 0x0b, 0x0b, 0x6c, 0xfe, 0x91, 0x86, 0xe2, 0xa6, 0x6d, 0x13, 0xce, 0xf7, 0x1c, 0x2e,
+	0x1a, 0x11, 0xba, 0x0f, 0x39, 0xbb, 0x83, 0x1d, 0xaf, 0xe9, 0xb4, 0x54, 0xf3, 0xf2, 0xf5, 0x1b,
+	0x2f, 0x54, 0x6d, 0xf6, 0xa6, 0xdc, 0x7d, 0x6b, 0xeb, 0xc9, 0xa0, 0x92, 0xb5, 0xe3, 0xa5, 0x95,
+	0x2c, 0x5a, 0x63, 0x5a, 0x52, 0x67, 0xd2, 0x92, 0xfe, 0xfb, 0xb4, 0xe8, 0x4f, 0xa7, 0x65, 0x76,
+	0x9a, 0x96, 0xcc, 0xcb, 0xa3, 0x25, 0x7b, 0x8c, 0x96, 0xfb, 0x90, 0xc3, 0xaa, 0xb7, 0x84, 0x1b,
+	0xb9, 0xe5, 0xf4, 0x4a, 0x61, 0xfd, 0x4a, 0xf5, 0xe4, 0x28, 0x57, 0xe3, 0xee, 0x37, 0x7a, 0x7e,
+	0x97, 0xd4, 0x97, 0x1f, 0x0d, 0x2a, 0x33, 0x4f, 0x06, 0x15, 0xc0, 0x23, 0x4a, 0xbe, 0xfe, 0xa5,
+	0x02, 0x63, 0x82, 0xac, 0x51, 0xc0, 0x98, 0xf3, 0xfc, 0x04, 0xe7, 0x30, 0xc1, 0x79, 0xe1, 0x2c,
+	0xce, 0xbf, 0xd3, 0xa1, 0xb8, 0xd5, 0xf7, 0xb0, 0xeb, 0xd8, 0x3b, 0x84, 0xfc, 0x37, 0x9c, 0xdf,
+	0x86, 0x82, 0xe4, 0x5c, 0x38, 0x7e, 0xd3, 0xc6, 0xfe, 0x39, 0x58, 0x97, 0x92, 0x69, 0x38, 0xfe,
+	0x4d, 0xec, 0x0f, 0x63, 0xb5, 0x09, 0x51, 0xb1, 0xf4, 0x73, 0xc5, 0xda, 0x21, 0x44, 0xc6, 0x4a,
+	0x24, 0x34, 0xfb, 0x74, 0x09, 0x65, 0xa6, 0x25, 0x94, 0x7d, 0x79, 0x12, 0xca, 0x9d, 0x21, 0xa1,
+	0xfc, 0x3f, 0x22, 0x21, 0x98, 0x90, 0x50, 0x61, 0x42, 0x42, 0xc5, 0xb3, 0x24, 0x64, 0x42, 0x69,
+	0x3b, 0x12, 0xc4, 0xe3, 0x0e, 0xf3, 0xde, 0xf3, 0xd5, 0x5f, 0x61, 0x5b, 0x56, 0x45, 0x7a, 0x6e,
+	0x23, 0x4a, 0x0e, 0xe4, 0x2f, 0x52, 0x70, 0x69, 0x9f, 0xd3, 0xf1, 0x7b, 0x8b, 0x70, 0x9f, 0x79,
+	0x5c, 0x7d, 0xa8, 0x3a, 0xe5, 0xb5, 0xf8, 0x10, 0x97, 0x6b, 0x74, 0x15, 0xf4, 0x2e, 0xa3, 0xdc,
+	0x48, 0xa9, 0x8f, 0xbc, 0x34, 0xfd, 0x91, 0x7b, 0x8c, 0x5a, 0x0a, 0x82, 0xe6, 0x21, 0x1d, 0x10,
+	0xa1, 0x34, 0x53, 0xb4, 0xe4, 0x12, 0x2d, 0x41, 0x2e, 0x74, 0x9b, 0x24, 0x08, 0x58, 0x90, 0x9c,
+	0xba, 0xd9, 0xd0, 0xdd, 0x96, 0xa6, 0x74, 0x49, 0x71, 0xf4, 0x38, 0x69, 0xc5, 0xac, 0x5a, 0x59,
+	0x8a, 0xf9, 0x5d, 0x4e, 0x5a, 0xe8, 0x1e, 0xfc, 0x9f, 0xb4, 0xdb, 0xc4, 0x16, 0x4e, 0x48, 0x9a,
+	0xe3, 0x13, 0x28, 0xf3, 0xc2, 0xfa, 0xf9, 0xdf, 0x28, 0xcc, 0xee, 0xf0, 0x28, 0x5a, 0x84, 0xac,
+	0x88, 0x9a, 0x12, 0xa2, 0x94, 0x71, 0xc1, 0xca, 0x88, 0xa8, 0xd1, 0xf7, 0x49, 0xd2, 0x9b, 0xcf,
+	0x34, 0xb8, 0xb8, 0xcf, 0xe9, 0x5d, 0xbf, 0x85, 0x05, 0xb9, 0x83, 0x03, 0xec, 0x72, 0x74, 0x1d,
+	0xf2, 0xb8, 0x27, 0x3a, 0x2c, 0x70, 0x44, 0x3f, 0x19, 0x43, 0xe3, 0x87, 0x87, 0x6b, 0x0b, 0xc9,
+	0x4f, 0x7c, 0xb3, 0xd5, 0x0a, 0x08, 0xe7, 0xef, 0x8b, 0xc0, 0xf1, 0xa8, 0x35, 0x86, 0xa2, 0xeb,
+	0x90, 0xf1, 0x55, 0x04, 0x35, 0x61, 0x85, 0x75, 0x63, 0xba, 0x77, 0x71, 0x86, 0xba, 0x2e, 0xb5,
+	0x61, 0x25, 0xe8, 0x8d, 0xb9, 0x07, 0xbf, 0x7f, 0xb3, 0x3a, 0x8e, 0x63, 0x2e, 0xc1, 0xe2, 0x89,
+	0x92, 0x86, 0x84, 0x99, 0x02, 0xe6, 0xf7, 0x39, 0xdd, 0x09, 0x08, 0xf9, 0x98, 0x6c, 0xda, 0xb6,
+	0x14, 0xed, 0xb9, 0xcb, 0x35, 0x20, 0x8b, 0x63, 0x5f, 0xfc, 0x0b, 0xb6, 0x86, 0xe6, 0x54, 0x41,
+	0x25, 0x30, 0x4e, 0x66, 0x1d, 0x55, 0x14, 0x02, 0x92, 0xc5, 0x7a, 0xed, 0x7f, 0xb9, 0xa6, 0xcb,
+	0x50, 0x9a, 0xce, 0x3b, 0xac, 0x6a, 0xfd, 0xab, 0x34, 0xa4, 0xf7, 0x39, 0x45, 0x1f, 0x41, 0x6e,
+	0x74, 0xf9, 0x39, 0x65, 0x5e, 0x8f, 0xdd, 0x8d, 0x4a, 0x6f, 0x9e, 0xea, 0x9e, 0x1e, 0x1a, 0xf3,
+	0xb5, 0x07, 0x3f, 0xfe, 0xf6, 0x79, 0xea, 0x8a, 0xf9, 0x4a, 0x6d, 0xea, 0x1e, 0xd7, 0x51, 0xc1,
+	0x9a, 0x22, 0x42, 0x1f, 0x40, 0x71, 0x42, 0x53, 0xaf, 0x9e, 0x1a, 0xfd, 0x38, 0xa4, 0x74, 0xf5,
+	0x99, 0x90, 0xd1, 0xdc, 0x36, 0xe1, 0xc2, 0xa4, 0x06, 0xcc, 0x53, 0xf7, 0x4e, 0x60, 0x4a, 0xab,
+	0xcf, 0xc6, 0x8c, 0x12, 0x10, 0xb8, 0x78, 0x92, 0xd2, 0xd7, 0x4f, 0x2f, 0x6f, 0x12, 0x55, 0x7a,
+	0xeb, 0x79, 0x50, 0xc3, 0x34, 0xf5, 0x1b, 0x8f, 0x0e, 0xcb, 0xda, 0xe3, 0xc3, 0xb2, 0xf6, 0xeb,
+	0x61, 0x59, 0xfb, 0xf4, 0xa8, 0x3c, 0xf3, 0xf8, 0xa8, 0x3c, 0xf3, 0xd3, 0x51, 0x79, 0xe6, 0xde,
+	0xf1, 0x89, 0x27, 0xa1, 0x1c, 0xf8, 0x71, 0xb3, 0x23, 0xd5, 0x6e, 0x35, 0xf5, 0x07, 0x19, 0x75,
+	0x77, 0x7d, 0xfb, 0xaf, 0x01, 0x00, 0xd7, 0xbd, 0xdd, 0x74, 0xb5, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -532,6 +737,14 @@ type MsgClient interface {
 	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
 	// account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// FreezeAccount defines a governance operation for freezing an externally
+	// owned account, blocking the transactions sent from it. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	FreezeAccount(ctx context.Context, in *MsgFreezeAccount, opts ...grpc.CallOption) (*MsgFreezeAccountResponse, error)
+	// UnfreezeAccount defines a governance operation for unfreezing a frozen
+	// account. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UnfreezeAccount(ctx context.Context, in *MsgUnfreezeAccount, opts ...grpc.CallOption) (*MsgUnfreezeAccountResponse, error)
 }
 
 type msgClient struct {
@@ -560,6 +773,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) FreezeAccount(ctx context.Context, in *MsgFreezeAccount, opts ...grpc.CallOption) (*MsgFreezeAccountResponse, error) {
+	out := new(MsgFreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/FreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeAccount(ctx context.Context, in *MsgUnfreezeAccount, opts ...grpc.CallOption) (*MsgUnfreezeAccountResponse, error) {
+	out := new(MsgUnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/UnfreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// HandleTx defines a method submitting Ethereum transactions.
@@ -568,6 +799,14 @@ type MsgServer interface {
 	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
 	// account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// FreezeAccount defines a governance operation for freezing an externally
+	// owned account, blocking the transactions sent from it. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	FreezeAccount(context.Context, *MsgFreezeAccount) (*MsgFreezeAccountResponse, error)
+	// UnfreezeAccount defines a governance operation for unfreezing a frozen
+	// account. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UnfreezeAccount(context.Context, *MsgUnfreezeAccount) (*MsgUnfreezeAccountResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -580,6 +819,12 @@ func (*UnimplementedMsgServer) HandleTx(ctx context.Context, req *MsgHandleTx) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) FreezeAccount(ctx context.Context, req *MsgFreezeAccount) (*MsgFreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeAccount(ctx context.Context, req *MsgUnfreezeAccount) (*MsgUnfreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -621,6 +866,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/FreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeAccount(ctx, req.(*MsgFreezeAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/UnfreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeAccount(ctx, req.(*MsgUnfreezeAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -633,6 +914,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _Msg_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _Msg_UnfreezeAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1070,6 +1359,23 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TxType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EffectiveGasPrice != nil {
+		{
+			size := m.EffectiveGasPrice.Size()
+			i -= size
+			if _, err := m.EffectiveGasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -1176,50 +1482,170 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgFreezeAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgHandleTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgFreezeAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LegacyTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFreezeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgHandleTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LegacyTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.GasLimit != 0 {
 		n += 1 + sovTx(uint64(m.GasLimit))
@@ -1397,6 +1823,13 @@ func (m *MsgEthereumTxResponse) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovTx(uint64(m.GasUsed))
 	}
+	if m.EffectiveGasPrice != nil {
+		l = m.EffectiveGasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TxType != 0 {
+		n += 1 + sovTx(uint64(m.TxType))
+	}
 	return n
 }
 
@@ -1424,6 +1857,58 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFreezeAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2970,6 +3455,61 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.EffectiveGasPrice = &v
+			if err := m.EffectiveGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3156,6 +3696,334 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFreezeAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
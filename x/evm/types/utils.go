@@ -36,6 +36,10 @@ var DefaultPriorityReduction = sdk.DefaultPowerReduction
 
 var EmptyCodeHash = crypto.Keccak256(nil)
 
+// Uint256Size is the size of fixed-width big-endian encoding of 256-bit values (balances, block numbers)
+// passed between Connector and SGXVM.
+const Uint256Size = 32
+
 // DecodeTxResponse decodes an protobuf-encoded byte slice into TxResponse
 func DecodeTxResponse(in []byte) (*MsgEthereumTxResponse, error) {
 	var txMsgData sdk.TxMsgData
@@ -116,3 +120,24 @@ func BinSearch(lo, hi uint64, executable func(uint64) (bool, *MsgEthereumTxRespo
 func EffectiveGasPrice(baseFee *big.Int, feeCap *big.Int, tipCap *big.Int) *big.Int {
 	return math.BigMin(new(big.Int).Add(tipCap, baseFee), feeCap)
 }
+
+// EncodeUint256 encodes the value as fixed-width 32-byte big-endian, so leading zeros are never stripped.
+// Returns an error if the value is negative or doesn't fit into 256 bits.
+func EncodeUint256(value *big.Int) ([]byte, error) {
+	if value == nil {
+		return make([]byte, Uint256Size), nil
+	}
+	if value.Sign() < 0 || value.BitLen() > 256 {
+		return nil, fmt.Errorf("value %s doesn't fit into uint256", value)
+	}
+	return math.PaddedBigBytes(value, Uint256Size), nil
+}
+
+// DecodeUint256 decodes big-endian encoded 256-bit value. Values shorter than 32 bytes are accepted
+// for compatibility with encodings with stripped leading zeros.
+func DecodeUint256(bz []byte) (*big.Int, error) {
+	if len(bz) > Uint256Size {
+		return nil, fmt.Errorf("value size %d exceeds uint256", len(bz))
+	}
+	return new(big.Int).SetBytes(bz), nil
+}
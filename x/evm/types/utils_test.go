@@ -4,6 +4,7 @@ import (
 	"errors"
 	"math/big"
 	"testing"
+	"testing/quick"
 
 	"github.com/SigmaGmbH/evm-module/app"
 	"github.com/SigmaGmbH/evm-module/encoding"
@@ -114,3 +115,34 @@ func TestTransactionLogsEncodeDecode(t *testing.T) {
 	require.Nil(t, decodeErr)
 	require.Equal(t, txLogs, txLogsEncodedDecoded)
 }
+
+func TestUint256Encoding(t *testing.T) {
+	roundTrip := func(limbs [4]uint64) bool {
+		value := new(big.Int)
+		for _, limb := range limbs {
+			value.Lsh(value, 64).Or(value, new(big.Int).SetUint64(limb))
+		}
+
+		bz, err := evmtypes.EncodeUint256(value)
+		if err != nil || len(bz) != evmtypes.Uint256Size {
+			return false
+		}
+
+		decoded, err := evmtypes.DecodeUint256(bz)
+		return err == nil && decoded.Cmp(value) == 0
+	}
+	require.NoError(t, quick.Check(roundTrip, nil))
+
+	stripped := func(value uint64) bool {
+		decoded, err := evmtypes.DecodeUint256(new(big.Int).SetUint64(value).Bytes())
+		return err == nil && decoded.Uint64() == value
+	}
+	require.NoError(t, quick.Check(stripped, nil))
+
+	_, err := evmtypes.EncodeUint256(new(big.Int).Lsh(big.NewInt(1), 256))
+	require.Error(t, err)
+	_, err = evmtypes.EncodeUint256(big.NewInt(-1))
+	require.Error(t, err)
+	_, err = evmtypes.DecodeUint256(make([]byte, evmtypes.Uint256Size+1))
+	require.Error(t, err)
+}
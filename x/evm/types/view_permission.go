@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmcommontypes "github.com/SigmaGmbH/evm-module/types"
+)
+
+// Validate performs a basic validation of the view permissions of a contract
+func (vp ViewPermissions) Validate() error {
+	if err := evmcommontypes.ValidateNonZeroAddress(vp.Contract); err != nil {
+		return err
+	}
+	if !vp.Restricted && len(vp.Viewers) == 0 {
+		return fmt.Errorf("view permissions of contract %s are the default", vp.Contract)
+	}
+
+	seen := make(map[common.Address]bool, len(vp.Viewers))
+	for _, viewer := range vp.Viewers {
+		if err := evmcommontypes.ValidateNonZeroAddress(viewer); err != nil {
+			return fmt.Errorf("invalid viewer %s: %w", viewer, err)
+		}
+		addr := common.HexToAddress(viewer)
+		if seen[addr] {
+			return fmt.Errorf("duplicated viewer %s", viewer)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// ValidateViewPermissions checks that view permissions are valid and set at most once per contract
+func ValidateViewPermissions(permissions []ViewPermissions) error {
+	seen := make(map[common.Address]bool, len(permissions))
+	for _, permission := range permissions {
+		if err := permission.Validate(); err != nil {
+			return fmt.Errorf("invalid view permissions of contract %s: %w", permission.Contract, err)
+		}
+		contract := common.HexToAddress(permission.Contract)
+		if seen[contract] {
+			return fmt.Errorf("duplicated view permissions of contract %s", permission.Contract)
+		}
+		seen[contract] = true
+	}
+
+	return nil
+}
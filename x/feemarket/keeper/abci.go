@@ -28,7 +28,7 @@ import (
 
 // BeginBlock updates base fee
 func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
-	baseFee := k.CalculateBaseFee(ctx)
+	baseFee, learningRate := k.calculateBaseFee(ctx)
 
 	// return immediately if base fee is nil
 	if baseFee == nil {
@@ -36,6 +36,10 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	}
 
 	k.SetBaseFee(ctx, baseFee)
+	if learningRate != nil {
+		k.SetAIMDLearningRate(ctx, *learningRate)
+	}
+	k.SetBlockBaseFee(ctx, ctx.BlockHeight(), baseFee)
 
 	defer func() {
 		telemetry.SetGauge(float32(baseFee.Int64()), "feemarket", "base_fee")
@@ -50,10 +54,12 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	})
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted and gas used and notifies the params listeners of any params change.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) {
+	defer k.NotifyParamsListeners(ctx)
+
 	if ctx.BlockGasMeter() == nil {
 		k.Logger(ctx).Error("block gas meter is nil when setting block gas wanted")
 		return
@@ -80,6 +86,11 @@ func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) {
 	updatedGasWanted := sdk.MaxDec(limitedGasWanted, sdk.NewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	// the gas used by the EVM transactions already includes the minimum gas charged per tx, so
+	// it's used for the base fee calculation of the next block
+	evmGasUsed := k.GetTransientEvmGasUsed(ctx)
+	k.SetBlockGasUsed(ctx, evmGasUsed)
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
 	}()
@@ -88,5 +99,6 @@ func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) {
 		"block_gas",
 		sdk.NewAttribute("height", fmt.Sprintf("%d", ctx.BlockHeight())),
 		sdk.NewAttribute("amount", fmt.Sprintf("%d", updatedGasWanted)),
+		sdk.NewAttribute("evm_gas_used", fmt.Sprintf("%d", evmGasUsed)),
 	))
 }
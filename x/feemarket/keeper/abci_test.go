@@ -2,23 +2,27 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
+	feemarkettypes "github.com/SigmaGmbH/evm-module/x/feemarket/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/abci/types"
 )
 
 func (suite *KeeperTestSuite) TestEndBlock() {
 	testCases := []struct {
-		name         string
-		NoBaseFee    bool
-		malleate     func()
-		expGasWanted uint64
+		name          string
+		NoBaseFee     bool
+		malleate      func()
+		expGasWanted  uint64
+		expEvmGasUsed uint64
 	}{
 		{
 			"baseFee nil",
 			true,
 			func() {},
 			uint64(0),
+			uint64(0),
 		},
 		{
 			"pass",
@@ -27,8 +31,11 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 				meter := sdk.NewGasMeter(uint64(1000000000))
 				suite.ctx = suite.ctx.WithBlockGasMeter(meter)
 				suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(suite.ctx, 5000000)
+				_, err := suite.app.FeeMarketKeeper.AddTransientEvmGasUsed(suite.ctx, 2100000)
+				suite.Require().NoError(err)
 			},
 			uint64(2500000),
+			uint64(2100000),
 		},
 	}
 	for _, tc := range testCases {
@@ -42,6 +49,30 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 			suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{Height: 1})
 			gasWanted := suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx)
 			suite.Require().Equal(tc.expGasWanted, gasWanted, tc.name)
+			evmGasUsed, _ := suite.app.FeeMarketKeeper.GetBlockGasUsed(suite.ctx)
+			suite.Require().Equal(tc.expEvmGasUsed, evmGasUsed, tc.name)
 		})
 	}
 }
+
+type paramsRecorder struct {
+	changes []feemarkettypes.Params
+}
+
+func (r *paramsRecorder) OnParamsChanged(_ int64, prev, next feemarkettypes.Params) {
+	r.changes = append(r.changes, prev, next)
+}
+
+func (suite *KeeperTestSuite) TestParamsListeners() {
+	recorder := &paramsRecorder{}
+	suite.app.FeeMarketKeeper.AddParamsListener(recorder)
+
+	// the first end block only records the current params
+	suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
+	suite.Require().Empty(recorder.changes)
+
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(12345))
+	suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
+	suite.Require().Len(recorder.changes, 2)
+	suite.Require().Equal(int64(12345), recorder.changes[1].BaseFee.Int64())
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/SigmaGmbH/evm-module/x/feemarket/types"
+)
+
+// calculateAIMDLearningRate returns the learning rate of the current block. The learning rate
+// increases additively by alpha when the parent block utilization is outside of the
+// [gamma, 1 - gamma] target range, so the base fee converges faster under bursty load, and
+// decreases multiplicatively by beta otherwise, so the base fee is stable under steady load.
+func calculateAIMDLearningRate(aimd types.AIMDParams, parentGasWanted uint64, gasLimit *big.Int) sdk.Dec {
+	utilization := sdk.OneDec()
+	if gasLimit.Sign() > 0 {
+		utilization = sdk.NewDecFromBigInt(new(big.Int).SetUint64(parentGasWanted)).
+			Quo(sdk.NewDecFromBigInt(gasLimit))
+	}
+
+	if utilization.LTE(aimd.Gamma) || utilization.GTE(sdk.OneDec().Sub(aimd.Gamma)) {
+		return sdk.MinDec(aimd.MaxLearningRate, aimd.LearningRate.Add(aimd.Alpha))
+	}
+
+	return sdk.MaxDec(aimd.MinLearningRate, aimd.LearningRate.Mul(aimd.Beta))
+}
+
+// calculateAIMDBaseFee calculates the base fee of the current block from the parent block:
+// parentBaseFee * (1 + learningRate * (parentGasWanted - parentGasTarget) / parentGasTarget)
+func calculateAIMDBaseFee(
+	parentBaseFee *big.Int,
+	parentGasWanted, parentGasTarget uint64,
+	learningRate sdk.Dec,
+	minGasPrice *big.Int,
+) *big.Int {
+	// If gas used == gas target or there is no target, base fee remains unchanged
+	if parentGasWanted == parentGasTarget || parentGasTarget == 0 {
+		return parentBaseFee
+	}
+
+	gasDelta := new(big.Int).Sub(new(big.Int).SetUint64(parentGasWanted), new(big.Int).SetUint64(parentGasTarget))
+	utilizationDelta := sdk.NewDecFromBigInt(gasDelta).Quo(sdk.NewDecFromBigInt(new(big.Int).SetUint64(parentGasTarget)))
+
+	baseFee := sdk.NewDecFromBigInt(parentBaseFee).
+		Mul(sdk.OneDec().Add(learningRate.Mul(utilizationDelta))).
+		TruncateInt().
+		BigInt()
+
+	// If gas used > gas target, base fee increases by at least 1
+	if parentGasWanted > parentGasTarget {
+		return math.BigMax(baseFee, new(big.Int).Add(parentBaseFee, common.Big1))
+	}
+
+	return math.BigMax(baseFee, minGasPrice)
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/SigmaGmbH/evm-module/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestCalculateAIMDBaseFee() {
+	testCases := []struct {
+		name                 string
+		learningRate         sdk.Dec
+		parentBlockGasWanted uint64
+		minGasPrice          sdk.Dec
+		expFee               *big.Int
+		expLearningRate      sdk.Dec
+	}{
+		{
+			"parent block wanted the same gas as its target",
+			types.DefaultAIMDLearningRate,
+			50,
+			sdk.ZeroDec(),
+			big.NewInt(1000000000),
+			sdk.NewDecWithPrec(11875, 5),
+		},
+		{
+			"parent block was full",
+			types.DefaultAIMDLearningRate,
+			100,
+			sdk.ZeroDec(),
+			big.NewInt(1150000000),
+			sdk.NewDecWithPrec(15, 2),
+		},
+		{
+			"parent block was full, learning rate at max",
+			types.DefaultAIMDMaxLearningRate,
+			100,
+			sdk.ZeroDec(),
+			big.NewInt(1500000000),
+			types.DefaultAIMDMaxLearningRate,
+		},
+		{
+			"parent block utilization below gamma",
+			types.DefaultAIMDLearningRate,
+			25,
+			sdk.ZeroDec(),
+			big.NewInt(925000000),
+			sdk.NewDecWithPrec(15, 2),
+		},
+		{
+			"parent block utilization below gamma, with higher min gas price",
+			types.DefaultAIMDLearningRate,
+			25,
+			sdk.NewDec(1500000000),
+			big.NewInt(1500000000),
+			sdk.NewDecWithPrec(15, 2),
+		},
+		{
+			"parent block utilization within target range",
+			types.DefaultAIMDLearningRate,
+			40,
+			sdk.ZeroDec(),
+			big.NewInt(976250000),
+			sdk.NewDecWithPrec(11875, 5),
+		},
+		{
+			"parent block utilization within target range, learning rate at min",
+			types.DefaultAIMDMinLearningRate,
+			60,
+			sdk.ZeroDec(),
+			big.NewInt(1002000000),
+			types.DefaultAIMDMinLearningRate,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.BaseFeeAlgorithm = types.BaseFeeAlgorithmAIMD
+			params.Aimd = types.DefaultAIMDParams()
+			params.Aimd.LearningRate = tc.learningRate
+			params.MinGasPrice = tc.minGasPrice
+			params.EnableHeight = 0
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			suite.ctx = suite.ctx.WithBlockHeight(1)
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, tc.parentBlockGasWanted)
+
+			blockParams := abci.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			consParams := abci.ConsensusParams{Block: &blockParams}
+			suite.ctx = suite.ctx.WithConsensusParams(&consParams)
+
+			fee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+			suite.Require().Equal(tc.expFee, fee)
+
+			// the learning rate is only updated in BeginBlock
+			suite.Require().Equal(tc.learningRate, suite.app.FeeMarketKeeper.GetParams(suite.ctx).Aimd.LearningRate)
+
+			suite.app.FeeMarketKeeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+
+			params = suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			suite.Require().Equal(tc.expFee, params.BaseFee.BigInt())
+			suite.Require().Equal(tc.expLearningRate, params.Aimd.LearningRate)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEIP1559KeepsAIMDLearningRate() {
+	suite.SetupTest()
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(types.BaseFeeAlgorithmEIP1559, params.BaseFeeAlgorithm)
+	params.EnableHeight = 0
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, 100)
+	suite.ctx = suite.ctx.WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 100, MaxBytes: 10}})
+
+	suite.app.FeeMarketKeeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+
+	params = suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(big.NewInt(1125000000), params.BaseFee.BigInt())
+	suite.Require().Equal(types.DefaultAIMDLearningRate, params.Aimd.LearningRate)
+}
@@ -20,6 +20,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/SigmaGmbH/evm-module/x/feemarket/types"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 )
@@ -29,11 +31,19 @@ import (
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
+	baseFee, _ := k.calculateBaseFee(ctx)
+	return baseFee
+}
+
+// calculateBaseFee calculates the base fee for the current block with the base fee algorithm
+// selected in the parameters. It also returns the learning rate for the current block if the
+// AIMD algorithm is selected, nil otherwise.
+func (k Keeper) calculateBaseFee(ctx sdk.Context) (*big.Int, *sdk.Dec) {
 	params := k.GetParams(ctx)
 
 	// Ignore the calculation if not enabled
 	if !params.IsBaseFeeEnabled(ctx.BlockHeight()) {
-		return nil
+		return nil, nil
 	}
 
 	consParams := ctx.ConsensusParams()
@@ -42,7 +52,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
 	if ctx.BlockHeight() == params.EnableHeight {
-		return params.BaseFee.BigInt()
+		return params.BaseFee.BigInt(), nil
 	}
 
 	// get the block gas used and the base fee values for the parent block.
@@ -51,10 +61,16 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// persistent KVStore after EndBlock (ABCI Commit).
 	parentBaseFee := params.BaseFee.BigInt()
 	if parentBaseFee == nil {
-		return nil
+		return nil, nil
+	}
+
+	// use the gas used by the EVM transactions of the parent block when it has been recorded,
+	// otherwise fall back to the block gas wanted
+	parentGasWanted, found := k.GetBlockGasUsed(ctx)
+	if !found {
+		parentGasWanted = k.GetBlockGasWanted(ctx)
 	}
 
-	parentGasWanted := k.GetBlockGasWanted(ctx)
 	gasLimit := new(big.Int).SetUint64(math.MaxUint64)
 
 	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
@@ -66,10 +82,31 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// validation
 	parentGasTargetBig := new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
 	if !parentGasTargetBig.IsUint64() {
-		return nil
+		return nil, nil
 	}
 
 	parentGasTarget := parentGasTargetBig.Uint64()
+
+	if params.BaseFeeAlgorithm == types.BaseFeeAlgorithmAIMD {
+		// CONTRACT: the AIMD params are set if the AIMD algorithm is selected, as it's checked
+		// in the params validation
+		learningRate := calculateAIMDLearningRate(*params.Aimd, parentGasWanted, gasLimit)
+		baseFee := calculateAIMDBaseFee(
+			parentBaseFee, parentGasWanted, parentGasTarget, learningRate, params.MinGasPrice.TruncateInt().BigInt(),
+		)
+		return baseFee, &learningRate
+	}
+
+	return calculateEIP1559BaseFee(params, parentBaseFee, parentGasWanted, parentGasTarget), nil
+}
+
+// calculateEIP1559BaseFee calculates the base fee of the current block from the parent block
+// as defined by EIP-1559.
+func calculateEIP1559BaseFee(
+	params types.Params,
+	parentBaseFee *big.Int,
+	parentGasWanted, parentGasTarget uint64,
+) *big.Int {
 	baseFeeChangeDenominator := new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator))
 
 	// If gas used == gas target, base fee remains unchanged
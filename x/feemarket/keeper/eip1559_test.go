@@ -114,3 +114,28 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCalculateBaseFeeFromBlockGasUsed() {
+	suite.SetupTest() // reset
+
+	// Set block height
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	// Set next block target/gasLimit through Consensus Param MaxGas
+	blockParams := abci.BlockParams{
+		MaxGas:   100,
+		MaxBytes: 10,
+	}
+	consParams := abci.ConsensusParams{Block: &blockParams}
+	suite.ctx = suite.ctx.WithConsensusParams(&consParams)
+
+	// the block gas wanted is used until the gas used is recorded
+	suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, 25)
+	fee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+	suite.Require().Equal(big.NewInt(937500000), fee)
+
+	// the recorded gas used of the parent block takes precedence over the gas wanted
+	suite.app.FeeMarketKeeper.SetBlockGasUsed(suite.ctx, 100)
+	fee = suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+	suite.Require().Equal(big.NewInt(1125000000), fee)
+}
@@ -21,6 +21,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/SigmaGmbH/evm-module/x/feemarket/types"
 )
@@ -61,7 +63,33 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		return nil, errorsmod.Wrapf(sdk.ErrIntOverflowCoin, "block gas %s is higher than MaxInt64", gas)
 	}
 
+	evmGasUsed, _ := k.GetBlockGasUsed(ctx)
+
 	return &types.QueryBlockGasResponse{
-		Gas: gas.Int64(),
+		Gas:        gas.Int64(),
+		EvmGasUsed: evmGasUsed,
+	}, nil
+}
+
+// BlockBaseFee implements the Query/BlockBaseFee gRPC method
+func (k Keeper) BlockBaseFee(c context.Context, req *types.QueryBlockBaseFeeRequest) (*types.QueryBlockBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block height %d", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	baseFee, found := k.GetBlockBaseFee(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "base fee not found for block %d", req.Height)
+	}
+
+	aux := sdkmath.NewIntFromBigInt(baseFee)
+	return &types.QueryBlockBaseFeeResponse{
+		BaseFee: &aux,
 	}, nil
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/SigmaGmbH/evm-module/x/feemarket/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -87,8 +88,9 @@ func (suite *KeeperTestSuite) TestQueryBlockGas() {
 		},
 	}
 	for _, tc := range testCases {
+		suite.app.FeeMarketKeeper.SetBlockGasUsed(suite.ctx, 21000)
 		gas := suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx)
-		exp := &types.QueryBlockGasResponse{Gas: int64(gas)}
+		exp := &types.QueryBlockGasResponse{Gas: int64(gas), EvmGasUsed: 21000}
 
 		res, err := suite.queryClient.BlockGas(suite.ctx.Context(), &types.QueryBlockGasRequest{})
 		if tc.expPass {
@@ -99,3 +101,64 @@ func (suite *KeeperTestSuite) TestQueryBlockGas() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryBlockBaseFee() {
+	var (
+		req    *types.QueryBlockBaseFeeRequest
+		expRes *types.QueryBlockBaseFeeResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid height",
+			func() {
+				req = &types.QueryBlockBaseFeeRequest{Height: 0}
+			},
+			false,
+		},
+		{
+			"fail - base fee not stored",
+			func() {
+				req = &types.QueryBlockBaseFeeRequest{Height: suite.ctx.BlockHeight() + 100}
+			},
+			false,
+		},
+		{
+			"pass - base fee stored in begin block",
+			func() {
+				suite.app.FeeMarketKeeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+				baseFee := sdkmath.NewIntFromBigInt(suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx))
+
+				req = &types.QueryBlockBaseFeeRequest{Height: suite.ctx.BlockHeight()}
+				expRes = &types.QueryBlockBaseFeeResponse{BaseFee: &baseFee}
+			},
+			true,
+		},
+		{
+			"pass - previous block base fee",
+			func() {
+				baseFee := sdkmath.NewInt(1000)
+				suite.app.FeeMarketKeeper.SetBlockBaseFee(suite.ctx, 1, baseFee.BigInt())
+
+				req = &types.QueryBlockBaseFeeRequest{Height: 1}
+				expRes = &types.QueryBlockBaseFeeResponse{BaseFee: &baseFee}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		tc.malleate()
+
+		res, err := suite.queryClient.BlockBaseFee(suite.ctx.Context(), req)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(expRes, res, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -16,8 +16,10 @@
 package keeper
 
 import (
+	"fmt"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,6 +43,8 @@ type Keeper struct {
 	authority sdk.AccAddress
 	// Legacy subspace
 	ss paramstypes.Subspace
+	// node-local listeners notified when the params change
+	paramsNotifier *paramsNotifier
 }
 
 // NewKeeper generates new fee market module keeper
@@ -53,11 +57,12 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		authority:    authority,
-		transientKey: transientKey,
-		ss:           ss,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		authority:      authority,
+		transientKey:   transientKey,
+		ss:             ss,
+		paramsNotifier: &paramsNotifier{},
 	}
 }
 
@@ -90,6 +95,52 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// SetBlockGasUsed sets the gas used by the EVM transactions of the last block to the store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixBlockGasUsed, sdk.Uint64ToBigEndian(gas))
+}
+
+// GetBlockGasUsed returns the gas used by the EVM transactions of the last block from the store.
+// It returns false if it wasn't recorded yet, i.e. before the first EndBlock after the upgrade.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixBlockGasUsed)
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetBlockBaseFee stores the base fee of the block at the given height.
+// CONTRACT: this should be only called during BeginBlock.
+func (k Keeper) SetBlockBaseFee(ctx sdk.Context, height int64, baseFee *big.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := sdkmath.NewIntFromBigInt(baseFee).Marshal()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal base fee of block %d: %w", height, err))
+	}
+	store.Set(types.BlockBaseFeeKey(height), bz)
+}
+
+// GetBlockBaseFee returns the base fee of the block at the given height.
+// It returns false if no base fee was stored for that block.
+func (k Keeper) GetBlockBaseFee(ctx sdk.Context, height int64) (*big.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockBaseFeeKey(height))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	var baseFee sdkmath.Int
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("failed to unmarshal base fee of block %d: %w", height, err))
+	}
+	return baseFee.BigInt(), true
+}
+
 // GetTransientGasWanted returns the gas wanted in the current block from transient store.
 func (k Keeper) GetTransientGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.TransientStore(k.transientKey)
@@ -114,6 +165,30 @@ func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64
 	return result, nil
 }
 
+// GetTransientEvmGasUsed returns the gas used by the EVM transactions in the current block from
+// transient store.
+func (k Keeper) GetTransientEvmGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientEvmGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddTransientEvmGasUsed adds the gas used by an EVM transaction to the cumulative gas used in the
+// transient store
+func (k Keeper) AddTransientEvmGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, error) {
+	result := k.GetTransientEvmGasUsed(ctx) + gasUsed
+	if result < gasUsed {
+		return 0, fmt.Errorf("transient evm gas used overflow: %d + %d", k.GetTransientEvmGasUsed(ctx), gasUsed)
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientEvmGasUsed, sdk.Uint64ToBigEndian(result))
+	return result, nil
+}
+
 // GetBaseFeeV1 get the base fee from v1 version of states.
 // return nil if base fee is not enabled
 // TODO: Figure out if this will be deleted ?
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	_ "embed"
+	"math"
 	"math/big"
 	"testing"
 	"time"
@@ -189,6 +190,38 @@ func (suite *KeeperTestSuite) TestSetGetBlockGasWanted() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSetGetBlockGasUsed() {
+	_, found := suite.app.FeeMarketKeeper.GetBlockGasUsed(suite.ctx)
+	suite.Require().False(found)
+
+	suite.app.FeeMarketKeeper.SetBlockGasUsed(suite.ctx, uint64(0))
+	gas, found := suite.app.FeeMarketKeeper.GetBlockGasUsed(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(0), gas)
+
+	suite.app.FeeMarketKeeper.SetBlockGasUsed(suite.ctx, uint64(1000000))
+	gas, found = suite.app.FeeMarketKeeper.GetBlockGasUsed(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1000000), gas)
+}
+
+func (suite *KeeperTestSuite) TestAddTransientEvmGasUsed() {
+	suite.Require().Equal(uint64(0), suite.app.FeeMarketKeeper.GetTransientEvmGasUsed(suite.ctx))
+
+	total, err := suite.app.FeeMarketKeeper.AddTransientEvmGasUsed(suite.ctx, 21000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(21000), total)
+
+	total, err = suite.app.FeeMarketKeeper.AddTransientEvmGasUsed(suite.ctx, 50000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(71000), total)
+	suite.Require().Equal(uint64(71000), suite.app.FeeMarketKeeper.GetTransientEvmGasUsed(suite.ctx))
+
+	_, err = suite.app.FeeMarketKeeper.AddTransientEvmGasUsed(suite.ctx, math.MaxUint64)
+	suite.Require().Error(err)
+	suite.Require().Equal(uint64(71000), suite.app.FeeMarketKeeper.GetTransientEvmGasUsed(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestSetGetGasFee() {
 	testCases := []struct {
 		name     string
@@ -211,3 +244,48 @@ func (suite *KeeperTestSuite) TestSetGetGasFee() {
 		suite.Require().Equal(tc.expFee, fee, tc.name)
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetGetBlockBaseFee() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		height   int64
+		expFee   *big.Int
+		expFound bool
+	}{
+		{
+			"not stored",
+			func() {},
+			1000,
+			nil,
+			false,
+		},
+		{
+			"zero base fee",
+			func() {
+				suite.app.FeeMarketKeeper.SetBlockBaseFee(suite.ctx, 1001, big.NewInt(0))
+			},
+			1001,
+			big.NewInt(0),
+			true,
+		},
+		{
+			"stored base fee",
+			func() {
+				suite.app.FeeMarketKeeper.SetBlockBaseFee(suite.ctx, 1002, big.NewInt(875000000))
+				suite.app.FeeMarketKeeper.SetBlockBaseFee(suite.ctx, 1003, big.NewInt(1))
+			},
+			1002,
+			big.NewInt(875000000),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.malleate()
+
+		fee, found := suite.app.FeeMarketKeeper.GetBlockBaseFee(suite.ctx, tc.height)
+		suite.Require().Equal(tc.expFound, found, tc.name)
+		suite.Require().Equal(tc.expFee, fee, tc.name)
+	}
+}
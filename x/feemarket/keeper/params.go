@@ -87,3 +87,16 @@ func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee *big.Int) {
 		return
 	}
 }
+
+// SetAIMDLearningRate sets the learning rate of the AIMD base fee algorithm in the store
+func (k Keeper) SetAIMDLearningRate(ctx sdk.Context, learningRate sdk.Dec) {
+	params := k.GetParams(ctx)
+	if params.Aimd == nil {
+		return
+	}
+
+	params.Aimd.LearningRate = learningRate
+	if err := k.SetParams(ctx, params); err != nil {
+		return
+	}
+}
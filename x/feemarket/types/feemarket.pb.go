@@ -24,6 +24,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeAlgorithm defines the algorithm used to adjust the base fee.
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee as defined by EIP-1559.
+	// It is the default algorithm.
+	BaseFeeAlgorithmEIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_AIMD adjusts the base fee with an additive increase
+	// multiplicative decrease learning rate.
+	BaseFeeAlgorithmAIMD BaseFeeAlgorithm = 1
+)
+
+var BaseFeeAlgorithm_name = map[int32]string{
+	0: "BASE_FEE_ALGORITHM_EIP1559",
+	1: "BASE_FEE_ALGORITHM_AIMD",
+}
+
+var BaseFeeAlgorithm_value = map[string]int32{
+	"BASE_FEE_ALGORITHM_EIP1559": 0,
+	"BASE_FEE_ALGORITHM_AIMD":    1,
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return proto.EnumName(BaseFeeAlgorithm_name, int32(x))
+}
+
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -45,6 +75,12 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// base_fee_algorithm selects the algorithm used to adjust the base fee
+	// between blocks.
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,9,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// aimd defines the parameters of the AIMD base fee algorithm. It is required
+	// if the AIMD algorithm is selected.
+	Aimd *AIMDParams `protobuf:"bytes,10,opt,name=aimd,proto3" json:"aimd,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -108,8 +144,80 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithmEIP1559
+}
+
+func (m *Params) GetAimd() *AIMDParams {
+	if m != nil {
+		return m.Aimd
+	}
+	return nil
+}
+
+// AIMDParams defines the parameters of the additive increase multiplicative
+// decrease (AIMD) base fee algorithm. The base fee changes proportionally to
+// the difference between the gas wanted and the gas target of the previous
+// block, scaled by a learning rate that adapts to the block utilization.
+type AIMDParams struct {
+	// alpha is the amount the learning rate increases by when the block
+	// utilization is outside of the target range.
+	Alpha github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=alpha,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"alpha"`
+	// beta is the factor the learning rate is multiplied by when the block
+	// utilization is within the target range.
+	Beta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=beta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"beta"`
+	// gamma bounds the target range of the block utilization, which is
+	// [gamma, 1 - gamma].
+	Gamma github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=gamma,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gamma"`
+	// min_learning_rate is the lower bound of the learning rate.
+	MinLearningRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_learning_rate,json=minLearningRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_learning_rate"`
+	// max_learning_rate is the upper bound of the learning rate.
+	MaxLearningRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_learning_rate,json=maxLearningRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_learning_rate"`
+	// learning_rate is the current learning rate. Like the base fee, it is
+	// updated every block.
+	LearningRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=learning_rate,json=learningRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"learning_rate"`
+}
+
+func (m *AIMDParams) Reset()         { *m = AIMDParams{} }
+func (m *AIMDParams) String() string { return proto.CompactTextString(m) }
+func (*AIMDParams) ProtoMessage()    {}
+func (*AIMDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *AIMDParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AIMDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AIMDParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AIMDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AIMDParams.Merge(m, src)
+}
+func (m *AIMDParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AIMDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AIMDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AIMDParams proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeAlgorithm", BaseFeeAlgorithm_name, BaseFeeAlgorithm_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*AIMDParams)(nil), "ethermint.feemarket.v1.AIMDParams")
 }
 
 func init() {
@@ -117,32 +225,46 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0x8a, 0xdb, 0x30,
-	0x14, 0xb4, 0x9a, 0xdd, 0xac, 0x57, 0xdb, 0x40, 0x10, 0xdb, 0x62, 0x5a, 0xf0, 0x9a, 0x16, 0x16,
-	0x1f, 0x5a, 0x9b, 0x65, 0xcf, 0xbd, 0xa4, 0x21, 0x6d, 0x0a, 0x85, 0xe0, 0x63, 0x29, 0x08, 0xd9,
-	0x79, 0xb1, 0x45, 0x2c, 0xc9, 0x58, 0x4a, 0x68, 0xfe, 0xa2, 0x9f, 0x95, 0x63, 0x4e, 0xa5, 0xf4,
-	0x10, 0x4a, 0xf2, 0x23, 0x25, 0x76, 0x62, 0xe7, 0xda, 0x3d, 0x49, 0x7a, 0x33, 0x9a, 0x79, 0xd2,
-	0x1b, 0x7c, 0x0f, 0x26, 0x83, 0x52, 0x70, 0x69, 0xc2, 0x19, 0x80, 0x60, 0xe5, 0x1c, 0x4c, 0xb8,
-	0x7c, 0x68, 0x0f, 0x41, 0x51, 0x2a, 0xa3, 0xc8, 0xcb, 0x86, 0x17, 0xb4, 0xd0, 0xf2, 0xe1, 0xd5,
-	0x6d, 0xaa, 0x52, 0x55, 0x51, 0xc2, 0xc3, 0xae, 0x66, 0xbf, 0xf9, 0xd5, 0xc1, 0xdd, 0x09, 0x2b,
-	0x99, 0xd0, 0xc4, 0xc5, 0x37, 0x52, 0xd1, 0x98, 0x69, 0xa0, 0x33, 0x00, 0x07, 0x79, 0xc8, 0xb7,
-	0xa3, 0x6b, 0xa9, 0x06, 0x4c, 0xc3, 0x08, 0x80, 0x7c, 0xc0, 0xaf, 0x4f, 0x20, 0x4d, 0x32, 0x26,
-	0x53, 0xa0, 0x53, 0x90, 0x4a, 0x70, 0xc9, 0x8c, 0x2a, 0x9d, 0x67, 0x1e, 0xf2, 0x7b, 0x91, 0x13,
-	0xd7, 0xec, 0x8f, 0x15, 0x61, 0xd8, 0xe2, 0xe4, 0x11, 0xbf, 0x80, 0x9c, 0x69, 0xc3, 0x13, 0x6e,
-	0x56, 0x54, 0x2c, 0x72, 0xc3, 0x8b, 0x9c, 0x43, 0xe9, 0x74, 0xaa, 0x8b, 0xb7, 0x2d, 0xf8, 0xb5,
-	0xc1, 0xc8, 0x5b, 0xdc, 0x03, 0xc9, 0xe2, 0x1c, 0x68, 0x06, 0x3c, 0xcd, 0x8c, 0x73, 0xe9, 0x21,
-	0xbf, 0x13, 0x3d, 0xaf, 0x8b, 0x9f, 0xab, 0x1a, 0x19, 0x63, 0xbb, 0xe9, 0xba, 0xeb, 0x21, 0xff,
-	0x7a, 0x10, 0xac, 0xb7, 0x77, 0xd6, 0x9f, 0xed, 0xdd, 0x7d, 0xca, 0x4d, 0xb6, 0x88, 0x83, 0x44,
-	0x89, 0x30, 0x51, 0x5a, 0x28, 0x7d, 0x5c, 0xde, 0xeb, 0xe9, 0x3c, 0x34, 0xab, 0x02, 0x74, 0x30,
-	0x96, 0x26, 0xba, 0x3a, 0x76, 0x4d, 0x22, 0xdc, 0x13, 0x5c, 0xd2, 0x94, 0x69, 0x5a, 0x94, 0x3c,
-	0x01, 0xe7, 0xea, 0xbf, 0xf5, 0x86, 0x90, 0x44, 0x37, 0x82, 0xcb, 0x4f, 0x4c, 0x4f, 0x0e, 0x12,
-	0xe4, 0x3b, 0x26, 0x27, 0xcd, 0xb3, 0x57, 0xdb, 0x4f, 0x12, 0xee, 0xd7, 0xc2, 0xed, 0x0f, 0x7d,
-	0xb9, 0xb0, 0x2f, 0xfa, 0x97, 0x51, 0x9f, 0x4b, 0x6e, 0x38, 0xcb, 0x9b, 0xf1, 0x0d, 0x46, 0xeb,
-	0x9d, 0x8b, 0x36, 0x3b, 0x17, 0xfd, 0xdd, 0xb9, 0xe8, 0xe7, 0xde, 0xb5, 0x36, 0x7b, 0xd7, 0xfa,
-	0xbd, 0x77, 0xad, 0x6f, 0xef, 0xce, 0xbc, 0x60, 0x79, 0xb0, 0x6a, 0x93, 0xf5, 0xe3, 0x2c, 0x5b,
-	0x95, 0x6b, 0xdc, 0xad, 0x72, 0xf2, 0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xce, 0xeb, 0x97,
-	0x7f, 0x02, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x5f, 0x4b, 0xdc, 0x4c,
+	0x14, 0xc6, 0x37, 0x6e, 0x76, 0x5d, 0x47, 0xf7, 0x7d, 0xd3, 0xc1, 0xd6, 0x61, 0x85, 0x18, 0x2c,
+	0x48, 0x28, 0x6d, 0x16, 0x15, 0x0b, 0xa5, 0xf4, 0x62, 0xb7, 0xbb, 0xea, 0x16, 0xa5, 0x12, 0x4b,
+	0x2f, 0xa4, 0x10, 0x26, 0xeb, 0x31, 0x19, 0xcc, 0x4c, 0x96, 0x64, 0x14, 0xfd, 0x06, 0x45, 0x7a,
+	0xd1, 0x2f, 0xe0, 0x55, 0xbf, 0x8c, 0x97, 0x5e, 0x96, 0x5e, 0x48, 0x51, 0x28, 0xf4, 0x5b, 0x94,
+	0x24, 0xfb, 0xcf, 0xc5, 0x5e, 0x34, 0x57, 0xbb, 0x99, 0xe7, 0x79, 0x7e, 0xcc, 0x99, 0x73, 0x38,
+	0x68, 0x05, 0xa4, 0x0f, 0x11, 0x67, 0x42, 0xd6, 0x8f, 0x00, 0x38, 0x8d, 0x8e, 0x41, 0xd6, 0x4f,
+	0x57, 0x47, 0x1f, 0x56, 0x2f, 0x0a, 0x65, 0x88, 0x9f, 0x0c, 0x7d, 0xd6, 0x48, 0x3a, 0x5d, 0xad,
+	0xcd, 0x7b, 0xa1, 0x17, 0xa6, 0x96, 0x7a, 0xf2, 0x2f, 0x73, 0x2f, 0xff, 0x52, 0x51, 0x79, 0x8f,
+	0x46, 0x94, 0xc7, 0x58, 0x47, 0xb3, 0x22, 0x74, 0x5c, 0x1a, 0x83, 0x73, 0x04, 0x40, 0x14, 0x43,
+	0x31, 0x2b, 0xf6, 0x8c, 0x08, 0x9b, 0x34, 0x86, 0x4d, 0x00, 0xfc, 0x06, 0x2d, 0x0e, 0x44, 0xa7,
+	0xeb, 0x53, 0xe1, 0x81, 0x73, 0x08, 0x22, 0xe4, 0x4c, 0x50, 0x19, 0x46, 0x64, 0xca, 0x50, 0xcc,
+	0xaa, 0x4d, 0xdc, 0xcc, 0xfd, 0x36, 0x35, 0xb4, 0x46, 0x3a, 0x5e, 0x47, 0x8f, 0x21, 0xa0, 0xb1,
+	0x64, 0x5d, 0x26, 0xcf, 0x1d, 0x7e, 0x12, 0x48, 0xd6, 0x0b, 0x18, 0x44, 0xa4, 0x98, 0x06, 0xe7,
+	0x47, 0xe2, 0xee, 0x50, 0xc3, 0x4f, 0x51, 0x15, 0x04, 0x75, 0x03, 0x70, 0x7c, 0x60, 0x9e, 0x2f,
+	0x49, 0xc9, 0x50, 0xcc, 0xa2, 0x3d, 0x97, 0x1d, 0x6e, 0xa7, 0x67, 0xb8, 0x83, 0x2a, 0xc3, 0x5b,
+	0x97, 0x0d, 0xc5, 0x9c, 0x69, 0x5a, 0x57, 0x37, 0x4b, 0x85, 0x1f, 0x37, 0x4b, 0x2b, 0x1e, 0x93,
+	0xfe, 0x89, 0x6b, 0x75, 0x43, 0x5e, 0xef, 0x86, 0x31, 0x0f, 0xe3, 0xfe, 0xcf, 0x8b, 0xf8, 0xf0,
+	0xb8, 0x2e, 0xcf, 0x7b, 0x10, 0x5b, 0x1d, 0x21, 0xed, 0xe9, 0xfe, 0xad, 0xb1, 0x8d, 0xaa, 0x9c,
+	0x09, 0xc7, 0xa3, 0xb1, 0xd3, 0x8b, 0x58, 0x17, 0xc8, 0xf4, 0x3f, 0xf3, 0x5a, 0xd0, 0xb5, 0x67,
+	0x39, 0x13, 0x5b, 0x34, 0xde, 0x4b, 0x10, 0xf8, 0x13, 0xc2, 0x03, 0xe6, 0x58, 0xd5, 0x95, 0x5c,
+	0x60, 0x2d, 0x03, 0x8f, 0xbd, 0xd0, 0x47, 0x84, 0x87, 0x5d, 0xa1, 0x81, 0x17, 0x46, 0x4c, 0xfa,
+	0x9c, 0xcc, 0x18, 0x8a, 0xf9, 0xdf, 0x9a, 0x69, 0x3d, 0x3c, 0x0b, 0x56, 0xbf, 0xa5, 0x8d, 0x81,
+	0xdf, 0xd6, 0xdc, 0x89, 0x13, 0xfc, 0x12, 0xa9, 0x94, 0xf1, 0x43, 0x82, 0x0c, 0xc5, 0x9c, 0x5d,
+	0x5b, 0xfe, 0x1b, 0xa9, 0xd1, 0xd9, 0x6d, 0x65, 0xf3, 0x63, 0xa7, 0xfe, 0x77, 0x6a, 0x45, 0xd5,
+	0x4a, 0xb6, 0xc6, 0x04, 0x93, 0x8c, 0x06, 0xc3, 0x71, 0x5a, 0xfe, 0x5d, 0x44, 0x68, 0x64, 0xc6,
+	0x2d, 0x54, 0xa2, 0x41, 0xcf, 0xa7, 0x44, 0xc9, 0xf5, 0x0e, 0x59, 0x18, 0x37, 0x91, 0xea, 0x82,
+	0xa4, 0x64, 0x2a, 0x17, 0x24, 0xcd, 0x26, 0x37, 0xf1, 0x28, 0xe7, 0x94, 0x14, 0x73, 0x41, 0xb2,
+	0x30, 0x3e, 0x40, 0x8f, 0x92, 0x26, 0x07, 0x40, 0x23, 0xc1, 0x84, 0xe7, 0x44, 0x54, 0x02, 0x51,
+	0x73, 0x11, 0xff, 0xe7, 0x4c, 0xec, 0xf4, 0x39, 0x36, 0x95, 0x90, 0xb2, 0xe9, 0xd9, 0x04, 0xbb,
+	0x94, 0x93, 0x4d, 0xcf, 0xee, 0xb1, 0xf7, 0x51, 0xf5, 0x3e, 0xb7, 0x9c, 0x8b, 0x3b, 0x17, 0x8c,
+	0x41, 0x9f, 0x7d, 0x51, 0x90, 0x36, 0x39, 0x62, 0xf8, 0x35, 0xaa, 0x35, 0x1b, 0xfb, 0x6d, 0x67,
+	0xb3, 0xdd, 0x76, 0x1a, 0x3b, 0x5b, 0xef, 0xed, 0xce, 0x87, 0xed, 0x5d, 0xa7, 0xdd, 0xd9, 0x5b,
+	0xdd, 0xd8, 0x78, 0xa5, 0x15, 0x6a, 0x8b, 0x17, 0x97, 0xc6, 0xc2, 0x64, 0xaa, 0x2f, 0xe3, 0x0d,
+	0xb4, 0xf0, 0x40, 0x38, 0x99, 0x27, 0x4d, 0xa9, 0x91, 0x8b, 0x4b, 0x63, 0x7e, 0x32, 0x99, 0x68,
+	0x35, 0xf5, 0xf3, 0x37, 0xbd, 0xd0, 0xdc, 0xbc, 0xba, 0xd5, 0x95, 0xeb, 0x5b, 0x5d, 0xf9, 0x79,
+	0xab, 0x2b, 0x5f, 0xef, 0xf4, 0xc2, 0xf5, 0x9d, 0x5e, 0xf8, 0x7e, 0xa7, 0x17, 0x0e, 0x9e, 0x8f,
+	0x95, 0x07, 0xa7, 0x49, 0x75, 0xa3, 0x25, 0x7b, 0x36, 0xb6, 0x66, 0xd3, 0x42, 0xdd, 0x72, 0xba,
+	0x32, 0xd7, 0xff, 0x0c, 0x00, 0x67, 0x94, 0x10, 0xe3, 0x8a, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -165,6 +287,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Aimd != nil {
+		{
+			size, err := m.Aimd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeemarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -223,6 +362,89 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AIMDParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AIMDParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AIMDParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxLearningRate.Size()
+		i -= size
+		if _, err := m.MaxLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinLearningRate.Size()
+		i -= size
+		if _, err := m.MinLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Gamma.Size()
+		i -= size
+		if _, err := m.Gamma.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Beta.Size()
+		i -= size
+		if _, err := m.Beta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Alpha.Size()
+		i -= size
+		if _, err := m.Alpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -258,6 +480,34 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	if m.Aimd != nil {
+		l = m.Aimd.Size()
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	return n
+}
+
+func (m *AIMDParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Alpha.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Beta.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Gamma.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.LearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
 	return n
 }
 
@@ -475,6 +725,315 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+			}
+			m.BaseFeeAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aimd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aimd == nil {
+				m.Aimd = &AIMDParams{}
+			}
+			if err := m.Aimd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AIMDParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AIMDParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AIMDParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -13,3 +13,10 @@ type (
 		GetParamSetIfExists(ctx sdk.Context, ps LegacyParams)
 	}
 )
+
+// ParamsListener is notified when the fee market params, including the base fee, change.
+// Listeners are node-local services and must not modify state: they are called at the end of
+// the block in which the change was committed, with the previously announced params.
+type ParamsListener interface {
+	OnParamsChanged(height int64, prev, next Params)
+}
@@ -15,6 +15,10 @@
 // along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -35,18 +39,29 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockBaseFee
+	prefixBlockGasUsed
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientEvmGasUsed
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockBaseFee   = []byte{prefixBlockBaseFee}
+	KeyPrefixBlockGasUsed   = []byte{prefixBlockGasUsed}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientEvmGasUsed     = []byte{prefixTransientEvmGasUsed}
 )
+
+// BlockBaseFeeKey returns the store key of the base fee for the given block height
+func BlockBaseFeeKey(height int64) []byte {
+	return append(KeyPrefixBlockBaseFee, sdk.Uint64ToBigEndian(uint64(height))...)
+}
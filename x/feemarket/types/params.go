@@ -33,6 +33,21 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeAlgorithm is EIP-1559
+	DefaultBaseFeeAlgorithm = BaseFeeAlgorithmEIP1559
+	// DefaultAIMDAlpha is 0.025
+	DefaultAIMDAlpha = sdk.NewDecWithPrec(25, 3)
+	// DefaultAIMDBeta is 0.95
+	DefaultAIMDBeta = sdk.NewDecWithPrec(95, 2)
+	// DefaultAIMDGamma is 0.25
+	DefaultAIMDGamma = sdk.NewDecWithPrec(25, 2)
+	// DefaultAIMDMinLearningRate is 0.01
+	DefaultAIMDMinLearningRate = sdk.NewDecWithPrec(1, 2)
+	// DefaultAIMDMaxLearningRate is 0.5
+	DefaultAIMDMaxLearningRate = sdk.NewDecWithPrec(50, 2)
+	// DefaultAIMDLearningRate is 0.125, which matches the maximum change of an
+	// EIP-1559 block with the default base fee change denominator
+	DefaultAIMDLearningRate = sdk.NewDecWithPrec(125, 3)
 )
 
 // Parameter keys
@@ -97,6 +112,20 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		Aimd:                     DefaultAIMDParams(),
+	}
+}
+
+// DefaultAIMDParams returns the default parameters of the AIMD base fee algorithm
+func DefaultAIMDParams() *AIMDParams {
+	return &AIMDParams{
+		Alpha:           DefaultAIMDAlpha,
+		Beta:            DefaultAIMDBeta,
+		Gamma:           DefaultAIMDGamma,
+		MinLearningRate: DefaultAIMDMinLearningRate,
+		MaxLearningRate: DefaultAIMDMaxLearningRate,
+		LearningRate:    DefaultAIMDLearningRate,
 	}
 }
 
@@ -118,7 +147,76 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if _, ok := BaseFeeAlgorithm_name[int32(p.BaseFeeAlgorithm)]; !ok {
+		return fmt.Errorf("invalid base fee algorithm: %d", p.BaseFeeAlgorithm)
+	}
+
+	if p.Aimd == nil {
+		if p.BaseFeeAlgorithm == BaseFeeAlgorithmAIMD {
+			return fmt.Errorf("aimd params are required by the AIMD base fee algorithm")
+		}
+		return nil
+	}
+
+	return p.Aimd.Validate()
+}
+
+// Validate performs basic validation on the AIMD base fee algorithm parameters.
+func (p AIMDParams) Validate() error {
+	for _, v := range []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{"alpha", p.Alpha},
+		{"beta", p.Beta},
+		{"gamma", p.Gamma},
+		{"min learning rate", p.MinLearningRate},
+		{"max learning rate", p.MaxLearningRate},
+		{"learning rate", p.LearningRate},
+	} {
+		if v.value.IsNil() {
+			return fmt.Errorf("aimd %s cannot be nil", v.name)
+		}
+		if v.value.IsNegative() {
+			return fmt.Errorf("aimd %s cannot be negative: %s", v.name, v.value)
+		}
+	}
+
+	if p.Alpha.GT(sdk.OneDec()) {
+		return fmt.Errorf("aimd alpha cannot be greater than 1: %s", p.Alpha)
+	}
+
+	if p.Beta.GT(sdk.OneDec()) {
+		return fmt.Errorf("aimd beta cannot be greater than 1: %s", p.Beta)
+	}
+
+	if p.Gamma.GT(sdk.NewDecWithPrec(5, 1)) {
+		return fmt.Errorf("aimd gamma cannot be greater than 0.5: %s", p.Gamma)
+	}
+
+	if p.MaxLearningRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("aimd max learning rate cannot be greater than 1: %s", p.MaxLearningRate)
+	}
+
+	if p.MinLearningRate.GT(p.MaxLearningRate) {
+		return fmt.Errorf(
+			"aimd min learning rate %s cannot be greater than the max learning rate %s",
+			p.MinLearningRate, p.MaxLearningRate,
+		)
+	}
+
+	if p.LearningRate.LT(p.MinLearningRate) || p.LearningRate.GT(p.MaxLearningRate) {
+		return fmt.Errorf(
+			"aimd learning rate %s must be between %s and %s",
+			p.LearningRate, p.MinLearningRate, p.MaxLearningRate,
+		)
+	}
+
+	return nil
 }
 
 func validateBool(i interface{}) error {
@@ -72,6 +72,83 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestParamsValidateBaseFeeAlgorithm() {
+	aimdParams := func(malleate func(p *AIMDParams)) *AIMDParams {
+		p := DefaultAIMDParams()
+		malleate(p)
+		return p
+	}
+
+	testCases := []struct {
+		name      string
+		algorithm BaseFeeAlgorithm
+		aimd      *AIMDParams
+		expError  bool
+	}{
+		{"valid: eip1559 without aimd params", BaseFeeAlgorithmEIP1559, nil, false},
+		{"valid: eip1559 with aimd params", BaseFeeAlgorithmEIP1559, DefaultAIMDParams(), false},
+		{"valid: aimd", BaseFeeAlgorithmAIMD, DefaultAIMDParams(), false},
+		{"invalid: unknown algorithm", BaseFeeAlgorithm(2), DefaultAIMDParams(), true},
+		{"invalid: aimd without aimd params", BaseFeeAlgorithmAIMD, nil, true},
+		{"invalid: eip1559 with invalid aimd params", BaseFeeAlgorithmEIP1559, &AIMDParams{}, true},
+		{
+			"invalid: negative alpha",
+			BaseFeeAlgorithmAIMD,
+			aimdParams(func(p *AIMDParams) { p.Alpha = sdk.NewDecWithPrec(-1, 2) }),
+			true,
+		},
+		{
+			"invalid: alpha bigger than 1",
+			BaseFeeAlgorithmAIMD,
+			aimdParams(func(p *AIMDParams) { p.Alpha = sdk.NewDec(2) }),
+			true,
+		},
+		{
+			"invalid: beta bigger than 1",
+			BaseFeeAlgorithmAIMD,
+			aimdParams(func(p *AIMDParams) { p.Beta = sdk.NewDecWithPrec(11, 1) }),
+			true,
+		},
+		{
+			"invalid: gamma bigger than 0.5",
+			BaseFeeAlgorithmAIMD,
+			aimdParams(func(p *AIMDParams) { p.Gamma = sdk.NewDecWithPrec(6, 1) }),
+			true,
+		},
+		{
+			"invalid: max learning rate bigger than 1",
+			BaseFeeAlgorithmAIMD,
+			aimdParams(func(p *AIMDParams) { p.MaxLearningRate = sdk.NewDec(2) }),
+			true,
+		},
+		{
+			"invalid: min learning rate bigger than max learning rate",
+			BaseFeeAlgorithmAIMD,
+			aimdParams(func(p *AIMDParams) { p.MinLearningRate = sdk.NewDecWithPrec(6, 1) }),
+			true,
+		},
+		{
+			"invalid: learning rate out of bounds",
+			BaseFeeAlgorithmAIMD,
+			aimdParams(func(p *AIMDParams) { p.LearningRate = sdk.NewDecWithPrec(6, 1) }),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.BaseFeeAlgorithm = tc.algorithm
+		params.Aimd = tc.aimd
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBool(2))
 	suite.Require().NoError(validateBool(true))
@@ -232,6 +232,8 @@ var xxx_messageInfo_QueryBlockGasRequest proto.InternalMessageInfo
 type QueryBlockGasResponse struct {
 	// gas is the returned block gas
 	Gas int64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	// evm_gas_used is the gas used by the EVM transactions of the last block
+	EvmGasUsed uint64 `protobuf:"varint,2,opt,name=evm_gas_used,json=evmGasUsed,proto3" json:"evm_gas_used,omitempty"`
 }
 
 func (m *QueryBlockGasResponse) Reset()         { *m = QueryBlockGasResponse{} }
@@ -274,6 +276,99 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+func (m *QueryBlockGasResponse) GetEvmGasUsed() uint64 {
+	if m != nil {
+		return m.EvmGasUsed
+	}
+	return 0
+}
+
+// QueryBlockBaseFeeRequest defines the request type for querying the base fee
+// of a given block.
+type QueryBlockBaseFeeRequest struct {
+	// height is the block height to query the base fee for
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlockBaseFeeRequest) Reset()         { *m = QueryBlockBaseFeeRequest{} }
+func (m *QueryBlockBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBlockBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBlockBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBlockBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockBaseFeeRequest proto.InternalMessageInfo
+
+func (m *QueryBlockBaseFeeRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBlockBaseFeeResponse returns the base fee of a given block.
+type QueryBlockBaseFeeResponse struct {
+	// base_fee is the EIP1559 base fee of the block
+	BaseFee *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee,omitempty"`
+}
+
+func (m *QueryBlockBaseFeeResponse) Reset()         { *m = QueryBlockBaseFeeResponse{} }
+func (m *QueryBlockBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBlockBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBlockBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBlockBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockBaseFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +376,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBlockBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryBlockBaseFeeRequest")
+	proto.RegisterType((*QueryBlockBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBlockBaseFeeResponse")
 }
 
 func init() {
@@ -288,35 +385,41 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xf5, 0x12, 0x48, 0xcb, 0x72, 0x41, 0x4b, 0x5a, 0x21, 0x0b, 0x6d, 0x83, 0x91, 0xa2, 0xb6,
-	0xb4, 0x5e, 0xb5, 0x5c, 0x39, 0x59, 0xa2, 0x88, 0x1b, 0x98, 0x1b, 0x12, 0xaa, 0xd6, 0x61, 0xea,
-	0x5a, 0xa9, 0xbd, 0xae, 0x77, 0x13, 0xd1, 0x2b, 0x37, 0x2e, 0x08, 0xc1, 0xd7, 0xf0, 0x07, 0x39,
-	0x46, 0xe2, 0x82, 0x38, 0x44, 0x28, 0xe1, 0x43, 0x90, 0x77, 0xd7, 0x49, 0x0c, 0x18, 0x72, 0xca,
-	0x6a, 0xf2, 0xe6, 0xbd, 0x37, 0x6f, 0xc6, 0xd8, 0x03, 0x75, 0x0e, 0x45, 0x9a, 0x64, 0x8a, 0x9d,
-	0x01, 0xa4, 0xbc, 0x18, 0x80, 0x62, 0xa3, 0x23, 0x76, 0x39, 0x84, 0xe2, 0xca, 0xcf, 0x0b, 0xa1,
-	0x04, 0xd9, 0x5e, 0x60, 0xfc, 0x05, 0xc6, 0x1f, 0x1d, 0xb9, 0x9d, 0x58, 0xc4, 0x42, 0x43, 0x58,
-	0xf9, 0x32, 0x68, 0xb7, 0xd7, 0xc0, 0xb8, 0x6c, 0x35, 0xb8, 0x7b, 0xb1, 0x10, 0xf1, 0x05, 0x30,
-	0x9e, 0x27, 0x8c, 0x67, 0x99, 0x50, 0x5c, 0x25, 0x22, 0x93, 0xe6, 0x5f, 0xaf, 0x83, 0xc9, 0x8b,
-	0xd2, 0xc2, 0x73, 0x5e, 0xf0, 0x54, 0x86, 0x70, 0x39, 0x04, 0xa9, 0xbc, 0x97, 0xf8, 0x4e, 0xad,
-	0x2a, 0x73, 0x91, 0x49, 0x20, 0x8f, 0x71, 0x3b, 0xd7, 0x95, 0xbb, 0xa8, 0x8b, 0x76, 0x6f, 0x1d,
-	0x53, 0xff, 0xef, 0x8e, 0x7d, 0xd3, 0x17, 0x5c, 0x1f, 0x4f, 0x77, 0x9c, 0xd0, 0xf6, 0x78, 0x5b,
-	0x96, 0x34, 0xe0, 0x12, 0x4e, 0x00, 0x2a, 0xad, 0xd7, 0xb8, 0x53, 0x2f, 0x5b, 0xb1, 0x27, 0x78,
-	0x33, 0xe2, 0x12, 0x4e, 0xcf, 0x00, 0xb4, 0xdc, 0xcd, 0x60, 0xff, 0xfb, 0x74, 0xa7, 0x17, 0x27,
-	0xea, 0x7c, 0x18, 0xf9, 0x7d, 0x91, 0xb2, 0xbe, 0x90, 0xa9, 0x90, 0xf6, 0xe7, 0x50, 0xbe, 0x19,
-	0x30, 0x75, 0x95, 0x83, 0xf4, 0x9f, 0x65, 0x2a, 0xdc, 0x88, 0x0c, 0x9d, 0xb7, 0x5d, 0xd1, 0x5f,
-	0x88, 0xfe, 0xe0, 0x29, 0x5f, 0x8c, 0xb8, 0x87, 0xb7, 0x7e, 0xab, 0x5b, 0xdd, 0xdb, 0xb8, 0x15,
-	0x73, 0x33, 0x61, 0x2b, 0x2c, 0x9f, 0xc7, 0x5f, 0x5a, 0xf8, 0x86, 0xc6, 0x92, 0xf7, 0x08, 0xb7,
-	0xcd, 0x6c, 0x64, 0xbf, 0x69, 0xf6, 0x3f, 0xe3, 0x74, 0x1f, 0xae, 0x85, 0x35, 0xfa, 0x5e, 0xef,
-	0xdd, 0xd7, 0x9f, 0x9f, 0xaf, 0x75, 0x09, 0x65, 0x0d, 0x0b, 0x36, 0x71, 0x92, 0x0f, 0x08, 0x6f,
-	0xd8, 0xcc, 0xc8, 0xbf, 0x05, 0xea, 0x81, 0xbb, 0x07, 0xeb, 0x81, 0xad, 0x9d, 0x5d, 0x6d, 0xc7,
-	0x23, 0xdd, 0x26, 0x3b, 0xd5, 0x92, 0xc8, 0x27, 0x84, 0x37, 0xab, 0x34, 0xc9, 0x7f, 0x44, 0xea,
-	0xcb, 0x70, 0x0f, 0xd7, 0x44, 0x5b, 0x4f, 0x7b, 0xda, 0xd3, 0x03, 0x72, 0xbf, 0xd1, 0x53, 0xd9,
-	0x71, 0x1a, 0x73, 0x19, 0x9c, 0x8c, 0x67, 0x14, 0x4d, 0x66, 0x14, 0xfd, 0x98, 0x51, 0xf4, 0x71,
-	0x4e, 0x9d, 0xc9, 0x9c, 0x3a, 0xdf, 0xe6, 0xd4, 0x79, 0x75, 0xb0, 0x72, 0x49, 0x30, 0x2a, 0x0f,
-	0x69, 0x49, 0xf6, 0x76, 0x85, 0x4e, 0xdf, 0x54, 0xd4, 0xd6, 0x9f, 0xcb, 0xa3, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x61, 0x3c, 0x39, 0xd8, 0xc8, 0x03, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0xb6, 0x21, 0x2d, 0x4b, 0x0f, 0x68, 0x49, 0xa3, 0x10, 0x21, 0x37, 0x18, 0x29, 0x4a,
+	0xbf, 0xbc, 0x24, 0x5c, 0x39, 0x45, 0xa2, 0x15, 0xe2, 0x02, 0x41, 0x5c, 0x90, 0x50, 0xb4, 0x4e,
+	0xa6, 0x8e, 0x95, 0xda, 0xeb, 0x7a, 0x37, 0x16, 0x15, 0xe2, 0xc2, 0x8d, 0x0b, 0x42, 0xf0, 0x1f,
+	0xf8, 0x2d, 0x3d, 0x56, 0xe2, 0x82, 0x38, 0x54, 0x28, 0xe1, 0x87, 0x20, 0x7b, 0x37, 0x1f, 0x2e,
+	0x35, 0x09, 0x52, 0x4f, 0x5e, 0x8f, 0xdf, 0xbc, 0xf7, 0x66, 0xf6, 0xc9, 0xd8, 0x04, 0xd9, 0x87,
+	0xd0, 0x73, 0x7d, 0x49, 0x8f, 0x00, 0x3c, 0x16, 0x0e, 0x40, 0xd2, 0xa8, 0x41, 0x4f, 0x86, 0x10,
+	0x9e, 0x5a, 0x41, 0xc8, 0x25, 0x27, 0xa5, 0x29, 0xc6, 0x9a, 0x62, 0xac, 0xa8, 0x51, 0x29, 0x3a,
+	0xdc, 0xe1, 0x09, 0x84, 0xc6, 0x27, 0x85, 0xae, 0xd4, 0x32, 0x18, 0x67, 0xad, 0x0a, 0x77, 0xcf,
+	0xe1, 0xdc, 0x39, 0x06, 0xca, 0x02, 0x97, 0x32, 0xdf, 0xe7, 0x92, 0x49, 0x97, 0xfb, 0x42, 0x7d,
+	0x35, 0x8b, 0x98, 0xbc, 0x88, 0x2d, 0x3c, 0x67, 0x21, 0xf3, 0x44, 0x1b, 0x4e, 0x86, 0x20, 0xa4,
+	0xf9, 0x12, 0xdf, 0x49, 0x55, 0x45, 0xc0, 0x7d, 0x01, 0xe4, 0x31, 0x2e, 0x04, 0x49, 0xa5, 0x8c,
+	0xaa, 0xa8, 0x7e, 0xab, 0x69, 0x58, 0x57, 0x3b, 0xb6, 0x54, 0x5f, 0x2b, 0x7f, 0x76, 0xb1, 0x95,
+	0x6b, 0xeb, 0x1e, 0x73, 0x53, 0x93, 0xb6, 0x98, 0x80, 0x03, 0x80, 0x89, 0xd6, 0x1b, 0x5c, 0x4c,
+	0x97, 0xb5, 0xd8, 0x13, 0xbc, 0x6e, 0x33, 0x01, 0x9d, 0x23, 0x80, 0x44, 0xee, 0x66, 0x6b, 0xe7,
+	0xe7, 0xc5, 0x56, 0xcd, 0x71, 0x65, 0x7f, 0x68, 0x5b, 0x5d, 0xee, 0xd1, 0x2e, 0x17, 0x1e, 0x17,
+	0xfa, 0xb1, 0x2f, 0x7a, 0x03, 0x2a, 0x4f, 0x03, 0x10, 0xd6, 0x53, 0x5f, 0xb6, 0xd7, 0x6c, 0x45,
+	0x67, 0x96, 0x26, 0xf4, 0xc7, 0xbc, 0x3b, 0x38, 0x64, 0xd3, 0x11, 0x9f, 0xe1, 0xcd, 0x4b, 0x75,
+	0xad, 0x7b, 0x1b, 0xaf, 0x3a, 0x4c, 0x4d, 0xb8, 0xda, 0x8e, 0x8f, 0xa4, 0x8a, 0x37, 0x20, 0xf2,
+	0x3a, 0x0e, 0x13, 0x9d, 0xa1, 0x80, 0x5e, 0x79, 0xa5, 0x8a, 0xea, 0xf9, 0x36, 0x86, 0xc8, 0x3b,
+	0x64, 0xe2, 0x95, 0x80, 0x9e, 0xd9, 0xc4, 0xe5, 0x19, 0x59, 0x7a, 0x3e, 0x52, 0xc2, 0x85, 0x3e,
+	0xb8, 0x4e, 0x5f, 0x6a, 0x4a, 0xfd, 0x66, 0xda, 0xf8, 0xee, 0x15, 0x3d, 0xd7, 0x3a, 0x7c, 0x73,
+	0x94, 0xc7, 0x37, 0x12, 0x11, 0xf2, 0x11, 0xe1, 0x82, 0xba, 0x15, 0xb2, 0x93, 0x75, 0x6b, 0x7f,
+	0x07, 0xa1, 0xb2, 0xbb, 0x14, 0x56, 0x99, 0x36, 0x6b, 0x1f, 0xbe, 0xff, 0xfe, 0xba, 0x52, 0x25,
+	0x06, 0xcd, 0x88, 0xa6, 0x0a, 0x02, 0xf9, 0x84, 0xf0, 0x9a, 0x1e, 0x98, 0xfc, 0x5b, 0x20, 0xbd,
+	0xca, 0xca, 0xde, 0x72, 0x60, 0x6d, 0xa7, 0x9e, 0xd8, 0x31, 0x49, 0x35, 0xcb, 0xce, 0x64, 0xc3,
+	0xe4, 0x0b, 0xc2, 0xeb, 0x93, 0x1c, 0x90, 0x05, 0x22, 0xe9, 0x18, 0x55, 0xf6, 0x97, 0x44, 0x6b,
+	0x4f, 0xdb, 0x89, 0xa7, 0x07, 0xe4, 0x7e, 0xa6, 0xa7, 0xb8, 0x23, 0x8e, 0x1a, 0xf9, 0x86, 0xf0,
+	0xc6, 0x7c, 0x36, 0xc8, 0xc3, 0xc5, 0x52, 0x97, 0xf6, 0xd5, 0xf8, 0x8f, 0x0e, 0x6d, 0xb0, 0x91,
+	0x18, 0xdc, 0x25, 0xdb, 0x8b, 0x96, 0x46, 0xdf, 0xa9, 0x1c, 0xbf, 0x6f, 0x1d, 0x9c, 0x8d, 0x0c,
+	0x74, 0x3e, 0x32, 0xd0, 0xaf, 0x91, 0x81, 0x3e, 0x8f, 0x8d, 0xdc, 0xf9, 0xd8, 0xc8, 0xfd, 0x18,
+	0x1b, 0xb9, 0xd7, 0x7b, 0x73, 0x79, 0x85, 0x28, 0x8e, 0xeb, 0x8c, 0xf4, 0xed, 0x1c, 0x6d, 0x92,
+	0x5c, 0xbb, 0x90, 0xfc, 0x91, 0x1e, 0xfd, 0x19, 0x00, 0x0d, 0x92, 0x14, 0x12, 0x2b, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +440,8 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BlockBaseFee queries the base fee of the block at a given height.
+	BlockBaseFee(ctx context.Context, in *QueryBlockBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlockBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -374,6 +479,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BlockBaseFee(ctx context.Context, in *QueryBlockBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlockBaseFeeResponse, error) {
+	out := new(QueryBlockBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BlockBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -382,6 +496,8 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BlockBaseFee queries the base fee of the block at a given height.
+	BlockBaseFee(context.Context, *QueryBlockBaseFeeRequest) (*QueryBlockBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -397,6 +513,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BlockBaseFee(ctx context.Context, req *QueryBlockBaseFeeRequest) (*QueryBlockBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -456,6 +575,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BlockBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockBaseFee(ctx, req.(*QueryBlockBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -472,6 +609,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BlockBaseFee",
+			Handler:    _Query_BlockBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -634,6 +775,11 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EvmGasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EvmGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Gas != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
 		i--
@@ -642,6 +788,69 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBlockBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -713,6 +922,34 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	if m.Gas != 0 {
 		n += 1 + sovQuery(uint64(m.Gas))
 	}
+	if m.EvmGasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.EvmGasUsed))
+	}
+	return n
+}
+
+func (m *QueryBlockBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1089,6 +1326,180 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGasUsed", wireType)
+			}
+			m.EvmGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
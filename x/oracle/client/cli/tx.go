@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/SigmaGmbH/evm-module/x/oracle/types"
+)
+
+// GetTxCmd returns the transaction commands for the oracle module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		NewPostPriceCmd(),
+	)
+	return cmd
+}
+
+// NewPostPriceCmd posts the price of an asset pair from a whitelisted feeder
+func NewPostPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "post-price PAIR PRICE",
+		Short: "Post the price of an asset pair, e.g. BTC/USD 27000.5",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
+			}
+
+			msg := &types.MsgPostPrice{
+				Feeder: clientCtx.GetFromAddress().String(),
+				Pair:   args[0],
+				Price:  price,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,36 @@
+package oracle
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/SigmaGmbH/evm-module/x/oracle/keeper"
+	"github.com/SigmaGmbH/evm-module/x/oracle/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	err := k.SetParams(ctx, data.Params)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	for _, price := range data.Prices {
+		k.SetPrice(ctx, price)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the oracle module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Prices: k.GetPrices(ctx),
+	}
+}
@@ -0,0 +1,11 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock pushes the prices posted in the block to the feed contract
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	// the gas of the pushes is bounded by the push gas limit and not charged to any account
+	k.PushPrices(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/SigmaGmbH/evm-module/x/oracle/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// Price implements the Query/Price gRPC method
+func (k Keeper) Price(c context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	price, found := k.GetPrice(ctx, req.Pair)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "price of pair %s not found", req.Pair)
+	}
+
+	return &types.QueryPriceResponse{
+		Price: price,
+	}, nil
+}
+
+// Prices implements the Query/Prices gRPC method
+func (k Keeper) Prices(c context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrice)
+
+	var prices []types.Price
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var price types.Price
+		if err := k.cdc.Unmarshal(value, &price); err != nil {
+			return err
+		}
+		prices = append(prices, price)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPricesResponse{
+		Prices:     prices,
+		Pagination: pageRes,
+	}, nil
+}
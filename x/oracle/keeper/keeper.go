@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/SigmaGmbH/evm-module/x/oracle/types"
+)
+
+// Keeper grants access to the oracle module state.
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// Store key required for the oracle Prefix KVStore.
+	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	evmKeeper types.EVMKeeper
+}
+
+// NewKeeper generates new oracle module keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	storeKey storetypes.StoreKey,
+	evmKeeper types.EVMKeeper,
+) Keeper {
+	// ensure authority account is correctly formatted
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+		evmKeeper: evmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// ModuleAddress returns the EVM address of the module, which pushes the prices to the
+// feed contract. The feed contract should only accept updates from this address.
+func (k Keeper) ModuleAddress() common.Address {
+	return common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/SigmaGmbH/evm-module/app"
+	"github.com/SigmaGmbH/evm-module/encoding"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+	"github.com/SigmaGmbH/evm-module/x/oracle/keeper"
+	"github.com/SigmaGmbH/evm-module/x/oracle/types"
+)
+
+// mockEVMKeeper records the pushed prices, pushes of the pair FAIL/USD revert
+type mockEVMKeeper struct {
+	pushes []map[string]interface{}
+}
+
+func (m *mockEVMKeeper) CallEVMWithData(
+	_ sdk.Context,
+	_ common.Address,
+	_ *common.Address,
+	data []byte,
+	_ uint64,
+	_ bool,
+) (*evmtypes.MsgEthereumTxResponse, common.Address, error) {
+	args := make(map[string]interface{})
+	if err := types.PriceFeedABI.Methods["setPrice"].Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+		return nil, common.Address{}, err
+	}
+	if args["pair"] == "FAIL/USD" {
+		return nil, common.Address{}, errors.New("execution reverted")
+	}
+
+	m.pushes = append(m.pushes, args)
+	return &evmtypes.MsgEthereumTxResponse{}, common.Address{}, nil
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	evm       *mockEVMKeeper
+	feeder    sdk.AccAddress
+	authority string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	suite.ctx = testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test")).WithBlockHeight(5)
+	suite.evm = &mockEVMKeeper{}
+
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+	suite.authority = govAddress.String()
+	suite.keeper = keeper.NewKeeper(encCfg.Codec, govAddress, storeKey, suite.evm)
+
+	suite.feeder = sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	suite.setParams(common.HexToAddress("0x2000000000000000000000000000000000000002").Hex())
+}
+
+func (suite *KeeperTestSuite) setParams(feedContract string) {
+	_, err := suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: suite.authority,
+		Params:    types.NewParams([]string{suite.feeder.String()}, feedContract, types.DefaultPushGasLimit),
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) postPrice(pair, price string) error {
+	_, err := suite.keeper.PostPrice(suite.ctx, &types.MsgPostPrice{
+		Feeder: suite.feeder.String(),
+		Pair:   pair,
+		Price:  sdk.MustNewDecFromStr(price),
+	})
+	return err
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	_, err := suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: "foobar"})
+	suite.Require().Error(err)
+
+	_, err = suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: suite.authority,
+		Params:    types.DefaultParams(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.keeper.GetParams(suite.ctx).Feeders)
+}
+
+func (suite *KeeperTestSuite) TestPostPrice() {
+	other := sdk.AccAddress(common.HexToAddress("0x3000000000000000000000000000000000000003").Bytes())
+	_, err := suite.keeper.PostPrice(suite.ctx, &types.MsgPostPrice{
+		Feeder: other.String(), Pair: "BTC/USD", Price: sdk.OneDec(),
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedFeeder)
+
+	suite.Require().NoError(suite.postPrice("BTC/USD", "27000.5"))
+	suite.Require().NoError(suite.postPrice("BTC/USD", "27001"))
+
+	price, found := suite.keeper.GetPrice(suite.ctx, "BTC/USD")
+	suite.Require().True(found)
+	suite.Require().Equal("27001.000000000000000000", price.Price.String())
+	suite.Require().Equal(suite.feeder.String(), price.Feeder)
+	suite.Require().Equal(int64(5), price.Height)
+	suite.Require().Len(suite.keeper.GetPrices(suite.ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockPushesPrices() {
+	suite.Require().NoError(suite.postPrice("BTC/USD", "27000.5"))
+	suite.Require().NoError(suite.postPrice("BTC/USD", "27001"))
+	suite.Require().NoError(suite.postPrice("ETH/USD", "1800"))
+	suite.Require().NoError(suite.postPrice("FAIL/USD", "1"))
+
+	suite.keeper.EndBlock(suite.ctx)
+
+	// every updated pair is pushed once with its latest price
+	suite.Require().Len(suite.evm.pushes, 2)
+	suite.Require().Equal("BTC/USD", suite.evm.pushes[0]["pair"])
+	expPrice, _ := new(big.Int).SetString("27001000000000000000000", 10)
+	suite.Require().Equal(expPrice, suite.evm.pushes[0]["price"])
+	suite.Require().Equal(uint64(5), suite.evm.pushes[0]["height"])
+	suite.Require().Equal("ETH/USD", suite.evm.pushes[1]["pair"])
+
+	var failed int
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypePushPriceFailed {
+			failed++
+		}
+	}
+	suite.Require().Equal(1, failed)
+
+	// failed pushes don't revert the price update
+	_, found := suite.keeper.GetPrice(suite.ctx, "FAIL/USD")
+	suite.Require().True(found)
+
+	// pairs are only pushed in the block they are updated in
+	suite.keeper.EndBlock(suite.ctx)
+	suite.Require().Len(suite.evm.pushes, 2)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockWithoutFeedContract() {
+	suite.setParams("")
+	suite.Require().NoError(suite.postPrice("BTC/USD", "27000.5"))
+
+	suite.keeper.EndBlock(suite.ctx)
+	suite.Require().Empty(suite.evm.pushes)
+
+	// the pending push is discarded, so setting the contract later doesn't push stale prices
+	suite.setParams(common.HexToAddress("0x2000000000000000000000000000000000000002").Hex())
+	suite.keeper.EndBlock(suite.ctx)
+	suite.Require().Empty(suite.evm.pushes)
+}
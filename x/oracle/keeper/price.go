@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/oracle/types"
+)
+
+// SetPrice stores the latest price of the pair
+func (k Keeper) SetPrice(ctx sdk.Context, price types.Price) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrice)
+	store.Set([]byte(price.Pair), k.cdc.MustMarshal(&price))
+}
+
+// GetPrice returns the latest price of the pair
+func (k Keeper) GetPrice(ctx sdk.Context, pair string) (types.Price, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrice)
+	bz := store.Get([]byte(pair))
+	if len(bz) == 0 {
+		return types.Price{}, false
+	}
+
+	var price types.Price
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+// GetPrices returns the latest prices of all pairs
+func (k Keeper) GetPrices(ctx sdk.Context) []types.Price {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPrice)
+	defer iterator.Close()
+
+	prices := []types.Price{}
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.Price
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// RecordPrice records the price posted by a whitelisted feeder and marks the pair to be
+// pushed to the feed contract at the end of the block
+func (k Keeper) RecordPrice(ctx sdk.Context, feeder sdk.AccAddress, pair string, price sdk.Dec) error {
+	if !k.GetParams(ctx).IsFeeder(feeder.String()) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedFeeder, "%s is not allowed to post prices", feeder)
+	}
+
+	k.SetPrice(ctx, types.Price{
+		Pair:   pair,
+		Price:  price,
+		Feeder: feeder.String(),
+		Height: ctx.BlockHeight(),
+	})
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingPush)
+	store.Set([]byte(pair), []byte{1})
+
+	return nil
+}
+
+// PushPrices pushes the prices updated in the current block to the feed contract. A failed
+// push doesn't revert the price update, it's reported through an event and the price is
+// pushed again with the next update of the pair.
+func (k Keeper) PushPrices(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingPush)
+	iterator := store.Iterator(nil, nil)
+
+	var pairs []string
+	for ; iterator.Valid(); iterator.Next() {
+		pairs = append(pairs, string(iterator.Key()))
+	}
+	iterator.Close()
+
+	params := k.GetParams(ctx)
+	for _, pair := range pairs {
+		store.Delete([]byte(pair))
+		if params.FeedContract == "" {
+			continue
+		}
+
+		price, found := k.GetPrice(ctx, pair)
+		if !found {
+			continue
+		}
+
+		if err := k.pushPrice(ctx, common.HexToAddress(params.FeedContract), params.PushGasLimit, price); err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePushPriceFailed,
+					sdk.NewAttribute(types.AttributeKeyPair, pair),
+					sdk.NewAttribute(types.AttributeKeyContract, params.FeedContract),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			k.Logger(ctx).Error("failed to push price", "pair", pair, "error", err)
+		}
+	}
+}
+
+// pushPrice calls the feed contract with the price scaled to 18 decimals. The state changes
+// of the call are discarded if it fails.
+func (k Keeper) pushPrice(ctx sdk.Context, contract common.Address, gasLimit uint64, price types.Price) error {
+	input, err := types.PriceFeedABI.Pack("setPrice", price.Pair, price.Price.BigInt(), uint64(price.Height))
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if _, _, err := k.evmKeeper.CallEVMWithData(cacheCtx, k.ModuleAddress(), &contract, input, gasLimit, true); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	k.Logger(ctx).Debug("pushed price", "pair", price.Pair, "height", price.Height)
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrUnauthorizedFeeder = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrPriceNotFound
+	codeErrInvalidPrice
+)
+
+var (
+	// ErrUnauthorizedFeeder returns an error if the account is not allowed to post prices
+	ErrUnauthorizedFeeder = errorsmod.Register(ModuleName, codeErrUnauthorizedFeeder, "unauthorized price feeder")
+
+	// ErrPriceNotFound returns an error if no price was posted for the pair
+	ErrPriceNotFound = errorsmod.Register(ModuleName, codeErrPriceNotFound, "price not found")
+
+	// ErrInvalidPrice returns an error if the pair or price are invalid
+	ErrInvalidPrice = errorsmod.Register(ModuleName, codeErrInvalidPrice, "invalid price")
+)
@@ -0,0 +1,13 @@
+package types
+
+// oracle events
+const (
+	EventTypePostPrice       = "post_price"
+	EventTypePushPriceFailed = "push_price_failed"
+
+	AttributeKeyPair     = "pair"
+	AttributeKeyPrice    = "price"
+	AttributeKeyFeeder   = "feeder"
+	AttributeKeyContract = "contract"
+	AttributeKeyError    = "error"
+)
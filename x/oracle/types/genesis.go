@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState sets default oracle genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Prices: []Price{},
+	}
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, prices []Price) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Prices: prices,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Prices))
+	for _, price := range gs.Prices {
+		if err := price.Validate(); err != nil {
+			return err
+		}
+		if seen[price.Pair] {
+			return fmt.Errorf("duplicate price of pair %s", price.Pair)
+		}
+		seen[price.Pair] = true
+	}
+
+	return gs.Params.Validate()
+}
+
+// Validate performs a stateless validation of the price
+func (p Price) Validate() error {
+	if err := ValidatePrice(p.Pair, p.Price); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Feeder); err != nil {
+		return fmt.Errorf("invalid feeder address %s: %w", p.Feeder, err)
+	}
+	if p.Height < 0 {
+		return fmt.Errorf("invalid height %d of pair %s", p.Height, p.Pair)
+	}
+
+	return nil
+}
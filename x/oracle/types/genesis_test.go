@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	feeder := sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes()).String()
+	price := Price{
+		Pair:   "BTC/USD",
+		Price:  sdk.MustNewDecFromStr("27000.5"),
+		Feeder: feeder,
+		Height: 10,
+	}
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid", NewGenesisState(NewParams([]string{feeder}, "", DefaultPushGasLimit), []Price{price}), true},
+		{"invalid params", NewGenesisState(NewParams(nil, "", 0), nil), false},
+		{"duplicate price", NewGenesisState(DefaultParams(), []Price{price, price}), false},
+		{
+			"invalid feeder",
+			NewGenesisState(DefaultParams(), []Price{func() Price {
+				p := price
+				p.Feeder = "invalid"
+				return p
+			}()}),
+			false,
+		},
+		{
+			"negative height",
+			NewGenesisState(DefaultParams(), []Price{func() Price {
+				p := price
+				p.Height = -1
+				return p
+			}()}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
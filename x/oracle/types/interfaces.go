@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// EVMKeeper defines the expected EVM keeper interface used to push prices to the feed contract
+type EVMKeeper interface {
+	CallEVMWithData(
+		ctx sdk.Context,
+		from common.Address,
+		contract *common.Address,
+		data []byte,
+		gasLimit uint64,
+		commit bool,
+	) (*evmtypes.MsgEthereumTxResponse, common.Address, error)
+}
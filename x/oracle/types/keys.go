@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName string name of module
+	ModuleName = "oracle"
+
+	// StoreKey key for the oracle store
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the oracle persistent store
+const (
+	prefixPrice = iota + 1
+	prefixPendingPush
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixPrice = []byte{prefixPrice}
+	// KeyPrefixPendingPush indexes the pairs updated in the current block, which are pushed
+	// to the price feed contract at the end of the block
+	KeyPrefixPendingPush = []byte{prefixPendingPush}
+	KeyPrefixParams      = []byte{prefixParams}
+)
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPairLength is the maximum length of an asset pair
+const MaxPairLength = 32
+
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// ValidatePrice returns an error if the pair isn't formatted as BASE/QUOTE or the price
+// isn't positive
+func ValidatePrice(pair string, price sdk.Dec) error {
+	if len(pair) == 0 || len(pair) > MaxPairLength {
+		return errorsmod.Wrapf(ErrInvalidPrice, "pair length must be between 1 and %d", MaxPairLength)
+	}
+	assets := strings.Split(pair, "/")
+	if len(assets) != 2 || assets[0] == "" || assets[1] == "" {
+		return errorsmod.Wrapf(ErrInvalidPrice, "pair %s must be formatted as BASE/QUOTE", pair)
+	}
+	if price.IsNil() || !price.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidPrice, "price of pair %s must be positive", pair)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgPostPrice message.
+func (m *MsgPostPrice) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Feeder)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgPostPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Feeder); err != nil {
+		return errorsmod.Wrap(err, "invalid feeder address")
+	}
+
+	return ValidatePrice(m.Pair, m.Price)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgPostPrice) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
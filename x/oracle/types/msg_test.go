@@ -0,0 +1,92 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+
+	feeder string
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) SetupTest() {
+	suite.feeder = sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes()).String()
+}
+
+func (suite *MsgsTestSuite) TestMsgPostPriceValidateBasic() {
+	price := sdk.MustNewDecFromStr("27000.5")
+
+	testCases := []struct {
+		name    string
+		msg     *MsgPostPrice
+		expPass bool
+	}{
+		{"fail - invalid feeder", &MsgPostPrice{Feeder: "invalid", Pair: "BTC/USD", Price: price}, false},
+		{"fail - empty pair", &MsgPostPrice{Feeder: suite.feeder, Price: price}, false},
+		{"fail - pair without quote", &MsgPostPrice{Feeder: suite.feeder, Pair: "BTC/", Price: price}, false},
+		{"fail - pair with three assets", &MsgPostPrice{Feeder: suite.feeder, Pair: "BTC/USD/EUR", Price: price}, false},
+		{"fail - long pair", &MsgPostPrice{Feeder: suite.feeder, Pair: strings.Repeat("B", MaxPairLength) + "/USD", Price: price}, false},
+		{"fail - nil price", &MsgPostPrice{Feeder: suite.feeder, Pair: "BTC/USD"}, false},
+		{"fail - zero price", &MsgPostPrice{Feeder: suite.feeder, Pair: "BTC/USD", Price: sdk.ZeroDec()}, false},
+		{"fail - negative price", &MsgPostPrice{Feeder: suite.feeder, Pair: "BTC/USD", Price: price.Neg()}, false},
+		{"pass", &MsgPostPrice{Feeder: suite.feeder, Pair: "BTC/USD", Price: price}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateParamsValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateParams
+		expPass bool
+	}{
+		{"fail - invalid authority", &MsgUpdateParams{Authority: "invalid", Params: DefaultParams()}, false},
+		{"fail - invalid feeder", &MsgUpdateParams{Authority: authority, Params: NewParams([]string{"invalid"}, "", DefaultPushGasLimit)}, false},
+		{"fail - duplicate feeder", &MsgUpdateParams{Authority: authority, Params: NewParams([]string{suite.feeder, suite.feeder}, "", DefaultPushGasLimit)}, false},
+		{"fail - invalid feed contract", &MsgUpdateParams{Authority: authority, Params: NewParams(nil, "0x1234", DefaultPushGasLimit)}, false},
+		{"fail - zero push gas limit", &MsgUpdateParams{Authority: authority, Params: NewParams(nil, "", 0)}, false},
+		{
+			"pass",
+			&MsgUpdateParams{
+				Authority: authority,
+				Params:    NewParams([]string{suite.feeder}, common.HexToAddress("0x2").Hex(), DefaultPushGasLimit),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
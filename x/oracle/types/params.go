@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultPushGasLimit is the default gas limit of a single price push
+var DefaultPushGasLimit = uint64(100_000)
+
+// NewParams creates a new Params instance
+func NewParams(feeders []string, feedContract string, pushGasLimit uint64) Params {
+	return Params{
+		Feeders:      feeders,
+		FeedContract: feedContract,
+		PushGasLimit: pushGasLimit,
+	}
+}
+
+// DefaultParams returns default oracle module parameters. No account is allowed to post
+// prices and no feed contract is set until governance configures them.
+func DefaultParams() Params {
+	return NewParams([]string{}, "", DefaultPushGasLimit)
+}
+
+// Validate performs basic validation on oracle parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Feeders))
+	for _, feeder := range p.Feeders {
+		if _, err := sdk.AccAddressFromBech32(feeder); err != nil {
+			return fmt.Errorf("invalid feeder address %s: %w", feeder, err)
+		}
+		if seen[feeder] {
+			return fmt.Errorf("duplicate feeder %s", feeder)
+		}
+		seen[feeder] = true
+	}
+
+	if p.FeedContract != "" && !common.IsHexAddress(p.FeedContract) {
+		return fmt.Errorf("invalid feed contract address %s", p.FeedContract)
+	}
+	if p.PushGasLimit == 0 {
+		return fmt.Errorf("push gas limit must be positive")
+	}
+
+	return nil
+}
+
+// IsFeeder returns true if the address is allowed to post prices
+func (p Params) IsFeeder(address string) bool {
+	for _, feeder := range p.Feeders {
+		if feeder == address {
+			return true
+		}
+	}
+
+	return false
+}
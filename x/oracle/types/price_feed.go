@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// priceFeedABIJSON is the interface the system price feed contract must implement. Prices
+// are pushed with 18 decimals and the block height they were posted at.
+const priceFeedABIJSON = `[
+	{"type":"function","name":"setPrice","stateMutability":"nonpayable","inputs":[{"name":"pair","type":"string"},{"name":"price","type":"uint256"},{"name":"height","type":"uint64"}],"outputs":[]}
+]`
+
+// PriceFeedABI is the ABI used to push prices to the price feed contract
+var PriceFeedABI abi.ABI
+
+func init() {
+	var err error
+	PriceFeedABI, err = abi.JSON(strings.NewReader(priceFeedABIJSON))
+	if err != nil {
+		panic(fmt.Errorf("failed to parse price feed contract ABI: %w", err))
+	}
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/SigmaGmbH/evm-module/x/scheduler/types"
+)
+
+// GetTxCmd returns the transaction commands for the scheduler module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		NewCreateScheduleCmd(),
+		NewCancelScheduleCmd(),
+	)
+	return cmd
+}
+
+// NewCreateScheduleCmd registers a recurring contract call paid by the sender
+func NewCreateScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create CONTRACT_ADDRESS CALLDATA_HEX GAS_LIMIT INTERVAL",
+		Short: "Register a contract call executed every INTERVAL blocks",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			calldata, err := hexutil.Decode(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid calldata: %w", err)
+			}
+
+			gasLimit, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid gas limit: %w", err)
+			}
+
+			interval, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid interval: %w", err)
+			}
+
+			msg := &types.MsgCreateSchedule{
+				Owner:    clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Calldata: calldata,
+				GasLimit: gasLimit,
+				Interval: interval,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelScheduleCmd cancels a schedule of the sender
+func NewCancelScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel ID",
+		Short: "Cancel a schedule owned by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id: %w", err)
+			}
+
+			msg := &types.MsgCancelSchedule{
+				Owner: clientCtx.GetFromAddress().String(),
+				Id:    id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
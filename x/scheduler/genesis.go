@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/SigmaGmbH/evm-module/x/scheduler/keeper"
+	"github.com/SigmaGmbH/evm-module/x/scheduler/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	err := k.SetParams(ctx, data.Params)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	for _, schedule := range data.Schedules {
+		k.SetSchedule(ctx, schedule)
+	}
+	k.SetNextScheduleID(ctx, data.NextScheduleId)
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the scheduler module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:         k.GetParams(ctx),
+		Schedules:      k.GetSchedules(ctx),
+		NextScheduleId: k.GetNextScheduleID(ctx),
+	}
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/scheduler/types"
+)
+
+// BeginBlock executes the scheduled calls due at the current height. The total gas limit of
+// the executed calls is bounded by the MaxBlockGas parameter, calls which don't fit in the
+// block stay queued and are executed first in the next block.
+func (k Keeper) BeginBlock(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	remaining := params.MaxBlockGas
+	height := ctx.BlockHeight()
+
+	for _, schedule := range k.GetDueSchedules(ctx, height) {
+		if schedule.GasLimit > params.MaxBlockGas {
+			k.cancelSchedule(ctx, schedule, "gas limit exceeds max block gas")
+			continue
+		}
+		if schedule.GasLimit > remaining {
+			continue
+		}
+
+		gasUsed, err := k.executeSchedule(ctx, schedule)
+		remaining -= gasUsed
+
+		if err != nil {
+			schedule.Failures++
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeScheduledCallErr,
+					sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyContract, schedule.Contract),
+					sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			k.Logger(ctx).Debug("scheduled call failed", "id", schedule.Id, "error", err)
+
+			if schedule.Failures >= params.MaxFailures {
+				k.cancelSchedule(ctx, schedule, "too many failures")
+				continue
+			}
+		} else {
+			schedule.Failures = 0
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeScheduledCall,
+					sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyContract, schedule.Contract),
+					sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+				),
+			)
+		}
+
+		schedule.NextHeight = height + int64(schedule.Interval)
+		k.SetSchedule(ctx, schedule)
+	}
+}
+
+// executeSchedule executes the scheduled call and charges the owner for the gas used.
+// Failed calls are charged the whole gas limit. The state changes of the call are
+// discarded if it fails or the owner can't pay for it. Returns the charged gas.
+func (k Keeper) executeSchedule(ctx sdk.Context, schedule types.Schedule) (uint64, error) {
+	owner := sdk.MustAccAddressFromBech32(schedule.Owner)
+	contract := common.HexToAddress(schedule.Contract)
+
+	cacheCtx, write := ctx.CacheContext()
+	res, _, callErr := k.evmKeeper.CallEVMWithData(
+		cacheCtx, common.BytesToAddress(owner), &contract, schedule.Calldata, schedule.GasLimit, true,
+	)
+
+	gasUsed := schedule.GasLimit
+	if callErr == nil {
+		gasUsed = res.GasUsed
+	}
+
+	if err := k.chargeGas(ctx, owner, gasUsed); err != nil {
+		return gasUsed, errorsmod.Wrap(err, "failed to pay for scheduled call")
+	}
+	if callErr != nil {
+		return gasUsed, callErr
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return gasUsed, nil
+}
+
+// chargeGas deducts the cost of the gas at the current base fee from the owner. Schedules
+// registered by governance are not charged.
+func (k Keeper) chargeGas(ctx sdk.Context, owner sdk.AccAddress, gasUsed uint64) error {
+	if owner.Equals(k.authority) {
+		return nil
+	}
+
+	baseFee := k.feeMarketKeeper.GetBaseFee(ctx)
+	if baseFee == nil || baseFee.Sign() == 0 {
+		return nil
+	}
+
+	fee := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasUsed))
+	fees := sdk.Coins{sdk.NewCoin(k.evmKeeper.GetParams(ctx).EvmDenom, sdkmath.NewIntFromBigInt(fee))}
+	return k.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(owner))
+}
+
+// cancelSchedule removes the schedule and emits the cancellation event
+func (k Keeper) cancelSchedule(ctx sdk.Context, schedule types.Schedule, reason string) {
+	k.DeleteSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, schedule.Owner),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	k.Logger(ctx).Info("schedule cancelled", "id", schedule.Id, "reason", reason)
+}
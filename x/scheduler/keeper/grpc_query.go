@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/SigmaGmbH/evm-module/x/scheduler/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// Schedule implements the Query/Schedule gRPC method
+func (k Keeper) Schedule(c context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule, found := k.GetSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "schedule %d not found", req.Id)
+	}
+
+	return &types.QueryScheduleResponse{
+		Schedule: schedule,
+	}, nil
+}
+
+// Schedules implements the Query/Schedules gRPC method
+func (k Keeper) Schedules(c context.Context, req *types.QuerySchedulesRequest) (*types.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSchedule)
+
+	var schedules []types.Schedule
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var schedule types.Schedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySchedulesResponse{
+		Schedules:  schedules,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/SigmaGmbH/evm-module/x/scheduler/types"
+)
+
+// Keeper grants access to the scheduler module state.
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// Store key required for the scheduler Prefix KVStore.
+	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	// Schedules owned by the authority don't pay for gas.
+	authority sdk.AccAddress
+
+	evmKeeper       types.EVMKeeper
+	feeMarketKeeper types.FeeMarketKeeper
+}
+
+// NewKeeper generates new scheduler module keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	storeKey storetypes.StoreKey,
+	evmKeeper types.EVMKeeper,
+	fmk types.FeeMarketKeeper,
+) Keeper {
+	// ensure authority account is correctly formatted
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:             cdc,
+		storeKey:        storeKey,
+		authority:       authority,
+		evmKeeper:       evmKeeper,
+		feeMarketKeeper: fmk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/SigmaGmbH/evm-module/app"
+	"github.com/SigmaGmbH/evm-module/encoding"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+	"github.com/SigmaGmbH/evm-module/x/scheduler/keeper"
+	"github.com/SigmaGmbH/evm-module/x/scheduler/types"
+)
+
+// mockEVMKeeper records the scheduled calls. Calls with the calldata 0xff revert,
+// successful calls use half of their gas limit.
+type mockEVMKeeper struct {
+	calls    []uint64
+	balances map[common.Address]int64
+}
+
+func (m *mockEVMKeeper) CallEVMWithData(
+	_ sdk.Context,
+	_ common.Address,
+	_ *common.Address,
+	data []byte,
+	gasLimit uint64,
+	_ bool,
+) (*evmtypes.MsgEthereumTxResponse, common.Address, error) {
+	m.calls = append(m.calls, gasLimit)
+	if len(data) > 0 && data[0] == 0xff {
+		return nil, common.Address{}, evmtypes.ErrVMExecution
+	}
+	return &evmtypes.MsgEthereumTxResponse{GasUsed: gasLimit / 2}, common.Address{}, nil
+}
+
+func (m *mockEVMKeeper) GetParams(_ sdk.Context) evmtypes.Params {
+	return evmtypes.DefaultParams()
+}
+
+func (m *mockEVMKeeper) DeductTxCostsFromUserBalance(_ sdk.Context, fees sdk.Coins, from common.Address) error {
+	amount := fees.AmountOf(evmtypes.DefaultEVMDenom).Int64()
+	if m.balances[from] < amount {
+		return errors.New("insufficient funds")
+	}
+	m.balances[from] -= amount
+	return nil
+}
+
+type mockFeeMarketKeeper struct{}
+
+func (mockFeeMarketKeeper) GetBaseFee(_ sdk.Context) *big.Int {
+	return big.NewInt(1)
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	evm       *mockEVMKeeper
+	owner     sdk.AccAddress
+	contract  string
+	authority string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	suite.ctx = testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test")).WithBlockHeight(1)
+
+	suite.owner = sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	suite.contract = common.HexToAddress("0x2000000000000000000000000000000000000002").Hex()
+	suite.evm = &mockEVMKeeper{
+		balances: map[common.Address]int64{common.BytesToAddress(suite.owner): 1_000_000},
+	}
+
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+	suite.authority = govAddress.String()
+	suite.keeper = keeper.NewKeeper(encCfg.Codec, govAddress, storeKey, suite.evm, mockFeeMarketKeeper{})
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.DefaultParams()))
+}
+
+func (suite *KeeperTestSuite) createSchedule(calldata []byte, gasLimit, interval uint64) uint64 {
+	res, err := suite.keeper.CreateSchedule(suite.ctx, &types.MsgCreateSchedule{
+		Owner:    suite.owner.String(),
+		Contract: suite.contract,
+		Calldata: calldata,
+		GasLimit: gasLimit,
+		Interval: interval,
+	})
+	suite.Require().NoError(err)
+	return res.Id
+}
+
+func (suite *KeeperTestSuite) TestCreateCancelSchedule() {
+	_, err := suite.keeper.CreateSchedule(suite.ctx, &types.MsgCreateSchedule{
+		Owner: suite.owner.String(), Contract: suite.contract, GasLimit: types.DefaultMaxBlockGas + 1, Interval: 1,
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidSchedule)
+
+	id := suite.createSchedule(nil, 100_000, 10)
+	suite.Require().Equal(uint64(1), id)
+	suite.Require().Equal(uint64(2), suite.keeper.GetNextScheduleID(suite.ctx))
+
+	schedule, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(11), schedule.NextHeight)
+
+	_, err = suite.keeper.CancelSchedule(suite.ctx, &types.MsgCancelSchedule{Owner: suite.authority, Id: id})
+	suite.Require().Error(err, "only the owner can cancel")
+
+	_, err = suite.keeper.CancelSchedule(suite.ctx, &types.MsgCancelSchedule{Owner: suite.owner.String(), Id: id})
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.keeper.GetDueSchedules(suite.ctx, 100))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockExecution() {
+	id := suite.createSchedule(nil, 100_000, 2)
+
+	suite.keeper.BeginBlock(suite.ctx.WithBlockHeight(2))
+	suite.Require().Empty(suite.evm.calls, "not due yet")
+
+	suite.keeper.BeginBlock(suite.ctx.WithBlockHeight(3))
+	suite.Require().Len(suite.evm.calls, 1)
+
+	schedule, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(5), schedule.NextHeight)
+	suite.Require().Equal(int64(1_000_000-50_000), suite.evm.balances[common.BytesToAddress(suite.owner)])
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockFailures() {
+	id := suite.createSchedule([]byte{0xff}, 100_000, 1)
+
+	for height := int64(2); height < 2+int64(types.DefaultMaxFailures); height++ {
+		suite.keeper.BeginBlock(suite.ctx.WithBlockHeight(height))
+	}
+
+	_, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found, "cancelled after max failures")
+	suite.Require().Len(suite.evm.calls, int(types.DefaultMaxFailures))
+	// failed calls pay for the whole gas limit
+	suite.Require().Equal(
+		int64(1_000_000-100_000*types.DefaultMaxFailures),
+		suite.evm.balances[common.BytesToAddress(suite.owner)],
+	)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockGasBudget() {
+	params := types.DefaultParams()
+	params.MaxBlockGas = 140_000
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	first := suite.createSchedule(nil, 100_000, 1)
+	second := suite.createSchedule(nil, 100_000, 1)
+
+	// the first call uses 50k gas, leaving less than the gas limit of the second one
+	suite.keeper.BeginBlock(suite.ctx.WithBlockHeight(2))
+	suite.Require().Len(suite.evm.calls, 1)
+
+	schedule, _ := suite.keeper.GetSchedule(suite.ctx, first)
+	suite.Require().Equal(int64(3), schedule.NextHeight)
+	schedule, _ = suite.keeper.GetSchedule(suite.ctx, second)
+	suite.Require().Equal(int64(2), schedule.NextHeight, "deferred to the next block")
+
+	suite.keeper.BeginBlock(suite.ctx.WithBlockHeight(3))
+	suite.Require().Len(suite.evm.calls, 2)
+	schedule, _ = suite.keeper.GetSchedule(suite.ctx, second)
+	suite.Require().Equal(int64(4), schedule.NextHeight)
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/scheduler/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateSchedule implements the gRPC MsgServer interface. It registers a recurring call
+// first executed in the block following the interval.
+func (k *Keeper) CreateSchedule(goCtx context.Context, msg *types.MsgCreateSchedule) (*types.MsgCreateScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if msg.GasLimit > params.MaxBlockGas {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSchedule, "gas limit %d exceeds max block gas %d", msg.GasLimit, params.MaxBlockGas)
+	}
+	if msg.Interval < params.MinInterval {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSchedule, "interval %d is lower than min interval %d", msg.Interval, params.MinInterval)
+	}
+
+	id := k.GetNextScheduleID(ctx)
+	k.SetNextScheduleID(ctx, id+1)
+
+	schedule := types.Schedule{
+		Id:         id,
+		Owner:      msg.Owner,
+		Contract:   common.HexToAddress(msg.Contract).Hex(),
+		Calldata:   msg.Calldata,
+		GasLimit:   msg.GasLimit,
+		Interval:   msg.Interval,
+		NextHeight: ctx.BlockHeight() + int64(msg.Interval),
+	}
+	k.SetSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, schedule.Owner),
+			sdk.NewAttribute(types.AttributeKeyContract, schedule.Contract),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+
+	return &types.MsgCreateScheduleResponse{Id: id}, nil
+}
+
+// CancelSchedule implements the gRPC MsgServer interface. Only the owner can cancel the schedule.
+func (k *Keeper) CancelSchedule(goCtx context.Context, msg *types.MsgCancelSchedule) (*types.MsgCancelScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule, found := k.GetSchedule(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrScheduleNotFound, "schedule %d", msg.Id)
+	}
+	if schedule.Owner != msg.Owner {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "schedule %d is owned by %s", msg.Id, schedule.Owner)
+	}
+
+	k.cancelSchedule(ctx, schedule, "cancelled by owner")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+
+	return &types.MsgCancelScheduleResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/SigmaGmbH/evm-module/x/scheduler/types"
+)
+
+// GetNextScheduleID returns the identifier assigned to the next schedule
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixNextScheduleID)
+	if len(bz) == 0 {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduleID stores the identifier assigned to the next schedule
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixNextScheduleID, sdk.Uint64ToBigEndian(id))
+}
+
+// SetSchedule stores the schedule and queues it for execution at its next height
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule types.Schedule) {
+	if old, found := k.GetSchedule(ctx, schedule.Id); found {
+		k.dequeue(ctx, old)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSchedule)
+	store.Set(types.ScheduleKey(schedule.Id), k.cdc.MustMarshal(&schedule))
+
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueue)
+	queue.Set(types.QueueKey(schedule.NextHeight, schedule.Id), sdk.Uint64ToBigEndian(schedule.Id))
+}
+
+// GetSchedule returns the schedule with the given identifier
+func (k Keeper) GetSchedule(ctx sdk.Context, id uint64) (types.Schedule, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSchedule)
+	bz := store.Get(types.ScheduleKey(id))
+	if len(bz) == 0 {
+		return types.Schedule{}, false
+	}
+
+	var schedule types.Schedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// DeleteSchedule removes the schedule and its queue entry
+func (k Keeper) DeleteSchedule(ctx sdk.Context, schedule types.Schedule) {
+	k.dequeue(ctx, schedule)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSchedule)
+	store.Delete(types.ScheduleKey(schedule.Id))
+}
+
+// GetSchedules returns all schedules ordered by identifier
+func (k Keeper) GetSchedules(ctx sdk.Context) []types.Schedule {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixSchedule)
+	defer iterator.Close()
+
+	schedules := []types.Schedule{}
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.Schedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+// GetDueSchedules returns the schedules due at or before the given height, in order of their
+// next height, so schedules deferred by the block gas limit keep their priority.
+func (k Keeper) GetDueSchedules(ctx sdk.Context, height int64) []types.Schedule {
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueue)
+	iterator := queue.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iterator.Close()
+
+	var schedules []types.Schedule
+	for ; iterator.Valid(); iterator.Next() {
+		schedule, found := k.GetSchedule(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if found {
+			schedules = append(schedules, schedule)
+		}
+	}
+
+	return schedules
+}
+
+func (k Keeper) dequeue(ctx sdk.Context, schedule types.Schedule) {
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueue)
+	queue.Delete(types.QueueKey(schedule.NextHeight, schedule.Id))
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrScheduleNotFound = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrInvalidSchedule
+)
+
+var (
+	// ErrScheduleNotFound returns an error if the schedule doesn't exist
+	ErrScheduleNotFound = errorsmod.Register(ModuleName, codeErrScheduleNotFound, "schedule not found")
+
+	// ErrInvalidSchedule returns an error if the schedule doesn't satisfy the module parameters
+	ErrInvalidSchedule = errorsmod.Register(ModuleName, codeErrInvalidSchedule, "invalid schedule")
+)
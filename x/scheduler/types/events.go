@@ -0,0 +1,16 @@
+package types
+
+// scheduler events
+const (
+	EventTypeCreateSchedule   = "create_schedule"
+	EventTypeCancelSchedule   = "cancel_schedule"
+	EventTypeScheduledCall    = "scheduled_call"
+	EventTypeScheduledCallErr = "scheduled_call_failed"
+
+	AttributeKeyScheduleID = "id"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyContract   = "contract"
+	AttributeKeyGasUsed    = "gasUsed"
+	AttributeKeyError      = "error"
+	AttributeKeyReason     = "reason"
+)
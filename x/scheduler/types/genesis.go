@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethermint "github.com/SigmaGmbH/evm-module/types"
+)
+
+// DefaultGenesisState sets default scheduler genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:         DefaultParams(),
+		Schedules:      []Schedule{},
+		NextScheduleId: 1,
+	}
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, schedules []Schedule, nextScheduleID uint64) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		Schedules:      schedules,
+		NextScheduleId: nextScheduleID,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[uint64]bool, len(gs.Schedules))
+	for _, schedule := range gs.Schedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if seen[schedule.Id] {
+			return fmt.Errorf("duplicate schedule %d", schedule.Id)
+		}
+		if schedule.Id >= gs.NextScheduleId {
+			return fmt.Errorf("schedule id %d must be lower than the next schedule id %d", schedule.Id, gs.NextScheduleId)
+		}
+		seen[schedule.Id] = true
+	}
+
+	return gs.Params.Validate()
+}
+
+// Validate performs a stateless validation of the schedule
+func (s Schedule) Validate() error {
+	if s.Id == 0 {
+		return fmt.Errorf("schedule id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %s: %w", s.Owner, err)
+	}
+	if err := ethermint.ValidateNonZeroAddress(s.Contract); err != nil {
+		return err
+	}
+	if s.GasLimit == 0 {
+		return fmt.Errorf("gas limit of schedule %d must be positive", s.Id)
+	}
+	if s.Interval == 0 {
+		return fmt.Errorf("interval of schedule %d must be positive", s.Id)
+	}
+
+	return nil
+}
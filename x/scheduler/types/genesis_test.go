@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	schedule := Schedule{
+		Id:         1,
+		Owner:      sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes()).String(),
+		Contract:   common.HexToAddress("0x2000000000000000000000000000000000000002").Hex(),
+		GasLimit:   100_000,
+		Interval:   10,
+		NextHeight: 10,
+	}
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid", NewGenesisState(DefaultParams(), []Schedule{schedule}, 2), true},
+		{"invalid params", NewGenesisState(NewParams(DefaultMaxBlockGas, 0, DefaultMaxFailures), nil, 1), false},
+		{"duplicate schedule", NewGenesisState(DefaultParams(), []Schedule{schedule, schedule}, 2), false},
+		{"id not lower than next id", NewGenesisState(DefaultParams(), []Schedule{schedule}, 1), false},
+		{
+			"zero gas limit",
+			NewGenesisState(DefaultParams(), []Schedule{func() Schedule {
+				s := schedule
+				s.GasLimit = 0
+				return s
+			}()}, 2),
+			false,
+		},
+		{
+			"invalid owner",
+			NewGenesisState(DefaultParams(), []Schedule{func() Schedule {
+				s := schedule
+				s.Owner = "invalid"
+				return s
+			}()}, 2),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
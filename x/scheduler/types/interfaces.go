@@ -0,0 +1,29 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+)
+
+// EVMKeeper defines the expected EVM keeper interface used to execute scheduled calls
+type EVMKeeper interface {
+	CallEVMWithData(
+		ctx sdk.Context,
+		from common.Address,
+		contract *common.Address,
+		data []byte,
+		gasLimit uint64,
+		commit bool,
+	) (*evmtypes.MsgEthereumTxResponse, common.Address, error)
+	GetParams(ctx sdk.Context) evmtypes.Params
+	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used to price scheduled calls
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
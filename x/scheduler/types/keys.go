@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "scheduler"
+
+	// StoreKey key for the scheduler store
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the scheduler persistent store
+const (
+	prefixSchedule = iota + 1
+	prefixQueue
+	prefixNextScheduleID
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixSchedule = []byte{prefixSchedule}
+	// KeyPrefixQueue indexes schedules by the height of their next execution
+	KeyPrefixQueue          = []byte{prefixQueue}
+	KeyPrefixNextScheduleID = []byte{prefixNextScheduleID}
+	KeyPrefixParams         = []byte{prefixParams}
+)
+
+// ScheduleKey returns the key under which the schedule is stored.
+func ScheduleKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// QueueKey returns the key under which the schedule is queued for execution at the given height.
+func QueueKey(height int64, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/SigmaGmbH/evm-module/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateSchedule{}
+	_ sdk.Msg = &MsgCancelSchedule{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// GetSigners returns the expected signers for a MsgCreateSchedule message.
+func (m *MsgCreateSchedule) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+	if err := ethermint.ValidateNonZeroAddress(m.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+	if m.GasLimit == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "gas limit must be positive")
+	}
+	if m.Interval == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "interval must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCancelSchedule message.
+func (m *MsgCancelSchedule) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCancelSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+	if m.Id == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "schedule id must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+
+	owner    string
+	contract string
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) SetupTest() {
+	suite.owner = sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes()).String()
+	suite.contract = common.HexToAddress("0x2000000000000000000000000000000000000002").Hex()
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateScheduleValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgCreateSchedule
+		expPass bool
+	}{
+		{"fail - invalid owner", &MsgCreateSchedule{Owner: "invalid", Contract: suite.contract, GasLimit: 100_000, Interval: 10}, false},
+		{"fail - zero contract", &MsgCreateSchedule{Owner: suite.owner, Contract: common.Address{}.Hex(), GasLimit: 100_000, Interval: 10}, false},
+		{"fail - zero gas limit", &MsgCreateSchedule{Owner: suite.owner, Contract: suite.contract, Interval: 10}, false},
+		{"fail - zero interval", &MsgCreateSchedule{Owner: suite.owner, Contract: suite.contract, GasLimit: 100_000}, false},
+		{"pass", &MsgCreateSchedule{Owner: suite.owner, Contract: suite.contract, Calldata: []byte{1}, GasLimit: 100_000, Interval: 10}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCancelScheduleValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgCancelSchedule
+		expPass bool
+	}{
+		{"fail - invalid owner", &MsgCancelSchedule{Owner: "invalid", Id: 1}, false},
+		{"fail - zero id", &MsgCancelSchedule{Owner: suite.owner}, false},
+		{"pass", &MsgCancelSchedule{Owner: suite.owner, Id: 1}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateParamsValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateParams
+		expPass bool
+	}{
+		{"fail - invalid authority", &MsgUpdateParams{Authority: "invalid", Params: DefaultParams()}, false},
+		{"fail - zero min interval", &MsgUpdateParams{Authority: authority, Params: NewParams(DefaultMaxBlockGas, 0, DefaultMaxFailures)}, false},
+		{"fail - zero max failures", &MsgUpdateParams{Authority: authority, Params: NewParams(DefaultMaxBlockGas, DefaultMinInterval, 0)}, false},
+		{"pass", &MsgUpdateParams{Authority: authority, Params: DefaultParams()}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
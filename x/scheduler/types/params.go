@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+var (
+	// DefaultMaxBlockGas is the default gas limit of all scheduled calls in a block
+	DefaultMaxBlockGas = uint64(5_000_000)
+	// DefaultMinInterval is the default minimum number of blocks between executions
+	DefaultMinInterval = uint64(1)
+	// DefaultMaxFailures is the default number of consecutive failures cancelling a schedule
+	DefaultMaxFailures = uint64(3)
+)
+
+// NewParams creates a new Params instance
+func NewParams(maxBlockGas, minInterval, maxFailures uint64) Params {
+	return Params{
+		MaxBlockGas: maxBlockGas,
+		MinInterval: minInterval,
+		MaxFailures: maxFailures,
+	}
+}
+
+// DefaultParams returns default scheduler module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultMaxBlockGas, DefaultMinInterval, DefaultMaxFailures)
+}
+
+// Validate performs basic validation on scheduler parameters.
+func (p Params) Validate() error {
+	if p.MinInterval == 0 {
+		return fmt.Errorf("min interval must be positive")
+	}
+	if p.MaxFailures == 0 {
+		return fmt.Errorf("max failures must be positive")
+	}
+
+	return nil
+}
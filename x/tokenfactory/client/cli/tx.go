@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/SigmaGmbH/evm-module/x/tokenfactory/types"
+)
+
+const flagConfidential = "confidential"
+
+// GetTxCmd returns the transaction commands for the token factory module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		NewCreateTokenCmd(),
+		NewMintCmd(),
+		NewBurnCmd(),
+	)
+	return cmd
+}
+
+// NewCreateTokenCmd creates a new token contract issued by the sender
+func NewCreateTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create NAME SYMBOL DECIMALS",
+		Short: "Deploy a new token issued by the sender",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			decimals, err := strconv.ParseUint(args[2], 10, 8)
+			if err != nil {
+				return fmt.Errorf("invalid decimals: %w", err)
+			}
+
+			confidential, err := cmd.Flags().GetBool(flagConfidential)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateToken{
+				Issuer:       clientCtx.GetFromAddress().String(),
+				Name:         args[0],
+				Symbol:       args[1],
+				Decimals:     uint32(decimals),
+				Confidential: confidential,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagConfidential, false, "hide token events and restrict balance queries to holders")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMintCmd mints tokens of the sender to the recipient
+func NewMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint CONTRACT_ADDRESS RECIPIENT AMOUNT",
+		Short: "Mint tokens to the recipient hex address",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[2])
+			}
+
+			msg := &types.MsgMint{
+				Issuer:          clientCtx.GetFromAddress().String(),
+				ContractAddress: args[0],
+				Recipient:       args[1],
+				Amount:          amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewBurnCmd burns tokens held by the sender
+func NewBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn CONTRACT_ADDRESS AMOUNT",
+		Short: "Burn tokens held by the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			msg := &types.MsgBurn{
+				Issuer:          clientCtx.GetFromAddress().String(),
+				ContractAddress: args[0],
+				Amount:          amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
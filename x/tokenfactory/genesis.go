@@ -0,0 +1,42 @@
+package tokenfactory
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/SigmaGmbH/evm-module/x/tokenfactory/keeper"
+	"github.com/SigmaGmbH/evm-module/x/tokenfactory/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	err := k.SetParams(ctx, data.Params)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	// ensure the module account, which owns the token contracts, is set
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the token factory module account has not been set")
+	}
+
+	for _, token := range data.Tokens {
+		k.SetToken(ctx, token)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the token factory module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Tokens: k.GetTokens(ctx),
+	}
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/SigmaGmbH/evm-module/x/tokenfactory/types"
+)
+
+const (
+	// createGasLimit is the gas limit for deploying token contracts
+	createGasLimit uint64 = 3_000_000
+	// callGasLimit is the gas limit for minting and burning tokens
+	callGasLimit uint64 = 200_000
+)
+
+// Keeper grants access to the token factory module state.
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// Store key required for the token factory Prefix KVStore.
+	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	accountKeeper types.AccountKeeper
+	evmKeeper     types.EVMKeeper
+}
+
+// NewKeeper generates new token factory module keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	storeKey storetypes.StoreKey,
+	ak types.AccountKeeper,
+	evmKeeper types.EVMKeeper,
+) Keeper {
+	// ensure authority account is correctly formatted
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		authority:     authority,
+		accountKeeper: ak,
+		evmKeeper:     evmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// ModuleAddress returns the EVM address of the module account, which deploys and owns
+// all token contracts
+func (k Keeper) ModuleAddress() common.Address {
+	return common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+}
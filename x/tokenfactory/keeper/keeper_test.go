@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/SigmaGmbH/evm-module/app"
+	"github.com/SigmaGmbH/evm-module/encoding"
+	evmtypes "github.com/SigmaGmbH/evm-module/x/evm/types"
+	"github.com/SigmaGmbH/evm-module/x/tokenfactory/keeper"
+	"github.com/SigmaGmbH/evm-module/x/tokenfactory/types"
+)
+
+// mockEVMKeeper executes token contracts in the go-ethereum EVM instead of SGXVM
+type mockEVMKeeper struct {
+	statedb *state.StateDB
+}
+
+func (m *mockEVMKeeper) CallEVMWithData(
+	_ sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	gasLimit uint64,
+	_ bool,
+) (*evmtypes.MsgEthereumTxResponse, common.Address, error) {
+	cfg := &runtime.Config{
+		ChainConfig: params.AllEthashProtocolChanges,
+		Origin:      from,
+		State:       m.statedb,
+		GasLimit:    gasLimit,
+	}
+
+	if contract == nil {
+		ret, addr, _, err := runtime.Create(data, cfg)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		return &evmtypes.MsgEthereumTxResponse{Ret: ret}, addr, nil
+	}
+
+	ret, _, err := runtime.Call(*contract, data, cfg)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	return &evmtypes.MsgEthereumTxResponse{Ret: ret}, common.Address{}, nil
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	evm       *mockEVMKeeper
+	issuer    sdk.AccAddress
+	authority string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	suite.ctx = testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	suite.Require().NoError(err)
+	suite.evm = &mockEVMKeeper{statedb: statedb}
+
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+	suite.authority = govAddress.String()
+	suite.keeper = keeper.NewKeeper(encCfg.Codec, govAddress, storeKey, nil, suite.evm)
+
+	suite.issuer = sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	_, err = suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: suite.authority,
+		Params:    types.NewParams([]string{suite.issuer.String()}),
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) balanceOf(contract, account common.Address) *big.Int {
+	input, err := types.ERC20ABI.Pack("balanceOf", account)
+	suite.Require().NoError(err)
+
+	// the module account owns the token, so it can read confidential balances too
+	res, _, err := suite.evm.CallEVMWithData(suite.ctx, suite.keeper.ModuleAddress(), &contract, input, 100_000, false)
+	suite.Require().NoError(err)
+
+	out, err := types.ERC20ABI.Unpack("balanceOf", res.Ret)
+	suite.Require().NoError(err)
+	return out[0].(*big.Int)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	_, err := suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: "foobar"})
+	suite.Require().Error(err)
+
+	_, err = suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: suite.authority,
+		Params:    types.DefaultParams(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.keeper.GetParams(suite.ctx).Issuers)
+}
+
+func (suite *KeeperTestSuite) TestCreateToken() {
+	other := sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes())
+	_, err := suite.keeper.CreateToken(suite.ctx, &types.MsgCreateToken{
+		Issuer: other.String(), Name: "Swiss Franc", Symbol: "SCHF", Decimals: 6,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedIssuer)
+
+	res, err := suite.keeper.CreateToken(suite.ctx, &types.MsgCreateToken{
+		Issuer: suite.issuer.String(), Name: "Swiss Franc", Symbol: "SCHF", Decimals: 6, Confidential: true,
+	})
+	suite.Require().NoError(err)
+
+	token, found := suite.keeper.GetToken(suite.ctx, common.HexToAddress(res.ContractAddress))
+	suite.Require().True(found)
+	suite.Require().Equal(suite.issuer.String(), token.Issuer)
+	suite.Require().True(token.Confidential)
+	suite.Require().True(token.Supply().IsZero())
+	suite.Require().Len(suite.keeper.GetTokens(suite.ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestMintBurn() {
+	res, err := suite.keeper.CreateToken(suite.ctx, &types.MsgCreateToken{
+		Issuer: suite.issuer.String(), Name: "Swiss Franc", Symbol: "SCHF", Decimals: 6,
+	})
+	suite.Require().NoError(err)
+	contract := common.HexToAddress(res.ContractAddress)
+	issuerAddress := common.BytesToAddress(suite.issuer)
+
+	_, err = suite.keeper.Mint(suite.ctx, &types.MsgMint{
+		Issuer:          suite.issuer.String(),
+		ContractAddress: common.HexToAddress("0x3").Hex(),
+		Recipient:       issuerAddress.Hex(),
+		Amount:          sdkmath.NewInt(100),
+	})
+	suite.Require().ErrorIs(err, types.ErrTokenNotFound)
+
+	_, err = suite.keeper.Mint(suite.ctx, &types.MsgMint{
+		Issuer:          suite.issuer.String(),
+		ContractAddress: contract.Hex(),
+		Recipient:       issuerAddress.Hex(),
+		Amount:          sdkmath.NewInt(100),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(100), suite.balanceOf(contract, issuerAddress).Int64())
+
+	_, err = suite.keeper.Burn(suite.ctx, &types.MsgBurn{
+		Issuer:          suite.issuer.String(),
+		ContractAddress: contract.Hex(),
+		Amount:          sdkmath.NewInt(101),
+	})
+	suite.Require().Error(err, "burn exceeds balance")
+
+	_, err = suite.keeper.Burn(suite.ctx, &types.MsgBurn{
+		Issuer:          suite.issuer.String(),
+		ContractAddress: contract.Hex(),
+		Amount:          sdkmath.NewInt(40),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(60), suite.balanceOf(contract, issuerAddress).Int64())
+
+	token, _ := suite.keeper.GetToken(suite.ctx, contract)
+	suite.Require().Equal(int64(100), token.TotalMinted.Int64())
+	suite.Require().Equal(int64(40), token.TotalBurned.Int64())
+
+	// revoked issuers can't mint anymore
+	_, err = suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: suite.authority, Params: types.DefaultParams()})
+	suite.Require().NoError(err)
+	_, err = suite.keeper.Mint(suite.ctx, &types.MsgMint{
+		Issuer:          suite.issuer.String(),
+		ContractAddress: contract.Hex(),
+		Recipient:       issuerAddress.Hex(),
+		Amount:          sdkmath.NewInt(1),
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedIssuer)
+}
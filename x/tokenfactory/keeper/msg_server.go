@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/tokenfactory/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateToken implements the gRPC MsgServer interface. It deploys a new token contract
+// if the signer is an authorized issuer.
+func (k *Keeper) CreateToken(goCtx context.Context, msg *types.MsgCreateToken) (*types.MsgCreateTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer := sdk.MustAccAddressFromBech32(msg.Issuer)
+
+	token, err := k.IssueToken(ctx, issuer, msg.Name, msg.Symbol, msg.Decimals, msg.Confidential)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateToken,
+			sdk.NewAttribute(types.AttributeKeyContract, token.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyIssuer, token.Issuer),
+			sdk.NewAttribute(types.AttributeKeySymbol, token.Symbol),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Issuer),
+		),
+	})
+
+	return &types.MsgCreateTokenResponse{ContractAddress: token.ContractAddress}, nil
+}
+
+// Mint implements the gRPC MsgServer interface. Only the issuer of the token can mint it.
+func (k *Keeper) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer := sdk.MustAccAddressFromBech32(msg.Issuer)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	if err := k.MintTokens(ctx, issuer, contract, common.HexToAddress(msg.Recipient), msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMint,
+			k.eventAttributes(ctx, contract,
+				sdk.NewAttribute(types.AttributeKeyRecipient, common.HexToAddress(msg.Recipient).Hex()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			)...,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Issuer),
+		),
+	})
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn implements the gRPC MsgServer interface. Only the issuer of the token can burn it,
+// tokens are burned from the EVM address of the issuer.
+func (k *Keeper) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer := sdk.MustAccAddressFromBech32(msg.Issuer)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	if err := k.BurnTokens(ctx, issuer, contract, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			k.eventAttributes(ctx, contract,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			)...,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Issuer),
+		),
+	})
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// eventAttributes returns the contract attribute followed by the private attributes,
+// which are omitted for confidential tokens
+func (k Keeper) eventAttributes(ctx sdk.Context, contract common.Address, private ...sdk.Attribute) []sdk.Attribute {
+	attrs := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyContract, contract.Hex())}
+	if token, found := k.GetToken(ctx, contract); found && !token.Confidential {
+		attrs = append(attrs, private...)
+	}
+
+	return attrs
+}
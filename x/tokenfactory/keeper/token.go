@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/SigmaGmbH/evm-module/x/tokenfactory/types"
+)
+
+// SetToken stores the issued token
+func (k Keeper) SetToken(ctx sdk.Context, token types.Token) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixToken)
+	store.Set(common.HexToAddress(token.ContractAddress).Bytes(), k.cdc.MustMarshal(&token))
+}
+
+// GetToken returns the token issued at the contract address
+func (k Keeper) GetToken(ctx sdk.Context, contract common.Address) (types.Token, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixToken)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.Token{}, false
+	}
+
+	var token types.Token
+	k.cdc.MustUnmarshal(bz, &token)
+	return token, true
+}
+
+// GetTokens returns all issued tokens
+func (k Keeper) GetTokens(ctx sdk.Context) []types.Token {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixToken)
+	defer iterator.Close()
+
+	tokens := []types.Token{}
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.Token
+		k.cdc.MustUnmarshal(iterator.Value(), &token)
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+// IssueToken deploys a new token contract owned by the module account and records the issuance
+func (k Keeper) IssueToken(
+	ctx sdk.Context,
+	issuer sdk.AccAddress,
+	name, symbol string,
+	decimals uint32,
+	confidential bool,
+) (types.Token, error) {
+	if !k.GetParams(ctx).IsIssuer(issuer.String()) {
+		return types.Token{}, errorsmod.Wrapf(types.ErrUnauthorizedIssuer, "%s is not allowed to issue tokens", issuer)
+	}
+
+	code, err := types.ERC20DeployCode(name, symbol, decimals, confidential)
+	if err != nil {
+		return types.Token{}, errorsmod.Wrap(types.ErrInvalidTokenMetadata, err.Error())
+	}
+
+	_, contract, err := k.evmKeeper.CallEVMWithData(ctx, k.ModuleAddress(), nil, code, createGasLimit, true)
+	if err != nil {
+		return types.Token{}, errorsmod.Wrap(err, "failed to deploy token contract")
+	}
+
+	token := types.Token{
+		ContractAddress: contract.Hex(),
+		Issuer:          issuer.String(),
+		Name:            name,
+		Symbol:          symbol,
+		Decimals:        decimals,
+		Confidential:    confidential,
+		TotalMinted:     sdkmath.ZeroInt(),
+		TotalBurned:     sdkmath.ZeroInt(),
+	}
+	k.SetToken(ctx, token)
+
+	k.Logger(ctx).Info("token created", "contract", token.ContractAddress, "issuer", token.Issuer, "symbol", symbol)
+
+	return token, nil
+}
+
+// MintTokens mints tokens to the recipient on behalf of the token issuer
+func (k Keeper) MintTokens(
+	ctx sdk.Context,
+	issuer sdk.AccAddress,
+	contract, recipient common.Address,
+	amount sdkmath.Int,
+) error {
+	token, err := k.issuerToken(ctx, issuer, contract)
+	if err != nil {
+		return err
+	}
+
+	if err := k.callToken(ctx, contract, "mint", recipient, amount.BigInt()); err != nil {
+		return err
+	}
+
+	token.TotalMinted = token.TotalMinted.Add(amount)
+	k.SetToken(ctx, token)
+
+	return nil
+}
+
+// BurnTokens burns tokens held by the token issuer
+func (k Keeper) BurnTokens(
+	ctx sdk.Context,
+	issuer sdk.AccAddress,
+	contract common.Address,
+	amount sdkmath.Int,
+) error {
+	token, err := k.issuerToken(ctx, issuer, contract)
+	if err != nil {
+		return err
+	}
+
+	if err := k.callToken(ctx, contract, "burn", common.BytesToAddress(issuer), amount.BigInt()); err != nil {
+		return err
+	}
+
+	token.TotalBurned = token.TotalBurned.Add(amount)
+	k.SetToken(ctx, token)
+
+	return nil
+}
+
+// issuerToken returns the token if the account issued it and is still an authorized issuer
+func (k Keeper) issuerToken(ctx sdk.Context, issuer sdk.AccAddress, contract common.Address) (types.Token, error) {
+	token, found := k.GetToken(ctx, contract)
+	if !found {
+		return types.Token{}, errorsmod.Wrapf(types.ErrTokenNotFound, "contract %s", contract)
+	}
+
+	if token.Issuer != issuer.String() || !k.GetParams(ctx).IsIssuer(issuer.String()) {
+		return types.Token{}, errorsmod.Wrapf(types.ErrUnauthorizedIssuer, "%s is not allowed to issue %s", issuer, token.Symbol)
+	}
+
+	return token, nil
+}
+
+// callToken calls the owner-only method of the token contract from the module account
+func (k Keeper) callToken(ctx sdk.Context, contract common.Address, method string, account common.Address, amount *big.Int) error {
+	data, err := types.ERC20ABI.Pack(method, account, amount)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := k.evmKeeper.CallEVMWithData(ctx, k.ModuleAddress(), &contract, data, callGasLimit, true); err != nil {
+		return errorsmod.Wrapf(err, "failed to %s %s", method, contract)
+	}
+
+	return nil
+}
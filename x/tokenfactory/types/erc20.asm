@@ -0,0 +1,571 @@
+;; Runtime code of the standardized ERC20 token deployed by the token factory module.
+;;
+;; Storage layout:
+;;   0                               total supply
+;;   1                               owner, the token factory module account
+;;   keccak256(account . 2)          balance of account
+;;   keccak256(spender . keccak256(owner . 3))   allowance
+;;
+;; Token metadata is appended to the runtime code after the data label:
+;;   word                            decimals
+;;   word                            confidential flag
+;;   word                            length of name ABI encoding
+;;   bytes                           name ABI encoding
+;;   bytes                           symbol ABI encoding, up to the end of code
+;;
+;; Confidential tokens don't emit Transfer and Approval events and only reveal
+;; balances and allowances to the involved accounts and to the owner.
+
+    CALLVALUE
+    JUMPI @revert
+    PUSH 0x04
+    CALLDATASIZE
+    LT
+    JUMPI @revert
+    PUSH 0
+    CALLDATALOAD
+    PUSH 0xe0
+    SHR
+
+    DUP1
+    PUSH 0x70a08231
+    EQ
+    JUMPI @balanceOf
+    DUP1
+    PUSH 0xa9059cbb
+    EQ
+    JUMPI @transfer
+    DUP1
+    PUSH 0x23b872dd
+    EQ
+    JUMPI @transferFrom
+    DUP1
+    PUSH 0x095ea7b3
+    EQ
+    JUMPI @approve
+    DUP1
+    PUSH 0xdd62ed3e
+    EQ
+    JUMPI @allowance
+    DUP1
+    PUSH 0x18160ddd
+    EQ
+    JUMPI @totalSupply
+    DUP1
+    PUSH 0x06fdde03
+    EQ
+    JUMPI @name
+    DUP1
+    PUSH 0x95d89b41
+    EQ
+    JUMPI @symbol
+    DUP1
+    PUSH 0x313ce567
+    EQ
+    JUMPI @decimals
+    DUP1
+    PUSH 0x8da5cb5b
+    EQ
+    JUMPI @owner
+    DUP1
+    PUSH 0x40c10f19
+    EQ
+    JUMPI @mint
+    DUP1
+    PUSH 0x9dc29fac
+    EQ
+    JUMPI @burn
+    JUMP @revert
+
+revert:
+    PUSH 0
+    DUP1
+    REVERT
+
+returnTrue:
+    PUSH 1
+    JUMP @returnWord
+
+;; stack: value
+returnWord:
+    PUSH 0
+    MSTORE
+    PUSH 0x20
+    PUSH 0
+    RETURN
+
+totalSupply:
+    PUSH 0
+    SLOAD
+    JUMP @returnWord
+
+owner:
+    PUSH 1
+    SLOAD
+    JUMP @returnWord
+
+decimals:
+    PUSH 0x20
+    PUSH 0x01
+    PUSH @data
+    ADD
+    PUSH 0
+    CODECOPY
+    PUSH 0
+    MLOAD
+    JUMP @returnWord
+
+name:
+    ;; name length
+    PUSH 0x20
+    PUSH 0x41
+    PUSH @data
+    ADD
+    PUSH 0
+    CODECOPY
+    PUSH 0
+    MLOAD
+    DUP1
+    PUSH 0x61
+    PUSH @data
+    ADD
+    PUSH 0
+    CODECOPY
+    PUSH 0
+    RETURN
+
+symbol:
+    ;; name length
+    PUSH 0x20
+    PUSH 0x41
+    PUSH @data
+    ADD
+    PUSH 0
+    CODECOPY
+    PUSH 0
+    MLOAD
+    ;; symbol offset
+    PUSH 0x61
+    PUSH @data
+    ADD
+    ADD
+    DUP1
+    CODESIZE
+    SUB
+    DUP1
+    SWAP2
+    PUSH 0
+    CODECOPY
+    PUSH 0
+    RETURN
+
+balanceOf:
+    PUSH 0x24
+    CALLDATASIZE
+    LT
+    JUMPI @revert
+    PUSH 0x04
+    CALLDATALOAD
+    DUP1
+    PUSH 0xa0
+    SHR
+    JUMPI @revert
+    ;; stack: account
+    PUSH 0x20
+    PUSH 0x21
+    PUSH @data
+    ADD
+    PUSH 0
+    CODECOPY
+    PUSH 0
+    MLOAD
+    ISZERO
+    JUMPI @balanceOfAllowed
+    DUP1
+    CALLER
+    EQ
+    JUMPI @balanceOfAllowed
+    PUSH 1
+    SLOAD
+    CALLER
+    EQ
+    JUMPI @balanceOfAllowed
+    JUMP @revert
+balanceOfAllowed:
+    PUSH 0
+    MSTORE
+    PUSH 2
+    PUSH 0x20
+    MSTORE
+    PUSH 0x40
+    PUSH 0
+    KECCAK256
+    SLOAD
+    JUMP @returnWord
+
+allowance:
+    PUSH 0x44
+    CALLDATASIZE
+    LT
+    JUMPI @revert
+    PUSH 0x24
+    CALLDATALOAD
+    DUP1
+    PUSH 0xa0
+    SHR
+    JUMPI @revert
+    PUSH 0x04
+    CALLDATALOAD
+    DUP1
+    PUSH 0xa0
+    SHR
+    JUMPI @revert
+    ;; stack: holder, spender
+    PUSH 0x20
+    PUSH 0x21
+    PUSH @data
+    ADD
+    PUSH 0
+    CODECOPY
+    PUSH 0
+    MLOAD
+    ISZERO
+    JUMPI @allowanceAllowed
+    DUP1
+    CALLER
+    EQ
+    JUMPI @allowanceAllowed
+    DUP2
+    CALLER
+    EQ
+    JUMPI @allowanceAllowed
+    PUSH 1
+    SLOAD
+    CALLER
+    EQ
+    JUMPI @allowanceAllowed
+    JUMP @revert
+allowanceAllowed:
+    PUSH @returnWordSload
+    SWAP2
+    SWAP1
+    ;; stack: holder, spender, return
+    JUMP @allowanceSlot
+returnWordSload:
+    SLOAD
+    JUMP @returnWord
+
+;; stack: holder, spender, return -> slot
+allowanceSlot:
+    PUSH 0
+    MSTORE
+    PUSH 3
+    PUSH 0x20
+    MSTORE
+    PUSH 0x40
+    PUSH 0
+    KECCAK256
+    PUSH 0x20
+    MSTORE
+    PUSH 0
+    MSTORE
+    PUSH 0x40
+    PUSH 0
+    KECCAK256
+    SWAP1
+    JUMP
+
+approve:
+    PUSH 0x44
+    CALLDATASIZE
+    LT
+    JUMPI @revert
+    PUSH 0x24
+    CALLDATALOAD
+    PUSH 0x04
+    CALLDATALOAD
+    DUP1
+    PUSH 0xa0
+    SHR
+    JUMPI @revert
+    ;; stack: spender, amount
+    DUP2
+    PUSH @approveStore
+    DUP3
+    CALLER
+    ;; stack: holder, spender, return, amount, spender, amount
+    JUMP @allowanceSlot
+approveStore:
+    SSTORE
+    ;; stack: spender, amount
+    PUSH 0x20
+    PUSH 0x21
+    PUSH @data
+    ADD
+    PUSH 0
+    CODECOPY
+    PUSH 0
+    MLOAD
+    JUMPI @returnTrue
+    DUP2
+    PUSH 0
+    MSTORE
+    CALLER
+    PUSH 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925
+    PUSH 0x20
+    PUSH 0
+    LOG3
+    JUMP @returnTrue
+
+transfer:
+    PUSH 0x44
+    CALLDATASIZE
+    LT
+    JUMPI @revert
+    PUSH @returnTrue
+    PUSH 0x24
+    CALLDATALOAD
+    PUSH 0x04
+    CALLDATALOAD
+    DUP1
+    PUSH 0xa0
+    SHR
+    JUMPI @revert
+    CALLER
+    ;; stack: from, to, amount, return
+    JUMP @doTransfer
+
+transferFrom:
+    PUSH 0x64
+    CALLDATASIZE
+    LT
+    JUMPI @revert
+    PUSH @returnTrue
+    PUSH 0x44
+    CALLDATALOAD
+    PUSH 0x24
+    CALLDATALOAD
+    DUP1
+    PUSH 0xa0
+    SHR
+    JUMPI @revert
+    PUSH 0x04
+    CALLDATALOAD
+    DUP1
+    PUSH 0xa0
+    SHR
+    JUMPI @revert
+    ;; stack: from, to, amount, return
+    PUSH @transferFromSpend
+    CALLER
+    DUP3
+    ;; stack: from, caller, return, from, to, amount, return
+    JUMP @allowanceSlot
+transferFromSpend:
+    ;; stack: slot, from, to, amount, return
+    DUP1
+    SLOAD
+    ;; unlimited allowance is not decreased
+    DUP1
+    NOT
+    ISZERO
+    JUMPI @transferFromUnlimited
+    ;; stack: allowance, slot, from, to, amount, return
+    DUP5
+    DUP2
+    LT
+    JUMPI @revert
+    DUP5
+    SWAP1
+    SUB
+    SWAP1
+    SSTORE
+    JUMP @doTransfer
+transferFromUnlimited:
+    POP
+    POP
+    JUMP @doTransfer
+
+;; stack: from, to, amount, return
+doTransfer:
+    DUP2
+    ISZERO
+    JUMPI @revert
+    ;; debit sender
+    DUP1
+    PUSH 0
+    MSTORE
+    PUSH 2
+    PUSH 0x20
+    MSTORE
+    PUSH 0x40
+    PUSH 0
+    KECCAK256
+    DUP1
+    SLOAD
+    ;; stack: balance, slot, from, to, amount, return
+    DUP5
+    DUP2
+    LT
+    JUMPI @revert
+    DUP5
+    SWAP1
+    SUB
+    SWAP1
+    SSTORE
+    ;; credit recipient
+    DUP2
+    PUSH 0
+    MSTORE
+    PUSH 2
+    PUSH 0x20
+    MSTORE
+    PUSH 0x40
+    PUSH 0
+    KECCAK256
+    DUP1
+    SLOAD
+    DUP5
+    ADD
+    SWAP1
+    SSTORE
+    JUMP @emitTransfer
+
+;; stack: from, to, amount, return
+emitTransfer:
+    PUSH 0x20
+    PUSH 0x21
+    PUSH @data
+    ADD
+    PUSH 0
+    CODECOPY
+    PUSH 0
+    MLOAD
+    JUMPI @emitTransferDone
+    DUP3
+    PUSH 0
+    MSTORE
+    DUP2
+    DUP2
+    PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+    PUSH 0x20
+    PUSH 0
+    LOG3
+emitTransferDone:
+    POP
+    POP
+    POP
+    JUMP
+
+;; stack: selector
+onlyOwner:
+    PUSH 1
+    SLOAD
+    CALLER
+    EQ
+    ISZERO
+    JUMPI @revert
+    PUSH 0x44
+    CALLDATASIZE
+    LT
+    JUMPI @revert
+    PUSH 0x24
+    CALLDATALOAD
+    PUSH 0x04
+    CALLDATALOAD
+    DUP1
+    PUSH 0xa0
+    SHR
+    JUMPI @revert
+    ;; stack: account, amount, selector
+    DUP3
+    PUSH 0x9dc29fac
+    EQ
+    JUMPI @doBurn
+
+;; stack: to, amount
+doMint:
+    DUP1
+    ISZERO
+    JUMPI @revert
+    ;; increase total supply, reverting on overflow
+    PUSH 0
+    SLOAD
+    DUP1
+    DUP4
+    ADD
+    DUP1
+    SWAP2
+    GT
+    JUMPI @revert
+    PUSH 0
+    SSTORE
+    ;; credit recipient
+    DUP1
+    PUSH 0
+    MSTORE
+    PUSH 2
+    PUSH 0x20
+    MSTORE
+    PUSH 0x40
+    PUSH 0
+    KECCAK256
+    DUP1
+    SLOAD
+    DUP4
+    ADD
+    SWAP1
+    SSTORE
+    PUSH @returnTrue
+    SWAP2
+    SWAP1
+    PUSH 0
+    ;; stack: from, to, amount, return
+    JUMP @emitTransfer
+
+;; stack: from, amount
+doBurn:
+    ;; debit holder
+    DUP1
+    PUSH 0
+    MSTORE
+    PUSH 2
+    PUSH 0x20
+    MSTORE
+    PUSH 0x40
+    PUSH 0
+    KECCAK256
+    DUP1
+    SLOAD
+    ;; stack: balance, slot, from, amount
+    DUP4
+    DUP2
+    LT
+    JUMPI @revert
+    DUP4
+    SWAP1
+    SUB
+    SWAP1
+    SSTORE
+    ;; decrease total supply, cannot underflow since it covers every balance
+    DUP2
+    PUSH 0
+    SLOAD
+    SUB
+    PUSH 0
+    SSTORE
+    PUSH @returnTrue
+    SWAP2
+    PUSH 0
+    SWAP1
+    SWAP2
+    ;; stack: from, to, amount, return
+    JUMP @emitTransfer
+
+mint:
+    JUMP @onlyOwner
+
+burn:
+    JUMP @onlyOwner
+
+data:
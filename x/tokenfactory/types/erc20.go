@@ -0,0 +1,111 @@
+package types
+
+import (
+	// embed token contract source
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// erc20ABIJSON is the ABI of the standardized token contract
+const erc20ABIJSON = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"allowance","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"mint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"burn","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Approval","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},{"name":"spender","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+var (
+	//go:embed erc20.asm
+	erc20Source []byte
+
+	// erc20RuntimeCode is the runtime code of the token contract without metadata
+	erc20RuntimeCode []byte
+
+	// ERC20ABI is the ABI of the token contracts deployed by the module
+	ERC20ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ERC20ABI, err = abi.JSON(strings.NewReader(erc20ABIJSON))
+	if err != nil {
+		panic(fmt.Errorf("failed to parse token contract ABI: %w", err))
+	}
+
+	compiler := asm.NewCompiler(false)
+	compiler.Feed(asm.Lex(erc20Source, false))
+	code, errs := compiler.Compile()
+	if len(errs) > 0 {
+		panic(fmt.Errorf("failed to compile token contract: %v", errs))
+	}
+
+	erc20RuntimeCode, err = hex.DecodeString(code)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ERC20DeployCode returns the init code deploying the token contract with the given metadata.
+// The metadata is appended to the runtime code, the deployer becomes the owner of the token.
+func ERC20DeployCode(name, symbol string, decimals uint32, confidential bool) ([]byte, error) {
+	stringArgs := abi.Arguments{{Type: ERC20ABI.Methods["name"].Outputs[0].Type}}
+
+	nameEnc, err := stringArgs.Pack(name)
+	if err != nil {
+		return nil, err
+	}
+	symbolEnc, err := stringArgs.Pack(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var confidentialFlag int64
+	if confidential {
+		confidentialFlag = 1
+	}
+
+	runtime := make([]byte, 0, len(erc20RuntimeCode)+3*common.HashLength+len(nameEnc)+len(symbolEnc))
+	runtime = append(runtime, erc20RuntimeCode...)
+	runtime = append(runtime, common.BigToHash(big.NewInt(int64(decimals))).Bytes()...)
+	runtime = append(runtime, common.BigToHash(big.NewInt(confidentialFlag)).Bytes()...)
+	runtime = append(runtime, common.BigToHash(big.NewInt(int64(len(nameEnc)))).Bytes()...)
+	runtime = append(runtime, nameEnc...)
+	runtime = append(runtime, symbolEnc...)
+
+	if len(runtime) > 0xffff {
+		return nil, fmt.Errorf("token metadata is too long")
+	}
+
+	// constructor stores the deployer as owner and returns the runtime code following it
+	constructor := []byte{
+		byte(vm.CALLER), byte(vm.PUSH1), 0x01, byte(vm.SSTORE),
+		byte(vm.PUSH2), byte(len(runtime) >> 8), byte(len(runtime)),
+		byte(vm.DUP1),
+		byte(vm.PUSH2), 0x00, 0x00,
+		byte(vm.PUSH1), 0x00,
+		byte(vm.CODECOPY),
+		byte(vm.PUSH1), 0x00,
+		byte(vm.RETURN),
+	}
+	constructor[10] = byte(len(constructor))
+
+	return append(constructor, runtime...), nil
+}
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	ownerAddress = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	aliceAddress = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	bobAddress   = common.HexToAddress("0x3000000000000000000000000000000000000003")
+)
+
+type ERC20TestSuite struct {
+	suite.Suite
+
+	statedb  *state.StateDB
+	contract common.Address
+}
+
+func TestERC20TestSuite(t *testing.T) {
+	suite.Run(t, new(ERC20TestSuite))
+}
+
+// deploy deploys the token contract to the in-memory go-ethereum EVM
+func (suite *ERC20TestSuite) deploy(confidential bool) {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	suite.Require().NoError(err)
+	suite.statedb = statedb
+
+	code, err := ERC20DeployCode("Swiss Franc", "SCHF", 6, confidential)
+	suite.Require().NoError(err)
+
+	_, suite.contract, _, err = runtime.Create(code, suite.config(ownerAddress))
+	suite.Require().NoError(err)
+}
+
+func (suite *ERC20TestSuite) config(origin common.Address) *runtime.Config {
+	return &runtime.Config{
+		ChainConfig: params.AllEthashProtocolChanges,
+		Origin:      origin,
+		State:       suite.statedb,
+		GasLimit:    10_000_000,
+	}
+}
+
+// call calls the token contract method and unpacks the first output
+func (suite *ERC20TestSuite) call(from common.Address, method string, args ...interface{}) (interface{}, error) {
+	input, err := ERC20ABI.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	ret, _, err := runtime.Call(suite.contract, input, suite.config(from))
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := ERC20ABI.Unpack(method, ret)
+	suite.Require().NoError(err)
+	return outputs[0], nil
+}
+
+func (suite *ERC20TestSuite) mustCall(from common.Address, method string, args ...interface{}) interface{} {
+	out, err := suite.call(from, method, args...)
+	suite.Require().NoError(err, method)
+	return out
+}
+
+func (suite *ERC20TestSuite) balance(account common.Address) int64 {
+	return suite.mustCall(ownerAddress, "balanceOf", account).(*big.Int).Int64()
+}
+
+func (suite *ERC20TestSuite) TestMetadata() {
+	suite.deploy(false)
+
+	suite.Require().Equal("Swiss Franc", suite.mustCall(aliceAddress, "name"))
+	suite.Require().Equal("SCHF", suite.mustCall(aliceAddress, "symbol"))
+	suite.Require().Equal(uint8(6), suite.mustCall(aliceAddress, "decimals"))
+	suite.Require().Equal(ownerAddress, suite.mustCall(aliceAddress, "owner"))
+	suite.Require().Equal(int64(0), suite.mustCall(aliceAddress, "totalSupply").(*big.Int).Int64())
+}
+
+func (suite *ERC20TestSuite) TestMintBurn() {
+	suite.deploy(false)
+
+	_, err := suite.call(aliceAddress, "mint", aliceAddress, big.NewInt(100))
+	suite.Require().Error(err, "only owner can mint")
+
+	suite.Require().Equal(true, suite.mustCall(ownerAddress, "mint", aliceAddress, big.NewInt(100)))
+	suite.Require().Equal(int64(100), suite.balance(aliceAddress))
+	suite.Require().Equal(int64(100), suite.mustCall(aliceAddress, "totalSupply").(*big.Int).Int64())
+
+	logs := suite.statedb.Logs()
+	suite.Require().Len(logs, 1)
+	suite.Require().Equal(ERC20ABI.Events["Transfer"].ID, logs[0].Topics[0])
+	suite.Require().Equal(common.Hash{}, logs[0].Topics[1])
+	suite.Require().Equal(common.BytesToHash(aliceAddress.Bytes()), logs[0].Topics[2])
+
+	_, err = suite.call(ownerAddress, "mint", aliceAddress, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+	suite.Require().Error(err, "total supply overflow")
+
+	_, err = suite.call(aliceAddress, "burn", aliceAddress, big.NewInt(10))
+	suite.Require().Error(err, "only owner can burn")
+
+	_, err = suite.call(ownerAddress, "burn", aliceAddress, big.NewInt(101))
+	suite.Require().Error(err, "burn exceeds balance")
+
+	suite.Require().Equal(true, suite.mustCall(ownerAddress, "burn", aliceAddress, big.NewInt(40)))
+	suite.Require().Equal(int64(60), suite.balance(aliceAddress))
+	suite.Require().Equal(int64(60), suite.mustCall(aliceAddress, "totalSupply").(*big.Int).Int64())
+}
+
+func (suite *ERC20TestSuite) TestTransfer() {
+	suite.deploy(false)
+	suite.mustCall(ownerAddress, "mint", aliceAddress, big.NewInt(100))
+
+	_, err := suite.call(aliceAddress, "transfer", bobAddress, big.NewInt(101))
+	suite.Require().Error(err, "transfer exceeds balance")
+
+	_, err = suite.call(aliceAddress, "transfer", common.Address{}, big.NewInt(1))
+	suite.Require().Error(err, "transfer to zero address")
+
+	suite.Require().Equal(true, suite.mustCall(aliceAddress, "transfer", bobAddress, big.NewInt(30)))
+	suite.Require().Equal(int64(70), suite.balance(aliceAddress))
+	suite.Require().Equal(int64(30), suite.balance(bobAddress))
+
+	suite.Require().Equal(true, suite.mustCall(aliceAddress, "transfer", aliceAddress, big.NewInt(70)))
+	suite.Require().Equal(int64(70), suite.balance(aliceAddress))
+}
+
+func (suite *ERC20TestSuite) TestTransferFrom() {
+	suite.deploy(false)
+	suite.mustCall(ownerAddress, "mint", aliceAddress, big.NewInt(100))
+
+	_, err := suite.call(bobAddress, "transferFrom", aliceAddress, bobAddress, big.NewInt(1))
+	suite.Require().Error(err, "no allowance")
+
+	suite.Require().Equal(true, suite.mustCall(aliceAddress, "approve", bobAddress, big.NewInt(50)))
+	suite.Require().Equal(int64(50), suite.mustCall(aliceAddress, "allowance", aliceAddress, bobAddress).(*big.Int).Int64())
+
+	suite.Require().Equal(true, suite.mustCall(bobAddress, "transferFrom", aliceAddress, bobAddress, big.NewInt(20)))
+	suite.Require().Equal(int64(30), suite.mustCall(aliceAddress, "allowance", aliceAddress, bobAddress).(*big.Int).Int64())
+	suite.Require().Equal(int64(80), suite.balance(aliceAddress))
+	suite.Require().Equal(int64(20), suite.balance(bobAddress))
+
+	_, err = suite.call(bobAddress, "transferFrom", aliceAddress, bobAddress, big.NewInt(31))
+	suite.Require().Error(err, "transfer exceeds allowance")
+
+	// unlimited allowance is not decreased
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	suite.mustCall(aliceAddress, "approve", bobAddress, maxUint256)
+	suite.mustCall(bobAddress, "transferFrom", aliceAddress, bobAddress, big.NewInt(10))
+	suite.Require().Equal(maxUint256, suite.mustCall(aliceAddress, "allowance", aliceAddress, bobAddress))
+}
+
+func (suite *ERC20TestSuite) TestConfidential() {
+	suite.deploy(true)
+	suite.mustCall(ownerAddress, "mint", aliceAddress, big.NewInt(100))
+	suite.mustCall(aliceAddress, "approve", bobAddress, big.NewInt(10))
+	suite.mustCall(aliceAddress, "transfer", bobAddress, big.NewInt(10))
+
+	suite.Require().Empty(suite.statedb.Logs(), "confidential token must not emit events")
+
+	suite.Require().Equal(int64(90), suite.mustCall(aliceAddress, "balanceOf", aliceAddress).(*big.Int).Int64())
+	suite.Require().Equal(int64(90), suite.mustCall(ownerAddress, "balanceOf", aliceAddress).(*big.Int).Int64())
+	_, err := suite.call(bobAddress, "balanceOf", aliceAddress)
+	suite.Require().Error(err, "balance is visible only to the holder and owner")
+
+	suite.Require().Equal(int64(10), suite.mustCall(bobAddress, "allowance", aliceAddress, bobAddress).(*big.Int).Int64())
+	_, err = suite.call(common.HexToAddress("0x4"), "allowance", aliceAddress, bobAddress)
+	suite.Require().Error(err, "allowance is visible only to the involved accounts and owner")
+}
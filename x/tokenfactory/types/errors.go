@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrUnauthorizedIssuer = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrTokenNotFound
+	codeErrInvalidTokenMetadata
+)
+
+var (
+	// ErrUnauthorizedIssuer returns an error if the account is not allowed to issue the token
+	ErrUnauthorizedIssuer = errorsmod.Register(ModuleName, codeErrUnauthorizedIssuer, "unauthorized token issuer")
+
+	// ErrTokenNotFound returns an error if the token was not issued through the module
+	ErrTokenNotFound = errorsmod.Register(ModuleName, codeErrTokenNotFound, "token not found")
+
+	// ErrInvalidTokenMetadata returns an error if the token name, symbol or decimals are invalid
+	ErrInvalidTokenMetadata = errorsmod.Register(ModuleName, codeErrInvalidTokenMetadata, "invalid token metadata")
+)
@@ -0,0 +1,13 @@
+package types
+
+// token factory events
+const (
+	EventTypeCreateToken = "create_token"
+	EventTypeMint        = "mint_token"
+	EventTypeBurn        = "burn_token"
+
+	AttributeKeyContract  = "contract"
+	AttributeKeyIssuer    = "issuer"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeySymbol    = "symbol"
+)
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethermint "github.com/SigmaGmbH/evm-module/types"
+)
+
+// DefaultGenesisState sets default token factory genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Tokens: []Token{},
+	}
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, tokens []Token) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Tokens: tokens,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Tokens))
+	for _, token := range gs.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		if seen[token.ContractAddress] {
+			return fmt.Errorf("duplicate token %s", token.ContractAddress)
+		}
+		seen[token.ContractAddress] = true
+	}
+
+	return gs.Params.Validate()
+}
+
+// Validate performs a stateless validation of the token
+func (t Token) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(t.ContractAddress); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Issuer); err != nil {
+		return fmt.Errorf("invalid issuer address %s: %w", t.Issuer, err)
+	}
+	if err := ValidateTokenMetadata(t.Name, t.Symbol, t.Decimals); err != nil {
+		return err
+	}
+	if t.TotalMinted.IsNil() || t.TotalMinted.IsNegative() {
+		return fmt.Errorf("invalid total minted amount of token %s", t.ContractAddress)
+	}
+	if t.TotalBurned.IsNil() || t.TotalBurned.IsNegative() || t.TotalBurned.GT(t.TotalMinted) {
+		return fmt.Errorf("invalid total burned amount of token %s", t.ContractAddress)
+	}
+
+	return nil
+}
+
+// Supply returns the circulating supply of the token
+func (t Token) Supply() sdk.Int {
+	return t.TotalMinted.Sub(t.TotalBurned)
+}
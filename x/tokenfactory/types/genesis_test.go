@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	issuer := sdk.AccAddress(bobAddress.Bytes()).String()
+	token := Token{
+		ContractAddress: aliceAddress.Hex(),
+		Issuer:          issuer,
+		Name:            "Swiss Franc",
+		Symbol:          "SCHF",
+		Decimals:        6,
+		TotalMinted:     sdkmath.NewInt(100),
+		TotalBurned:     sdkmath.NewInt(40),
+	}
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid", NewGenesisState(NewParams([]string{issuer}), []Token{token}), true},
+		{"invalid issuer param", NewGenesisState(NewParams([]string{"invalid"}), nil), false},
+		{"duplicate token", NewGenesisState(DefaultParams(), []Token{token, token}), false},
+		{
+			"burned exceeds minted",
+			NewGenesisState(DefaultParams(), []Token{func() Token {
+				t := token
+				t.TotalBurned = sdkmath.NewInt(101)
+				return t
+			}()}),
+			false,
+		},
+		{
+			"invalid contract address",
+			NewGenesisState(DefaultParams(), []Token{func() Token {
+				t := token
+				t.ContractAddress = "0x"
+				return t
+			}()}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+	require.Equal(t, int64(60), token.Supply().Int64())
+}
@@ -0,0 +1,24 @@
+package types
+
+const (
+	// ModuleName string name of module
+	ModuleName = "tokenfactory"
+
+	// StoreKey key for the token factory store
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the token factory persistent store
+const (
+	prefixToken = iota + 1
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixToken  = []byte{prefixToken}
+	KeyPrefixParams = []byte{prefixParams}
+)
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/SigmaGmbH/evm-module/types"
+)
+
+const (
+	// MaxNameLength is the maximum length of the token name
+	MaxNameLength = 128
+	// MaxSymbolLength is the maximum length of the token symbol
+	MaxSymbolLength = 32
+)
+
+var (
+	_ sdk.Msg = &MsgCreateToken{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// ValidateTokenMetadata returns an error if the token name, symbol or decimals are invalid
+func ValidateTokenMetadata(name, symbol string, decimals uint32) error {
+	if len(name) == 0 || len(name) > MaxNameLength {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "name length must be between 1 and %d", MaxNameLength)
+	}
+	if len(symbol) == 0 || len(symbol) > MaxSymbolLength {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "symbol length must be between 1 and %d", MaxSymbolLength)
+	}
+	if decimals > math.MaxUint8 {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "decimals must not exceed %d", math.MaxUint8)
+	}
+
+	return nil
+}
+
+func validateAmount(amount sdk.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "amount must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgCreateToken message.
+func (m *MsgCreateToken) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return errorsmod.Wrap(err, "invalid issuer address")
+	}
+
+	return ValidateTokenMetadata(m.Name, m.Symbol, m.Decimals)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgMint message.
+func (m *MsgMint) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return errorsmod.Wrap(err, "invalid issuer address")
+	}
+	if err := ethermint.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+	if err := ethermint.ValidateNonZeroAddress(m.Recipient); err != nil {
+		return errorsmod.Wrap(err, "invalid recipient address")
+	}
+
+	return validateAmount(m.Amount)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgBurn message.
+func (m *MsgBurn) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return errorsmod.Wrap(err, "invalid issuer address")
+	}
+	if err := ethermint.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	return validateAmount(m.Amount)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+
+	issuer   string
+	contract string
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) SetupTest() {
+	suite.issuer = sdk.AccAddress(bobAddress.Bytes()).String()
+	suite.contract = aliceAddress.Hex()
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateTokenValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgCreateToken
+		expPass bool
+	}{
+		{"fail - invalid issuer", &MsgCreateToken{Issuer: "invalid", Name: "Swiss Franc", Symbol: "SCHF"}, false},
+		{"fail - empty name", &MsgCreateToken{Issuer: suite.issuer, Symbol: "SCHF"}, false},
+		{"fail - empty symbol", &MsgCreateToken{Issuer: suite.issuer, Name: "Swiss Franc"}, false},
+		{"fail - long symbol", &MsgCreateToken{Issuer: suite.issuer, Name: "Swiss Franc", Symbol: strings.Repeat("S", MaxSymbolLength+1)}, false},
+		{"fail - decimals overflow", &MsgCreateToken{Issuer: suite.issuer, Name: "Swiss Franc", Symbol: "SCHF", Decimals: 256}, false},
+		{"pass", &MsgCreateToken{Issuer: suite.issuer, Name: "Swiss Franc", Symbol: "SCHF", Decimals: 6, Confidential: true}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgMintValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgMint
+		expPass bool
+	}{
+		{"fail - invalid issuer", &MsgMint{Issuer: "invalid", ContractAddress: suite.contract, Recipient: suite.contract, Amount: sdkmath.OneInt()}, false},
+		{"fail - invalid contract", &MsgMint{Issuer: suite.issuer, ContractAddress: "0x1", Recipient: suite.contract, Amount: sdkmath.OneInt()}, false},
+		{"fail - zero recipient", &MsgMint{Issuer: suite.issuer, ContractAddress: suite.contract, Recipient: "0x0000000000000000000000000000000000000000", Amount: sdkmath.OneInt()}, false},
+		{"fail - zero amount", &MsgMint{Issuer: suite.issuer, ContractAddress: suite.contract, Recipient: suite.contract, Amount: sdkmath.ZeroInt()}, false},
+		{"pass", &MsgMint{Issuer: suite.issuer, ContractAddress: suite.contract, Recipient: suite.contract, Amount: sdkmath.OneInt()}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgBurnValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgBurn
+		expPass bool
+	}{
+		{"fail - invalid issuer", &MsgBurn{Issuer: "invalid", ContractAddress: suite.contract, Amount: sdkmath.OneInt()}, false},
+		{"fail - invalid contract", &MsgBurn{Issuer: suite.issuer, ContractAddress: "contract", Amount: sdkmath.OneInt()}, false},
+		{"fail - negative amount", &MsgBurn{Issuer: suite.issuer, ContractAddress: suite.contract, Amount: sdkmath.NewInt(-1)}, false},
+		{"pass", &MsgBurn{Issuer: suite.issuer, ContractAddress: suite.contract, Amount: sdkmath.OneInt()}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
+	testCases := []struct {
+		name      string
+		msgUpdate *MsgUpdateParams
+		expPass   bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUpdateParams{
+				Authority: "invalid",
+				Params:    DefaultParams(),
+			},
+			false,
+		},
+		{
+			"fail - duplicate issuer",
+			&MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    NewParams([]string{suite.issuer, suite.issuer}),
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    NewParams([]string{suite.issuer}),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msgUpdate.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance
+func NewParams(issuers []string) Params {
+	return Params{
+		Issuers: issuers,
+	}
+}
+
+// DefaultParams returns default token factory module parameters, no account is allowed to
+// issue tokens until governance adds it.
+func DefaultParams() Params {
+	return Params{
+		Issuers: []string{},
+	}
+}
+
+// Validate performs basic validation on token factory parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Issuers))
+	for _, issuer := range p.Issuers {
+		if _, err := sdk.AccAddressFromBech32(issuer); err != nil {
+			return fmt.Errorf("invalid issuer address %s: %w", issuer, err)
+		}
+		if seen[issuer] {
+			return fmt.Errorf("duplicate issuer %s", issuer)
+		}
+		seen[issuer] = true
+	}
+
+	return nil
+}
+
+// IsIssuer returns true if the address is allowed to issue tokens
+func (p Params) IsIssuer(address string) bool {
+	for _, issuer := range p.Issuers {
+		if issuer == address {
+			return true
+		}
+	}
+
+	return false
+}